@@ -0,0 +1,378 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"sync"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+	"agendamento-backend/internal/infrastructure/adapters"
+	"agendamento-backend/internal/infrastructure/config"
+	"agendamento-backend/internal/infrastructure/email"
+	infraRepositories "agendamento-backend/internal/infrastructure/repositories"
+	"agendamento-backend/internal/infrastructure/scheduler"
+	"agendamento-backend/internal/infrastructure/tenancy"
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+	"agendamento-backend/internal/interfaces/http/routes"
+	dashboardRoutes "agendamento-backend/internal/interfaces/routes"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// appDeps reúne o que é compartilhado entre os tenants
+type appDeps struct {
+	config              *config.Config
+	emailConfig         *email.Config
+	passwordHasher      ports.PasswordHasher
+	validator           ports.Validator
+	logger              ports.Logger
+	timeService         ports.TimeService
+	presenceTokenSigner ports.TokenSigner
+	penaltySettings     entities.PenaltySettings
+	loginProtection     entities.LoginProtectionSettings
+}
+
+// emailServiceFor cria o serviço de email com os links apontando para o endereço do tenant
+func (d *appDeps) emailServiceFor(appURL string) repositories.EmailRepository {
+	if d.emailConfig == nil {
+		return email.NewEmailService(nil)
+	}
+	tenantConfig := *d.emailConfig
+	tenantConfig.AppURL = appURL
+	return email.NewEmailService(&tenantConfig)
+}
+
+// tenantApp é a aplicação de um tenant: todos os repositórios recebem a conexão
+// restrita ao tenant, e o router só aceita tokens emitidos para ele
+type tenantApp struct {
+	router      *gin.Engine
+	scheduler   *scheduler.Scheduler
+	userUseCase *usecases.UserUseCase
+}
+
+// newTenantApp monta repositórios, casos de uso, handlers, rotas e scheduler do tenant
+func newTenantApp(db *gorm.DB, tenant *entities.Tenant, deps *appDeps) *tenantApp {
+	appConfig := deps.config
+	appURL := appConfig.Tenancy.AppURL(appConfig.Server.AppURL, tenant.Slug)
+
+	// Inicializar repositórios
+	userRepo := infraRepositories.NewUserRepository(db)
+	chairRepo := infraRepositories.NewChairRepository(db)
+	bookingRepo := infraRepositories.NewBookingRepository(db)
+	availabilityRepo := infraRepositories.NewAvailabilityRepository(db)
+	auditLogRepo := infraRepositories.NewAuditLogRepository(db)
+	serviceRepo := infraRepositories.NewServiceRepository(db)
+	waitlistRepo := infraRepositories.NewWaitlistRepository(db)
+	policyRepo := infraRepositories.NewBookingPolicyRepository(db)
+	penaltyRepo := infraRepositories.NewPenaltyRepository(db)
+	slotHoldRepo := infraRepositories.NewSlotHoldRepository(db)
+	presenceRepo := infraRepositories.NewPresenceConfirmationRepository(db)
+	closureRepo := infraRepositories.NewClosureRepository(db)
+	locationRepo := infraRepositories.NewLocationRepository(db)
+	therapistRepo := infraRepositories.NewTherapistRepository(db)
+	feedbackRepo := infraRepositories.NewFeedbackRepository(db)
+	refreshTokenRepo := infraRepositories.NewRefreshTokenRepository(db)
+	sessionRepo := infraRepositories.NewUserSessionRepository(db)
+	passwordResetRepo := infraRepositories.NewPasswordResetRepository(db)
+	loginAttemptRepo := infraRepositories.NewLoginAttemptRepository(db)
+
+	// Serviço de email com os links do tenant
+	emailService := deps.emailServiceFor(appURL)
+	notificationService := adapters.NewEmailNotificationService(emailService)
+
+	// Inicializar casos de uso
+	userUseCase := usecases.NewUserUseCase(
+		userRepo,
+		auditLogRepo,
+		notificationService,
+		deps.passwordHasher,
+		deps.validator,
+		deps.logger,
+		deps.timeService,
+	)
+	serviceUseCase := usecases.NewServiceUseCase(serviceRepo, chairRepo, auditLogRepo, deps.validator)
+	policyUseCase := usecases.NewBookingPolicyUseCase(policyRepo, bookingRepo, userRepo, chairRepo, locationRepo, auditLogRepo, deps.validator)
+	penaltyUseCase := usecases.NewPenaltyUseCase(penaltyRepo, userRepo, auditLogRepo, emailService, deps.penaltySettings)
+	bookingUseCase := usecases.NewBookingUseCase(bookingRepo, chairRepo, userRepo, availabilityRepo, serviceRepo, waitlistRepo, slotHoldRepo, therapistRepo, locationRepo, policyUseCase, penaltyUseCase, auditLogRepo, emailService, deps.validator)
+	chairUseCase := usecases.NewChairUseCase(chairRepo, locationRepo, bookingRepo, userRepo, bookingUseCase, auditLogRepo, emailService, deps.validator)
+	waitlistUseCase := usecases.NewWaitlistUseCase(waitlistRepo, chairRepo, auditLogRepo, bookingUseCase, deps.validator)
+	slotHoldUseCase := usecases.NewSlotHoldUseCase(slotHoldRepo, userRepo, chairRepo, bookingUseCase, penaltyUseCase)
+	presenceUseCase := usecases.NewPresenceConfirmationUseCase(presenceRepo, bookingRepo, bookingUseCase, deps.presenceTokenSigner, appConfig.Presence.ConfirmationCutoff)
+	availabilityUseCase := usecases.NewAvailabilityUseCase(availabilityRepo, bookingRepo, slotHoldRepo, chairRepo, serviceRepo, closureRepo, locationRepo, therapistRepo, auditLogRepo, deps.validator, appConfig.Slots.HorizonDays)
+	auditLogUseCase := usecases.NewAuditLogUseCase(auditLogRepo, userRepo, deps.validator)
+	notificationUseCase := usecases.NewNotificationUseCase(emailService, bookingRepo, userRepo, chairRepo, presenceUseCase)
+	closureUseCase := usecases.NewClosureUseCase(closureRepo, bookingRepo, locationRepo, bookingUseCase, auditLogRepo)
+	locationUseCase := usecases.NewLocationUseCase(locationRepo, chairRepo, userRepo, auditLogRepo, deps.validator)
+	therapistUseCase := usecases.NewTherapistUseCase(therapistRepo, chairRepo, userRepo, bookingRepo, auditLogRepo, deps.validator)
+	feedbackUseCase := usecases.NewFeedbackUseCase(feedbackRepo, bookingRepo, emailService, deps.presenceTokenSigner, deps.validator, appConfig.Feedback.Window)
+	calendarUseCase := usecases.NewCalendarUseCase(userRepo, bookingRepo, auditLogRepo, appURL)
+	refreshTokenUseCase := usecases.NewRefreshTokenUseCase(refreshTokenRepo, sessionRepo, userRepo, appConfig.JWT.RefreshExpiration)
+	loginProtectionUseCase := usecases.NewLoginProtectionUseCase(loginAttemptRepo, auditLogRepo, emailService, deps.loginProtection)
+	passwordResetUseCase := usecases.NewPasswordResetUseCase(passwordResetRepo, userRepo, auditLogRepo, emailService, refreshTokenUseCase, loginProtectionUseCase, deps.passwordHasher, appConfig.Auth.PasswordResetTTL)
+	emailVerificationUseCase := usecases.NewEmailVerificationUseCase(userRepo, auditLogRepo, emailService, userUseCase, deps.presenceTokenSigner, appConfig.Auth.EmailVerificationTTL, appConfig.Auth.EmailAutoApproveDomains)
+
+	// Inicializar handlers
+	userHandler := handlers.NewUserHandler(userUseCase, auditLogUseCase)
+	chairHandler := handlers.NewChairHandler(chairUseCase)
+	serviceHandler := handlers.NewServiceHandler(serviceUseCase)
+	bookingHandler := handlers.NewBookingHandler(bookingUseCase)
+	availabilityHandler := handlers.NewAvailabilityHandler(availabilityUseCase)
+	waitlistHandler := handlers.NewWaitlistHandler(waitlistUseCase)
+	slotHoldHandler := handlers.NewSlotHoldHandler(slotHoldUseCase)
+	presenceHandler := handlers.NewPresenceConfirmationHandler(presenceUseCase)
+	calendarHandler := handlers.NewCalendarHandler(calendarUseCase)
+	closureHandler := handlers.NewClosureHandler(closureUseCase)
+	locationHandler := handlers.NewLocationHandler(locationUseCase)
+	therapistHandler := handlers.NewTherapistHandler(therapistUseCase)
+	feedbackHandler := handlers.NewFeedbackHandler(feedbackUseCase, bookingUseCase)
+	policyHandler := handlers.NewBookingPolicyHandler(policyUseCase)
+	penaltyHandler := handlers.NewPenaltyHandler(penaltyUseCase)
+	auditLogHandler := handlers.NewAuditLogHandler(auditLogUseCase)
+	authHandler := handlers.NewAuthHandler(userUseCase, auditLogUseCase, refreshTokenUseCase, loginProtectionUseCase, emailVerificationUseCase, deps.passwordHasher)
+	passwordResetHandler := handlers.NewPasswordResetHandler(passwordResetUseCase)
+	emailVerificationHandler := handlers.NewEmailVerificationHandler(emailVerificationUseCase)
+	dashboardHandler := handlers.NewDashboardHandler(bookingUseCase, userUseCase, chairUseCase, availabilityUseCase, notificationUseCase, feedbackUseCase)
+
+	// Router do tenant (CORS, logs e recuperação ficam no router principal)
+	router := gin.New()
+	router.Use(middleware.TenantContext(tenant.ID))
+
+	// Configurar rotas
+	api := router.Group("/api")
+	{
+		// Rotas de autenticação
+		auth := api.Group("/auth")
+		{
+			auth.POST("/login", authHandler.Login)
+			auth.POST("/register", authHandler.Register)
+			auth.POST("/refresh", authHandler.Refresh)
+			auth.POST("/logout", authHandler.Logout)
+			auth.GET("/forgot-password", passwordResetHandler.ShowForgotPassword)
+			auth.POST("/forgot-password", passwordResetHandler.ForgotPassword)
+			auth.GET("/reset-password", passwordResetHandler.ShowResetPassword)
+			auth.POST("/reset-password", passwordResetHandler.ResetPassword)
+			auth.GET("/verify-email/:token", emailVerificationHandler.GetVerification)
+			auth.POST("/verify-email/:token", emailVerificationHandler.VerifyEmail)
+			auth.POST("/resend-verification", emailVerificationHandler.ResendVerification)
+		}
+
+		// Links de oferta da lista de espera (token enviado por email, sem login)
+		routes.SetupWaitlistOfferRoutes(api, waitlistHandler)
+
+		// Links de confirmação de presença do lembrete (token assinado, sem login)
+		routes.SetupPresenceConfirmationRoutes(api, presenceHandler)
+
+		// Links do convite de avaliação (token assinado, sem login)
+		routes.SetupFeedbackLinkRoutes(api, feedbackHandler)
+
+		// Feed de calendário (token secreto na URL, sem login)
+		routes.SetupCalendarFeedRoutes(api, calendarHandler)
+
+		// Rotas protegidas
+		protected := api.Group("/")
+		protected.Use(middleware.AuthMiddleware(userUseCase, refreshTokenUseCase))
+		{
+			// Rotas das sessões de login
+			routes.SetupSessionRoutes(protected, authHandler)
+			routes.SetupUserSessionRoutes(protected, authHandler)
+			routes.SetupUserLockoutRoutes(protected, authHandler)
+
+			// Rotas de usuários
+			routes.SetupUserRoutes(protected, userHandler)
+
+			// Rotas de cadeiras
+			routes.SetupChairRoutes(protected, chairHandler)
+
+			// Rotas do catálogo de serviços
+			routes.SetupServiceRoutes(protected, serviceHandler)
+
+			// Rotas de agendamentos
+			routes.SetupBookingRoutes(protected, bookingHandler)
+
+			// Rotas de avaliação das sessões
+			routes.SetupFeedbackRoutes(protected, feedbackHandler)
+
+			// Rotas da lista de espera
+			routes.SetupWaitlistRoutes(protected, waitlistHandler)
+
+			// Rotas de reserva temporária de horários
+			routes.SetupSlotHoldRoutes(protected, slotHoldHandler)
+
+			// Rotas do feed de calendário
+			routes.SetupCalendarRoutes(protected, calendarHandler)
+
+			// Rotas de políticas de agendamento
+			routes.SetupBookingPolicyRoutes(protected, policyHandler)
+
+			// Rotas de penalidades e suspensões
+			routes.SetupPenaltyRoutes(protected, penaltyHandler)
+
+			// Rotas de disponibilidade
+			routes.SetupAvailabilityRoutes(protected, availabilityHandler)
+
+			// Rotas de feriados e fechamentos
+			routes.SetupClosureRoutes(protected, closureHandler)
+
+			// Rotas de localidades
+			routes.SetupLocationRoutes(protected, locationHandler)
+			routes.SetupTherapistRoutes(protected, therapistHandler)
+
+			// Rotas de auditoria
+			routes.SetupAuditLogRoutes(protected, auditLogHandler)
+		}
+
+		// Rotas de dashboard
+		dashboardRoutes.SetupDashboardRoutes(api, dashboardHandler, userUseCase, refreshTokenUseCase)
+	}
+
+	return &tenantApp{
+		router:      router,
+		scheduler:   scheduler.NewScheduler(notificationUseCase, bookingUseCase, waitlistUseCase, slotHoldUseCase, presenceUseCase, feedbackUseCase, appConfig.Scheduler.NoShowGracePeriod),
+		userUseCase: userUseCase,
+	}
+}
+
+// platformApp é a aplicação da plataforma, em /api/platform: login dos super admins
+// e cadastro de tenants
+type platformApp struct {
+	router        *gin.Engine
+	userUseCase   *usecases.UserUseCase
+	tenantUseCase *usecases.TenantUseCase
+}
+
+// newPlatformApp monta a aplicação da plataforma sobre o escopo de tenant zero
+func newPlatformApp(rootDB *gorm.DB, registry *tenantRegistry, deps *appDeps) *platformApp {
+	db := tenancy.Scope(rootDB, entities.PlatformTenantID)
+	userRepo := infraRepositories.NewUserRepository(db)
+	auditLogRepo := infraRepositories.NewAuditLogRepository(db)
+	refreshTokenRepo := infraRepositories.NewRefreshTokenRepository(db)
+	sessionRepo := infraRepositories.NewUserSessionRepository(db)
+	loginAttemptRepo := infraRepositories.NewLoginAttemptRepository(db)
+	emailService := deps.emailServiceFor(deps.config.Server.AppURL)
+
+	userUseCase := usecases.NewUserUseCase(
+		userRepo,
+		auditLogRepo,
+		adapters.NewEmailNotificationService(emailService),
+		deps.passwordHasher,
+		deps.validator,
+		deps.logger,
+		deps.timeService,
+	)
+	auditLogUseCase := usecases.NewAuditLogUseCase(auditLogRepo, userRepo, deps.validator)
+	tenantUseCase := usecases.NewTenantUseCase(registry.tenantRepo, auditLogRepo, registry, deps.validator)
+	refreshTokenUseCase := usecases.NewRefreshTokenUseCase(refreshTokenRepo, sessionRepo, userRepo, deps.config.JWT.RefreshExpiration)
+	loginProtectionUseCase := usecases.NewLoginProtectionUseCase(loginAttemptRepo, auditLogRepo, emailService, deps.loginProtection)
+
+	authHandler := handlers.NewAuthHandler(userUseCase, auditLogUseCase, refreshTokenUseCase, loginProtectionUseCase, nil, deps.passwordHasher)
+	tenantHandler := handlers.NewTenantHandler(tenantUseCase)
+
+	router := gin.New()
+	router.Use(middleware.TenantContext(entities.PlatformTenantID))
+
+	platform := router.Group("/api/platform")
+	{
+		auth := platform.Group("/auth")
+		{
+			auth.POST("/login", authHandler.Login)
+			auth.POST("/refresh", authHandler.Refresh)
+			auth.POST("/logout", authHandler.Logout)
+		}
+
+		protected := platform.Group("/")
+		protected.Use(middleware.AuthMiddleware(userUseCase, refreshTokenUseCase))
+		{
+			routes.SetupSessionRoutes(protected, authHandler)
+			routes.SetupTenantRoutes(protected, tenantHandler)
+		}
+	}
+
+	return &platformApp{
+		router:        router,
+		userUseCase:   userUseCase,
+		tenantUseCase: tenantUseCase,
+	}
+}
+
+// tenantRegistry guarda a aplicação de cada tenant. A aplicação é criada na
+// inicialização (tenants ativos) ou na primeira requisição, e descartada quando o
+// tenant é desativado. Implementa ports.TenantProvisioner
+type tenantRegistry struct {
+	mu         sync.Mutex
+	apps       map[uint]*tenantApp
+	db         *gorm.DB
+	tenantRepo repositories.TenantRepository
+	deps       *appDeps
+}
+
+func newTenantRegistry(db *gorm.DB, tenantRepo repositories.TenantRepository, deps *appDeps) *tenantRegistry {
+	return &tenantRegistry{
+		apps:       make(map[uint]*tenantApp),
+		db:         db,
+		tenantRepo: tenantRepo,
+		deps:       deps,
+	}
+}
+
+// app devolve a aplicação do tenant, criando-a e iniciando o scheduler na primeira vez
+func (r *tenantRegistry) app(tenant *entities.Tenant) *tenantApp {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if app, ok := r.apps[tenant.ID]; ok {
+		return app
+	}
+
+	app := newTenantApp(tenancy.Scope(r.db, tenant.ID), tenant, r.deps)
+	app.scheduler.Start()
+	r.apps[tenant.ID] = app
+	log.Printf("Tenant %s carregado", tenant.Slug)
+	return app
+}
+
+// serve encaminha a requisição ao router do tenant identificado pelo TenantMiddleware
+func (r *tenantRegistry) serve(c *gin.Context) {
+	tenant, ok := middleware.GetTenantFromContext(c)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tenant não encontrado"})
+		return
+	}
+
+	r.app(tenant).router.ServeHTTP(c.Writer, c.Request)
+}
+
+// CreateUser cadastra o usuário pelo caso de uso de usuários do tenant
+func (r *tenantRegistry) CreateUser(tenantID uint, user *entities.User, createdBy *uint) error {
+	tenant, err := r.tenantRepo.GetByID(tenantID)
+	if err != nil {
+		return err
+	}
+	return r.app(tenant).userUseCase.CreateUser(user, createdBy)
+}
+
+// Release para o scheduler e descarta a aplicação do tenant
+func (r *tenantRegistry) Release(tenantID uint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if app, ok := r.apps[tenantID]; ok {
+		app.scheduler.Stop()
+		delete(r.apps, tenantID)
+	}
+}
+
+// stopAll para os schedulers de todos os tenants
+func (r *tenantRegistry) stopAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, app := range r.apps {
+		app.scheduler.Stop()
+		delete(r.apps, id)
+	}
+}
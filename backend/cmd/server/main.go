@@ -24,6 +24,7 @@
 // - **Dashboard**: Estatísticas e relatórios
 //
 // ## Roles de Usuário:
+// - **super_admin**: Gerencia os tenants (clientes) da plataforma em /api/platform
 // - **usuario**: Usuário comum, pode fazer agendamentos
 // - **atendente**: Pode aprovar usuários e gerenciar agendamentos
 // - **admin**: Acesso total ao sistema
@@ -54,16 +55,13 @@ import (
 	"syscall"
 
 	_ "agendamento-backend/docs" // Importar docs gerados pelo Swagger
-	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/infrastructure/adapters"
+	"agendamento-backend/internal/infrastructure/config"
 	"agendamento-backend/internal/infrastructure/database"
 	"agendamento-backend/internal/infrastructure/email"
 	"agendamento-backend/internal/infrastructure/repositories"
-	"agendamento-backend/internal/infrastructure/scheduler"
-	"agendamento-backend/internal/interfaces/http/handlers"
 	"agendamento-backend/internal/interfaces/http/middleware"
-	"agendamento-backend/internal/interfaces/http/routes"
-	dashboardRoutes "agendamento-backend/internal/interfaces/routes"
 
 	"github.com/gin-gonic/gin"
 	swaggerfiles "github.com/swaggo/files"
@@ -94,18 +92,13 @@ func main() {
 		log.Fatal("Falha ao executar migrações:", err)
 	}
 
-	// Inicializar adapters (ports)
-	passwordHasher := adapters.NewBcryptPasswordHasher()
-	validatorAdapter := adapters.NewValidatorAdapter()
-	loggerAdapter := adapters.NewLoggerAdapter()
-	timeServiceAdapter := adapters.NewTimeServiceAdapter()
+	appConfig := config.Load()
 
-	// Inicializar repositórios
-	userRepo := repositories.NewUserRepository(db.DB)
-	chairRepo := repositories.NewChairRepository(db.DB)
-	bookingRepo := repositories.NewBookingRepository(db.DB)
-	availabilityRepo := repositories.NewAvailabilityRepository(db.DB)
-	auditLogRepo := repositories.NewAuditLogRepository(db.DB)
+	// Tenant das requisições sem subdomínio nem token (recebe os dados anteriores aos tenants)
+	defaultTenant, err := db.EnsureDefaultTenant(appConfig.Tenancy.DefaultSlug)
+	if err != nil {
+		log.Fatal("Falha ao preparar tenant padrão:", err)
+	}
 
 	// Inicializar serviço de email
 	emailConfig, err := email.NewConfig()
@@ -113,39 +106,62 @@ func main() {
 		log.Printf("Aviso: Falha ao configurar email: %v", err)
 		log.Println("Sistema continuará sem notificações por email")
 	}
-	emailService := email.NewEmailService(emailConfig)
-	notificationService := adapters.NewEmailNotificationService(emailService)
-
-	// Inicializar casos de uso
-	userUseCase := usecases.NewUserUseCase(
-		userRepo,
-		auditLogRepo,
-		notificationService,
-		passwordHasher,
-		validatorAdapter,
-		loggerAdapter,
-		timeServiceAdapter,
-	)
-	chairUseCase := usecases.NewChairUseCase(chairRepo, auditLogRepo, validatorAdapter)
-	bookingUseCase := usecases.NewBookingUseCase(bookingRepo, chairRepo, userRepo, availabilityRepo, auditLogRepo, emailService, validatorAdapter)
-	availabilityUseCase := usecases.NewAvailabilityUseCase(availabilityRepo, bookingRepo, chairRepo, auditLogRepo, validatorAdapter)
-	auditLogUseCase := usecases.NewAuditLogUseCase(auditLogRepo, userRepo, validatorAdapter)
-	notificationUseCase := usecases.NewNotificationUseCase(emailService, bookingRepo, userRepo, chairRepo)
+
+	// Configuração de penalidades por faltas e cancelamentos tardios
+	penaltyConfig := appConfig.Penalty
+	penaltySettings := entities.PenaltySettings{
+		Window:                 penaltyConfig.Window,
+		NoShowLimit:            penaltyConfig.NoShowLimit,
+		LateCancellationLimit:  penaltyConfig.LateCancellationLimit,
+		LateCancellationWindow: penaltyConfig.LateCancellationWindow,
+		SuspensionDuration:     penaltyConfig.SuspensionDuration,
+	}
+
+	// Limites das tentativas de login com senha errada
+	authConfig := appConfig.Auth
+	loginProtection := entities.LoginProtectionSettings{
+		FailureWindow:    authConfig.LoginFailureWindow,
+		MaxFailures:      authConfig.LoginMaxFailures,
+		LockoutDuration:  authConfig.LoginLockoutDuration,
+		MaxFailuresPerIP: authConfig.LoginMaxFailuresPerIP,
+	}
+
+	// Dependências compartilhadas pelos tenants
+	deps := &appDeps{
+		config:          appConfig,
+		emailConfig:     emailConfig,
+		passwordHasher:  adapters.NewBcryptPasswordHasher(),
+		validator:       adapters.NewValidatorAdapter(),
+		logger:          adapters.NewLoggerAdapter(),
+		timeService:     adapters.NewTimeServiceAdapter(),
+		penaltySettings: penaltySettings,
+		loginProtection: loginProtection,
+		// Assinatura dos links de confirmação de presença enviados no lembrete
+		presenceTokenSigner: adapters.NewHMACTokenSigner(appConfig.Presence.TokenSecret),
+	}
+
+	// Cada tenant tem repositórios, casos de uso, rotas e scheduler próprios
+	tenantRepo := repositories.NewTenantRepository(db.DB)
+	registry := newTenantRegistry(db.DB, tenantRepo, deps)
+	platform := newPlatformApp(db.DB, registry, deps)
 
 	// Inserir dados iniciais
-	if err := db.SeedData(userUseCase); err != nil {
+	if err := db.ForTenant(entities.PlatformTenantID).SeedSuperAdmin(platform.userUseCase); err != nil {
+		log.Printf("Aviso: Falha ao criar super admin: %v", err)
+	}
+	if err := db.ForTenant(defaultTenant.ID).SeedData(registry.app(defaultTenant).userUseCase); err != nil {
 		log.Printf("Aviso: Falha ao inserir dados iniciais: %v", err)
 		log.Println("Sistema continuará sem dados padrão")
 	}
 
-	// Inicializar handlers
-	userHandler := handlers.NewUserHandler(userUseCase, auditLogUseCase)
-	chairHandler := handlers.NewChairHandler(chairUseCase)
-	bookingHandler := handlers.NewBookingHandler(bookingUseCase)
-	availabilityHandler := handlers.NewAvailabilityHandler(availabilityUseCase)
-	auditLogHandler := handlers.NewAuditLogHandler(auditLogUseCase)
-	authHandler := handlers.NewAuthHandler(userUseCase, auditLogUseCase, passwordHasher)
-	dashboardHandler := handlers.NewDashboardHandler(bookingUseCase, userUseCase, chairUseCase, notificationUseCase)
+	// Carregar os tenants ativos para que os lembretes e rotinas de todos rodem
+	tenants, err := tenantRepo.List(false)
+	if err != nil {
+		log.Fatal("Falha ao carregar tenants:", err)
+	}
+	for _, tenant := range tenants {
+		registry.app(tenant)
+	}
 
 	// Configurar router
 	router := gin.New()
@@ -156,41 +172,14 @@ func main() {
 	router.Use(gin.Recovery())
 	router.Use(middleware.SecurityHeaders())
 
-	// Configurar rotas
-	api := router.Group("/api")
-	{
-		// Rotas de autenticação
-		auth := api.Group("/auth")
-		{
-			auth.POST("/login", authHandler.Login)
-			auth.POST("/register", authHandler.Register)
-			auth.POST("/refresh", authHandler.Refresh)
-			auth.POST("/logout", authHandler.Logout)
-		}
-
-		// Rotas protegidas
-		protected := api.Group("/")
-		protected.Use(middleware.AuthMiddleware(userUseCase))
-		{
-			// Rotas de usuários
-			routes.SetupUserRoutes(protected, userHandler)
-
-			// Rotas de cadeiras
-			routes.SetupChairRoutes(protected, chairHandler)
-
-			// Rotas de agendamentos
-			routes.SetupBookingRoutes(protected, bookingHandler)
+	// Rotas da plataforma (super admins e cadastro de tenants)
+	router.Any("/api/platform/*path", gin.WrapH(platform.router))
 
-			// Rotas de disponibilidade
-			routes.SetupAvailabilityRoutes(protected, availabilityHandler)
-
-			// Rotas de auditoria
-			routes.SetupAuditLogRoutes(protected, auditLogHandler)
-		}
-
-		// Rotas de dashboard
-		dashboardRoutes.SetupDashboardRoutes(api, dashboardHandler, userUseCase)
-	}
+	// Demais rotas da API: identificar o tenant e encaminhar ao router dele
+	router.NoRoute(
+		middleware.TenantMiddleware(platform.tenantUseCase, appConfig.Tenancy.BaseDomain, appConfig.Tenancy.DefaultSlug),
+		registry.serve,
+	)
 
 	// Rota de health check
 	// @Summary Health check do sistema
@@ -217,10 +206,6 @@ func main() {
 	// @Router /swagger/*any [get]
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
-	// Inicializar scheduler para lembretes
-	schedulerInstance := scheduler.NewScheduler(notificationUseCase, bookingUseCase)
-	schedulerInstance.Start()
-
 	// Canal para capturar sinais de interrupção
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -243,7 +228,7 @@ func main() {
 
 	// Aguardar sinal de interrupção
 	<-sigChan
-	log.Println("Recebido sinal de interrupção, parando scheduler...")
-	schedulerInstance.Stop()
+	log.Println("Recebido sinal de interrupção, parando schedulers...")
+	registry.stopAll()
 	log.Println("Servidor finalizado")
 }
@@ -118,3 +118,39 @@ type AvailableSlotsResponse struct {
 	Date    string   `json:"date"`
 	Data    []string `json:"data"`
 }
+
+// AvailabilitySearchSlot representa um horário livre em pelo menos uma cadeira
+type AvailabilitySearchSlot struct {
+	Time            string `json:"time"`
+	AvailableChairs int    `json:"available_chairs"`
+	ChairIDs        []uint `json:"chair_ids"`
+}
+
+// AvailabilitySearchDay agrupa os horários livres de uma data na busca em todas as cadeiras
+type AvailabilitySearchDay struct {
+	Date  string                   `json:"date"`
+	Slots []AvailabilitySearchSlot `json:"slots"`
+}
+
+// AvailabilityExceptionRequest representa os dados de uma exceção de disponibilidade.
+// Exceções do tipo fechado não informam horário
+type AvailabilityExceptionRequest struct {
+	Date      string `json:"date" validate:"required"`                                    // Formato YYYY-MM-DD
+	Type      string `json:"type" validate:"required,oneof=fechado substituir adicional"` // fechado, substituir ou adicional
+	StartTime string `json:"start_time"`                                                  // Formato HH:MM
+	EndTime   string `json:"end_time"`                                                    // Formato HH:MM
+	Reason    string `json:"reason" validate:"max=255"`
+}
+
+// AvailabilityExceptionResponse representa uma exceção de disponibilidade
+type AvailabilityExceptionResponse struct {
+	ID        uint      `json:"id"`
+	ChairID   uint      `json:"chair_id"`
+	Date      string    `json:"date"`
+	Type      string    `json:"type"`
+	StartTime string    `json:"start_time,omitempty"`
+	EndTime   string    `json:"end_time,omitempty"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
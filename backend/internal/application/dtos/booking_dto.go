@@ -5,8 +5,21 @@ import "time"
 // CreateBookingRequest representa os dados para criar um agendamento
 type CreateBookingRequest struct {
 	ChairID   uint      `json:"chair_id" validate:"required"`
+	ServiceID *uint     `json:"service_id"`
 	StartTime time.Time `json:"start_time" validate:"required"`
 	Notes     string    `json:"notes"`
+	HoldToken string    `json:"hold_token"` // token da reserva temporária do horário, se houver
+}
+
+// CreateAnyChairBookingRequest representa um agendamento sem cadeira definida: o
+// sistema escolhe uma cadeira livre da localidade (location_id ou location; sem
+// nenhum dos dois, qualquer localidade)
+type CreateAnyChairBookingRequest struct {
+	ServiceID  *uint     `json:"service_id"`
+	StartTime  time.Time `json:"start_time" validate:"required"`
+	LocationID uint      `json:"location_id"`
+	Location   string    `json:"location"`
+	Notes      string    `json:"notes"`
 }
 
 // CreateBookingResponse representa a resposta da criação de agendamento
@@ -14,11 +27,16 @@ type CreateBookingResponse struct {
 	ID        uint      `json:"id"`
 	UserID    uint      `json:"user_id"`
 	ChairID   uint      `json:"chair_id"`
+	ServiceID *uint     `json:"service_id,omitempty"`
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time"`
 	Status    string    `json:"status"`
 	Notes     string    `json:"notes"`
 	CreatedAt time.Time `json:"created_at"`
+
+	// Massoterapeuta de plantão na sessão
+	TherapistID   *uint  `json:"therapist_id,omitempty"`
+	TherapistName string `json:"therapist_name,omitempty"`
 }
 
 // UpdateBookingRequest representa os dados para atualizar um agendamento
@@ -33,13 +51,19 @@ type BookingResponse struct {
 	ID        uint      `json:"id"`
 	UserID    uint      `json:"user_id"`
 	ChairID   uint      `json:"chair_id"`
+	ServiceID *uint     `json:"service_id,omitempty"`
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time"`
 	Status    string    `json:"status"`
 	Notes     string    `json:"notes"`
+	ClosureID *uint     `json:"closure_id,omitempty"` // marcado para cancelamento por feriado ou fechamento
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
+	// Massoterapeuta de plantão na sessão
+	TherapistID   *uint  `json:"therapist_id,omitempty"`
+	TherapistName string `json:"therapist_name,omitempty"`
+
 	// Relacionamentos
 	User  UserResponse  `json:"user,omitempty"`
 	Chair ChairResponse `json:"chair,omitempty"`
@@ -50,6 +74,7 @@ type BookingWithRelationsResponse struct {
 	ID        uint          `json:"id"`
 	UserID    uint          `json:"user_id"`
 	ChairID   uint          `json:"chair_id"`
+	ServiceID *uint         `json:"service_id,omitempty"`
 	StartTime time.Time     `json:"start_time"`
 	EndTime   time.Time     `json:"end_time"`
 	Status    string        `json:"status"`
@@ -134,3 +159,16 @@ type RescheduleOptionsResponse struct {
 	ChairID        uint            `json:"chair_id"`
 	ChairName      string          `json:"chair_name"`
 }
+
+// BookingStatusHistoryResponse representa uma mudança de status do agendamento
+type BookingStatusHistoryResponse struct {
+	ID         uint      `json:"id"`
+	BookingID  uint      `json:"booking_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    *uint     `json:"actor_id"`
+	ActorName  string    `json:"actor_name,omitempty"`
+	Source     string    `json:"source"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
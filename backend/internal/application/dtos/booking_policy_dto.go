@@ -0,0 +1,59 @@
+package dtos
+
+import "time"
+
+// BookingPolicyRequest representa os dados para criar ou atualizar uma política de agendamento.
+// Regras omitidas (null) herdam o valor de políticas menos específicas; zero desativa o limite
+type BookingPolicyRequest struct {
+	Name                      string `json:"name" validate:"required,min=2,max=100"`
+	Description               string `json:"description" validate:"max=255"`
+	Role                      string `json:"role" validate:"omitempty,oneof=usuario atendente admin"`
+	Sector                    string `json:"sector"`
+	ChairID                   *uint  `json:"chair_id"`
+	MaxActiveBookings         *int   `json:"max_active_bookings"`
+	BookingHorizonDays        *int   `json:"booking_horizon_days"`
+	MinLeadTimeMinutes        *int   `json:"min_lead_time_minutes"`
+	CancellationCutoffMinutes *int   `json:"cancellation_cutoff_minutes"`
+	MaxDailyBookings          *int   `json:"max_daily_bookings"`
+	MaxWeeklyBookings         *int   `json:"max_weekly_bookings"`
+	IsActive                  *bool  `json:"is_active"`
+}
+
+// BookingPolicyResponse representa a resposta de dados de política de agendamento
+type BookingPolicyResponse struct {
+	ID                        uint      `json:"id"`
+	Name                      string    `json:"name"`
+	Description               string    `json:"description"`
+	Role                      string    `json:"role"`
+	Sector                    string    `json:"sector"`
+	ChairID                   *uint     `json:"chair_id"`
+	MaxActiveBookings         *int      `json:"max_active_bookings"`
+	BookingHorizonDays        *int      `json:"booking_horizon_days"`
+	MinLeadTimeMinutes        *int      `json:"min_lead_time_minutes"`
+	CancellationCutoffMinutes *int      `json:"cancellation_cutoff_minutes"`
+	MaxDailyBookings          *int      `json:"max_daily_bookings"`
+	MaxWeeklyBookings         *int      `json:"max_weekly_bookings"`
+	IsActive                  bool      `json:"is_active"`
+	CreatedAt                 time.Time `json:"created_at"`
+	UpdatedAt                 time.Time `json:"updated_at"`
+}
+
+// ListBookingPoliciesResponse representa a resposta da listagem de políticas
+type ListBookingPoliciesResponse struct {
+	Policies []BookingPolicyResponse `json:"policies"`
+	Total    int64                   `json:"total"`
+	Limit    int                     `json:"limit"`
+	Offset   int                     `json:"offset"`
+}
+
+// EffectivePolicyResponse representa as regras efetivas para um usuário em uma cadeira
+type EffectivePolicyResponse struct {
+	UserID                    uint `json:"user_id"`
+	ChairID                   uint `json:"chair_id"`
+	MaxActiveBookings         int  `json:"max_active_bookings"`
+	BookingHorizonDays        int  `json:"booking_horizon_days"`
+	MinLeadTimeMinutes        int  `json:"min_lead_time_minutes"`
+	CancellationCutoffMinutes int  `json:"cancellation_cutoff_minutes"`
+	MaxDailyBookings          int  `json:"max_daily_bookings"`
+	MaxWeeklyBookings         int  `json:"max_weekly_bookings"`
+}
@@ -4,39 +4,47 @@ import "time"
 
 // CreateChairRequest representa os dados para criar uma cadeira
 type CreateChairRequest struct {
-	Name        string `json:"name" validate:"required,min=2,max=100"`
-	Description string `json:"description" validate:"max=500"`
-	Location    string `json:"location" validate:"required,min=2,max=100"`
-	Status      string `json:"status" validate:"oneof=ativa inativa"`
+	Name          string `json:"name" validate:"required,min=2,max=100"`
+	Description   string `json:"description" validate:"max=500"`
+	Location      string `json:"location" validate:"required_without=LocationID,omitempty,min=2,max=100"`
+	LocationID    *uint  `json:"location_id"` // localidade cadastrada; quando informada, define location
+	Status        string `json:"status" validate:"oneof=ativa inativa"`
+	BufferMinutes int    `json:"buffer_minutes" validate:"min=0,max=120"`
 }
 
 // CreateChairResponse representa a resposta da criação de cadeira
 type CreateChairResponse struct {
-	ID          uint      `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Location    string    `json:"location"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID            uint      `json:"id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Location      string    `json:"location"`
+	LocationID    *uint     `json:"location_id,omitempty"`
+	Status        string    `json:"status"`
+	BufferMinutes int       `json:"buffer_minutes"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 // UpdateChairRequest representa os dados para atualizar uma cadeira
 type UpdateChairRequest struct {
-	Name        string `json:"name" validate:"required,min=2,max=100"`
-	Description string `json:"description" validate:"max=500"`
-	Location    string `json:"location" validate:"required,min=2,max=100"`
-	Status      string `json:"status" validate:"oneof=ativa inativa"`
+	Name          string `json:"name" validate:"required,min=2,max=100"`
+	Description   string `json:"description" validate:"max=500"`
+	Location      string `json:"location" validate:"required_without=LocationID,omitempty,min=2,max=100"`
+	LocationID    *uint  `json:"location_id"` // localidade cadastrada; quando informada, define location
+	Status        string `json:"status" validate:"oneof=ativa inativa"`
+	BufferMinutes int    `json:"buffer_minutes" validate:"min=0,max=120"`
 }
 
 // ChairResponse representa a resposta de dados de cadeira
 type ChairResponse struct {
-	ID          uint      `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Location    string    `json:"location"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            uint      `json:"id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Location      string    `json:"location"`
+	LocationID    *uint     `json:"location_id,omitempty"`
+	Status        string    `json:"status"`
+	BufferMinutes int       `json:"buffer_minutes"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // ListChairsRequest representa os filtros para listar cadeiras
@@ -61,3 +69,68 @@ type ChairStatsResponse struct {
 	InactiveChairs  int64 `json:"inactive_chairs"`
 	AvailableChairs int64 `json:"available_chairs"`
 }
+
+// ChairBreakRequest representa os dados de uma pausa da cadeira. Sem dia da semana
+// a pausa vale para todos os dias
+type ChairBreakRequest struct {
+	Name      string `json:"name" validate:"required,min=2,max=100"`
+	DayOfWeek *int   `json:"day_of_week" validate:"omitempty,min=0,max=6"` // 0=Domingo, 6=Sábado
+	StartTime string `json:"start_time" validate:"required"`               // Formato HH:MM
+	EndTime   string `json:"end_time" validate:"required"`                 // Formato HH:MM
+}
+
+// ChairBreakResponse representa uma pausa da cadeira
+type ChairBreakResponse struct {
+	ID            uint      `json:"id"`
+	ChairID       uint      `json:"chair_id"`
+	Name          string    `json:"name"`
+	DayOfWeek     *int      `json:"day_of_week,omitempty"`
+	DayOfWeekName string    `json:"day_of_week_name"`
+	StartTime     string    `json:"start_time"`
+	EndTime       string    `json:"end_time"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ChairMaintenanceRequest representa uma manutenção programada da cadeira
+type ChairMaintenanceRequest struct {
+	StartTime time.Time `json:"start_time" validate:"required"`
+	EndTime   time.Time `json:"end_time" validate:"required"`
+	Reason    string    `json:"reason" validate:"required,min=2,max=255"`
+}
+
+// ChairMaintenanceResponse representa uma manutenção programada da cadeira
+type ChairMaintenanceResponse struct {
+	ID                uint      `json:"id"`
+	ChairID           uint      `json:"chair_id"`
+	StartTime         time.Time `json:"start_time"`
+	EndTime           time.Time `json:"end_time"`
+	Reason            string    `json:"reason"`
+	RelocatedBookings int       `json:"relocated_bookings"`
+	CancelledBookings int       `json:"cancelled_bookings"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// OutageImpactResponse representa o destino de um agendamento atingido pela
+// indisponibilidade da cadeira
+type OutageImpactResponse struct {
+	BookingID       uint      `json:"booking_id"`
+	UserID          uint      `json:"user_id"`
+	UserName        string    `json:"user_name"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	Action          string    `json:"action"` // transferir ou cancelar
+	TargetChairID   *uint     `json:"target_chair_id,omitempty"`
+	TargetChairName string    `json:"target_chair_name,omitempty"`
+	Detail          string    `json:"detail,omitempty"`
+}
+
+// ChairOutageResponse resume os agendamentos transferidos e cancelados. Com dry_run
+// nada foi gravado: é a prévia do que aconteceria
+type ChairOutageResponse struct {
+	DryRun      bool                      `json:"dry_run"`
+	Relocated   int                       `json:"relocated"`
+	Cancelled   int                       `json:"cancelled"`
+	Bookings    []OutageImpactResponse    `json:"bookings"`
+	Maintenance *ChairMaintenanceResponse `json:"maintenance,omitempty"`
+}
@@ -0,0 +1,40 @@
+package dtos
+
+import "time"
+
+// CreateClosureRequest representa os dados para cadastrar um feriado ou fechamento.
+// Sem end_date o fechamento dura apenas o dia de início; sem location_id (ou o nome
+// em location) vale para todas as localidades
+type CreateClosureRequest struct {
+	LocationID *uint  `json:"location_id"`
+	Location   string `json:"location" validate:"max=100"`
+	StartDate  string `json:"start_date" validate:"required"` // Formato YYYY-MM-DD
+	EndDate    string `json:"end_date"`                       // Formato YYYY-MM-DD
+	Reason     string `json:"reason" validate:"required,min=2,max=255"`
+}
+
+// CancelClosureBookingsRequest representa o cancelamento em lote dos agendamentos atingidos
+type CancelClosureBookingsRequest struct {
+	Reason string `json:"reason" validate:"max=255"` // Padrão: motivo do fechamento
+}
+
+// ClosureResponse representa um feriado ou fechamento
+type ClosureResponse struct {
+	ID              uint      `json:"id"`
+	LocationID      *uint     `json:"location_id,omitempty"`
+	Location        string    `json:"location"`
+	Global          bool      `json:"global"`
+	StartDate       string    `json:"start_date"`
+	EndDate         string    `json:"end_date"`
+	Reason          string    `json:"reason"`
+	Source          string    `json:"source"`
+	CreatedAt       time.Time `json:"created_at"`
+	FlaggedBookings *int      `json:"flagged_bookings,omitempty"` // agendamentos marcados no cadastro
+}
+
+// ImportClosuresResponse resume a importação de um arquivo .ics
+type ImportClosuresResponse struct {
+	Imported        []ClosureResponse `json:"imported"`
+	Skipped         int               `json:"skipped"`
+	FlaggedBookings int               `json:"flagged_bookings"`
+}
@@ -0,0 +1,7 @@
+package dtos
+
+// ResendVerificationRequest representa o pedido de reenvio do link de confirmação
+// de email
+type ResendVerificationRequest struct {
+	Identifier string `json:"identifier" validate:"required"` // CPF (com ou sem pontuação) ou email da conta
+}
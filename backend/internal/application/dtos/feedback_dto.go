@@ -0,0 +1,62 @@
+package dtos
+
+import "time"
+
+// FeedbackRequest representa a avaliação de uma sessão realizada
+type FeedbackRequest struct {
+	Rating         *int   `json:"rating" form:"rating" validate:"required,min=0,max=10"` // 0 a 10: quanto recomendaria as sessões
+	Comments       string `json:"comments" form:"comments" validate:"max=1000"`
+	ChairIssue     bool   `json:"chair_issue" form:"chair_issue"`         // houve problema com a cadeira
+	TherapistIssue bool   `json:"therapist_issue" form:"therapist_issue"` // houve problema com o atendimento
+}
+
+// FeedbackResponse representa a resposta de dados de uma avaliação
+type FeedbackResponse struct {
+	ID             uint      `json:"id"`
+	BookingID      uint      `json:"booking_id"`
+	UserID         uint      `json:"user_id"`
+	UserName       string    `json:"user_name,omitempty"`
+	ChairID        uint      `json:"chair_id"`
+	ChairName      string    `json:"chair_name,omitempty"`
+	LocationID     *uint     `json:"location_id,omitempty"`
+	LocationName   string    `json:"location_name,omitempty"`
+	TherapistID    *uint     `json:"therapist_id,omitempty"`
+	TherapistName  string    `json:"therapist_name,omitempty"`
+	Rating         int       `json:"rating"`
+	Comments       string    `json:"comments"`
+	ChairIssue     bool      `json:"chair_issue"`
+	TherapistIssue bool      `json:"therapist_issue"`
+	Source         string    `json:"source"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ListFeedbacksResponse representa a resposta de listagem de avaliações
+type ListFeedbacksResponse struct {
+	Feedbacks []FeedbackResponse `json:"feedbacks"`
+	Total     int64              `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+// SatisfactionStatsResponse representa as métricas de satisfação de um grupo
+type SatisfactionStatsResponse struct {
+	GroupID         uint    `json:"group_id,omitempty"`
+	GroupName       string  `json:"group_name,omitempty"`
+	Responses       int64   `json:"responses"`
+	AverageRating   float64 `json:"average_rating"`
+	NPS             float64 `json:"nps"` // % de promotores menos % de detratores, de -100 a 100
+	Promoters       int64   `json:"promoters"`
+	Passives        int64   `json:"passives"`
+	Detractors      int64   `json:"detractors"`
+	ChairIssues     int64   `json:"chair_issues"`
+	TherapistIssues int64   `json:"therapist_issues"`
+}
+
+// SatisfactionReportResponse representa as métricas de satisfação do período, no
+// total e por cadeira, massoterapeuta e localidade
+type SatisfactionReportResponse struct {
+	Overall     SatisfactionStatsResponse   `json:"overall"`
+	ByChair     []SatisfactionStatsResponse `json:"by_chair"`
+	ByTherapist []SatisfactionStatsResponse `json:"by_therapist"`
+	ByLocation  []SatisfactionStatsResponse `json:"by_location"`
+}
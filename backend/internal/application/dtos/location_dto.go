@@ -0,0 +1,44 @@
+package dtos
+
+import "time"
+
+// LocationRequest representa os dados para cadastrar ou atualizar uma localidade.
+// Sem timezone vale America/Sao_Paulo; sem opens_at e closes_at a localidade não
+// restringe o horário das cadeiras
+type LocationRequest struct {
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	Site     string `json:"site" validate:"required,min=2,max=100"` // escritório ou unidade
+	Building string `json:"building" validate:"max=100"`
+	Floor    string `json:"floor" validate:"max=50"`
+	Room     string `json:"room" validate:"max=50"`
+	Address  string `json:"address" validate:"max=255"`
+	Timezone string `json:"timezone"`
+	OpensAt  string `json:"opens_at"`  // Formato HH:MM
+	ClosesAt string `json:"closes_at"` // Formato HH:MM
+	IsActive *bool  `json:"is_active"` // apenas na atualização; padrão: ativa
+}
+
+// LocationResponse representa uma localidade
+type LocationResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Site        string    `json:"site"`
+	Building    string    `json:"building"`
+	Floor       string    `json:"floor"`
+	Room        string    `json:"room"`
+	Address     string    `json:"address"`
+	Description string    `json:"description"` // escritório, prédio, andar e sala
+	Timezone    string    `json:"timezone"`
+	OpensAt     string    `json:"opens_at,omitempty"`
+	ClosesAt    string    `json:"closes_at,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// LocationAttendantResponse representa um atendente vinculado à localidade
+type LocationAttendantResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
@@ -0,0 +1,13 @@
+package dtos
+
+// ForgotPasswordRequest representa o pedido de redefinição de senha
+type ForgotPasswordRequest struct {
+	Identifier string `json:"identifier" form:"identifier" validate:"required"` // CPF (com ou sem pontuação) ou email da conta
+}
+
+// ResetPasswordRequest representa a nova senha enviada com o token do link, em JSON
+// ou pelo formulário da página do link
+type ResetPasswordRequest struct {
+	Token    string `json:"token" form:"token" validate:"required"`
+	Password string `json:"password" form:"password" validate:"required,min=6"`
+}
@@ -0,0 +1,59 @@
+package dtos
+
+import "time"
+
+// LiftSuspensionRequest representa os dados para encerrar uma suspensão
+type LiftSuspensionRequest struct {
+	Reason         string `json:"reason" validate:"required,min=3,max=500"`
+	ClearPenalties bool   `json:"clear_penalties"` // perdoa as penalidades da janela atual
+}
+
+// PenaltyResponse representa uma penalidade registrada
+type PenaltyResponse struct {
+	ID               uint       `json:"id"`
+	BookingID        uint       `json:"booking_id"`
+	Type             string     `json:"type"`
+	OccurredAt       time.Time  `json:"occurred_at"`
+	BookingStartTime *time.Time `json:"booking_start_time,omitempty"`
+	ChairName        string     `json:"chair_name,omitempty"`
+	Waived           bool       `json:"waived"`
+	WaivedAt         *time.Time `json:"waived_at,omitempty"`
+}
+
+// SuspensionResponse representa uma suspensão do direito de agendar
+type SuspensionResponse struct {
+	ID                    uint       `json:"id"`
+	UserID                uint       `json:"user_id"`
+	UserName              string     `json:"user_name,omitempty"`
+	Reason                string     `json:"reason"`
+	NoShowCount           int        `json:"no_show_count"`
+	LateCancellationCount int        `json:"late_cancellation_count"`
+	StartsAt              time.Time  `json:"starts_at"`
+	EndsAt                time.Time  `json:"ends_at"`
+	Automatic             bool       `json:"automatic"`
+	LiftedAt              *time.Time `json:"lifted_at,omitempty"`
+	LiftedBy              *uint      `json:"lifted_by,omitempty"`
+	LiftReason            string     `json:"lift_reason,omitempty"`
+}
+
+// PenaltyStatusResponse resume a situação de penalidades de um usuário
+type PenaltyStatusResponse struct {
+	UserID                 uint                `json:"user_id"`
+	WindowDays             int                 `json:"window_days"`
+	NoShowCount            int64               `json:"no_show_count"`
+	NoShowLimit            int                 `json:"no_show_limit"`
+	LateCancellationCount  int64               `json:"late_cancellation_count"`
+	LateCancellationLimit  int                 `json:"late_cancellation_limit"`
+	LateCancellationWindow int                 `json:"late_cancellation_window_minutes"`
+	Suspended              bool                `json:"suspended"`
+	ActiveSuspension       *SuspensionResponse `json:"active_suspension,omitempty"`
+	Penalties              []PenaltyResponse   `json:"penalties"`
+}
+
+// ListSuspensionsResponse representa a resposta de listagem de suspensões
+type ListSuspensionsResponse struct {
+	Suspensions []SuspensionResponse `json:"suspensions"`
+	Total       int64                `json:"total"`
+	Limit       int                  `json:"limit"`
+	Offset      int                  `json:"offset"`
+}
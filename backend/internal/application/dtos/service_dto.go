@@ -0,0 +1,42 @@
+package dtos
+
+import "time"
+
+// CreateServiceRequest representa os dados para criar um serviço
+type CreateServiceRequest struct {
+	Name            string `json:"name" validate:"required,min=2,max=100"`
+	Description     string `json:"description" validate:"max=255"`
+	DurationMinutes int    `json:"duration_minutes" validate:"required,min=5,max=240"`
+}
+
+// UpdateServiceRequest representa os dados para atualizar um serviço
+type UpdateServiceRequest struct {
+	Name            string `json:"name" validate:"required,min=2,max=100"`
+	Description     string `json:"description" validate:"max=255"`
+	DurationMinutes int    `json:"duration_minutes" validate:"required,min=5,max=240"`
+	IsActive        bool   `json:"is_active"`
+}
+
+// ServiceResponse representa a resposta de dados de serviço
+type ServiceResponse struct {
+	ID              uint      `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	DurationMinutes int       `json:"duration_minutes"`
+	IsActive        bool      `json:"is_active"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ListServicesResponse representa a resposta da listagem de serviços
+type ListServicesResponse struct {
+	Services []ServiceResponse `json:"services"`
+	Total    int64             `json:"total"`
+	Limit    int               `json:"limit"`
+	Offset   int               `json:"offset"`
+}
+
+// SetChairServicesRequest representa os serviços oferecidos por uma cadeira
+type SetChairServicesRequest struct {
+	ServiceIDs []uint `json:"service_ids"`
+}
@@ -0,0 +1,15 @@
+package dtos
+
+import "time"
+
+// SessionResponse representa uma sessão de login aberta
+type SessionResponse struct {
+	ID         uint      `json:"id"`
+	DeviceName string    `json:"device_name,omitempty"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`   // início da sessão (login)
+	LastSeenAt time.Time `json:"last_seen_at"` // último acesso registrado
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"` // sessão do token usado na requisição
+}
@@ -0,0 +1,23 @@
+package dtos
+
+import "time"
+
+// CreateSlotHoldRequest representa os dados para reservar temporariamente um horário
+type CreateSlotHoldRequest struct {
+	ChairID   uint      `json:"chair_id" validate:"required"`
+	ServiceID *uint     `json:"service_id"`
+	StartTime time.Time `json:"start_time" validate:"required"`
+}
+
+// SlotHoldResponse representa uma reserva temporária de horário
+type SlotHoldResponse struct {
+	Token     string    `json:"token"`
+	ChairID   uint      `json:"chair_id"`
+	ChairName string    `json:"chair_name,omitempty"`
+	ServiceID *uint     `json:"service_id,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Status    string    `json:"status"`
+	BookingID *uint     `json:"booking_id,omitempty"`
+}
@@ -0,0 +1,38 @@
+package dtos
+
+import "time"
+
+// CreateTenantRequest representa os dados para cadastrar um tenant junto com o
+// primeiro admin. O slug é o subdomínio do cliente e não muda depois do cadastro
+type CreateTenantRequest struct {
+	Name  string             `json:"name" validate:"required,min=2,max=100"`
+	Slug  string             `json:"slug" validate:"required,min=2,max=63"`
+	Admin TenantAdminRequest `json:"admin"`
+}
+
+// UpdateTenantRequest representa os dados editáveis de um tenant. Desativar o tenant
+// bloqueia todas as requisições do cliente e para as rotinas automáticas dele
+type UpdateTenantRequest struct {
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	IsActive *bool  `json:"is_active"`
+}
+
+// TenantAdminRequest representa um admin cadastrado pelo super admin dentro do tenant
+type TenantAdminRequest struct {
+	Name     string `json:"name" validate:"required,min=2,max=60"`
+	CPF      string `json:"cpf" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Phone    string `json:"phone" validate:"required,min=10,max=20"`
+	Password string `json:"password" validate:"required,min=6"`
+	Gender   string `json:"gender"` // padrão: outro
+}
+
+// TenantResponse representa um tenant
+type TenantResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
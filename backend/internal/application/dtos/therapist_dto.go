@@ -0,0 +1,83 @@
+package dtos
+
+import "time"
+
+// TherapistRequest representa os dados para cadastrar ou atualizar um massoterapeuta
+type TherapistRequest struct {
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	Email    string `json:"email" validate:"omitempty,email"`
+	Phone    string `json:"phone" validate:"max=20"`
+	UserID   *uint  `json:"user_id"`   // conta do massoterapeuta no sistema, se houver
+	IsActive *bool  `json:"is_active"` // apenas na atualização; padrão: ativo
+}
+
+// TherapistResponse representa um massoterapeuta
+type TherapistResponse struct {
+	ID        uint                     `json:"id"`
+	Name      string                   `json:"name"`
+	Email     string                   `json:"email,omitempty"`
+	Phone     string                   `json:"phone,omitempty"`
+	UserID    *uint                    `json:"user_id,omitempty"`
+	IsActive  bool                     `json:"is_active"`
+	Shifts    []TherapistShiftResponse `json:"shifts,omitempty"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+}
+
+// TherapistShiftRequest representa o turno semanal do massoterapeuta em uma cadeira.
+// Sem valid_from e valid_to o turno vale por tempo indeterminado
+type TherapistShiftRequest struct {
+	ChairID   uint   `json:"chair_id" validate:"required"`
+	DayOfWeek int    `json:"day_of_week" validate:"min=0,max=6"` // 0=Domingo, 6=Sábado
+	StartTime string `json:"start_time" validate:"required"`     // Formato HH:MM
+	EndTime   string `json:"end_time" validate:"required"`       // Formato HH:MM
+	ValidFrom string `json:"valid_from"`                         // Formato YYYY-MM-DD
+	ValidTo   string `json:"valid_to"`                           // Formato YYYY-MM-DD
+}
+
+// TherapistShiftResponse representa um turno do massoterapeuta
+type TherapistShiftResponse struct {
+	ID            uint   `json:"id"`
+	TherapistID   uint   `json:"therapist_id"`
+	ChairID       uint   `json:"chair_id"`
+	ChairName     string `json:"chair_name,omitempty"`
+	DayOfWeek     int    `json:"day_of_week"`
+	DayOfWeekName string `json:"day_of_week_name"`
+	StartTime     string `json:"start_time"`
+	EndTime       string `json:"end_time"`
+	ValidFrom     string `json:"valid_from,omitempty"`
+	ValidTo       string `json:"valid_to,omitempty"`
+}
+
+// TherapistAbsenceRequest representa uma ausência do massoterapeuta. Sem end_date a
+// ausência dura apenas o dia de início
+type TherapistAbsenceRequest struct {
+	StartDate string `json:"start_date" validate:"required"` // Formato YYYY-MM-DD
+	EndDate   string `json:"end_date"`                       // Formato YYYY-MM-DD
+	Reason    string `json:"reason" validate:"max=255"`
+}
+
+// TherapistAbsenceResponse representa uma ausência do massoterapeuta
+type TherapistAbsenceResponse struct {
+	ID               uint      `json:"id"`
+	TherapistID      uint      `json:"therapist_id"`
+	StartDate        string    `json:"start_date"`
+	EndDate          string    `json:"end_date"`
+	Reason           string    `json:"reason"`
+	CreatedAt        time.Time `json:"created_at"`
+	AffectedBookings []uint    `json:"affected_bookings,omitempty"` // agendamentos do massoterapeuta no período, a remanejar
+}
+
+// TherapistHoursResponse resume as horas do massoterapeuta no período, para o
+// planejamento das horas contratadas
+type TherapistHoursResponse struct {
+	TherapistID    uint    `json:"therapist_id"`
+	TherapistName  string  `json:"therapist_name"`
+	StartDate      string  `json:"start_date"`
+	EndDate        string  `json:"end_date"`
+	ScheduledHours float64 `json:"scheduled_hours"` // horas de turno, descontadas as ausências
+	AbsentHours    float64 `json:"absent_hours"`    // horas de turno perdidas por ausências
+	BookedHours    float64 `json:"booked_hours"`    // horas de sessões agendadas ou realizadas
+	Sessions       int     `json:"sessions"`
+	Utilization    float64 `json:"utilization"` // percentual das horas de turno ocupado por sessões
+}
@@ -71,6 +71,8 @@ type UserResponse struct {
 	CreatedAt     time.Time  `json:"created_at"`
 	UpdatedAt     time.Time  `json:"updated_at"`
 	LastLogin     *time.Time `json:"last_login"`
+
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
 }
 
 // ListUsersRequest representa os filtros para listar usuários
@@ -0,0 +1,32 @@
+package dtos
+
+import "time"
+
+// JoinWaitlistRequest representa os dados para entrar na lista de espera
+type JoinWaitlistRequest struct {
+	ChairID     uint   `json:"chair_id" validate:"required"`
+	ServiceID   *uint  `json:"service_id"`
+	Date        string `json:"date" validate:"required"`         // YYYY-MM-DD
+	WindowStart string `json:"window_start" validate:"required"` // HH:MM
+	WindowEnd   string `json:"window_end" validate:"required"`   // HH:MM
+	Preference  string `json:"preference"`                       // oferta ou automatico
+}
+
+// WaitlistEntryResponse representa uma entrada da lista de espera
+type WaitlistEntryResponse struct {
+	ID             uint       `json:"id"`
+	UserID         uint       `json:"user_id"`
+	ChairID        uint       `json:"chair_id"`
+	ChairName      string     `json:"chair_name,omitempty"`
+	UserName       string     `json:"user_name,omitempty"`
+	ServiceID      *uint      `json:"service_id,omitempty"`
+	Date           string     `json:"date"`
+	WindowStart    string     `json:"window_start"`
+	WindowEnd      string     `json:"window_end"`
+	Preference     string     `json:"preference"`
+	Status         string     `json:"status"`
+	OfferedStart   *time.Time `json:"offered_start,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	BookingID      *uint      `json:"booking_id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
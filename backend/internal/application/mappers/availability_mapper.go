@@ -1,6 +1,7 @@
 package mappers
 
 import (
+	"sort"
 	"time"
 	"agendamento-backend/internal/application/dtos"
 	"agendamento-backend/internal/domain/entities"
@@ -103,3 +104,48 @@ func ToWeeklyAvailabilityResponse(chairID uint, weekOf time.Time, dailySlots map
 		DailySlots: dailySlots,
 	}
 }
+
+// ToAvailabilityExceptionResponse converte entidade AvailabilityException para AvailabilityExceptionResponse
+func ToAvailabilityExceptionResponse(exception *entities.AvailabilityException) *dtos.AvailabilityExceptionResponse {
+	return &dtos.AvailabilityExceptionResponse{
+		ID:        exception.ID,
+		ChairID:   exception.ChairID,
+		Date:      exception.Date.Format("2006-01-02"),
+		Type:      exception.Type,
+		StartTime: exception.StartTime,
+		EndTime:   exception.EndTime,
+		Reason:    exception.Reason,
+		CreatedAt: exception.CreatedAt,
+		UpdatedAt: exception.UpdatedAt,
+	}
+}
+
+// ToAvailabilityExceptionResponseList converte lista de entidades AvailabilityException
+func ToAvailabilityExceptionResponseList(exceptions []*entities.AvailabilityException) []dtos.AvailabilityExceptionResponse {
+	responses := make([]dtos.AvailabilityExceptionResponse, len(exceptions))
+	for i, exception := range exceptions {
+		responses[i] = *ToAvailabilityExceptionResponse(exception)
+	}
+	return responses
+}
+
+// ToAvailabilitySearchResponse converte o resultado da busca em todas as cadeiras
+// (data -> horário -> cadeiras livres) em lista ordenada por data e horário
+func ToAvailabilitySearchResponse(results map[string]map[string][]uint) []dtos.AvailabilitySearchDay {
+	days := make([]dtos.AvailabilitySearchDay, 0, len(results))
+	for date, slots := range results {
+		day := dtos.AvailabilitySearchDay{Date: date, Slots: make([]dtos.AvailabilitySearchSlot, 0, len(slots))}
+		for slot, chairIDs := range slots {
+			day.Slots = append(day.Slots, dtos.AvailabilitySearchSlot{
+				Time:            slot,
+				AvailableChairs: len(chairIDs),
+				ChairIDs:        chairIDs,
+			})
+		}
+		sort.Slice(day.Slots, func(i, j int) bool { return day.Slots[i].Time < day.Slots[j].Time })
+		days = append(days, day)
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Date < days[j].Date })
+	return days
+}
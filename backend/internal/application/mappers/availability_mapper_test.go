@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"testing"
+
+	"agendamento-backend/internal/application/dtos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToAvailabilitySearchResponse(t *testing.T) {
+	results := map[string]map[string][]uint{
+		"2025-01-16": {"09:00": {2}},
+		"2025-01-15": {
+			"10:00": {1},
+			"09:30": {1, 3},
+		},
+	}
+
+	response := ToAvailabilitySearchResponse(results)
+
+	assert.Equal(t, []dtos.AvailabilitySearchDay{
+		{Date: "2025-01-15", Slots: []dtos.AvailabilitySearchSlot{
+			{Time: "09:30", AvailableChairs: 2, ChairIDs: []uint{1, 3}},
+			{Time: "10:00", AvailableChairs: 1, ChairIDs: []uint{1}},
+		}},
+		{Date: "2025-01-16", Slots: []dtos.AvailabilitySearchSlot{
+			{Time: "09:00", AvailableChairs: 1, ChairIDs: []uint{2}},
+		}},
+	}, response)
+}
+
+func TestToAvailabilitySearchResponse_Empty(t *testing.T) {
+	assert.Empty(t, ToAvailabilitySearchResponse(nil))
+}
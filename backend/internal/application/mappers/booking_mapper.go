@@ -9,10 +9,11 @@ import (
 func ToBookingEntity(req *dtos.CreateBookingRequest) *entities.Booking {
 	return &entities.Booking{
 		ChairID:   req.ChairID,
+		ServiceID: req.ServiceID,
 		StartTime: req.StartTime,
 		Notes:     req.Notes,
 		// UserID deve ser definido pelo handler a partir do contexto de autenticação
-		// EndTime será definido automaticamente pelos hooks da entidade (30 minutos após StartTime)
+		// EndTime é calculado pelo caso de uso a partir da duração do serviço
 	}
 }
 
@@ -29,30 +30,45 @@ func ToBookingUpdateEntity(id uint, req *dtos.UpdateBookingRequest) *entities.Bo
 // ToBookingResponse converte entidade Booking para BookingResponse
 func ToBookingResponse(booking *entities.Booking) *dtos.BookingResponse {
 	return &dtos.BookingResponse{
-		ID:        booking.ID,
-		UserID:    booking.UserID,
-		ChairID:   booking.ChairID,
-		StartTime: booking.StartTime,
-		EndTime:   booking.EndTime,
-		Status:    booking.Status,
-		Notes:     booking.Notes,
-		CreatedAt: booking.CreatedAt,
-		UpdatedAt: booking.UpdatedAt,
+		ID:            booking.ID,
+		UserID:        booking.UserID,
+		ChairID:       booking.ChairID,
+		ServiceID:     booking.ServiceID,
+		StartTime:     booking.StartTime,
+		EndTime:       booking.EndTime,
+		Status:        booking.Status,
+		Notes:         booking.Notes,
+		ClosureID:     booking.ClosureID,
+		CreatedAt:     booking.CreatedAt,
+		UpdatedAt:     booking.UpdatedAt,
+		TherapistID:   booking.TherapistID,
+		TherapistName: therapistName(booking),
 	}
 }
 
 // ToCreateBookingResponse converte entidade Booking para CreateBookingResponse
 func ToCreateBookingResponse(booking *entities.Booking) *dtos.CreateBookingResponse {
 	return &dtos.CreateBookingResponse{
-		ID:        booking.ID,
-		UserID:    booking.UserID,
-		ChairID:   booking.ChairID,
-		StartTime: booking.StartTime,
-		EndTime:   booking.EndTime,
-		Status:    booking.Status,
-		Notes:     booking.Notes,
-		CreatedAt: booking.CreatedAt,
+		ID:            booking.ID,
+		UserID:        booking.UserID,
+		ChairID:       booking.ChairID,
+		ServiceID:     booking.ServiceID,
+		StartTime:     booking.StartTime,
+		EndTime:       booking.EndTime,
+		Status:        booking.Status,
+		Notes:         booking.Notes,
+		CreatedAt:     booking.CreatedAt,
+		TherapistID:   booking.TherapistID,
+		TherapistName: therapistName(booking),
+	}
+}
+
+// therapistName retorna o nome do massoterapeuta do agendamento, se carregado
+func therapistName(booking *entities.Booking) string {
+	if booking.Therapist == nil {
+		return ""
 	}
+	return booking.Therapist.Name
 }
 
 // ToBookingWithRelationsResponse converte entidade Booking com relacionamentos
@@ -61,6 +77,7 @@ func ToBookingWithRelationsResponse(booking *entities.Booking, user *entities.Us
 		ID:        booking.ID,
 		UserID:    booking.UserID,
 		ChairID:   booking.ChairID,
+		ServiceID: booking.ServiceID,
 		StartTime: booking.StartTime,
 		EndTime:   booking.EndTime,
 		Status:    booking.Status,
@@ -111,3 +128,32 @@ func ToBookingStatsResponse(stats map[string]int64) *dtos.BookingStatsResponse {
 		PendingBookings:   stats["pending"],
 	}
 }
+
+// ToBookingStatusHistoryResponse converte entidade BookingStatusHistory para BookingStatusHistoryResponse
+func ToBookingStatusHistoryResponse(entry *entities.BookingStatusHistory) *dtos.BookingStatusHistoryResponse {
+	response := &dtos.BookingStatusHistoryResponse{
+		ID:         entry.ID,
+		BookingID:  entry.BookingID,
+		FromStatus: entry.FromStatus,
+		ToStatus:   entry.ToStatus,
+		ActorID:    entry.ActorID,
+		Source:     entry.Source,
+		Reason:     entry.Reason,
+		CreatedAt:  entry.CreatedAt,
+	}
+
+	if entry.Actor != nil {
+		response.ActorName = entry.Actor.Name
+	}
+
+	return response
+}
+
+// ToBookingStatusHistoryResponseList converte o histórico de status para lista de BookingStatusHistoryResponse
+func ToBookingStatusHistoryResponseList(history []*entities.BookingStatusHistory) []dtos.BookingStatusHistoryResponse {
+	responses := make([]dtos.BookingStatusHistoryResponse, len(history))
+	for i, entry := range history {
+		responses[i] = *ToBookingStatusHistoryResponse(entry)
+	}
+	return responses
+}
@@ -0,0 +1,80 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToBookingPolicyEntity converte BookingPolicyRequest para entidade BookingPolicy
+func ToBookingPolicyEntity(id uint, req *dtos.BookingPolicyRequest) *entities.BookingPolicy {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return &entities.BookingPolicy{
+		ID:                        id,
+		Name:                      req.Name,
+		Description:               req.Description,
+		Role:                      req.Role,
+		Sector:                    req.Sector,
+		ChairID:                   req.ChairID,
+		MaxActiveBookings:         req.MaxActiveBookings,
+		BookingHorizonDays:        req.BookingHorizonDays,
+		MinLeadTimeMinutes:        req.MinLeadTimeMinutes,
+		CancellationCutoffMinutes: req.CancellationCutoffMinutes,
+		MaxDailyBookings:          req.MaxDailyBookings,
+		MaxWeeklyBookings:         req.MaxWeeklyBookings,
+		IsActive:                  isActive,
+	}
+}
+
+// ToBookingPolicyResponse converte entidade BookingPolicy para BookingPolicyResponse
+func ToBookingPolicyResponse(policy *entities.BookingPolicy) *dtos.BookingPolicyResponse {
+	return &dtos.BookingPolicyResponse{
+		ID:                        policy.ID,
+		Name:                      policy.Name,
+		Description:               policy.Description,
+		Role:                      policy.Role,
+		Sector:                    policy.Sector,
+		ChairID:                   policy.ChairID,
+		MaxActiveBookings:         policy.MaxActiveBookings,
+		BookingHorizonDays:        policy.BookingHorizonDays,
+		MinLeadTimeMinutes:        policy.MinLeadTimeMinutes,
+		CancellationCutoffMinutes: policy.CancellationCutoffMinutes,
+		MaxDailyBookings:          policy.MaxDailyBookings,
+		MaxWeeklyBookings:         policy.MaxWeeklyBookings,
+		IsActive:                  policy.IsActive,
+		CreatedAt:                 policy.CreatedAt,
+		UpdatedAt:                 policy.UpdatedAt,
+	}
+}
+
+// ToListBookingPoliciesResponse converte lista de entidades BookingPolicy para ListBookingPoliciesResponse
+func ToListBookingPoliciesResponse(policies []*entities.BookingPolicy, total int64, limit, offset int) *dtos.ListBookingPoliciesResponse {
+	responses := make([]dtos.BookingPolicyResponse, len(policies))
+	for i, policy := range policies {
+		responses[i] = *ToBookingPolicyResponse(policy)
+	}
+
+	return &dtos.ListBookingPoliciesResponse{
+		Policies: responses,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}
+}
+
+// ToEffectivePolicyResponse converte as regras efetivas para EffectivePolicyResponse
+func ToEffectivePolicyResponse(userID, chairID uint, rules entities.PolicyRules) *dtos.EffectivePolicyResponse {
+	return &dtos.EffectivePolicyResponse{
+		UserID:                    userID,
+		ChairID:                   chairID,
+		MaxActiveBookings:         rules.MaxActiveBookings,
+		BookingHorizonDays:        int(rules.BookingHorizon.Hours() / 24),
+		MinLeadTimeMinutes:        int(rules.MinLeadTime.Minutes()),
+		CancellationCutoffMinutes: int(rules.CancellationCutoff.Minutes()),
+		MaxDailyBookings:          rules.MaxDailyBookings,
+		MaxWeeklyBookings:         rules.MaxWeeklyBookings,
+	}
+}
@@ -0,0 +1,75 @@
+package mappers
+
+import (
+	"fmt"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/pkg/ical"
+)
+
+// calendarProductID identifica o sistema nos arquivos .ics gerados
+const calendarProductID = "-//Agendamento de Massagens//Agendamentos//PT"
+
+// ToCalendarEvent converte um agendamento em evento iCalendar. Cadeira e serviço
+// entram no resumo e no local quando estão carregados
+func ToCalendarEvent(booking *entities.Booking) ical.Event {
+	summary := "Sessão de massagem"
+	if booking.Service != nil && booking.Service.Name != "" {
+		summary = booking.Service.Name
+	}
+
+	event := ical.Event{
+		UID:         booking.CalendarUID(),
+		Sequence:    booking.CalendarSequence,
+		Start:       booking.StartTime,
+		End:         booking.EndTime,
+		Summary:     summary,
+		Description: booking.Notes,
+		Status:      ical.StatusConfirmed,
+	}
+
+	if booking.Chair.ID != 0 {
+		event.Summary = fmt.Sprintf("%s - %s", summary, booking.Chair.Name)
+		event.Location = booking.Chair.Location
+	}
+
+	if booking.IsCancelled() {
+		event.Status = ical.StatusCancelled
+	}
+
+	return event
+}
+
+// ToCalendarInvite monta o convite enviado por email. No cancelamento o SEQUENCE
+// avança para que o cliente de calendário aceite a atualização
+func ToCalendarInvite(booking *entities.Booking, method, organizer, attendee string) *ical.Calendar {
+	event := ToCalendarEvent(booking)
+	event.Organizer = organizer
+	event.Attendee = attendee
+
+	if method == ical.MethodCancel {
+		event.Sequence++
+		event.Status = ical.StatusCancelled
+	}
+
+	return &ical.Calendar{
+		ProductID: calendarProductID,
+		Method:    method,
+		Events:    []ical.Event{event},
+	}
+}
+
+// ToCalendarFeed monta o feed de assinatura com os agendamentos do usuário
+func ToCalendarFeed(bookings []*entities.Booking) *ical.Calendar {
+	events := make([]ical.Event, len(bookings))
+	for i, booking := range bookings {
+		events[i] = ToCalendarEvent(booking)
+	}
+
+	return &ical.Calendar{
+		ProductID: calendarProductID,
+		Name:      "Minhas massagens",
+		Method:    ical.MethodPublish,
+		Events:    events,
+	}
+}
@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/pkg/ical"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToCalendarInvite(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	booking := &entities.Booking{
+		ID:               7,
+		StartTime:        start,
+		EndTime:          start.Add(30 * time.Minute),
+		Status:           entities.BookingStatusScheduled,
+		CalendarSequence: 1,
+		Chair:            entities.Chair{ID: 2, Name: "Cadeira 2", Location: "Térreo"},
+	}
+
+	tests := []struct {
+		name             string
+		method           string
+		expectedSequence int
+		expectedStatus   string
+	}{
+		{"Convite mantém a sequência do agendamento", ical.MethodRequest, 1, ical.StatusConfirmed},
+		{"Cancelamento avança a sequência", ical.MethodCancel, 2, ical.StatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := ToCalendarInvite(booking, tt.method, "noreply@agendamento.com", "joao@example.com")
+
+			assert.Equal(t, tt.method, calendar.Method)
+			assert.Len(t, calendar.Events, 1)
+			event := calendar.Events[0]
+			assert.Equal(t, booking.CalendarUID(), event.UID)
+			assert.Equal(t, tt.expectedSequence, event.Sequence)
+			assert.Equal(t, tt.expectedStatus, event.Status)
+			assert.Equal(t, "Sessão de massagem - Cadeira 2", event.Summary)
+			assert.Equal(t, "Térreo", event.Location)
+			assert.Equal(t, "joao@example.com", event.Attendee)
+		})
+	}
+
+	assert.Equal(t, 1, booking.CalendarSequence)
+}
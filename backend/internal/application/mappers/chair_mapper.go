@@ -1,6 +1,8 @@
 package mappers
 
 import (
+	"strings"
+
 	"agendamento-backend/internal/application/dtos"
 	"agendamento-backend/internal/domain/entities"
 )
@@ -13,46 +15,54 @@ func ToChairEntity(req *dtos.CreateChairRequest) *entities.Chair {
 	}
 
 	return &entities.Chair{
-		Name:        req.Name,
-		Description: req.Description,
-		Location:    req.Location,
-		Status:      status,
+		Name:          req.Name,
+		Description:   req.Description,
+		Location:      req.Location,
+		LocationID:    req.LocationID,
+		Status:        status,
+		BufferMinutes: req.BufferMinutes,
 	}
 }
 
 // ToChairUpdateEntity converte UpdateChairRequest para entidade Chair
 func ToChairUpdateEntity(id uint, req *dtos.UpdateChairRequest) *entities.Chair {
 	return &entities.Chair{
-		ID:          id,
-		Name:        req.Name,
-		Description: req.Description,
-		Location:    req.Location,
-		Status:      req.Status,
+		ID:            id,
+		Name:          req.Name,
+		Description:   req.Description,
+		Location:      req.Location,
+		LocationID:    req.LocationID,
+		Status:        req.Status,
+		BufferMinutes: req.BufferMinutes,
 	}
 }
 
 // ToChairResponse converte entidade Chair para ChairResponse
 func ToChairResponse(chair *entities.Chair) *dtos.ChairResponse {
 	return &dtos.ChairResponse{
-		ID:          chair.ID,
-		Name:        chair.Name,
-		Description: chair.Description,
-		Location:    chair.Location,
-		Status:      chair.Status,
-		CreatedAt:   chair.CreatedAt,
-		UpdatedAt:   chair.UpdatedAt,
+		ID:            chair.ID,
+		Name:          chair.Name,
+		Description:   chair.Description,
+		Location:      chair.Location,
+		LocationID:    chair.LocationID,
+		Status:        chair.Status,
+		BufferMinutes: chair.BufferMinutes,
+		CreatedAt:     chair.CreatedAt,
+		UpdatedAt:     chair.UpdatedAt,
 	}
 }
 
 // ToCreateChairResponse converte entidade Chair para CreateChairResponse
 func ToCreateChairResponse(chair *entities.Chair) *dtos.CreateChairResponse {
 	return &dtos.CreateChairResponse{
-		ID:          chair.ID,
-		Name:        chair.Name,
-		Description: chair.Description,
-		Location:    chair.Location,
-		Status:      chair.Status,
-		CreatedAt:   chair.CreatedAt,
+		ID:            chair.ID,
+		Name:          chair.Name,
+		Description:   chair.Description,
+		Location:      chair.Location,
+		LocationID:    chair.LocationID,
+		Status:        chair.Status,
+		BufferMinutes: chair.BufferMinutes,
+		CreatedAt:     chair.CreatedAt,
 	}
 }
 
@@ -84,3 +94,92 @@ func ToChairStatsResponse(stats map[string]int64) *dtos.ChairStatsResponse {
 		AvailableChairs: stats["available"],
 	}
 }
+
+// ToChairBreakEntity converte ChairBreakRequest para entidade ChairBreak
+func ToChairBreakEntity(chairID uint, req *dtos.ChairBreakRequest) *entities.ChairBreak {
+	return &entities.ChairBreak{
+		ChairID:   chairID,
+		Name:      req.Name,
+		DayOfWeek: req.DayOfWeek,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+}
+
+// ToChairBreakResponse converte entidade ChairBreak para ChairBreakResponse
+func ToChairBreakResponse(chairBreak *entities.ChairBreak) *dtos.ChairBreakResponse {
+	return &dtos.ChairBreakResponse{
+		ID:            chairBreak.ID,
+		ChairID:       chairBreak.ChairID,
+		Name:          chairBreak.Name,
+		DayOfWeek:     chairBreak.DayOfWeek,
+		DayOfWeekName: chairBreak.GetDayOfWeekName(),
+		StartTime:     chairBreak.StartTime,
+		EndTime:       chairBreak.EndTime,
+		CreatedAt:     chairBreak.CreatedAt,
+		UpdatedAt:     chairBreak.UpdatedAt,
+	}
+}
+
+// ToChairBreakResponseList converte lista de entidades ChairBreak
+func ToChairBreakResponseList(breaks []*entities.ChairBreak) []dtos.ChairBreakResponse {
+	responses := make([]dtos.ChairBreakResponse, len(breaks))
+	for i, chairBreak := range breaks {
+		responses[i] = *ToChairBreakResponse(chairBreak)
+	}
+	return responses
+}
+
+// ToChairMaintenanceEntity converte ChairMaintenanceRequest para entidade ChairMaintenance
+func ToChairMaintenanceEntity(chairID uint, req *dtos.ChairMaintenanceRequest) *entities.ChairMaintenance {
+	return &entities.ChairMaintenance{
+		ChairID:   chairID,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Reason:    strings.TrimSpace(req.Reason),
+	}
+}
+
+// ToChairMaintenanceResponse converte entidade ChairMaintenance para ChairMaintenanceResponse
+func ToChairMaintenanceResponse(maintenance *entities.ChairMaintenance) *dtos.ChairMaintenanceResponse {
+	return &dtos.ChairMaintenanceResponse{
+		ID:                maintenance.ID,
+		ChairID:           maintenance.ChairID,
+		StartTime:         maintenance.StartTime,
+		EndTime:           maintenance.EndTime,
+		Reason:            maintenance.Reason,
+		RelocatedBookings: maintenance.RelocatedBookings,
+		CancelledBookings: maintenance.CancelledBookings,
+		CreatedAt:         maintenance.CreatedAt,
+	}
+}
+
+// ToChairMaintenanceResponseList converte lista de entidades ChairMaintenance
+func ToChairMaintenanceResponseList(maintenances []*entities.ChairMaintenance) []dtos.ChairMaintenanceResponse {
+	responses := make([]dtos.ChairMaintenanceResponse, len(maintenances))
+	for i, maintenance := range maintenances {
+		responses[i] = *ToChairMaintenanceResponse(maintenance)
+	}
+	return responses
+}
+
+// ToOutageImpactResponseList converte os destinos dos agendamentos atingidos
+func ToOutageImpactResponseList(impacts []*entities.OutageImpact) []dtos.OutageImpactResponse {
+	responses := make([]dtos.OutageImpactResponse, len(impacts))
+	for i, impact := range impacts {
+		responses[i] = dtos.OutageImpactResponse{
+			BookingID: impact.Booking.ID,
+			UserID:    impact.Booking.UserID,
+			UserName:  impact.Booking.User.Name,
+			StartTime: impact.Booking.StartTime,
+			EndTime:   impact.Booking.EndTime,
+			Action:    impact.Action,
+			Detail:    impact.Detail,
+		}
+		if impact.TargetChair != nil {
+			responses[i].TargetChairID = &impact.TargetChair.ID
+			responses[i].TargetChairName = impact.TargetChair.Name
+		}
+	}
+	return responses
+}
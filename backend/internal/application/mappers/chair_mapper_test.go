@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToOutageImpactResponseList(t *testing.T) {
+	start := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	booking := &entities.Booking{
+		ID: 9, UserID: 4, StartTime: start, EndTime: start.Add(30 * time.Minute),
+		User: entities.User{ID: 4, Name: "Maria"},
+	}
+
+	responses := ToOutageImpactResponseList([]*entities.OutageImpact{
+		{Booking: booking, Action: entities.OutageActionRelocate, TargetChair: &entities.Chair{ID: 2, Name: "Cadeira 02"}},
+		{Booking: booking, Action: entities.OutageActionCancel, Detail: "nenhuma outra cadeira livre no horário"},
+	})
+
+	assert.Len(t, responses, 2)
+	assert.Equal(t, uint(9), responses[0].BookingID)
+	assert.Equal(t, "Maria", responses[0].UserName)
+	assert.Equal(t, uint(2), *responses[0].TargetChairID)
+	assert.Equal(t, "Cadeira 02", responses[0].TargetChairName)
+	assert.Nil(t, responses[1].TargetChairID)
+	assert.Equal(t, entities.OutageActionCancel, responses[1].Action)
+	assert.NotEmpty(t, responses[1].Detail)
+}
@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToClosureResponse converte entidade Closure para ClosureResponse
+func ToClosureResponse(closure *entities.Closure) *dtos.ClosureResponse {
+	return &dtos.ClosureResponse{
+		ID:         closure.ID,
+		LocationID: closure.LocationID,
+		Location:   closure.Location,
+		Global:     closure.IsGlobal(),
+		StartDate:  closure.StartDate.Format("2006-01-02"),
+		EndDate:    closure.EndDate.Format("2006-01-02"),
+		Reason:     closure.Reason,
+		Source:     closure.Source,
+		CreatedAt:  closure.CreatedAt,
+	}
+}
+
+// ToClosureResponseList converte lista de entidades Closure
+func ToClosureResponseList(closures []*entities.Closure) []dtos.ClosureResponse {
+	responses := make([]dtos.ClosureResponse, len(closures))
+	for i, closure := range closures {
+		responses[i] = *ToClosureResponse(closure)
+	}
+	return responses
+}
@@ -0,0 +1,100 @@
+package mappers
+
+import (
+	"math"
+	"strings"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToBookingFeedbackEntity converte FeedbackRequest para entidade BookingFeedback.
+// O vínculo com o agendamento é feito no caso de uso
+func ToBookingFeedbackEntity(req *dtos.FeedbackRequest) *entities.BookingFeedback {
+	feedback := &entities.BookingFeedback{
+		Comments:       strings.TrimSpace(req.Comments),
+		ChairIssue:     req.ChairIssue,
+		TherapistIssue: req.TherapistIssue,
+	}
+	if req.Rating != nil {
+		feedback.Rating = *req.Rating
+	}
+	return feedback
+}
+
+// ToFeedbackResponse converte entidade BookingFeedback para FeedbackResponse
+func ToFeedbackResponse(feedback *entities.BookingFeedback) *dtos.FeedbackResponse {
+	response := &dtos.FeedbackResponse{
+		ID:             feedback.ID,
+		BookingID:      feedback.BookingID,
+		UserID:         feedback.UserID,
+		ChairID:        feedback.ChairID,
+		LocationID:     feedback.LocationID,
+		TherapistID:    feedback.TherapistID,
+		Rating:         feedback.Rating,
+		Comments:       feedback.Comments,
+		ChairIssue:     feedback.ChairIssue,
+		TherapistIssue: feedback.TherapistIssue,
+		Source:         feedback.Source,
+		CreatedAt:      feedback.CreatedAt,
+	}
+	if feedback.User != nil {
+		response.UserName = feedback.User.Name
+	}
+	if feedback.Chair != nil {
+		response.ChairName = feedback.Chair.Name
+	}
+	if feedback.Location != nil {
+		response.LocationName = feedback.Location.Name
+	}
+	if feedback.Therapist != nil {
+		response.TherapistName = feedback.Therapist.Name
+	}
+	return response
+}
+
+// ToListFeedbacksResponse converte a lista paginada de avaliações para ListFeedbacksResponse
+func ToListFeedbacksResponse(feedbacks []*entities.BookingFeedback, total int64, limit, offset int) *dtos.ListFeedbacksResponse {
+	responses := make([]dtos.FeedbackResponse, len(feedbacks))
+	for i, feedback := range feedbacks {
+		responses[i] = *ToFeedbackResponse(feedback)
+	}
+
+	return &dtos.ListFeedbacksResponse{
+		Feedbacks: responses,
+		Total:     total,
+		Limit:     limit,
+		Offset:    offset,
+	}
+}
+
+// ToSatisfactionStatsResponse converte as métricas de um grupo, arredondando nota
+// média e NPS para uma casa decimal
+func ToSatisfactionStatsResponse(stats *entities.SatisfactionStats) *dtos.SatisfactionStatsResponse {
+	return &dtos.SatisfactionStatsResponse{
+		GroupID:         stats.GroupID,
+		GroupName:       stats.GroupName,
+		Responses:       stats.Responses,
+		AverageRating:   roundOneDecimal(stats.AverageRating()),
+		NPS:             roundOneDecimal(stats.NPS()),
+		Promoters:       stats.Promoters,
+		Passives:        stats.Passives(),
+		Detractors:      stats.Detractors,
+		ChairIssues:     stats.ChairIssues,
+		TherapistIssues: stats.TherapistIssues,
+	}
+}
+
+// ToSatisfactionStatsResponseList converte as métricas de vários grupos
+func ToSatisfactionStatsResponseList(stats []*entities.SatisfactionStats) []dtos.SatisfactionStatsResponse {
+	responses := make([]dtos.SatisfactionStatsResponse, len(stats))
+	for i, group := range stats {
+		responses[i] = *ToSatisfactionStatsResponse(group)
+	}
+	return responses
+}
+
+// roundOneDecimal arredonda para uma casa decimal
+func roundOneDecimal(value float64) float64 {
+	return math.Round(value*10) / 10
+}
@@ -0,0 +1,38 @@
+package mappers
+
+import (
+	"testing"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBookingFeedbackEntity(t *testing.T) {
+	rating := 0
+	req := &dtos.FeedbackRequest{Rating: &rating, Comments: "  Cadeira rangendo  ", ChairIssue: true}
+
+	feedback := ToBookingFeedbackEntity(req)
+
+	assert.Equal(t, 0, feedback.Rating)
+	assert.Equal(t, "Cadeira rangendo", feedback.Comments)
+	assert.True(t, feedback.ChairIssue)
+	assert.False(t, feedback.TherapistIssue)
+}
+
+func TestToSatisfactionStatsResponse(t *testing.T) {
+	// 3 promotores, 2 neutros e 1 detrator: NPS = (3 - 1) / 6 = 33,3
+	stats := &entities.SatisfactionStats{
+		GroupID: 2, GroupName: "Cadeira 2",
+		Responses: 6, RatingSum: 49, Promoters: 3, Detractors: 1, ChairIssues: 1,
+	}
+
+	response := ToSatisfactionStatsResponse(stats)
+
+	assert.Equal(t, "Cadeira 2", response.GroupName)
+	assert.Equal(t, int64(2), response.Passives)
+	assert.Equal(t, 8.2, response.AverageRating)
+	assert.Equal(t, 33.3, response.NPS)
+	assert.Equal(t, int64(1), response.ChairIssues)
+}
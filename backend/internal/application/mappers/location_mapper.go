@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToLocationEntity converte LocationRequest para entidade Location
+func ToLocationEntity(req *dtos.LocationRequest) *entities.Location {
+	location := &entities.Location{
+		Name:     req.Name,
+		Site:     req.Site,
+		Building: req.Building,
+		Floor:    req.Floor,
+		Room:     req.Room,
+		Address:  req.Address,
+		Timezone: req.Timezone,
+		OpensAt:  req.OpensAt,
+		ClosesAt: req.ClosesAt,
+		IsActive: true,
+	}
+	if req.IsActive != nil {
+		location.IsActive = *req.IsActive
+	}
+	return location
+}
+
+// ToLocationResponse converte entidade Location para LocationResponse
+func ToLocationResponse(location *entities.Location) *dtos.LocationResponse {
+	return &dtos.LocationResponse{
+		ID:          location.ID,
+		Name:        location.Name,
+		Site:        location.Site,
+		Building:    location.Building,
+		Floor:       location.Floor,
+		Room:        location.Room,
+		Address:     location.Address,
+		Description: location.Describe(),
+		Timezone:    location.Timezone,
+		OpensAt:     location.OpensAt,
+		ClosesAt:    location.ClosesAt,
+		IsActive:    location.IsActive,
+		CreatedAt:   location.CreatedAt,
+		UpdatedAt:   location.UpdatedAt,
+	}
+}
+
+// ToLocationResponseList converte lista de entidades Location
+func ToLocationResponseList(locations []*entities.Location) []dtos.LocationResponse {
+	responses := make([]dtos.LocationResponse, len(locations))
+	for i, location := range locations {
+		responses[i] = *ToLocationResponse(location)
+	}
+	return responses
+}
+
+// ToLocationAttendantResponseList converte os atendentes vinculados à localidade
+func ToLocationAttendantResponseList(users []*entities.User) []dtos.LocationAttendantResponse {
+	responses := make([]dtos.LocationAttendantResponse, len(users))
+	for i, user := range users {
+		responses[i] = dtos.LocationAttendantResponse{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+		}
+	}
+	return responses
+}
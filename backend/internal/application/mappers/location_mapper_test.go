@@ -0,0 +1,39 @@
+package mappers
+
+import (
+	"testing"
+
+	"agendamento-backend/internal/application/dtos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToLocationEntity(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name           string
+		isActive       *bool
+		expectedActive bool
+	}{
+		{"Sem status cria ativa", nil, true},
+		{"Status informado é mantido", &inactive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location := ToLocationEntity(&dtos.LocationRequest{Name: "SP - Torre A", Site: "São Paulo", IsActive: tt.isActive})
+
+			assert.Equal(t, "SP - Torre A", location.Name)
+			assert.Equal(t, tt.expectedActive, location.IsActive)
+		})
+	}
+}
+
+func TestToLocationResponse_Description(t *testing.T) {
+	location := ToLocationEntity(&dtos.LocationRequest{Name: "SP - Torre A", Site: "São Paulo", Building: "Torre A", Room: "Sala 12"})
+
+	response := ToLocationResponse(location)
+
+	assert.Equal(t, "São Paulo - Torre A - Sala 12", response.Description)
+}
@@ -0,0 +1,93 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToPenaltyResponse converte entidade BookingPenalty para PenaltyResponse
+func ToPenaltyResponse(penalty *entities.BookingPenalty) *dtos.PenaltyResponse {
+	response := &dtos.PenaltyResponse{
+		ID:         penalty.ID,
+		BookingID:  penalty.BookingID,
+		Type:       penalty.Type,
+		OccurredAt: penalty.OccurredAt,
+		Waived:     penalty.IsWaived(),
+		WaivedAt:   penalty.WaivedAt,
+	}
+
+	if penalty.Booking != nil {
+		response.BookingStartTime = &penalty.Booking.StartTime
+		response.ChairName = penalty.Booking.Chair.Name
+	}
+
+	return response
+}
+
+// ToSuspensionResponse converte entidade BookingSuspension para SuspensionResponse
+func ToSuspensionResponse(suspension *entities.BookingSuspension) *dtos.SuspensionResponse {
+	response := &dtos.SuspensionResponse{
+		ID:                    suspension.ID,
+		UserID:                suspension.UserID,
+		Reason:                suspension.Reason,
+		NoShowCount:           suspension.NoShowCount,
+		LateCancellationCount: suspension.LateCancellationCount,
+		StartsAt:              suspension.StartsAt,
+		EndsAt:                suspension.EndsAt,
+		Automatic:             suspension.CreatedBy == nil,
+		LiftedAt:              suspension.LiftedAt,
+		LiftedBy:              suspension.LiftedBy,
+		LiftReason:            suspension.LiftReason,
+	}
+
+	if suspension.User != nil {
+		response.UserName = suspension.User.Name
+	}
+
+	return response
+}
+
+// ToSuspensionResponseList converte lista de entidades BookingSuspension para lista de SuspensionResponse
+func ToSuspensionResponseList(suspensions []*entities.BookingSuspension) []dtos.SuspensionResponse {
+	responses := make([]dtos.SuspensionResponse, len(suspensions))
+	for i, suspension := range suspensions {
+		responses[i] = *ToSuspensionResponse(suspension)
+	}
+	return responses
+}
+
+// ToListSuspensionsResponse converte lista de suspensões para ListSuspensionsResponse
+func ToListSuspensionsResponse(suspensions []*entities.BookingSuspension, total int64, limit, offset int) *dtos.ListSuspensionsResponse {
+	return &dtos.ListSuspensionsResponse{
+		Suspensions: ToSuspensionResponseList(suspensions),
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+	}
+}
+
+// ToPenaltyStatusResponse converte o resumo de penalidades para PenaltyStatusResponse
+func ToPenaltyStatusResponse(status *entities.PenaltyStatus) *dtos.PenaltyStatusResponse {
+	penalties := make([]dtos.PenaltyResponse, len(status.Penalties))
+	for i, penalty := range status.Penalties {
+		penalties[i] = *ToPenaltyResponse(penalty)
+	}
+
+	response := &dtos.PenaltyStatusResponse{
+		UserID:                 status.UserID,
+		WindowDays:             int(status.Settings.Window.Hours() / 24),
+		NoShowCount:            status.NoShowCount,
+		NoShowLimit:            status.Settings.NoShowLimit,
+		LateCancellationCount:  status.LateCancellationCount,
+		LateCancellationLimit:  status.Settings.LateCancellationLimit,
+		LateCancellationWindow: int(status.Settings.LateCancellationWindow.Minutes()),
+		Suspended:              status.ActiveSuspension != nil,
+		Penalties:              penalties,
+	}
+
+	if status.ActiveSuspension != nil {
+		response.ActiveSuspension = ToSuspensionResponse(status.ActiveSuspension)
+	}
+
+	return response
+}
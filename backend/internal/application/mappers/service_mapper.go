@@ -0,0 +1,59 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToServiceEntity converte CreateServiceRequest para entidade Service
+func ToServiceEntity(req *dtos.CreateServiceRequest) *entities.Service {
+	return &entities.Service{
+		Name:            req.Name,
+		Description:     req.Description,
+		DurationMinutes: req.DurationMinutes,
+		IsActive:        true,
+	}
+}
+
+// ToServiceUpdateEntity converte UpdateServiceRequest para entidade Service
+func ToServiceUpdateEntity(id uint, req *dtos.UpdateServiceRequest) *entities.Service {
+	return &entities.Service{
+		ID:              id,
+		Name:            req.Name,
+		Description:     req.Description,
+		DurationMinutes: req.DurationMinutes,
+		IsActive:        req.IsActive,
+	}
+}
+
+// ToServiceResponse converte entidade Service para ServiceResponse
+func ToServiceResponse(service *entities.Service) *dtos.ServiceResponse {
+	return &dtos.ServiceResponse{
+		ID:              service.ID,
+		Name:            service.Name,
+		Description:     service.Description,
+		DurationMinutes: service.DurationMinutes,
+		IsActive:        service.IsActive,
+		CreatedAt:       service.CreatedAt,
+		UpdatedAt:       service.UpdatedAt,
+	}
+}
+
+// ToServiceResponseList converte lista de entidades Service para lista de ServiceResponse
+func ToServiceResponseList(services []*entities.Service) []dtos.ServiceResponse {
+	responses := make([]dtos.ServiceResponse, len(services))
+	for i, service := range services {
+		responses[i] = *ToServiceResponse(service)
+	}
+	return responses
+}
+
+// ToListServicesResponse converte lista de entidades Service para ListServicesResponse
+func ToListServicesResponse(services []*entities.Service, total int64, limit, offset int) *dtos.ListServicesResponse {
+	return &dtos.ListServicesResponse{
+		Services: ToServiceResponseList(services),
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}
+}
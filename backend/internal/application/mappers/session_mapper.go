@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToSessionResponse converte entidade UserSession para SessionResponse, marcando a
+// sessão da requisição atual
+func ToSessionResponse(session *entities.UserSession, currentSessionID uint) *dtos.SessionResponse {
+	return &dtos.SessionResponse{
+		ID:         session.ID,
+		DeviceName: session.DeviceName,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+		Current:    session.ID == currentSessionID,
+	}
+}
+
+// ToSessionResponseList converte as sessões de um usuário
+func ToSessionResponseList(sessions []*entities.UserSession, currentSessionID uint) []dtos.SessionResponse {
+	responses := make([]dtos.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = *ToSessionResponse(session, currentSessionID)
+	}
+	return responses
+}
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToSlotHoldResponse converte entidade SlotHold para SlotHoldResponse
+func ToSlotHoldResponse(hold *entities.SlotHold) *dtos.SlotHoldResponse {
+	response := &dtos.SlotHoldResponse{
+		Token:     hold.Token,
+		ChairID:   hold.ChairID,
+		ServiceID: hold.ServiceID,
+		StartTime: hold.StartTime,
+		EndTime:   hold.EndTime,
+		ExpiresAt: hold.ExpiresAt,
+		Status:    hold.Status,
+		BookingID: hold.BookingID,
+	}
+
+	if hold.Chair != nil {
+		response.ChairName = hold.Chair.Name
+	}
+
+	return response
+}
@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	"strings"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToTenantEntity converte CreateTenantRequest para entidade Tenant
+func ToTenantEntity(req *dtos.CreateTenantRequest) *entities.Tenant {
+	return &entities.Tenant{
+		Name:     strings.TrimSpace(req.Name),
+		Slug:     strings.ToLower(strings.TrimSpace(req.Slug)),
+		IsActive: true,
+	}
+}
+
+// ToTenantAdminEntity converte TenantAdminRequest para o usuário admin do tenant
+func ToTenantAdminEntity(req *dtos.TenantAdminRequest) *entities.User {
+	gender := req.Gender
+	if gender == "" {
+		gender = "outro"
+	}
+	return &entities.User{
+		Name:          req.Name,
+		CPF:           req.CPF,
+		Email:         req.Email,
+		Phone:         req.Phone,
+		Password:      req.Password,
+		Gender:        gender,
+		Role:          "admin",
+		RequestedRole: "admin",
+		Status:        "aprovado",
+	}
+}
+
+// ToTenantResponse converte entidade Tenant para TenantResponse
+func ToTenantResponse(tenant *entities.Tenant) *dtos.TenantResponse {
+	return &dtos.TenantResponse{
+		ID:        tenant.ID,
+		Name:      tenant.Name,
+		Slug:      tenant.Slug,
+		IsActive:  tenant.IsActive,
+		CreatedAt: tenant.CreatedAt,
+		UpdatedAt: tenant.UpdatedAt,
+	}
+}
+
+// ToTenantResponseList converte lista de tenants
+func ToTenantResponseList(tenants []*entities.Tenant) []*dtos.TenantResponse {
+	responses := make([]*dtos.TenantResponse, len(tenants))
+	for i, tenant := range tenants {
+		responses[i] = ToTenantResponse(tenant)
+	}
+	return responses
+}
@@ -0,0 +1,38 @@
+package mappers
+
+import (
+	"testing"
+
+	"agendamento-backend/internal/application/dtos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTenantEntity(t *testing.T) {
+	tenant := ToTenantEntity(&dtos.CreateTenantRequest{Name: " Empresa ABC ", Slug: " Empresa-ABC "})
+
+	assert.Equal(t, "Empresa ABC", tenant.Name)
+	assert.Equal(t, "empresa-abc", tenant.Slug)
+	assert.True(t, tenant.IsActive)
+}
+
+func TestToTenantAdminEntity(t *testing.T) {
+	tests := []struct {
+		name           string
+		gender         string
+		expectedGender string
+	}{
+		{"Sem gênero usa o padrão", "", "outro"},
+		{"Gênero informado é mantido", "feminino", "feminino"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := ToTenantAdminEntity(&dtos.TenantAdminRequest{Name: "Ana", CPF: "52998224725", Gender: tt.gender})
+
+			assert.Equal(t, "admin", admin.Role)
+			assert.Equal(t, "aprovado", admin.Status)
+			assert.Equal(t, tt.expectedGender, admin.Gender)
+		})
+	}
+}
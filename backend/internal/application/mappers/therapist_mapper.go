@@ -0,0 +1,101 @@
+package mappers
+
+import (
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToTherapistEntity converte TherapistRequest para entidade Therapist
+func ToTherapistEntity(req *dtos.TherapistRequest) *entities.Therapist {
+	therapist := &entities.Therapist{
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		UserID:   req.UserID,
+		IsActive: true,
+	}
+	if req.IsActive != nil {
+		therapist.IsActive = *req.IsActive
+	}
+	return therapist
+}
+
+// ToTherapistResponse converte entidade Therapist para TherapistResponse
+func ToTherapistResponse(therapist *entities.Therapist) *dtos.TherapistResponse {
+	response := &dtos.TherapistResponse{
+		ID:        therapist.ID,
+		Name:      therapist.Name,
+		Email:     therapist.Email,
+		Phone:     therapist.Phone,
+		UserID:    therapist.UserID,
+		IsActive:  therapist.IsActive,
+		CreatedAt: therapist.CreatedAt,
+		UpdatedAt: therapist.UpdatedAt,
+	}
+	for i := range therapist.Shifts {
+		response.Shifts = append(response.Shifts, *ToTherapistShiftResponse(&therapist.Shifts[i]))
+	}
+	return response
+}
+
+// ToTherapistResponseList converte lista de entidades Therapist
+func ToTherapistResponseList(therapists []*entities.Therapist) []dtos.TherapistResponse {
+	responses := make([]dtos.TherapistResponse, len(therapists))
+	for i, therapist := range therapists {
+		responses[i] = *ToTherapistResponse(therapist)
+	}
+	return responses
+}
+
+// ToTherapistShiftResponse converte entidade TherapistShift para TherapistShiftResponse
+func ToTherapistShiftResponse(shift *entities.TherapistShift) *dtos.TherapistShiftResponse {
+	response := &dtos.TherapistShiftResponse{
+		ID:            shift.ID,
+		TherapistID:   shift.TherapistID,
+		ChairID:       shift.ChairID,
+		DayOfWeek:     shift.DayOfWeek,
+		DayOfWeekName: shift.GetDayOfWeekName(),
+		StartTime:     shift.StartTime,
+		EndTime:       shift.EndTime,
+	}
+	if shift.Chair != nil {
+		response.ChairName = shift.Chair.Name
+	}
+	if shift.ValidFrom != nil {
+		response.ValidFrom = shift.ValidFrom.Format("2006-01-02")
+	}
+	if shift.ValidTo != nil {
+		response.ValidTo = shift.ValidTo.Format("2006-01-02")
+	}
+	return response
+}
+
+// ToTherapistShiftResponseList converte lista de entidades TherapistShift
+func ToTherapistShiftResponseList(shifts []*entities.TherapistShift) []dtos.TherapistShiftResponse {
+	responses := make([]dtos.TherapistShiftResponse, len(shifts))
+	for i, shift := range shifts {
+		responses[i] = *ToTherapistShiftResponse(shift)
+	}
+	return responses
+}
+
+// ToTherapistAbsenceResponse converte entidade TherapistAbsence para TherapistAbsenceResponse
+func ToTherapistAbsenceResponse(absence *entities.TherapistAbsence) *dtos.TherapistAbsenceResponse {
+	return &dtos.TherapistAbsenceResponse{
+		ID:          absence.ID,
+		TherapistID: absence.TherapistID,
+		StartDate:   absence.StartDate.Format("2006-01-02"),
+		EndDate:     absence.EndDate.Format("2006-01-02"),
+		Reason:      absence.Reason,
+		CreatedAt:   absence.CreatedAt,
+	}
+}
+
+// ToTherapistAbsenceResponseList converte lista de entidades TherapistAbsence
+func ToTherapistAbsenceResponseList(absences []*entities.TherapistAbsence) []dtos.TherapistAbsenceResponse {
+	responses := make([]dtos.TherapistAbsenceResponse, len(absences))
+	for i, absence := range absences {
+		responses[i] = *ToTherapistAbsenceResponse(absence)
+	}
+	return responses
+}
@@ -0,0 +1,59 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTherapistEntity(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name           string
+		isActive       *bool
+		expectedActive bool
+	}{
+		{"Sem status cria ativo", nil, true},
+		{"Status informado é mantido", &inactive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			therapist := ToTherapistEntity(&dtos.TherapistRequest{Name: "Ana Souza", IsActive: tt.isActive})
+
+			assert.Equal(t, "Ana Souza", therapist.Name)
+			assert.Equal(t, tt.expectedActive, therapist.IsActive)
+		})
+	}
+}
+
+func TestToTherapistShiftResponse(t *testing.T) {
+	validFrom := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	shift := &entities.TherapistShift{
+		ID: 4, TherapistID: 2, ChairID: 1, DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00",
+		ValidFrom: &validFrom,
+		Chair:     &entities.Chair{ID: 1, Name: "Cadeira 1"},
+	}
+
+	response := ToTherapistShiftResponse(shift)
+
+	assert.Equal(t, "Cadeira 1", response.ChairName)
+	assert.Equal(t, "Segunda", response.DayOfWeekName)
+	assert.Equal(t, "2025-03-01", response.ValidFrom)
+	assert.Empty(t, response.ValidTo)
+}
+
+func TestToBookingResponse_Therapist(t *testing.T) {
+	therapistID := uint(2)
+	booking := &entities.Booking{ID: 1, TherapistID: &therapistID, Therapist: &entities.Therapist{ID: 2, Name: "Ana Souza"}}
+
+	response := ToBookingResponse(booking)
+
+	assert.Equal(t, &therapistID, response.TherapistID)
+	assert.Equal(t, "Ana Souza", response.TherapistName)
+}
@@ -61,6 +61,8 @@ func ToResponse(user *entities.User) *dtos.UserResponse {
 		CreatedAt:     user.CreatedAt,
 		UpdatedAt:     user.UpdatedAt,
 		LastLogin:     user.LastLogin,
+
+		EmailVerifiedAt: user.EmailVerifiedAt,
 	}
 }
 
@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+)
+
+// ToWaitlistEntryEntity converte JoinWaitlistRequest para entidade WaitlistEntry
+func ToWaitlistEntryEntity(req *dtos.JoinWaitlistRequest, userID uint, date time.Time) *entities.WaitlistEntry {
+	preference := req.Preference
+	if preference == "" {
+		preference = entities.WaitlistPreferenceOffer
+	}
+
+	return &entities.WaitlistEntry{
+		UserID:      userID,
+		ChairID:     req.ChairID,
+		ServiceID:   req.ServiceID,
+		Date:        date,
+		WindowStart: req.WindowStart,
+		WindowEnd:   req.WindowEnd,
+		Preference:  preference,
+		Status:      entities.WaitlistStatusWaiting,
+	}
+}
+
+// ToWaitlistEntryResponse converte entidade WaitlistEntry para WaitlistEntryResponse
+func ToWaitlistEntryResponse(entry *entities.WaitlistEntry) *dtos.WaitlistEntryResponse {
+	return &dtos.WaitlistEntryResponse{
+		ID:             entry.ID,
+		UserID:         entry.UserID,
+		ChairID:        entry.ChairID,
+		ChairName:      entry.Chair.Name,
+		UserName:       entry.User.Name,
+		ServiceID:      entry.ServiceID,
+		Date:           entry.Date.Format("2006-01-02"),
+		WindowStart:    entry.WindowStart,
+		WindowEnd:      entry.WindowEnd,
+		Preference:     entry.Preference,
+		Status:         entry.Status,
+		OfferedStart:   entry.OfferedStart,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		BookingID:      entry.BookingID,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
+
+// ToWaitlistEntryResponseList converte lista de entidades WaitlistEntry para lista de WaitlistEntryResponse
+func ToWaitlistEntryResponseList(entries []*entities.WaitlistEntry) []dtos.WaitlistEntryResponse {
+	responses := make([]dtos.WaitlistEntryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = *ToWaitlistEntryResponse(entry)
+	}
+	return responses
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,27 +15,48 @@ import (
 type AvailabilityUseCase struct {
 	availabilityRepo repositories.AvailabilityRepository
 	bookingRepo      repositories.BookingRepository
+	holdRepo         repositories.SlotHoldRepository
 	chairRepo        repositories.ChairRepository
+	serviceRepo      repositories.ServiceRepository
 	auditRepo        repositories.AuditLogRepository
 	validator        ports.Validator
+	slotEngine       *SlotEngine
+	horizonDays      int // quantos dias à frente os horários podem ser consultados
 }
 
 func NewAvailabilityUseCase(
 	availabilityRepo repositories.AvailabilityRepository,
 	bookingRepo repositories.BookingRepository,
+	holdRepo repositories.SlotHoldRepository,
 	chairRepo repositories.ChairRepository,
+	serviceRepo repositories.ServiceRepository,
+	closureRepo repositories.ClosureRepository,
+	locationRepo repositories.LocationRepository,
+	therapistRepo repositories.TherapistRepository,
 	auditRepo repositories.AuditLogRepository,
 	validator ports.Validator,
+	horizonDays int,
 ) *AvailabilityUseCase {
+	if horizonDays <= 0 {
+		horizonDays = DefaultSlotHorizonDays
+	}
+
 	return &AvailabilityUseCase{
 		availabilityRepo: availabilityRepo,
 		bookingRepo:      bookingRepo,
+		holdRepo:         holdRepo,
 		chairRepo:        chairRepo,
+		serviceRepo:      serviceRepo,
 		auditRepo:        auditRepo,
 		validator:        validator,
+		slotEngine:       NewSlotEngine(availabilityRepo, bookingRepo, holdRepo, chairRepo, closureRepo, locationRepo, therapistRepo),
+		horizonDays:      horizonDays,
 	}
 }
 
+// DefaultSlotHorizonDays é o horizonte padrão da consulta de horários
+const DefaultSlotHorizonDays = 15
+
 // CreateAvailability cria uma nova disponibilidade
 func (uc *AvailabilityUseCase) CreateAvailability(availability *entities.Availability, createdBy uint) error {
 	// Validar dados
@@ -172,8 +194,44 @@ func (uc *AvailabilityUseCase) GetChairAvailabilities(chairID uint) ([]*entities
 	return uc.availabilityRepo.GetByChair(chairID)
 }
 
-// GetAvailableTimeSlots retorna os horários disponíveis para uma data específica
-func (uc *AvailabilityUseCase) GetAvailableTimeSlots(chairID uint, date time.Time) ([]string, error) {
+// GetAvailableTimeSlots retorna os horários disponíveis para uma data específica.
+// Com serviceID zero os horários seguem a duração padrão da sessão. Em feriados e
+// fechamentos da localidade da cadeira a lista fica vazia
+func (uc *AvailabilityUseCase) GetAvailableTimeSlots(chairID uint, date time.Time, serviceID uint) ([]string, error) {
+	slotsByDate, err := uc.chairSlotsInRange(chairID, serviceID, date, 1)
+	if err != nil {
+		return nil, err
+	}
+	return slotsByDate[date.Format("2006-01-02")], nil
+}
+
+// GetUpcomingAvailableSlots retorna os horários disponíveis da cadeira nos próximos
+// dias, a partir de hoje. Com days zero vale o horizonte configurado, que também é o limite
+func (uc *AvailabilityUseCase) GetUpcomingAvailableSlots(chairID, serviceID uint, days int) (map[string][]string, error) {
+	if days <= 0 {
+		days = uc.horizonDays
+	}
+	if days > uc.horizonDays {
+		return nil, fmt.Errorf("os horários podem ser consultados para no máximo %d dias", uc.horizonDays)
+	}
+
+	slotsByDate, err := uc.chairSlotsInRange(chairID, serviceID, time.Now(), days)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dias sem horários ficam fora do resultado
+	result := make(map[string][]string)
+	for date, slots := range slotsByDate {
+		if len(slots) > 0 {
+			result[date] = slots
+		}
+	}
+	return result, nil
+}
+
+// chairSlotsInRange valida cadeira e serviço e calcula os horários livres da cadeira no período
+func (uc *AvailabilityUseCase) chairSlotsInRange(chairID, serviceID uint, from time.Time, days int) (map[string][]string, error) {
 	// Verificar se cadeira existe e está ativa
 	chair, err := uc.chairRepo.GetByID(chairID)
 	if err != nil {
@@ -183,74 +241,177 @@ func (uc *AvailabilityUseCase) GetAvailableTimeSlots(chairID uint, date time.Tim
 		return nil, errors.New("cadeira não está disponível")
 	}
 
-	// Buscar disponibilidades da cadeira para o dia da semana
-	availabilities, err := uc.availabilityRepo.GetChairAvailabilityForDate(chairID, date)
+	// Duração da sessão conforme o serviço escolhido
+	duration, err := sessionDuration(uc.serviceRepo, chairID, &serviceID)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao buscar disponibilidades: %w", err)
+		return nil, err
 	}
 
-	// Buscar agendamentos existentes para a cadeira na data
-	bookings, err := uc.bookingRepo.GetByChairAndDate(chairID, date)
+	slots, err := uc.slotEngine.Compute(SlotRange{
+		Chairs:   []*entities.Chair{chair},
+		From:     from,
+		Days:     days,
+		Duration: duration,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("erro ao buscar agendamentos: %w", err)
+		return nil, err
 	}
+	return slots[chair.ID], nil
+}
+
+// GetDailyCapacity retorna quantas sessões de duração padrão cada cadeira comporta
+// na data, descontando pausas e fechamentos mas não os agendamentos
+func (uc *AvailabilityUseCase) GetDailyCapacity(chairs []*entities.Chair, date time.Time) (map[uint]int, error) {
+	slots, err := uc.slotEngine.Compute(SlotRange{
+		Chairs:         chairs,
+		From:           date,
+		Days:           1,
+		Duration:       entities.DefaultSessionDuration,
+		IgnoreBookings: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := make(map[uint]int, len(chairs))
+	day := date.Format("2006-01-02")
+	for chairID, slotsByDate := range slots {
+		capacity[chairID] = len(slotsByDate[day])
+	}
+	return capacity, nil
+}
 
-	// Criar mapa de horários já agendados (apenas agendamentos não cancelados)
-	bookedSlots := make(map[string]bool)
+// buildAvailableSlots gera os horários de início livres em uma data. Um horário só
+// é oferecido se a sessão inteira cabe na janela fora das pausas da cadeira e não
+// sobrepõe nenhum agendamento da cadeira (cancelados e faltas liberam o horário) nem
+// reserva temporária em vigor, respeitando a folga de higienização antes e depois deles.
+// date é a meia-noite no fuso da localidade da cadeira, em que os horários dos
+// agendamentos e das reservas são lidos
+func buildAvailableSlots(availabilities []*entities.Availability, bookings []*entities.Booking, holds []*entities.SlotHold, breaks []*entities.ChairBreak, date time.Time, duration, buffer time.Duration, excludeBookingID uint) []string {
+	type interval struct{ start, end int }
+
+	zone := date.Location()
+	gap := int(buffer.Minutes())
+	var occupied []interval
 	for _, booking := range bookings {
-		if booking.Status != "cancelado" && booking.Status != "falta" {
-			slotTime := booking.StartTime.Format("15:04")
-			bookedSlots[slotTime] = true
+		if booking.Status == "cancelado" || booking.Status == "falta" || booking.ID == excludeBookingID {
+			continue
 		}
+		startTime := booking.StartTime.In(zone)
+		start := startTime.Hour()*60 + startTime.Minute()
+		occupied = append(occupied, interval{start: start - gap, end: start + int(booking.SessionDuration().Minutes()) + gap})
+	}
+	for _, hold := range holds {
+		startTime := hold.StartTime.In(zone)
+		start := startTime.Hour()*60 + startTime.Minute()
+		occupied = append(occupied, interval{start: start - gap, end: start + int(hold.EndTime.Sub(hold.StartTime).Minutes()) + gap})
 	}
 
-	// Gerar todos os slots disponíveis e remover os já agendados
+	// As janelas valem por dia do calendário
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	length := int(duration.Minutes())
 	var availableSlots []string
+	seen := make(map[string]bool)
 	for _, availability := range availabilities {
-		if availability.IsValidForDate(date) {
-			slots, err := availability.GetTimeSlots()
-			if err != nil {
-				continue
-			}
+		if !availability.IsValidForDate(day) {
+			continue
+		}
 
-			// Filtrar apenas slots não agendados
-			for _, slot := range slots {
-				if !bookedSlots[slot] {
-					availableSlots = append(availableSlots, slot)
+		slots, err := availability.GetTimeSlotsWithBreaks(duration, buffer, breaks)
+		if err != nil {
+			continue
+		}
+
+		for _, slot := range slots {
+			parsed, _ := time.Parse("15:04", slot)
+			start := parsed.Hour()*60 + parsed.Minute()
+
+			free := true
+			for _, busy := range occupied {
+				if start < busy.end && busy.start < start+length {
+					free = false
+					break
 				}
 			}
+			// Janelas adicionais de exceções podem repetir horários das semanais
+			if free && !seen[slot] {
+				seen[slot] = true
+				availableSlots = append(availableSlots, slot)
+			}
 		}
 	}
 
-	return availableSlots, nil
+	sort.Strings(availableSlots)
+	return availableSlots
 }
 
-// GetNext15DaysAvailableSlots retorna os horários disponíveis para os próximos 15 dias
-func (uc *AvailabilityUseCase) GetNext15DaysAvailableSlots(chairID uint) (map[string][]string, error) {
-	// Verificar se cadeira existe e está ativa
-	chair, err := uc.chairRepo.GetByID(chairID)
-	if err != nil {
-		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+// SearchAvailableSlots busca horários livres em todas as cadeiras ativas entre as
+// datas informadas (inclusive), com filtro opcional de localidade e serviço. O
+// resultado agrupa por data ("2006-01-02") e horário as cadeiras livres
+func (uc *AvailabilityUseCase) SearchAvailableSlots(startDate, endDate time.Time, location entities.LocationFilter, serviceID uint) (map[string]map[string][]uint, error) {
+	if endDate.Before(startDate) {
+		return nil, errors.New("data final deve ser igual ou posterior à data inicial")
 	}
-	if !chair.IsAvailable() {
-		return nil, errors.New("cadeira não está disponível")
+	if startDate.Before(time.Now().Truncate(24 * time.Hour)) {
+		return nil, errors.New("não é possível buscar horários em datas passadas")
+	}
+	days := int(endDate.Sub(startDate).Hours()/24) + 1
+	if days > uc.horizonDays {
+		return nil, fmt.Errorf("o período da busca deve ter no máximo %d dias", uc.horizonDays)
 	}
 
-	result := make(map[string][]string)
-
-	// Gerar próximos 15 dias
-	for i := 0; i < 15; i++ {
-		date := time.Now().AddDate(0, 0, i)
-		dateStr := date.Format("2006-01-02")
+	duration := entities.DefaultSessionDuration
+	var offering map[uint]bool
+	if serviceID != 0 {
+		service, err := uc.serviceRepo.GetByID(serviceID)
+		if err != nil {
+			return nil, fmt.Errorf("serviço não encontrado: %w", err)
+		}
+		if !service.IsActive {
+			return nil, errors.New("serviço não está ativo")
+		}
+		duration = service.GetDuration()
 
-		slots, err := uc.GetAvailableTimeSlots(chairID, date)
+		chairIDs, err := uc.serviceRepo.GetChairIDsByService(serviceID)
 		if err != nil {
-			// Se houver erro, continuar com próximo dia
-			continue
+			return nil, fmt.Errorf("erro ao verificar serviços das cadeiras: %w", err)
+		}
+		offering = make(map[uint]bool, len(chairIDs))
+		for _, chairID := range chairIDs {
+			offering[chairID] = true
 		}
+	}
 
-		if len(slots) > 0 {
-			result[dateStr] = slots
+	chairs, err := uc.chairRepo.GetAvailableChairs()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar cadeiras: %w", err)
+	}
+
+	// Cadeiras de outra localidade ou que não oferecem o serviço ficam de fora
+	var candidates []*entities.Chair
+	for _, chair := range chairs {
+		if location.Matches(chair) && (offering == nil || offering[chair.ID]) {
+			candidates = append(candidates, chair)
+		}
+	}
+
+	slots, err := uc.slotEngine.Compute(SlotRange{Chairs: candidates, From: startDate, Days: days, Duration: duration})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string][]uint)
+	for _, chair := range candidates {
+		for date, times := range slots[chair.ID] {
+			if len(times) == 0 {
+				continue
+			}
+			if result[date] == nil {
+				result[date] = make(map[string][]uint)
+			}
+			for _, slot := range times {
+				result[date][slot] = append(result[date][slot], chair.ID)
+			}
 		}
 	}
 
@@ -340,6 +501,143 @@ func (uc *AvailabilityUseCase) GetAvailabilityStats() (map[string]int64, error)
 	return stats, nil
 }
 
+// CreateException cadastra uma exceção de disponibilidade da cadeira em uma data
+func (uc *AvailabilityUseCase) CreateException(exception *entities.AvailabilityException, createdBy uint) error {
+	chair, err := uc.validateException(exception, nil)
+	if err != nil {
+		return err
+	}
+
+	exception.CreatedBy = &createdBy
+	if err := uc.availabilityRepo.CreateException(exception); err != nil {
+		return fmt.Errorf("erro ao criar exceção de disponibilidade: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceAvailability, &exception.ID)
+	auditLog.SetDescription(fmt.Sprintf("Exceção de disponibilidade criada para cadeira %s: %s", chair.Name, describeException(exception)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetException busca uma exceção da cadeira
+func (uc *AvailabilityUseCase) GetException(chairID, exceptionID uint) (*entities.AvailabilityException, error) {
+	exception, err := uc.availabilityRepo.GetExceptionByID(exceptionID)
+	if err != nil || exception.ChairID != chairID {
+		return nil, errors.New("exceção de disponibilidade não encontrada")
+	}
+	return exception, nil
+}
+
+// GetChairExceptions lista as exceções de uma cadeira no período
+func (uc *AvailabilityUseCase) GetChairExceptions(chairID uint, from, to *time.Time) ([]*entities.AvailabilityException, error) {
+	if _, err := uc.chairRepo.GetByID(chairID); err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	return uc.availabilityRepo.GetExceptionsByChair(chairID, from, to)
+}
+
+// UpdateException atualiza uma exceção de disponibilidade
+func (uc *AvailabilityUseCase) UpdateException(exception *entities.AvailabilityException, updatedBy uint) error {
+	current, err := uc.GetException(exception.ChairID, exception.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.validateException(exception, &exception.ID); err != nil {
+		return err
+	}
+
+	exception.CreatedBy = current.CreatedBy
+	exception.CreatedAt = current.CreatedAt
+	if err := uc.availabilityRepo.UpdateException(exception); err != nil {
+		return fmt.Errorf("erro ao atualizar exceção de disponibilidade: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceAvailability, &exception.ID)
+	auditLog.SetDescription(fmt.Sprintf("Exceção de disponibilidade atualizada: %s", describeException(exception)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeleteException exclui uma exceção de disponibilidade
+func (uc *AvailabilityUseCase) DeleteException(chairID, exceptionID, deletedBy uint) error {
+	exception, err := uc.GetException(chairID, exceptionID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.availabilityRepo.DeleteException(exceptionID); err != nil {
+		return fmt.Errorf("erro ao excluir exceção de disponibilidade: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceAvailability, &exceptionID)
+	auditLog.SetDescription(fmt.Sprintf("Exceção de disponibilidade excluída: %s", describeException(exception)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// validateException valida a exceção e impede fechamentos duplicados ou horários
+// sobrepostos na mesma data da cadeira
+func (uc *AvailabilityUseCase) validateException(exception *entities.AvailabilityException, excludeID *uint) (*entities.Chair, error) {
+	if err := uc.validator.ValidateStruct(exception); err != nil {
+		return nil, fmt.Errorf("dados inválidos: %w", err)
+	}
+	if err := exception.Validate(); err != nil {
+		return nil, err
+	}
+	if !exception.IsClosed() {
+		if err := uc.validateTimeRange(exception.StartTime, exception.EndTime); err != nil {
+			return nil, err
+		}
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	if exception.Date.Before(today) {
+		return nil, errors.New("não é possível cadastrar exceção para uma data no passado")
+	}
+
+	chair, err := uc.chairRepo.GetByID(exception.ChairID)
+	if err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	if !chair.IsActive() {
+		return nil, errors.New("não é possível cadastrar exceção para uma cadeira inativa")
+	}
+
+	existing, err := uc.availabilityRepo.GetExceptionsByChair(exception.ChairID, &exception.Date, &exception.Date)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar exceções da data: %w", err)
+	}
+	for _, other := range existing {
+		if excludeID != nil && other.ID == *excludeID {
+			continue
+		}
+		if exception.IsClosed() || other.IsClosed() {
+			return nil, errors.New("a cadeira já tem exceção de fechamento ou horários cadastrados para esta data")
+		}
+		if exception.Overlaps(other) {
+			return nil, errors.New("já existe exceção com horário sobreposto nesta data")
+		}
+	}
+
+	return chair, nil
+}
+
+// describeException descreve a exceção para a auditoria
+func describeException(exception *entities.AvailabilityException) string {
+	date := exception.Date.Format("02/01/2006")
+	if exception.IsClosed() {
+		return fmt.Sprintf("%s fechada", date)
+	}
+	return fmt.Sprintf("%s %s %s-%s", date, exception.Type, exception.StartTime, exception.EndTime)
+}
+
 // validateTimeRange valida se o horário está no formato correto e é válido
 func (uc *AvailabilityUseCase) validateTimeRange(startTime, endTime string) error {
 	// Validar formato
@@ -0,0 +1,259 @@
+package usecases
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type BookingPolicyUseCase struct {
+	policyRepo   repositories.BookingPolicyRepository
+	bookingRepo  repositories.BookingRepository
+	userRepo     repositories.UserRepository
+	chairRepo    repositories.ChairRepository
+	locationRepo repositories.LocationRepository
+	auditRepo    repositories.AuditLogRepository
+	validator    ports.Validator
+}
+
+func NewBookingPolicyUseCase(
+	policyRepo repositories.BookingPolicyRepository,
+	bookingRepo repositories.BookingRepository,
+	userRepo repositories.UserRepository,
+	chairRepo repositories.ChairRepository,
+	locationRepo repositories.LocationRepository,
+	auditRepo repositories.AuditLogRepository,
+	validator ports.Validator,
+) *BookingPolicyUseCase {
+	return &BookingPolicyUseCase{
+		policyRepo:   policyRepo,
+		bookingRepo:  bookingRepo,
+		userRepo:     userRepo,
+		chairRepo:    chairRepo,
+		locationRepo: locationRepo,
+		auditRepo:    auditRepo,
+		validator:    validator,
+	}
+}
+
+// CreatePolicy cria uma nova política de agendamento
+func (uc *BookingPolicyUseCase) CreatePolicy(policy *entities.BookingPolicy, createdBy uint) error {
+	if err := uc.validatePolicy(policy); err != nil {
+		return err
+	}
+
+	if err := uc.policyRepo.Create(policy); err != nil {
+		return fmt.Errorf("erro ao criar política: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourcePolicy, &policy.ID)
+	auditLog.SetDescription(fmt.Sprintf("Política de agendamento %s criada", policy.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetPolicyByID busca política por ID
+func (uc *BookingPolicyUseCase) GetPolicyByID(id uint) (*entities.BookingPolicy, error) {
+	return uc.policyRepo.GetByID(id)
+}
+
+// UpdatePolicy atualiza uma política de agendamento
+func (uc *BookingPolicyUseCase) UpdatePolicy(policy *entities.BookingPolicy, updatedBy uint) error {
+	currentPolicy, err := uc.policyRepo.GetByID(policy.ID)
+	if err != nil {
+		return fmt.Errorf("política não encontrada: %w", err)
+	}
+
+	if err := uc.validatePolicy(policy); err != nil {
+		return err
+	}
+
+	policy.CreatedAt = currentPolicy.CreatedAt
+
+	if err := uc.policyRepo.Update(policy); err != nil {
+		return fmt.Errorf("erro ao atualizar política: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourcePolicy, &policy.ID)
+	auditLog.SetDescription(fmt.Sprintf("Política de agendamento %s atualizada", policy.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeletePolicy exclui uma política de agendamento
+func (uc *BookingPolicyUseCase) DeletePolicy(policyID, deletedBy uint) error {
+	policy, err := uc.policyRepo.GetByID(policyID)
+	if err != nil {
+		return fmt.Errorf("política não encontrada: %w", err)
+	}
+
+	if err := uc.policyRepo.Delete(policyID); err != nil {
+		return fmt.Errorf("erro ao excluir política: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourcePolicy, &policyID)
+	auditLog.SetDescription(fmt.Sprintf("Política de agendamento %s excluída", policy.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// ListPolicies lista políticas com paginação
+func (uc *BookingPolicyUseCase) ListPolicies(limit, offset int, filters map[string]interface{}) ([]*entities.BookingPolicy, int64, error) {
+	return uc.policyRepo.List(limit, offset, filters)
+}
+
+// validatePolicy valida os dados e o escopo da política
+func (uc *BookingPolicyUseCase) validatePolicy(policy *entities.BookingPolicy) error {
+	if err := uc.validator.ValidateStruct(policy); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+
+	if policy.ChairID != nil {
+		if _, err := uc.chairRepo.GetByID(*policy.ChairID); err != nil {
+			return fmt.Errorf("cadeira não encontrada: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ResolveRules combina as políticas ativas que se aplicam ao usuário e à cadeira.
+// As regras padrão servem de base e cada política, da menos para a mais específica,
+// sobrescreve os campos que define
+func (uc *BookingPolicyUseCase) ResolveRules(userID, chairID uint) (entities.PolicyRules, error) {
+	rules := entities.DefaultPolicyRules()
+
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return rules, fmt.Errorf("usuário não encontrado: %w", err)
+	}
+
+	policies, err := uc.policyRepo.GetActive()
+	if err != nil {
+		return rules, fmt.Errorf("erro ao buscar políticas de agendamento: %w", err)
+	}
+
+	var matching []*entities.BookingPolicy
+	for _, policy := range policies {
+		if policy.Matches(user.Role, user.Sector, chairID) {
+			matching = append(matching, policy)
+		}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].Specificity() < matching[j].Specificity()
+	})
+
+	for _, policy := range matching {
+		policy.ApplyTo(&rules)
+	}
+
+	return rules, nil
+}
+
+// CheckBooking avalia as regras de política para um novo horário do usuário.
+// excludeBookingID evita que o próprio agendamento conte nos limites ao reagendar.
+// Violações retornam *entities.PolicyViolation
+func (uc *BookingPolicyUseCase) CheckBooking(userID, chairID uint, startTime time.Time, excludeBookingID *uint) error {
+	rules, err := uc.ResolveRules(userID, chairID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if rules.MinLeadTime > 0 && startTime.Before(now.Add(rules.MinLeadTime)) {
+		return entities.NewPolicyViolation(entities.PolicyViolationMinLeadTime,
+			fmt.Sprintf("agendamentos devem ser feitos com pelo menos %s de antecedência", formatPolicyDuration(rules.MinLeadTime)))
+	}
+
+	if rules.BookingHorizon > 0 && startTime.After(now.Add(rules.BookingHorizon)) {
+		return entities.NewPolicyViolation(entities.PolicyViolationBookingHorizon,
+			fmt.Sprintf("agendamentos só podem ser feitos com até %s de antecedência", formatPolicyDuration(rules.BookingHorizon)))
+	}
+
+	if rules.MaxActiveBookings > 0 {
+		count, err := uc.bookingRepo.CountActiveByUser(userID, excludeBookingID)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar agendamentos ativos: %w", err)
+		}
+		if count >= int64(rules.MaxActiveBookings) {
+			return entities.NewPolicyViolation(entities.PolicyViolationMaxActiveBookings,
+				fmt.Sprintf("usuário já possui %d agendamento(s) ativo(s); o limite é %d", count, rules.MaxActiveBookings))
+		}
+	}
+
+	// Dias e semanas dos limites são os do calendário da localidade da cadeira
+	if rules.MaxDailyBookings > 0 || rules.MaxWeeklyBookings > 0 {
+		zone, err := chairTimeZone(uc.locationRepo, chairID)
+		if err != nil {
+			return err
+		}
+		startTime = startTime.In(zone)
+	}
+
+	if rules.MaxDailyBookings > 0 {
+		dayStart := time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, startTime.Location())
+		count, err := uc.bookingRepo.CountUserBookingsInRange(userID, dayStart, dayStart.AddDate(0, 0, 1), excludeBookingID)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar limite diário: %w", err)
+		}
+		if count >= int64(rules.MaxDailyBookings) {
+			return entities.NewPolicyViolation(entities.PolicyViolationDailyLimit,
+				fmt.Sprintf("limite de %d agendamento(s) por dia atingido", rules.MaxDailyBookings))
+		}
+	}
+
+	if rules.MaxWeeklyBookings > 0 {
+		// Semana de segunda a domingo
+		offset := (int(startTime.Weekday()) + 6) % 7
+		weekStart := time.Date(startTime.Year(), startTime.Month(), startTime.Day()-offset, 0, 0, 0, 0, startTime.Location())
+		count, err := uc.bookingRepo.CountUserBookingsInRange(userID, weekStart, weekStart.AddDate(0, 0, 7), excludeBookingID)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar limite semanal: %w", err)
+		}
+		if count >= int64(rules.MaxWeeklyBookings) {
+			return entities.NewPolicyViolation(entities.PolicyViolationWeeklyLimit,
+				fmt.Sprintf("limite de %d agendamento(s) por semana atingido", rules.MaxWeeklyBookings))
+		}
+	}
+
+	return nil
+}
+
+// CheckCancellation verifica se o agendamento ainda pode ser cancelado pelo prazo da política
+func (uc *BookingPolicyUseCase) CheckCancellation(booking *entities.Booking) error {
+	rules, err := uc.ResolveRules(booking.UserID, booking.ChairID)
+	if err != nil {
+		return err
+	}
+
+	if !booking.CanBeCancelledWithin(rules.CancellationCutoff) {
+		return entities.NewPolicyViolation(entities.PolicyViolationCancellationCutoff,
+			fmt.Sprintf("agendamento não pode ser cancelado (o prazo é de %s antes do horário ou já foi realizado)", formatPolicyDuration(rules.CancellationCutoff)))
+	}
+
+	return nil
+}
+
+// formatPolicyDuration formata durações de política em dias, horas ou minutos
+func formatPolicyDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour && d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%d dia(s)", int(d.Hours()/24))
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%d hora(s)", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%d minuto(s)", int(d.Minutes()))
+	}
+}
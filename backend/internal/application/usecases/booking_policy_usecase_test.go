@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertPolicyViolation verifica que o erro é a violação de política com o código informado
+func assertPolicyViolation(t *testing.T, err error, code string) {
+	t.Helper()
+	var violation *entities.PolicyViolation
+	if assert.True(t, errors.As(err, &violation), "erro: %v", err) {
+		assert.Equal(t, code, violation.Code)
+	}
+}
+
+func TestBookingPolicyUseCase_CheckBooking_LocationCalendar(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	user := &entities.User{ID: 1, Name: "Ana", Role: "usuario", Status: "aprovado"}
+	unlimited := 0
+	one := 1
+
+	// Domingo daqui a pelo menos uma semana, no calendário de São Paulo
+	now := time.Now().In(saoPaulo)
+	sunday := time.Date(now.Year(), now.Month(), now.Day()+7+(7-int(now.Weekday()))%7, 0, 0, 0, 0, saoPaulo)
+	monday := sunday.AddDate(0, 0, 1)
+
+	setup := func(policy *entities.BookingPolicy, existing time.Time) *BookingPolicyUseCase {
+		booking := &entities.Booking{
+			ID:        10,
+			UserID:    user.ID,
+			ChairID:   1,
+			StartTime: existing.UTC(),
+			EndTime:   existing.UTC().Add(entities.DefaultSessionDuration),
+			Status:    entities.BookingStatusScheduled,
+		}
+		env := newBookingTestEnv([]*entities.User{user}, booking)
+		env.policies.Policies = []*entities.BookingPolicy{policy}
+		env.locations.ByChair[1] = &entities.Location{ID: 1, Name: "Sede", Timezone: "America/Sao_Paulo"}
+		return env.uc.policyUseCase
+	}
+
+	dailyLimit := &entities.BookingPolicy{Name: "Uma por dia", IsActive: true, MaxActiveBookings: &unlimited, MaxDailyBookings: &one}
+	weeklyLimit := &entities.BookingPolicy{Name: "Uma por semana", IsActive: true, MaxActiveBookings: &unlimited, MaxWeeklyBookings: &one}
+
+	t.Run("Sessão à noite conta no dia local, não no dia UTC seguinte", func(t *testing.T) {
+		uc := setup(dailyLimit, monday.Add(10*time.Hour))
+
+		// 22:30 em São Paulo já é o dia seguinte em UTC
+		evening := monday.Add(22*time.Hour + 30*time.Minute).UTC()
+		err := uc.CheckBooking(user.ID, 1, evening, nil)
+		assertPolicyViolation(t, err, entities.PolicyViolationDailyLimit)
+	})
+
+	t.Run("Sessão da noite anterior não conta no dia seguinte", func(t *testing.T) {
+		uc := setup(dailyLimit, sunday.Add(21*time.Hour+30*time.Minute))
+
+		err := uc.CheckBooking(user.ID, 1, monday.Add(10*time.Hour).UTC(), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Domingo à noite pertence à semana que termina no domingo", func(t *testing.T) {
+		uc := setup(weeklyLimit, monday.Add(10*time.Hour))
+
+		// 22:00 de domingo em São Paulo já é segunda-feira em UTC
+		err := uc.CheckBooking(user.ID, 1, sunday.Add(22*time.Hour).UTC(), nil)
+		assert.NoError(t, err)
+
+		err = uc.CheckBooking(user.ID, 1, monday.AddDate(0, 0, 6).Add(22*time.Hour).UTC(), nil)
+		assertPolicyViolation(t, err, entities.PolicyViolationWeeklyLimit)
+	})
+}
@@ -17,6 +17,13 @@ type BookingUseCase struct {
 	chairRepo        repositories.ChairRepository
 	userRepo         repositories.UserRepository
 	availabilityRepo repositories.AvailabilityRepository
+	serviceRepo      repositories.ServiceRepository
+	waitlistRepo     repositories.WaitlistRepository
+	holdRepo         repositories.SlotHoldRepository
+	therapistRepo    repositories.TherapistRepository
+	locationRepo     repositories.LocationRepository
+	policyUseCase    *BookingPolicyUseCase
+	penaltyUseCase   *PenaltyUseCase
 	auditRepo        repositories.AuditLogRepository
 	emailRepo        repositories.EmailRepository
 	validator        ports.Validator
@@ -27,6 +34,13 @@ func NewBookingUseCase(
 	chairRepo repositories.ChairRepository,
 	userRepo repositories.UserRepository,
 	availabilityRepo repositories.AvailabilityRepository,
+	serviceRepo repositories.ServiceRepository,
+	waitlistRepo repositories.WaitlistRepository,
+	holdRepo repositories.SlotHoldRepository,
+	therapistRepo repositories.TherapistRepository,
+	locationRepo repositories.LocationRepository,
+	policyUseCase *BookingPolicyUseCase,
+	penaltyUseCase *PenaltyUseCase,
 	auditRepo repositories.AuditLogRepository,
 	emailRepo repositories.EmailRepository,
 	validator ports.Validator,
@@ -36,6 +50,13 @@ func NewBookingUseCase(
 		chairRepo:        chairRepo,
 		userRepo:         userRepo,
 		availabilityRepo: availabilityRepo,
+		serviceRepo:      serviceRepo,
+		waitlistRepo:     waitlistRepo,
+		holdRepo:         holdRepo,
+		therapistRepo:    therapistRepo,
+		locationRepo:     locationRepo,
+		policyUseCase:    policyUseCase,
+		penaltyUseCase:   penaltyUseCase,
 		auditRepo:        auditRepo,
 		emailRepo:        emailRepo,
 		validator:        validator,
@@ -44,6 +65,12 @@ func NewBookingUseCase(
 
 // CreateBooking cria um novo agendamento
 func (uc *BookingUseCase) CreateBooking(booking *entities.Booking, createdBy uint) error {
+	return uc.CreateBookingWithHold(booking, createdBy, "")
+}
+
+// CreateBookingWithHold cria o agendamento consumindo a reserva temporária do token.
+// Sem token, o horário não pode estar reservado por outro usuário
+func (uc *BookingUseCase) CreateBookingWithHold(booking *entities.Booking, createdBy uint, holdToken string) error {
 	// Verificar se usuário existe e está aprovado
 	user, err := uc.userRepo.GetByID(booking.UserID)
 	if err != nil {
@@ -54,6 +81,11 @@ func (uc *BookingUseCase) CreateBooking(booking *entities.Booking, createdBy uin
 		return fmt.Errorf("usuário não está aprovado")
 	}
 
+	// Usuários suspensos por faltas ou cancelamentos tardios não podem agendar
+	if err := uc.penaltyUseCase.CheckBookingAllowed(booking.UserID); err != nil {
+		return err
+	}
+
 	// Verificar se cadeira existe e está ativa
 	chair, err := uc.chairRepo.GetByID(booking.ChairID)
 	if err != nil {
@@ -64,13 +96,33 @@ func (uc *BookingUseCase) CreateBooking(booking *entities.Booking, createdBy uin
 		return fmt.Errorf("cadeira não está disponível")
 	}
 
+	// Validar a reserva temporária apresentada
+	var hold *entities.SlotHold
+	if holdToken != "" {
+		hold, err = uc.holdRepo.GetByToken(holdToken)
+		if err != nil {
+			return errors.New("reserva de horário não encontrada")
+		}
+		if !hold.IsActiveAt(time.Now()) {
+			return entities.ErrSlotHoldInactive
+		}
+		if !hold.Covers(booking) {
+			return errors.New("agendamento não corresponde ao horário reservado")
+		}
+	}
+
 	// Verificar disponibilidade
-	if err := uc.checkAvailability(booking); err != nil {
+	if err := uc.checkAvailability(booking, holdToken); err != nil {
 		return err
 	}
 
-	// Criar agendamento
-	if err := uc.bookingRepo.Create(booking); err != nil {
+	// Criar agendamento; a reserva é consumida na mesma transação
+	if hold != nil {
+		err = uc.bookingRepo.CreateWithHold(booking, hold, time.Now())
+	} else {
+		err = uc.bookingRepo.Create(booking)
+	}
+	if err != nil {
 		return fmt.Errorf("erro ao criar agendamento: %w", err)
 	}
 
@@ -79,6 +131,8 @@ func (uc *BookingUseCase) CreateBooking(booking *entities.Booking, createdBy uin
 	auditLog.SetDescription(fmt.Sprintf("Agendamento criado para %s na cadeira %s", user.Name, chair.Name))
 	uc.auditRepo.Create(auditLog)
 
+	uc.recordStatusHistory(booking.ID, "", booking.Status, uc.resolveActor(createdBy), "Agendamento criado")
+
 	// Enviar email de confirmação
 	if err := uc.emailRepo.SendBookingConfirmation(user, booking); err != nil {
 		// Não falhar se o email falhar
@@ -87,27 +141,131 @@ func (uc *BookingUseCase) CreateBooking(booking *entities.Booking, createdBy uin
 	return nil
 }
 
-// checkAvailability verifica se o agendamento é possível
-func (uc *BookingUseCase) checkAvailability(booking *entities.Booking) error {
-	// Garantir que a sessão seja de 30 minutos
-	booking.EndTime = booking.StartTime.Add(30 * time.Minute)
+// CreateBookingAnyChair agenda o horário pedido na cadeira escolhida pelo sistema,
+// entre as cadeiras ativas da localidade (vazia = todas) que oferecem o serviço. A
+// escolha segue RankChairsByLoad: vence a cadeira com menos agendamentos no dia
+func (uc *BookingUseCase) CreateBookingAnyChair(booking *entities.Booking, location entities.LocationFilter, createdBy uint) error {
+	candidates, err := uc.rankFreeChairs(booking, location)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, chair := range candidates {
+		booking.ID = 0
+		booking.ChairID = chair.ID
+
+		err := uc.CreateBooking(booking, createdBy)
+		if err == nil {
+			return nil
+		}
+		// Outro usuário pode ter ocupado a cadeira entre a busca e a gravação
+		if errors.Is(err, entities.ErrBookingConflict) || errors.Is(err, entities.ErrSlotHeld) {
+			continue
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	booking.ChairID = 0
+	if firstErr != nil {
+		return firstErr
+	}
+	return entities.ErrNoChairAvailable
+}
+
+// rankFreeChairs lista as cadeiras livres para o horário do agendamento, da menos
+// para a mais ocupada no dia
+func (uc *BookingUseCase) rankFreeChairs(booking *entities.Booking, location entities.LocationFilter) ([]*entities.Chair, error) {
+	chairs, err := uc.chairRepo.GetAvailableChairs()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar cadeiras: %w", err)
+	}
+
+	var free []*entities.Chair
+	var chairIDs []uint
+	for _, chair := range chairs {
+		if !location.Matches(chair) {
+			continue
+		}
+
+		// Cadeiras que não oferecem o serviço ficam de fora
+		duration, err := uc.resolveSessionDuration(chair.ID, booking.ServiceID)
+		if err != nil {
+			continue
+		}
+		endTime := booking.StartTime.Add(duration)
+
+		available, err := uc.availabilityRepo.IsChairAvailableForPeriod(chair.ID, booking.StartTime, endTime)
+		if err != nil || !available {
+			continue
+		}
+		hasConflict, err := uc.bookingRepo.HasConflict(chair.ID, booking.StartTime, endTime, nil)
+		if err != nil || hasConflict {
+			continue
+		}
+		isHeld, err := uc.holdRepo.HasActiveHold(chair.ID, booking.StartTime, endTime, time.Now(), "")
+		if err != nil || isHeld {
+			continue
+		}
+		if _, err := uc.onDutyTherapist(chair.ID, booking.StartTime, endTime); err != nil {
+			continue
+		}
+
+		free = append(free, chair)
+		chairIDs = append(chairIDs, chair.ID)
+	}
+
+	if len(free) == 0 {
+		return nil, entities.ErrNoChairAvailable
+	}
+
+	day := dayStart(booking.StartTime)
+	loads, err := uc.bookingRepo.CountActiveByChairsInRange(chairIDs, day, day.Add(24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao calcular ocupação das cadeiras: %w", err)
+	}
+
+	return entities.RankChairsByLoad(free, loads), nil
+}
+
+// checkAvailability verifica se o agendamento é possível. A reserva do holdToken
+// pertence ao próprio agendamento e não conta como conflito
+func (uc *BookingUseCase) checkAvailability(booking *entities.Booking, holdToken string) error {
+	// A duração da sessão vem do serviço escolhido
+	duration, err := uc.resolveSessionDuration(booking.ChairID, booking.ServiceID)
+	if err != nil {
+		return err
+	}
+	booking.EndTime = booking.StartTime.Add(duration)
 
 	// Verificar se o horário está no futuro
 	if booking.StartTime.Before(time.Now()) {
 		return errors.New("não é possível agendar para horários passados")
 	}
 
-	// Verificar se há conflito de horário
-	canBook, reason, err := uc.bookingRepo.CanBook(booking.UserID, booking.ChairID, booking.StartTime)
+	// Verificar se há conflito de horário na cadeira
+	hasConflict, err := uc.bookingRepo.HasConflict(booking.ChairID, booking.StartTime, booking.EndTime, nil)
 	if err != nil {
 		return fmt.Errorf("erro ao verificar disponibilidade: %w", err)
 	}
-	if !canBook {
-		return errors.New(reason)
+	if hasConflict {
+		return entities.ErrBookingConflict
 	}
 
-	// Verificar se a cadeira tem disponibilidade configurada para este horário
-	isAvailable, err := uc.availabilityRepo.IsChairAvailableAtTime(booking.ChairID, booking.StartTime)
+	// Horários reservados temporariamente por outros usuários não podem ser agendados
+	if err := uc.checkNotHeld(booking.ChairID, booking.StartTime, booking.EndTime, holdToken); err != nil {
+		return err
+	}
+
+	// Aplicar as políticas de agendamento do usuário
+	if err := uc.policyUseCase.CheckBooking(booking.UserID, booking.ChairID, booking.StartTime, nil); err != nil {
+		return err
+	}
+
+	// Verificar se a cadeira tem disponibilidade configurada para a sessão inteira
+	isAvailable, err := uc.availabilityRepo.IsChairAvailableForPeriod(booking.ChairID, booking.StartTime, booking.EndTime)
 	if err != nil {
 		return fmt.Errorf("erro ao verificar disponibilidade da cadeira: %w", err)
 	}
@@ -115,9 +273,84 @@ func (uc *BookingUseCase) checkAvailability(booking *entities.Booking) error {
 		return errors.New("cadeira não tem disponibilidade configurada para este horário")
 	}
 
+	// Nas cadeiras com turnos, a sessão precisa de um massoterapeuta de plantão
+	return uc.assignTherapist(booking)
+}
+
+// checkNotHeld recusa o intervalo quando há reserva temporária em vigor na cadeira.
+// A reserva do holdToken é ignorada; token vazio considera todas as reservas, como
+// na edição, no reagendamento e na transferência de um agendamento existente
+func (uc *BookingUseCase) checkNotHeld(chairID uint, startTime, endTime time.Time, holdToken string) error {
+	isHeld, err := uc.holdRepo.HasActiveHold(chairID, startTime, endTime, time.Now(), holdToken)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar reservas de horário: %w", err)
+	}
+	if isHeld {
+		return entities.ErrSlotHeld
+	}
+	return nil
+}
+
+// assignTherapist registra no agendamento o massoterapeuta de plantão na cadeira
+// durante a sessão. Cadeiras sem turnos cadastrados seguem sem massoterapeuta
+func (uc *BookingUseCase) assignTherapist(booking *entities.Booking) error {
+	therapist, err := uc.onDutyTherapist(booking.ChairID, booking.StartTime, booking.EndTime)
+	if err != nil {
+		return err
+	}
+
+	booking.TherapistID = nil
+	booking.Therapist = therapist
+	if therapist != nil {
+		booking.TherapistID = &therapist.ID
+	}
 	return nil
 }
 
+// onDutyTherapist busca o massoterapeuta de plantão na cadeira durante o período.
+// Retorna nil sem erro quando a cadeira não tem turnos cadastrados
+func (uc *BookingUseCase) onDutyTherapist(chairID uint, startTime, endTime time.Time) (*entities.Therapist, error) {
+	shifts, err := uc.therapistRepo.GetShiftsByChairs([]uint{chairID})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar turnos da cadeira: %w", err)
+	}
+	if len(shifts) == 0 {
+		return nil, nil
+	}
+
+	// Os turnos são lidos no fuso da localidade da cadeira, como a disponibilidade
+	zone, err := chairTimeZone(uc.locationRepo, chairID)
+	if err != nil {
+		return nil, err
+	}
+	localStart := startTime.In(zone)
+	day := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, time.UTC)
+	startMinute := localStart.Hour()*60 + localStart.Minute()
+	endMinute := startMinute + int(endTime.Sub(startTime).Minutes())
+
+	absences, err := uc.therapistRepo.GetAbsencesInRange(shiftTherapistIDs(shifts), day, day)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar ausências dos massoterapeutas: %w", err)
+	}
+
+	therapistID, ok := entities.OnDutyTherapist(shifts, absences, day, startMinute, endMinute)
+	if !ok {
+		return nil, entities.ErrNoTherapistAvailable
+	}
+	for _, shift := range shifts {
+		if shift.TherapistID == therapistID {
+			return shift.Therapist, nil
+		}
+	}
+	return nil, entities.ErrNoTherapistAvailable
+}
+
+// resolveSessionDuration retorna a duração da sessão para o serviço na cadeira.
+// Agendamentos sem serviço usam a duração padrão
+func (uc *BookingUseCase) resolveSessionDuration(chairID uint, serviceID *uint) (time.Duration, error) {
+	return sessionDuration(uc.serviceRepo, chairID, serviceID)
+}
+
 // GetBookingByID busca agendamento por ID
 func (uc *BookingUseCase) GetBookingByID(id uint) (*entities.Booking, error) {
 	return uc.bookingRepo.GetByID(id)
@@ -141,10 +374,35 @@ func (uc *BookingUseCase) UpdateBooking(booking *entities.Booking, updatedBy uin
 		return errors.New("agendamento não pode ser alterado")
 	}
 
-	// Se horário foi alterado, verificar disponibilidade
-	if !booking.StartTime.Equal(currentBooking.StartTime) || booking.ChairID != currentBooking.ChairID {
-		// Garantir que a sessão seja de 30 minutos
-		booking.EndTime = booking.StartTime.Add(30 * time.Minute)
+	// Mudanças de status seguem a máquina de estados
+	statusChanged := booking.Status != currentBooking.Status
+	if statusChanged {
+		if err := entities.ValidateBookingTransition(currentBooking.Status, booking.Status); err != nil {
+			return err
+		}
+	}
+
+	// Campos controlados pelo servidor não vêm no corpo da requisição
+	booking.CreatedAt = currentBooking.CreatedAt
+	booking.CalendarSequence = currentBooking.CalendarSequence
+	booking.ClosureID = currentBooking.ClosureID
+	booking.FeedbackRequestedAt = currentBooking.FeedbackRequestedAt
+
+	// Manter o serviço atual quando não informado
+	if booking.ServiceID == nil {
+		booking.ServiceID = currentBooking.ServiceID
+	}
+	serviceChanged := (booking.ServiceID == nil) != (currentBooking.ServiceID == nil) ||
+		(booking.ServiceID != nil && currentBooking.ServiceID != nil && *booking.ServiceID != *currentBooking.ServiceID)
+
+	// Se horário, cadeira ou serviço foram alterados, verificar disponibilidade
+	scheduleChanged := !booking.StartTime.Equal(currentBooking.StartTime) || booking.ChairID != currentBooking.ChairID || serviceChanged
+	if scheduleChanged {
+		duration, err := uc.resolveSessionDuration(booking.ChairID, booking.ServiceID)
+		if err != nil {
+			return err
+		}
+		booking.EndTime = booking.StartTime.Add(duration)
 
 		// Verificar se o horário está no futuro
 		if booking.StartTime.Before(time.Now()) {
@@ -157,17 +415,35 @@ func (uc *BookingUseCase) UpdateBooking(booking *entities.Booking, updatedBy uin
 			return fmt.Errorf("erro ao verificar conflitos: %w", err)
 		}
 		if hasConflict {
-			return errors.New("já existe um agendamento para este horário")
+			return entities.ErrBookingConflict
+		}
+		if err := uc.checkNotHeld(booking.ChairID, booking.StartTime, booking.EndTime, ""); err != nil {
+			return err
+		}
+
+		// Aplicar as políticas de agendamento do usuário
+		if err := uc.policyUseCase.CheckBooking(currentBooking.UserID, booking.ChairID, booking.StartTime, &booking.ID); err != nil {
+			return err
 		}
 
 		// Verificar disponibilidade da cadeira
-		isAvailable, err := uc.availabilityRepo.IsChairAvailableAtTime(booking.ChairID, booking.StartTime)
+		isAvailable, err := uc.availabilityRepo.IsChairAvailableForPeriod(booking.ChairID, booking.StartTime, booking.EndTime)
 		if err != nil {
 			return fmt.Errorf("erro ao verificar disponibilidade da cadeira: %w", err)
 		}
 		if !isAvailable {
 			return errors.New("cadeira não tem disponibilidade configurada para este horário")
 		}
+
+		if err := uc.assignTherapist(booking); err != nil {
+			return err
+		}
+
+		// O convite já enviado precisa ser substituído pelo novo horário
+		booking.CalendarSequence++
+	} else {
+		booking.EndTime = currentBooking.EndTime
+		booking.TherapistID = currentBooking.TherapistID
 	}
 
 	// Atualizar agendamento
@@ -180,6 +456,14 @@ func (uc *BookingUseCase) UpdateBooking(booking *entities.Booking, updatedBy uin
 	auditLog.SetDescription("Agendamento atualizado")
 	uc.auditRepo.Create(auditLog)
 
+	if statusChanged {
+		uc.recordStatusHistory(booking.ID, currentBooking.Status, booking.Status, uc.resolveActor(updatedBy), "Status alterado na edição do agendamento")
+	}
+
+	if scheduleChanged {
+		go uc.notifyRescheduled(booking)
+	}
+
 	return nil
 }
 
@@ -190,19 +474,75 @@ func (uc *BookingUseCase) CancelBooking(bookingID, cancelledBy uint, reason stri
 		return fmt.Errorf("agendamento não encontrado: %w", err)
 	}
 
-	if !booking.CanBeCancelled() {
-		return errors.New("agendamento não pode ser cancelado (muito próximo do horário ou já realizado)")
+	// Prazo de cancelamento definido pela política de agendamento
+	if err := uc.policyUseCase.CheckCancellation(booking); err != nil {
+		return err
+	}
+
+	return uc.cancelBooking(booking, uc.resolveActor(cancelledBy), reason)
+}
+
+// ReleaseUnconfirmedBooking cancela em nome do sistema um agendamento cuja presença
+// não foi confirmada pelo link do lembrete até o prazo. Não gera penalidade
+func (uc *BookingUseCase) ReleaseUnconfirmedBooking(bookingID uint, reason string) error {
+	booking, err := uc.bookingRepo.GetByID(bookingID)
+	if err != nil {
+		return fmt.Errorf("agendamento não encontrado: %w", err)
+	}
+
+	if booking.Status != entities.BookingStatusScheduled {
+		return nil
+	}
+
+	return uc.cancelBooking(booking, entities.SystemBookingActor(), reason)
+}
+
+// CancelForClosure cancela um agendamento atingido por feriado ou fechamento. É um
+// cancelamento administrativo: não respeita o prazo da política nem gera penalidade
+func (uc *BookingUseCase) CancelForClosure(bookingID, cancelledBy uint, reason string) error {
+	return uc.cancelAdministratively(bookingID, cancelledBy, reason)
+}
+
+// CancelForChairOutage cancela um agendamento que não pôde ser transferido quando a
+// cadeira entrou em manutenção ou foi desativada. Como no fechamento, não há prazo
+// nem penalidade
+func (uc *BookingUseCase) CancelForChairOutage(bookingID, cancelledBy uint, reason string) error {
+	return uc.cancelAdministratively(bookingID, cancelledBy, reason)
+}
+
+// cancelAdministratively cancela o agendamento ainda ativo sem aplicar a política de cancelamento
+func (uc *BookingUseCase) cancelAdministratively(bookingID, cancelledBy uint, reason string) error {
+	booking, err := uc.bookingRepo.GetByID(bookingID)
+	if err != nil {
+		return fmt.Errorf("agendamento não encontrado: %w", err)
+	}
+
+	if !booking.IsActive() {
+		return nil
 	}
 
-	if err := uc.bookingRepo.Cancel(bookingID, cancelledBy, reason); err != nil {
+	return uc.cancelBooking(booking, uc.resolveActor(cancelledBy), reason)
+}
+
+// cancelBooking grava o cancelamento, avisa o usuário e oferece o horário à lista de espera
+func (uc *BookingUseCase) cancelBooking(booking *entities.Booking, actor entities.BookingActor, reason string) error {
+	bookingID := booking.ID
+	if err := uc.bookingRepo.Cancel(bookingID, actor, reason); err != nil {
 		return fmt.Errorf("erro ao cancelar agendamento: %w", err)
 	}
 
 	// Log de auditoria
-	auditLog := entities.NewAuditLog(&cancelledBy, entities.ActionCancel, entities.ResourceBooking, &bookingID)
+	auditLog := entities.NewAuditLog(actor.UserID, entities.ActionCancel, entities.ResourceBooking, &bookingID)
 	auditLog.SetDescription(fmt.Sprintf("Agendamento cancelado: %s", reason))
 	uc.auditRepo.Create(auditLog)
 
+	// Cancelamentos do próprio usuário perto do horário contam como penalidade
+	if actor.UserID != nil && *actor.UserID == booking.UserID {
+		if err := uc.penaltyUseCase.RecordCancellation(booking, time.Now()); err != nil {
+			fmt.Printf("Erro ao registrar cancelamento tardio: %v\n", err)
+		}
+	}
+
 	// Enviar email de cancelamento (não bloquear se falhar)
 	go func() {
 		// Buscar dados completos para o email
@@ -225,6 +565,9 @@ func (uc *BookingUseCase) CancelBooking(bookingID, cancelledBy uint, reason stri
 		}
 	}()
 
+	// Oferecer o horário liberado para a lista de espera
+	go uc.ReleaseSlotToWaitlist(booking.ChairID, booking.StartTime, booking.EndTime)
+
 	return nil
 }
 
@@ -239,7 +582,7 @@ func (uc *BookingUseCase) CompleteBooking(bookingID, completedBy uint) error {
 		return errors.New("agendamento não está ativo")
 	}
 
-	if err := uc.bookingRepo.Complete(bookingID, completedBy); err != nil {
+	if err := uc.bookingRepo.Complete(bookingID, uc.resolveActor(completedBy)); err != nil {
 		return fmt.Errorf("erro ao marcar agendamento como realizado: %w", err)
 	}
 
@@ -262,7 +605,7 @@ func (uc *BookingUseCase) ConfirmPresence(bookingID, confirmedBy uint) error {
 		return errors.New("apenas agendamentos com status 'agendado' podem ter presença confirmada")
 	}
 
-	if err := uc.bookingRepo.ConfirmPresence(bookingID, confirmedBy); err != nil {
+	if err := uc.bookingRepo.ConfirmPresence(bookingID, uc.resolveActor(confirmedBy)); err != nil {
 		return fmt.Errorf("erro ao confirmar presença: %w", err)
 	}
 
@@ -276,6 +619,11 @@ func (uc *BookingUseCase) ConfirmPresence(bookingID, confirmedBy uint) error {
 
 // MarkAsNoShow marca um agendamento como falta
 func (uc *BookingUseCase) MarkAsNoShow(bookingID, markedBy uint) error {
+	return uc.markAsNoShow(bookingID, uc.resolveActor(markedBy), "")
+}
+
+// markAsNoShow registra a falta em nome do ator. Ator sem usuário gera auditoria do sistema
+func (uc *BookingUseCase) markAsNoShow(bookingID uint, actor entities.BookingActor, reason string) error {
 	booking, err := uc.bookingRepo.GetByID(bookingID)
 	if err != nil {
 		return fmt.Errorf("agendamento não encontrado: %w", err)
@@ -285,18 +633,234 @@ func (uc *BookingUseCase) MarkAsNoShow(bookingID, markedBy uint) error {
 		return errors.New("só é possível marcar como falta agendamentos que já passaram")
 	}
 
-	if err := uc.bookingRepo.MarkAsNoShow(bookingID, markedBy); err != nil {
+	if err := uc.bookingRepo.MarkAsNoShow(bookingID, actor, reason); err != nil {
 		return fmt.Errorf("erro ao marcar como falta: %w", err)
 	}
 
 	// Log de auditoria
-	auditLog := entities.NewAuditLog(&markedBy, entities.ActionUpdate, entities.ResourceBooking, &bookingID)
-	auditLog.SetDescription("Agendamento marcado como falta")
+	description := "Agendamento marcado como falta"
+	if reason != "" {
+		description += ": " + reason
+	}
+	auditLog := entities.NewAuditLog(actor.UserID, entities.ActionUpdate, entities.ResourceBooking, &bookingID)
+	auditLog.SetDescription(description)
+	uc.auditRepo.Create(auditLog)
+
+	if err := uc.penaltyUseCase.RecordNoShow(booking); err != nil {
+		fmt.Printf("Erro ao registrar penalidade por falta: %v\n", err)
+	}
+
+	// Oferecer o que restar do horário para a lista de espera
+	go uc.ReleaseSlotToWaitlist(booking.ChairID, booking.StartTime, booking.EndTime)
+
+	return nil
+}
+
+// MarkStaleNoShows marca como falta os agendamentos que continuam em 'agendado' depois
+// do fim da sessão mais a tolerância, liberando o usuário para novos agendamentos
+func (uc *BookingUseCase) MarkStaleNoShows(gracePeriod time.Duration) error {
+	bookings, err := uc.bookingRepo.GetScheduledEndedBefore(time.Now().Add(-gracePeriod))
+	if err != nil {
+		return fmt.Errorf("erro ao buscar agendamentos sem presença confirmada: %w", err)
+	}
+
+	reason := fmt.Sprintf("Presença não confirmada até %s após o término da sessão", formatPolicyDuration(gracePeriod))
+
+	markedCount := 0
+	for _, booking := range bookings {
+		if err := uc.markAsNoShow(booking.ID, entities.SystemBookingActor(), reason); err != nil {
+			log.Printf("Erro ao marcar agendamento %d como falta: %v", booking.ID, err)
+			continue
+		}
+		markedCount++
+	}
+
+	if markedCount > 0 {
+		log.Printf("Marcados %d agendamentos como falta automaticamente", markedCount)
+	}
+
+	return nil
+}
+
+// GetBookingHistory retorna o histórico de status do agendamento em ordem cronológica
+func (uc *BookingUseCase) GetBookingHistory(bookingID uint) ([]*entities.BookingStatusHistory, error) {
+	return uc.bookingRepo.GetStatusHistory(bookingID)
+}
+
+// resolveActor identifica a origem da ação pela role do usuário que a executou
+func (uc *BookingUseCase) resolveActor(userID uint) entities.BookingActor {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return entities.NewBookingActor(userID, "usuario")
+	}
+	return entities.NewBookingActor(userID, user.Role)
+}
+
+// recordStatusHistory grava no histórico mudanças de status feitas fora das operações
+// de status do repositório (criação e edição)
+func (uc *BookingUseCase) recordStatusHistory(bookingID uint, from, to string, actor entities.BookingActor, reason string) {
+	entry := entities.NewBookingStatusHistory(bookingID, from, to, actor, reason)
+	if err := uc.bookingRepo.CreateStatusHistory(entry); err != nil {
+		log.Printf("Erro ao registrar histórico do agendamento %d: %v", bookingID, err)
+	}
+}
+
+// ReleaseSlotToWaitlist repassa um horário liberado ao primeiro usuário elegível da
+// lista de espera da cadeira. Quem prefere agendamento automático é agendado na hora;
+// os demais recebem uma oferta com prazo para aceite. Entradas cuja janela ou serviço
+// não cabem no horário, ou de usuários que não podem agendar no momento, são puladas
+// e continuam na fila
+func (uc *BookingUseCase) ReleaseSlotToWaitlist(chairID uint, startTime, endTime time.Time) {
+	// Horários que já começaram não podem mais ser agendados
+	if !startTime.After(time.Now()) {
+		return
+	}
+
+	// Cadeira desativada ou em manutenção não tem horário a repassar
+	chair, err := uc.chairRepo.GetByID(chairID)
+	if err != nil || !chair.IsActive() {
+		return
+	}
+	if inMaintenance, err := uc.chairRepo.HasMaintenanceInPeriod(chairID, startTime, endTime); err != nil || inMaintenance {
+		return
+	}
+
+	entries, err := uc.waitlistRepo.GetWaitingForChairAndDate(chairID, startTime)
+	if err != nil {
+		fmt.Printf("Erro ao buscar lista de espera: %v\n", err)
+		return
+	}
+
+	freed := endTime.Sub(startTime)
+	for _, entry := range entries {
+		duration, err := uc.resolveSessionDuration(chairID, entry.ServiceID)
+		if err != nil || duration > freed || !entry.CoversSlot(startTime, duration) {
+			continue
+		}
+
+		// Cadastro não aprovado ou suspenso: a oferta só prenderia o horário até vencer
+		if !uc.canBookFromWaitlist(entry.UserID) {
+			continue
+		}
+
+		if entry.WantsAutoBook() {
+			if uc.autoBookWaitlistEntry(entry, startTime) {
+				return
+			}
+			continue
+		}
+
+		if err := uc.offerWaitlistSlot(entry, startTime, duration); err != nil {
+			fmt.Printf("Erro ao ofertar vaga da lista de espera: %v\n", err)
+			continue
+		}
+		return
+	}
+}
+
+// canBookFromWaitlist verifica se o usuário da fila pode agendar agora: cadastro
+// aprovado e sem suspensão em vigor
+func (uc *BookingUseCase) canBookFromWaitlist(userID uint) bool {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil || user.Status != "aprovado" {
+		return false
+	}
+	return uc.penaltyUseCase.CheckBookingAllowed(userID) == nil
+}
+
+// autoBookWaitlistEntry cria o agendamento para o usuário da fila. Retorna false quando
+// o usuário não pode ocupar o horário (ex.: já possui outro agendamento ativo)
+func (uc *BookingUseCase) autoBookWaitlistEntry(entry *entities.WaitlistEntry, startTime time.Time) bool {
+	booking := &entities.Booking{
+		UserID:    entry.UserID,
+		ChairID:   entry.ChairID,
+		ServiceID: entry.ServiceID,
+		StartTime: startTime,
+		Status:    "agendado",
+		Notes:     "Agendado automaticamente pela lista de espera",
+	}
+
+	if err := uc.CreateBooking(booking, entry.UserID); err != nil {
+		return false
+	}
+
+	entry.Fulfill(booking.ID)
+	if err := uc.waitlistRepo.Update(entry); err != nil {
+		fmt.Printf("Erro ao atualizar lista de espera: %v\n", err)
+	}
+
+	auditLog := entities.NewAuditLog(&entry.UserID, entities.ActionUpdate, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription(fmt.Sprintf("Vaga da lista de espera agendada automaticamente (agendamento %d)", booking.ID))
+	uc.auditRepo.Create(auditLog)
+
+	return true
+}
+
+// offerWaitlistSlot reserva a oferta para a entrada e envia o link de aceite por email.
+// O horário fica seguro por uma reserva temporária até o prazo de aceite, para que
+// ninguém mais o agende enquanto o usuário decide. Como a entrada, a reserva guarda
+// só o hash do token do link
+func (uc *BookingUseCase) offerWaitlistSlot(entry *entities.WaitlistEntry, startTime time.Time, duration time.Duration) error {
+	token, err := generateSecureToken()
+	if err != nil {
+		return err
+	}
+
+	// Reservas vencidas ainda marcadas como ativas bloqueariam a restrição do banco
+	now := time.Now()
+	if _, err := uc.holdRepo.ExpireStale(now); err != nil {
+		return fmt.Errorf("erro ao expirar reservas vencidas: %w", err)
+	}
+
+	expiresAt := now.Add(WaitlistOfferTTL)
+	hold := &entities.SlotHold{
+		Token:           entities.HashToken(token),
+		UserID:          entry.UserID,
+		ChairID:         entry.ChairID,
+		ServiceID:       entry.ServiceID,
+		StartTime:       startTime,
+		EndTime:         startTime.Add(duration),
+		ExpiresAt:       expiresAt,
+		Status:          entities.SlotHoldStatusActive,
+		WaitlistEntryID: &entry.ID,
+	}
+	if err := uc.holdRepo.Create(hold); err != nil {
+		return fmt.Errorf("erro ao reservar vaga ofertada: %w", err)
+	}
+
+	entry.Offer(startTime, token, expiresAt)
+	if err := uc.waitlistRepo.Update(entry); err != nil {
+		uc.releaseWaitlistOfferHold(entry.ID)
+		return fmt.Errorf("erro ao registrar oferta: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&entry.UserID, entities.ActionUpdate, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription(fmt.Sprintf("Vaga ofertada para %s", startTime.Format("02/01/2006 15:04")))
 	uc.auditRepo.Create(auditLog)
 
+	user, err := uc.userRepo.GetByID(entry.UserID)
+	if err != nil {
+		return nil
+	}
+	chair, err := uc.chairRepo.GetByID(entry.ChairID)
+	if err != nil {
+		return nil
+	}
+
+	if err := uc.emailRepo.SendWaitlistOffer(user, entry, chair, token); err != nil {
+		fmt.Printf("Erro ao enviar email de oferta da lista de espera: %v\n", err)
+	}
+
 	return nil
 }
 
+// releaseWaitlistOfferHold devolve o horário reservado para a oferta da entrada
+func (uc *BookingUseCase) releaseWaitlistOfferHold(entryID uint) {
+	if err := uc.holdRepo.ReleaseActiveByWaitlistEntry(entryID); err != nil {
+		log.Printf("Erro ao liberar reserva da oferta da lista de espera %d: %v", entryID, err)
+	}
+}
+
 // ListBookings lista agendamentos com paginação
 func (uc *BookingUseCase) ListBookings(limit, offset int, filters map[string]interface{}) ([]*entities.Booking, int64, error) {
 	return uc.bookingRepo.List(limit, offset, filters)
@@ -367,6 +931,11 @@ func (uc *BookingUseCase) GetBookingStats() (map[string]int64, error) {
 	return stats, nil
 }
 
+// GetChairByID busca a cadeira cujos agendamentos são consultados
+func (uc *BookingUseCase) GetChairByID(chairID uint) (*entities.Chair, error) {
+	return uc.chairRepo.GetByID(chairID)
+}
+
 // GetBookingsByChair busca agendamentos de uma cadeira específica
 func (uc *BookingUseCase) GetBookingsByChair(chairID uint, limit, offset int) ([]*entities.Booking, int64, error) {
 	return uc.bookingRepo.GetByChair(chairID, limit, offset)
@@ -414,30 +983,91 @@ func (uc *BookingUseCase) RescheduleBooking(bookingID uint, newStartTime time.Ti
 		return errors.New("cadeira não está disponível")
 	}
 
-	// Calcular novo horário de fim (30 minutos após o início)
-	newEndTime := newStartTime.Add(30 * time.Minute)
+	// Verificar se não está tentando reagendar para o mesmo horário
+	if newStartTime.Equal(booking.StartTime) && newChairID == booking.ChairID {
+		return errors.New("não é possível reagendar para o mesmo horário e cadeira")
+	}
+
+	if err := uc.moveBooking(booking, chair, newStartTime, updatedBy, true); err != nil {
+		return err
+	}
+
+	go uc.notifyRescheduled(booking)
+
+	return nil
+}
+
+// RelocateBooking transfere o agendamento para outra cadeira no mesmo horário porque
+// a cadeira original ficou indisponível. É uma transferência administrativa: as
+// políticas de agendamento do usuário não se aplicam. O usuário recebe o motivo
+func (uc *BookingUseCase) RelocateBooking(bookingID, newChairID, relocatedBy uint, reason string) error {
+	booking, err := uc.bookingRepo.GetByID(bookingID)
+	if err != nil {
+		return fmt.Errorf("agendamento não encontrado: %w", err)
+	}
+	if !booking.IsActive() {
+		return errors.New("agendamento não está ativo")
+	}
+	if booking.ChairID == newChairID {
+		return errors.New("agendamento já está nesta cadeira")
+	}
+
+	chair, err := uc.chairRepo.GetByID(newChairID)
+	if err != nil {
+		return fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	if !chair.IsActive() {
+		return errors.New("cadeira não está disponível")
+	}
+
+	if err := uc.moveBooking(booking, chair, booking.StartTime, relocatedBy, false); err != nil {
+		return err
+	}
+
+	go uc.notifyRelocated(booking, reason)
+
+	return nil
+}
+
+// moveBooking leva o agendamento para a cadeira e o horário informados depois de
+// verificar serviço, conflitos, disponibilidade e massoterapeuta. As políticas do
+// usuário só são aplicadas quando enforcePolicies é verdadeiro
+func (uc *BookingUseCase) moveBooking(booking *entities.Booking, chair *entities.Chair, newStartTime time.Time, updatedBy uint, enforcePolicies bool) error {
+	bookingID := booking.ID
+
+	// Calcular novo horário de fim conforme o serviço (a nova cadeira precisa oferecê-lo)
+	duration, err := uc.resolveSessionDuration(chair.ID, booking.ServiceID)
+	if err != nil {
+		return err
+	}
+	newEndTime := newStartTime.Add(duration)
 
 	// Verificar se o novo horário está no futuro
 	if newStartTime.Before(time.Now()) {
 		return errors.New("não é possível reagendar para um horário no passado")
 	}
 
-	// Verificar se não está tentando reagendar para o mesmo horário
-	if newStartTime.Equal(booking.StartTime) && newChairID == booking.ChairID {
-		return errors.New("não é possível reagendar para o mesmo horário e cadeira")
-	}
-
-	// Verificar disponibilidade no novo horário
-	canBook, message, err := uc.bookingRepo.CanBook(booking.UserID, newChairID, newStartTime)
+	// Verificar disponibilidade no novo horário (excluindo o próprio agendamento)
+	hasConflict, err := uc.bookingRepo.HasConflict(chair.ID, newStartTime, newEndTime, &bookingID)
 	if err != nil {
 		return fmt.Errorf("erro ao verificar disponibilidade: %w", err)
 	}
-	if !canBook {
-		return fmt.Errorf("não é possível reagendar: %s", message)
+	if hasConflict {
+		return fmt.Errorf("não é possível reagendar: %w", entities.ErrBookingConflict)
+	}
+	if err := uc.checkNotHeld(chair.ID, newStartTime, newEndTime, ""); err != nil {
+		return fmt.Errorf("não é possível reagendar: %w", err)
+	}
+
+	// Aplicar as políticas de agendamento do usuário
+	if enforcePolicies {
+		if err := uc.policyUseCase.CheckBooking(booking.UserID, chair.ID, newStartTime, &bookingID); err != nil {
+			return err
+		}
 	}
 
 	// Verificar se a cadeira está disponível no novo horário
-	isAvailable, err := uc.availabilityRepo.IsChairAvailableAtTime(newChairID, newStartTime)
+	isAvailable, err := uc.availabilityRepo.IsChairAvailableForPeriod(chair.ID, newStartTime, newEndTime)
 	if err != nil {
 		return fmt.Errorf("erro ao verificar disponibilidade da cadeira: %w", err)
 	}
@@ -446,9 +1076,16 @@ func (uc *BookingUseCase) RescheduleBooking(bookingID uint, newStartTime time.Ti
 	}
 
 	// Atualizar agendamento
-	booking.ChairID = newChairID
+	booking.ChairID = chair.ID
+	booking.Chair = *chair
 	booking.StartTime = newStartTime
 	booking.EndTime = newEndTime
+	booking.CalendarSequence++
+
+	// O massoterapeuta acompanha a nova cadeira e o novo horário
+	if err := uc.assignTherapist(booking); err != nil {
+		return err
+	}
 
 	err = uc.bookingRepo.Update(booking)
 	if err != nil {
@@ -457,12 +1094,76 @@ func (uc *BookingUseCase) RescheduleBooking(bookingID uint, newStartTime time.Ti
 
 	// Registrar auditoria
 	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceBooking, &bookingID)
-	auditLog.SetDescription(fmt.Sprintf("Reagendado para %s na cadeira %d", newStartTime.Format("2006-01-02 15:04"), newChairID))
+	auditLog.SetDescription(fmt.Sprintf("Reagendado para %s na cadeira %d", newStartTime.Format("2006-01-02 15:04"), chair.ID))
 	uc.auditRepo.Create(auditLog)
 
 	return nil
 }
 
+// RelocationCandidates lista as cadeiras ativas livres no horário do agendamento,
+// exceto a atual. Cadeiras da mesma localidade vêm antes e, em cada grupo, a menos
+// ocupada no dia. Retorna lista vazia quando nenhuma cadeira pode receber a sessão
+func (uc *BookingUseCase) RelocationCandidates(booking *entities.Booking) ([]*entities.Chair, error) {
+	ranked, err := uc.rankFreeChairs(booking, entities.LocationFilter{})
+	if errors.Is(err, entities.ErrNoChairAvailable) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sameLocation := entities.LocationFilter{Name: booking.Chair.Location}
+	if booking.Chair.LocationID != nil {
+		sameLocation.ID = *booking.Chair.LocationID
+	}
+
+	var near, far []*entities.Chair
+	for _, chair := range ranked {
+		switch {
+		case chair.ID == booking.ChairID:
+			continue
+		case sameLocation.Matches(chair):
+			near = append(near, chair)
+		default:
+			far = append(far, chair)
+		}
+	}
+	return append(near, far...), nil
+}
+
+// notifyRescheduled envia o novo horário com o convite atualizado (não bloqueia o reagendamento)
+func (uc *BookingUseCase) notifyRescheduled(booking *entities.Booking) {
+	user, err := uc.userRepo.GetByID(booking.UserID)
+	if err != nil {
+		return
+	}
+
+	chair, err := uc.chairRepo.GetByID(booking.ChairID)
+	if err != nil {
+		return
+	}
+
+	booking.User = *user
+	booking.Chair = *chair
+
+	if err := uc.emailRepo.SendBookingRescheduled(user, booking); err != nil {
+		fmt.Printf("Erro ao enviar email de reagendamento: %v\n", err)
+	}
+}
+
+// notifyRelocated avisa o usuário da troca de cadeira e do motivo, com o convite atualizado
+func (uc *BookingUseCase) notifyRelocated(booking *entities.Booking, reason string) {
+	user, err := uc.userRepo.GetByID(booking.UserID)
+	if err != nil {
+		return
+	}
+
+	booking.User = *user
+	if err := uc.emailRepo.SendBookingRelocated(user, booking, reason); err != nil {
+		fmt.Printf("Erro ao enviar email de transferência de cadeira: %v\n", err)
+	}
+}
+
 // GetRescheduleOptions busca opções disponíveis para reagendamento
 func (uc *BookingUseCase) GetRescheduleOptions(bookingID uint, date time.Time) (*dtos.RescheduleOptionsResponse, error) {
 	// Buscar agendamento atual
@@ -504,38 +1205,50 @@ func (uc *BookingUseCase) GetRescheduleOptions(bookingID uint, date time.Time) (
 		return nil, fmt.Errorf("erro ao buscar agendamentos: %w", err)
 	}
 
-	// Criar mapa de horários já agendados (apenas agendamentos não cancelados)
-	bookedSlots := make(map[string]bool)
-	for _, existingBooking := range bookings {
-		if existingBooking.Status != "cancelado" && existingBooking.Status != "falta" && existingBooking.ID != bookingID {
-			slotTime := existingBooking.StartTime.Format("15:04")
-			bookedSlots[slotTime] = true
-		}
+	breaks, err := uc.chairRepo.GetBreaksByChair(booking.ChairID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar pausas da cadeira: %w", err)
 	}
 
-	// Gerar todos os slots disponíveis e remover os já agendados
-	var availableSlots []string
-	for _, availability := range availabilities {
-		if availability.IsValidForDate(date) {
-			slots, err := availability.GetTimeSlots()
-			if err != nil {
-				continue
-			}
+	// Reservas temporárias em vigor também ocupam o horário
+	holds, err := uc.holdRepo.GetActiveByChairAndDate(booking.ChairID, date, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar reservas de horário: %w", err)
+	}
 
-			// Filtrar apenas slots não agendados
-			for _, slot := range slots {
-				if !bookedSlots[slot] {
-					availableSlots = append(availableSlots, slot)
-				}
-			}
-		}
+	// Agendamentos, reservas e manutenções são lidos no fuso da localidade da cadeira
+	zone, err := chairTimeZone(uc.locationRepo, booking.ChairID)
+	if err != nil {
+		return nil, err
+	}
+	localDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, zone)
+
+	// Gerar slots livres com a duração da sessão atual, ignorando o próprio agendamento
+	availableSlots := buildAvailableSlots(availabilities, bookings, holds, breaks, localDate, booking.SessionDuration(), chair.BufferDuration(), bookingID)
+
+	maintenances, err := uc.chairRepo.GetMaintenancesByChairsInRange([]uint{booking.ChairID}, localDate, localDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar manutenções da cadeira: %w", err)
+	}
+	availableSlots = filterMaintenances(availableSlots, maintenances, localDate, booking.SessionDuration())
+
+	// Nas cadeiras com turnos, só horários com massoterapeuta de plantão
+	shifts, err := uc.therapistRepo.GetShiftsByChairs([]uint{booking.ChairID})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar turnos da cadeira: %w", err)
+	}
+	absences, err := uc.therapistRepo.GetAbsencesInRange(shiftTherapistIDs(shifts), date, date)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar ausências dos massoterapeutas: %w", err)
 	}
+	availableSlots = filterOnDuty(availableSlots, shifts, absences, date, booking.SessionDuration())
 
 	// Converter booking para DTO
 	bookingResponse := dtos.BookingResponse{
 		ID:        booking.ID,
 		UserID:    booking.UserID,
 		ChairID:   booking.ChairID,
+		ServiceID: booking.ServiceID,
 		StartTime: booking.StartTime,
 		EndTime:   booking.EndTime,
 		Status:    booking.Status,
@@ -584,8 +1297,8 @@ func (uc *BookingUseCase) RescheduleBookingDateTime(bookingID uint, newStartTime
 		return errors.New("não é possível reagendar para o mesmo horário")
 	}
 
-	// Calcular novo horário de fim (30 minutos após o início)
-	newEndTime := newStartTime.Add(30 * time.Minute)
+	// Calcular novo horário de fim mantendo a duração da sessão
+	newEndTime := newStartTime.Add(booking.SessionDuration())
 
 	// Verificar disponibilidade no novo horário (excluindo o próprio agendamento)
 	hasConflict, err := uc.bookingRepo.HasConflict(booking.ChairID, newStartTime, newEndTime, &bookingID)
@@ -593,11 +1306,19 @@ func (uc *BookingUseCase) RescheduleBookingDateTime(bookingID uint, newStartTime
 		return fmt.Errorf("erro ao verificar disponibilidade: %w", err)
 	}
 	if hasConflict {
-		return errors.New("já existe um agendamento neste horário")
+		return fmt.Errorf("não é possível reagendar: %w", entities.ErrBookingConflict)
+	}
+	if err := uc.checkNotHeld(booking.ChairID, newStartTime, newEndTime, ""); err != nil {
+		return fmt.Errorf("não é possível reagendar: %w", err)
+	}
+
+	// Aplicar as políticas de agendamento do usuário
+	if err := uc.policyUseCase.CheckBooking(booking.UserID, booking.ChairID, newStartTime, &bookingID); err != nil {
+		return err
 	}
 
 	// Verificar se a cadeira está disponível no novo horário
-	isAvailable, err := uc.availabilityRepo.IsChairAvailableAtTime(booking.ChairID, newStartTime)
+	isAvailable, err := uc.availabilityRepo.IsChairAvailableForPeriod(booking.ChairID, newStartTime, newEndTime)
 	if err != nil {
 		return fmt.Errorf("erro ao verificar disponibilidade da cadeira: %w", err)
 	}
@@ -608,6 +1329,12 @@ func (uc *BookingUseCase) RescheduleBookingDateTime(bookingID uint, newStartTime
 	// Atualizar apenas data e horário
 	booking.StartTime = newStartTime
 	booking.EndTime = newEndTime
+	booking.CalendarSequence++
+
+	// O plantão pode ser de outro massoterapeuta no novo horário
+	if err := uc.assignTherapist(booking); err != nil {
+		return err
+	}
 
 	err = uc.bookingRepo.Update(booking)
 	if err != nil {
@@ -619,6 +1346,8 @@ func (uc *BookingUseCase) RescheduleBookingDateTime(bookingID uint, newStartTime
 	auditLog.SetDescription(fmt.Sprintf("Reagendado para %s", newStartTime.Format("2006-01-02 15:04")))
 	uc.auditRepo.Create(auditLog)
 
+	go uc.notifyRescheduled(booking)
+
 	return nil
 }
 
@@ -735,6 +1464,7 @@ func (uc *BookingUseCase) GetAttendantStats() (map[string]interface{}, error) {
 	totalSessions := int64(len(recentBookings))
 	attendedSessions := int64(0)
 	noShows := int64(0)
+	totalMinutes := 0.0
 
 	for _, booking := range recentBookings {
 		if booking.Status == "realizado" {
@@ -742,6 +1472,7 @@ func (uc *BookingUseCase) GetAttendantStats() (map[string]interface{}, error) {
 		} else if booking.Status == "falta" {
 			noShows++
 		}
+		totalMinutes += booking.SessionDuration().Minutes()
 	}
 
 	attendanceRate := 0.0
@@ -749,8 +1480,11 @@ func (uc *BookingUseCase) GetAttendantStats() (map[string]interface{}, error) {
 		attendanceRate = float64(attendedSessions) / float64(totalSessions) * 100
 	}
 
-	// Duração média das sessões (fixa em 30 minutos)
-	avgSessionDuration := 30.0
+	// Duração média das sessões (padrão quando não há histórico)
+	avgSessionDuration := entities.DefaultSessionDuration.Minutes()
+	if totalSessions > 0 {
+		avgSessionDuration = totalMinutes / float64(totalSessions)
+	}
 
 	return map[string]interface{}{
 		"total_sessions":       totalSessions,
@@ -823,13 +1557,13 @@ func (uc *BookingUseCase) MarkCompletedSessions() error {
 	for _, booking := range bookings {
 		// Se a sessão já terminou (end_time < now), marcar como realizado
 		if booking.EndTime.Before(now) {
-			if err := uc.bookingRepo.Complete(booking.ID, 0); err != nil { // 0 = sistema
+			if err := uc.bookingRepo.Complete(booking.ID, entities.SystemBookingActor()); err != nil {
 				log.Printf("Erro ao marcar agendamento %d como realizado: %v", booking.ID, err)
 				continue
 			}
 
 			// Log de auditoria
-			auditLog := entities.NewAuditLog(&booking.UserID, entities.ActionUpdate, entities.ResourceBooking, &booking.ID)
+			auditLog := entities.NewAuditLog(nil, entities.ActionUpdate, entities.ResourceBooking, &booking.ID)
 			auditLog.SetDescription("Agendamento marcado como realizado automaticamente após término da sessão")
 			uc.auditRepo.Create(auditLog)
 
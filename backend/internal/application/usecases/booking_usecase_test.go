@@ -0,0 +1,213 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// bookingTestEnv reúne o caso de uso de agendamentos e os fakes que ele usa
+type bookingTestEnv struct {
+	uc          *BookingUseCase
+	userRepo    *MockUserRepository
+	chairRepo   *fakes.ChairRepository
+	auditRepo   *MockAuditLogRepository
+	validator   *MockValidator
+	bookingRepo *fakes.BookingRepository
+	holdRepo    *fakes.SlotHoldRepository
+	waitlist    *fakes.WaitlistRepository
+	policies    *fakes.BookingPolicyRepository
+	locations   *fakes.LocationRepository
+	penalties   *fakes.PenaltyRepository
+	email       *fakes.EmailRepository
+	chair       *entities.Chair
+}
+
+// newBookingTestEnv monta o caso de uso com uma cadeira ativa (ID 1) e os usuários
+// informados, todos aprovados
+func newBookingTestEnv(users []*entities.User, bookings ...*entities.Booking) *bookingTestEnv {
+	chair := &entities.Chair{ID: 1, Name: "Cadeira 1", Status: "ativa"}
+
+	userRepo := new(MockUserRepository)
+	for _, user := range users {
+		userRepo.On("GetByID", user.ID).Return(user, nil)
+	}
+	auditRepo := new(MockAuditLogRepository)
+	auditRepo.On("Create", mock.Anything).Return(nil)
+	validator := new(MockValidator)
+	validator.On("ValidateStruct", mock.Anything).Return(nil)
+
+	holdRepo := &fakes.SlotHoldRepository{}
+	env := &bookingTestEnv{
+		userRepo:    userRepo,
+		chairRepo:   &fakes.ChairRepository{Chairs: map[uint]*entities.Chair{chair.ID: chair}},
+		auditRepo:   auditRepo,
+		validator:   validator,
+		bookingRepo: fakes.NewBookingRepository(holdRepo, bookings...),
+		holdRepo:    holdRepo,
+		waitlist:    &fakes.WaitlistRepository{},
+		policies:    &fakes.BookingPolicyRepository{},
+		locations:   &fakes.LocationRepository{ByChair: map[uint]*entities.Location{}},
+		penalties:   &fakes.PenaltyRepository{},
+		email:       fakes.NewEmailRepository(),
+		chair:       chair,
+	}
+
+	policyUseCase := NewBookingPolicyUseCase(env.policies, env.bookingRepo, userRepo, env.chairRepo, env.locations, auditRepo, validator)
+	penaltyUseCase := NewPenaltyUseCase(env.penalties, userRepo, auditRepo, env.email, entities.PenaltySettings{})
+	env.uc = NewBookingUseCase(env.bookingRepo, env.chairRepo, userRepo, fakes.AvailabilityRepository{}, nil, env.waitlist, env.holdRepo,
+		fakes.TherapistRepository{}, env.locations, policyUseCase, penaltyUseCase, auditRepo, env.email, validator)
+	return env
+}
+
+func TestBookingUseCase_UpdateBooking_CalendarSequence(t *testing.T) {
+	user := &entities.User{ID: 1, Name: "João", Email: "joao@empresa.com.br", Role: "usuario", Status: "aprovado"}
+	admin := &entities.User{ID: 2, Name: "Admin", Role: "admin", Status: "aprovado"}
+	closureID := uint(7)
+	feedbackAt := time.Now().Add(-time.Hour)
+
+	startTime := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	current := &entities.Booking{
+		ID:                  10,
+		UserID:              user.ID,
+		ChairID:             1,
+		StartTime:           startTime,
+		EndTime:             startTime.Add(entities.DefaultSessionDuration),
+		Status:              entities.BookingStatusScheduled,
+		CalendarSequence:    2,
+		ClosureID:           &closureID,
+		FeedbackRequestedAt: &feedbackAt,
+	}
+	env := newBookingTestEnv([]*entities.User{user, admin}, current)
+
+	// Corpo da edição: os campos controlados pelo servidor não vêm no JSON
+	edited := &entities.Booking{
+		ID:        current.ID,
+		UserID:    user.ID,
+		ChairID:   1,
+		StartTime: startTime.Add(2 * time.Hour),
+		Status:    entities.BookingStatusScheduled,
+	}
+	require.NoError(t, env.uc.UpdateBooking(edited, admin.ID))
+
+	stored, err := env.bookingRepo.GetByID(current.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stored.CalendarSequence)
+	assert.Equal(t, &closureID, stored.ClosureID)
+	assert.Equal(t, &feedbackAt, stored.FeedbackRequestedAt)
+
+	// O convite atualizado sai com o novo SEQUENCE
+	rescheduled := fakes.Receive(t, env.email.Rescheduled)
+	assert.Equal(t, 3, rescheduled.CalendarSequence)
+	assert.True(t, rescheduled.StartTime.Equal(startTime.Add(2*time.Hour)))
+
+	// O cancelamento parte do SEQUENCE do último convite, não de zero
+	require.NoError(t, env.uc.CancelBooking(current.ID, admin.ID, "Teste"))
+	cancelled := fakes.Receive(t, env.email.Cancelled)
+	assert.Equal(t, 3, cancelled.CalendarSequence)
+}
+
+func TestBookingUseCase_UpdateBooking_NotesOnlyKeepsSequence(t *testing.T) {
+	user := &entities.User{ID: 1, Name: "João", Role: "usuario", Status: "aprovado"}
+	startTime := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	current := &entities.Booking{
+		ID:               10,
+		UserID:           user.ID,
+		ChairID:          1,
+		StartTime:        startTime,
+		EndTime:          startTime.Add(entities.DefaultSessionDuration),
+		Status:           entities.BookingStatusScheduled,
+		CalendarSequence: 1,
+	}
+	env := newBookingTestEnv([]*entities.User{user}, current)
+
+	edited := &entities.Booking{
+		ID:        current.ID,
+		UserID:    user.ID,
+		ChairID:   1,
+		StartTime: startTime,
+		Status:    entities.BookingStatusScheduled,
+		Notes:     "Prefere pressão leve",
+	}
+	require.NoError(t, env.uc.UpdateBooking(edited, user.ID))
+
+	stored, err := env.bookingRepo.GetByID(current.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stored.CalendarSequence)
+	assert.Equal(t, "Prefere pressão leve", stored.Notes)
+	assert.Empty(t, env.email.Rescheduled)
+}
+
+func TestBookingUseCase_MarkStaleNoShows(t *testing.T) {
+	user := &entities.User{ID: 1, Name: "João", Role: "usuario", Status: "aprovado"}
+	gracePeriod := 30 * time.Minute
+
+	// booking monta uma sessão que terminou há endedAgo
+	booking := func(id uint, endedAgo time.Duration, status string) *entities.Booking {
+		endTime := time.Now().Add(-endedAgo)
+		return &entities.Booking{ID: id, UserID: user.ID, ChairID: 1, StartTime: endTime.Add(-entities.DefaultSessionDuration),
+			EndTime: endTime, Status: status}
+	}
+
+	t.Run("Só marca as sessões que passaram da tolerância", func(t *testing.T) {
+		env := newBookingTestEnv([]*entities.User{user},
+			booking(1, gracePeriod+time.Minute, entities.BookingStatusScheduled),
+			booking(2, gracePeriod-time.Minute, entities.BookingStatusScheduled))
+
+		require.NoError(t, env.uc.MarkStaleNoShows(gracePeriod))
+
+		stale, err := env.bookingRepo.GetByID(1)
+		require.NoError(t, err)
+		assert.Equal(t, entities.BookingStatusNoShow, stale.Status)
+
+		withinGrace, err := env.bookingRepo.GetByID(2)
+		require.NoError(t, err)
+		assert.Equal(t, entities.BookingStatusScheduled, withinGrace.Status)
+
+		require.Len(t, env.penalties.Penalties, 1)
+		assert.Equal(t, uint(1), env.penalties.Penalties[0].BookingID)
+		assert.Equal(t, entities.PenaltyTypeNoShow, env.penalties.Penalties[0].Type)
+	})
+
+	t.Run("Sessões confirmadas ou canceladas não viram falta", func(t *testing.T) {
+		env := newBookingTestEnv([]*entities.User{user},
+			booking(1, 2*time.Hour, entities.BookingStatusPresenceConfirmed),
+			booking(2, 2*time.Hour, entities.BookingStatusCancelled),
+			booking(3, 2*time.Hour, entities.BookingStatusCompleted))
+
+		require.NoError(t, env.uc.MarkStaleNoShows(gracePeriod))
+
+		for id, status := range map[uint]string{
+			1: entities.BookingStatusPresenceConfirmed,
+			2: entities.BookingStatusCancelled,
+			3: entities.BookingStatusCompleted,
+		} {
+			stored, err := env.bookingRepo.GetByID(id)
+			require.NoError(t, err)
+			assert.Equal(t, status, stored.Status)
+		}
+		assert.Empty(t, env.penalties.Penalties)
+	})
+
+	t.Run("Execuções repetidas registram a penalidade uma única vez", func(t *testing.T) {
+		env := newBookingTestEnv([]*entities.User{user}, booking(1, 2*time.Hour, entities.BookingStatusScheduled))
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, env.uc.MarkStaleNoShows(gracePeriod))
+		}
+
+		// Marcar de novo, como faria uma execução simultânea, é recusado pela transição
+		assert.Error(t, env.uc.MarkAsNoShow(1, user.ID))
+
+		stored, err := env.bookingRepo.GetByID(1)
+		require.NoError(t, err)
+		assert.Equal(t, entities.BookingStatusNoShow, stored.Status)
+		assert.Len(t, env.penalties.Penalties, 1)
+	})
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// calendarFeedLimit limita a quantidade de agendamentos publicados no feed
+const calendarFeedLimit = 100
+
+type CalendarUseCase struct {
+	userRepo    repositories.UserRepository
+	bookingRepo repositories.BookingRepository
+	auditRepo   repositories.AuditLogRepository
+	appURL      string
+}
+
+func NewCalendarUseCase(
+	userRepo repositories.UserRepository,
+	bookingRepo repositories.BookingRepository,
+	auditRepo repositories.AuditLogRepository,
+	appURL string,
+) *CalendarUseCase {
+	return &CalendarUseCase{
+		userRepo:    userRepo,
+		bookingRepo: bookingRepo,
+		auditRepo:   auditRepo,
+		appURL:      appURL,
+	}
+}
+
+// GetFeedURL retorna o endereço secreto do feed de calendário do usuário,
+// gerando o token no primeiro acesso
+func (uc *CalendarUseCase) GetFeedURL(userID uint) (string, error) {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return "", fmt.Errorf("usuário não encontrado: %w", err)
+	}
+
+	if user.CalendarToken != nil {
+		return uc.feedURL(*user.CalendarToken), nil
+	}
+
+	return uc.RotateFeedToken(userID)
+}
+
+// RotateFeedToken gera um novo token para o feed, invalidando o endereço anterior
+func (uc *CalendarUseCase) RotateFeedToken(userID uint) (string, error) {
+	token, err := generateSecureToken()
+	if err != nil {
+		return "", err
+	}
+
+	if err := uc.userRepo.UpdateCalendarToken(userID, token); err != nil {
+		return "", fmt.Errorf("erro ao salvar token do calendário: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&userID, entities.ActionUpdate, entities.ResourceUser, &userID)
+	auditLog.SetDescription("Endereço do feed de calendário gerado")
+	uc.auditRepo.Create(auditLog)
+
+	return uc.feedURL(token), nil
+}
+
+// GetFeedBookings busca os próximos agendamentos do dono do token do feed
+func (uc *CalendarUseCase) GetFeedBookings(token string) ([]*entities.Booking, error) {
+	if token == "" {
+		return nil, errors.New("feed de calendário não encontrado")
+	}
+
+	user, err := uc.userRepo.GetByCalendarToken(token)
+	if err != nil {
+		return nil, errors.New("feed de calendário não encontrado")
+	}
+
+	bookings, err := uc.bookingRepo.GetUpcomingBookings(user.ID, calendarFeedLimit)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar agendamentos: %w", err)
+	}
+
+	return bookings, nil
+}
+
+func (uc *CalendarUseCase) feedURL(token string) string {
+	return fmt.Sprintf("%s/api/calendar/%s.ics", uc.appURL, token)
+}
@@ -3,32 +3,66 @@ package usecases
 import (
 	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/domain/ports"
 	"agendamento-backend/internal/domain/repositories"
 )
 
+// outageReportRecipientsLimit limita quantos admins e atendentes recebem o resumo de
+// indisponibilidade de uma cadeira
+const outageReportRecipientsLimit = 100
+
+// ChairOutageResult resume o que aconteceu (ou, na prévia, o que aconteceria) com os
+// agendamentos atingidos por manutenção ou desativação da cadeira
+type ChairOutageResult struct {
+	Impacts   []*entities.OutageImpact
+	Relocated int
+	Cancelled int
+	DryRun    bool
+}
+
 type ChairUseCase struct {
-	chairRepo repositories.ChairRepository
-	auditRepo repositories.AuditLogRepository
-	validator ports.Validator
+	chairRepo      repositories.ChairRepository
+	locationRepo   repositories.LocationRepository
+	bookingRepo    repositories.BookingRepository
+	userRepo       repositories.UserRepository
+	bookingUseCase *BookingUseCase
+	auditRepo      repositories.AuditLogRepository
+	emailRepo      repositories.EmailRepository
+	validator      ports.Validator
 }
 
 func NewChairUseCase(
 	chairRepo repositories.ChairRepository,
+	locationRepo repositories.LocationRepository,
+	bookingRepo repositories.BookingRepository,
+	userRepo repositories.UserRepository,
+	bookingUseCase *BookingUseCase,
 	auditRepo repositories.AuditLogRepository,
+	emailRepo repositories.EmailRepository,
 	validator ports.Validator,
 ) *ChairUseCase {
 	return &ChairUseCase{
-		chairRepo: chairRepo,
-		auditRepo: auditRepo,
-		validator: validator,
+		chairRepo:      chairRepo,
+		locationRepo:   locationRepo,
+		bookingRepo:    bookingRepo,
+		userRepo:       userRepo,
+		bookingUseCase: bookingUseCase,
+		auditRepo:      auditRepo,
+		emailRepo:      emailRepo,
+		validator:      validator,
 	}
 }
 
 // CreateChair cria uma nova cadeira
 func (uc *ChairUseCase) CreateChair(chair *entities.Chair, createdBy uint) error {
+	if err := uc.resolveLocation(chair); err != nil {
+		return err
+	}
+
 	// Validar dados
 	if err := uc.validator.ValidateStruct(chair); err != nil {
 		return fmt.Errorf("dados inválidos: %w", err)
@@ -61,6 +95,10 @@ func (uc *ChairUseCase) GetChairByID(id uint) (*entities.Chair, error) {
 
 // UpdateChair atualiza uma cadeira
 func (uc *ChairUseCase) UpdateChair(chair *entities.Chair, updatedBy uint) error {
+	if err := uc.resolveLocation(chair); err != nil {
+		return err
+	}
+
 	// Validar dados
 	if err := uc.validator.ValidateStruct(chair); err != nil {
 		return fmt.Errorf("dados inválidos: %w", err)
@@ -128,11 +166,13 @@ func (uc *ChairUseCase) GetAvailableChairs() ([]*entities.Chair, error) {
 	return uc.chairRepo.GetAvailableChairs()
 }
 
-// ChangeChairStatus altera o status de uma cadeira
-func (uc *ChairUseCase) ChangeChairStatus(chairID uint, newStatus string, changedBy uint) error {
+// ChangeChairStatus altera o status de uma cadeira. Ao desativá-la, os agendamentos
+// futuros são transferidos para outras cadeiras ou cancelados; na ativação o
+// resultado vem vazio
+func (uc *ChairUseCase) ChangeChairStatus(chairID uint, newStatus string, changedBy uint) (*ChairOutageResult, error) {
 	chair, err := uc.chairRepo.GetByID(chairID)
 	if err != nil {
-		return fmt.Errorf("cadeira não encontrada: %w", err)
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
 	}
 
 	// Validar status
@@ -146,13 +186,13 @@ func (uc *ChairUseCase) ChangeChairStatus(chairID uint, newStatus string, change
 	}
 
 	if !validStatus {
-		return errors.New("status inválido")
+		return nil, errors.New("status inválido")
 	}
 
 	oldStatus := chair.Status
 
 	if err := uc.chairRepo.ChangeStatus(chairID, newStatus, changedBy); err != nil {
-		return fmt.Errorf("erro ao alterar status da cadeira: %w", err)
+		return nil, fmt.Errorf("erro ao alterar status da cadeira: %w", err)
 	}
 
 	// Log de auditoria
@@ -160,7 +200,26 @@ func (uc *ChairUseCase) ChangeChairStatus(chairID uint, newStatus string, change
 	auditLog.SetDescription(fmt.Sprintf("Status da cadeira %s alterado de %s para %s", chair.Name, oldStatus, newStatus))
 	uc.auditRepo.Create(auditLog)
 
-	return nil
+	if newStatus != "inativa" || oldStatus == "inativa" {
+		return &ChairOutageResult{}, nil
+	}
+
+	chair.Status = newStatus
+	return uc.resolveOutage(chair, time.Now(), nil, "cadeira desativada", changedBy, false)
+}
+
+// PreviewDeactivation mostra, sem gravar nada, o destino dos agendamentos futuros
+// caso a cadeira seja desativada agora
+func (uc *ChairUseCase) PreviewDeactivation(chairID uint) (*ChairOutageResult, error) {
+	chair, err := uc.chairRepo.GetByID(chairID)
+	if err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	if !chair.IsActive() {
+		return nil, errors.New("cadeira já está inativa")
+	}
+
+	return uc.resolveOutage(chair, time.Now(), nil, "cadeira desativada", 0, true)
 }
 
 // GetChairStats retorna estatísticas das cadeiras
@@ -187,3 +246,369 @@ func (uc *ChairUseCase) GetChairStats() (map[string]int64, error) {
 
 	return stats, nil
 }
+
+// CreateBreak cadastra uma pausa nomeada da cadeira. Pausas substituem os
+// agendamentos fictícios usados para bloquear horários
+func (uc *ChairUseCase) CreateBreak(chairBreak *entities.ChairBreak, createdBy uint) error {
+	chair, err := uc.validateBreak(chairBreak, nil)
+	if err != nil {
+		return err
+	}
+
+	chairBreak.CreatedBy = &createdBy
+	if err := uc.chairRepo.CreateBreak(chairBreak); err != nil {
+		return fmt.Errorf("erro ao criar pausa: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceChair, &chair.ID)
+	auditLog.SetDescription(fmt.Sprintf("Pausa criada na cadeira %s: %s", chair.Name, describeBreak(chairBreak)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetBreak busca uma pausa da cadeira
+func (uc *ChairUseCase) GetBreak(chairID, breakID uint) (*entities.ChairBreak, error) {
+	chairBreak, err := uc.chairRepo.GetBreakByID(breakID)
+	if err != nil || chairBreak.ChairID != chairID {
+		return nil, errors.New("pausa não encontrada")
+	}
+	return chairBreak, nil
+}
+
+// GetChairBreaks lista as pausas da cadeira
+func (uc *ChairUseCase) GetChairBreaks(chairID uint) ([]*entities.ChairBreak, error) {
+	if _, err := uc.chairRepo.GetByID(chairID); err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	return uc.chairRepo.GetBreaksByChair(chairID)
+}
+
+// UpdateBreak atualiza nome, dia ou horário de uma pausa
+func (uc *ChairUseCase) UpdateBreak(chairBreak *entities.ChairBreak, updatedBy uint) error {
+	current, err := uc.GetBreak(chairBreak.ChairID, chairBreak.ID)
+	if err != nil {
+		return err
+	}
+
+	chair, err := uc.validateBreak(chairBreak, &chairBreak.ID)
+	if err != nil {
+		return err
+	}
+
+	chairBreak.CreatedBy = current.CreatedBy
+	chairBreak.CreatedAt = current.CreatedAt
+	if err := uc.chairRepo.UpdateBreak(chairBreak); err != nil {
+		return fmt.Errorf("erro ao atualizar pausa: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceChair, &chair.ID)
+	auditLog.SetDescription(fmt.Sprintf("Pausa atualizada na cadeira %s: %s", chair.Name, describeBreak(chairBreak)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeleteBreak exclui uma pausa; o horário volta a ser oferecido
+func (uc *ChairUseCase) DeleteBreak(chairID, breakID, deletedBy uint) error {
+	chairBreak, err := uc.GetBreak(chairID, breakID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.chairRepo.DeleteBreak(breakID); err != nil {
+		return fmt.Errorf("erro ao excluir pausa: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceChair, &chairID)
+	auditLog.SetDescription(fmt.Sprintf("Pausa excluída: %s", describeBreak(chairBreak)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// validateBreak valida a pausa e impede pausas sobrepostas na mesma cadeira
+func (uc *ChairUseCase) validateBreak(chairBreak *entities.ChairBreak, excludeID *uint) (*entities.Chair, error) {
+	if err := uc.validator.ValidateStruct(chairBreak); err != nil {
+		return nil, fmt.Errorf("dados inválidos: %w", err)
+	}
+	if err := chairBreak.Validate(); err != nil {
+		return nil, err
+	}
+
+	chair, err := uc.chairRepo.GetByID(chairBreak.ChairID)
+	if err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+
+	existing, err := uc.chairRepo.GetBreaksByChair(chairBreak.ChairID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar pausas da cadeira: %w", err)
+	}
+	for _, other := range existing {
+		if excludeID != nil && other.ID == *excludeID {
+			continue
+		}
+		if chairBreak.Overlaps(other) {
+			return nil, fmt.Errorf("pausa sobrepõe a pausa %s já cadastrada", other.Name)
+		}
+	}
+
+	return chair, nil
+}
+
+// ScheduleMaintenance programa uma manutenção da cadeira. Os agendamentos da janela
+// são transferidos para outra cadeira no mesmo horário ou, sem cadeira livre, cancelados
+func (uc *ChairUseCase) ScheduleMaintenance(maintenance *entities.ChairMaintenance, createdBy uint) (*ChairOutageResult, error) {
+	chair, err := uc.validateMaintenance(maintenance)
+	if err != nil {
+		return nil, err
+	}
+
+	maintenance.CreatedBy = &createdBy
+	if err := uc.chairRepo.CreateMaintenance(maintenance); err != nil {
+		return nil, fmt.Errorf("erro ao programar manutenção: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceChair, &chair.ID)
+	auditLog.SetDescription(fmt.Sprintf("Manutenção programada na cadeira %s: %s", chair.Name, describeMaintenance(maintenance)))
+	uc.auditRepo.Create(auditLog)
+
+	result, err := uc.resolveOutage(chair, maintenance.StartTime, &maintenance.EndTime, maintenance.Reason, createdBy, false)
+	if err != nil {
+		return nil, err
+	}
+
+	maintenance.RelocatedBookings = result.Relocated
+	maintenance.CancelledBookings = result.Cancelled
+	if err := uc.chairRepo.UpdateMaintenance(maintenance); err != nil {
+		log.Printf("Erro ao registrar resultado da manutenção %d: %v", maintenance.ID, err)
+	}
+
+	return result, nil
+}
+
+// PreviewMaintenance mostra, sem gravar nada, o destino dos agendamentos caso a
+// manutenção seja programada
+func (uc *ChairUseCase) PreviewMaintenance(maintenance *entities.ChairMaintenance) (*ChairOutageResult, error) {
+	chair, err := uc.validateMaintenance(maintenance)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.resolveOutage(chair, maintenance.StartTime, &maintenance.EndTime, maintenance.Reason, 0, true)
+}
+
+// GetMaintenance busca uma manutenção da cadeira
+func (uc *ChairUseCase) GetMaintenance(chairID, maintenanceID uint) (*entities.ChairMaintenance, error) {
+	maintenance, err := uc.chairRepo.GetMaintenanceByID(maintenanceID)
+	if err != nil || maintenance.ChairID != chairID {
+		return nil, errors.New("manutenção não encontrada")
+	}
+	return maintenance, nil
+}
+
+// GetChairMaintenances lista as manutenções da cadeira
+func (uc *ChairUseCase) GetChairMaintenances(chairID uint) ([]*entities.ChairMaintenance, error) {
+	if _, err := uc.chairRepo.GetByID(chairID); err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	return uc.chairRepo.GetMaintenancesByChair(chairID)
+}
+
+// DeleteMaintenance exclui uma manutenção e a cadeira volta a oferecer os horários.
+// Agendamentos já transferidos ou cancelados não são desfeitos
+func (uc *ChairUseCase) DeleteMaintenance(chairID, maintenanceID, deletedBy uint) error {
+	maintenance, err := uc.GetMaintenance(chairID, maintenanceID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.chairRepo.DeleteMaintenance(maintenanceID); err != nil {
+		return fmt.Errorf("erro ao excluir manutenção: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceChair, &chairID)
+	auditLog.SetDescription(fmt.Sprintf("Manutenção excluída: %s", describeMaintenance(maintenance)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// validateMaintenance valida a janela e impede manutenções sobrepostas na mesma cadeira
+func (uc *ChairUseCase) validateMaintenance(maintenance *entities.ChairMaintenance) (*entities.Chair, error) {
+	if err := uc.validator.ValidateStruct(maintenance); err != nil {
+		return nil, fmt.Errorf("dados inválidos: %w", err)
+	}
+	if err := maintenance.Validate(); err != nil {
+		return nil, err
+	}
+	if !maintenance.EndTime.After(time.Now()) {
+		return nil, errors.New("não é possível programar manutenção que já terminou")
+	}
+
+	chair, err := uc.chairRepo.GetByID(maintenance.ChairID)
+	if err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+
+	overlapping, err := uc.chairRepo.HasMaintenanceInPeriod(maintenance.ChairID, maintenance.StartTime, maintenance.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar manutenções da cadeira: %w", err)
+	}
+	if overlapping {
+		return nil, errors.New("já existe manutenção programada neste período")
+	}
+
+	return chair, nil
+}
+
+// resolveOutage trata os agendamentos futuros da cadeira no período (sem fim = todos):
+// cada um vai para a primeira cadeira livre no mesmo horário, preferindo a mesma
+// localidade, ou é cancelado. Em dryRun nada é gravado nem enviado; as cadeiras já
+// escolhidas para outros agendamentos da prévia são respeitadas
+func (uc *ChairUseCase) resolveOutage(chair *entities.Chair, start time.Time, end *time.Time, reason string, actor uint, dryRun bool) (*ChairOutageResult, error) {
+	bookings, err := uc.bookingRepo.GetActiveByChairInPeriod(chair.ID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar agendamentos atingidos: %w", err)
+	}
+
+	result := &ChairOutageResult{DryRun: dryRun}
+	planned := make(map[uint][]*entities.Booking)
+	cancelReason := fmt.Sprintf("Cadeira %s indisponível: %s", chair.Name, reason)
+	now := time.Now()
+
+	for _, booking := range bookings {
+		// Sessões já iniciadas seguem na cadeira
+		if !booking.StartTime.After(now) {
+			continue
+		}
+
+		impact := uc.relocateOrCancel(booking, planned, reason, cancelReason, actor, dryRun)
+		result.Impacts = append(result.Impacts, impact)
+		switch impact.Action {
+		case entities.OutageActionRelocate:
+			result.Relocated++
+		case entities.OutageActionCancel:
+			result.Cancelled++
+		}
+	}
+
+	if dryRun || len(result.Impacts) == 0 {
+		return result, nil
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&actor, entities.ActionUpdate, entities.ResourceChair, &chair.ID)
+	auditLog.SetDescription(fmt.Sprintf("Indisponibilidade da cadeira %s (%s): %d agendamento(s) transferido(s), %d cancelado(s)",
+		chair.Name, reason, result.Relocated, result.Cancelled))
+	uc.auditRepo.Create(auditLog)
+
+	go uc.notifyStaff(chair, reason, result.Impacts)
+
+	return result, nil
+}
+
+// relocateOrCancel decide o destino de um agendamento atingido e, fora da prévia, o executa
+func (uc *ChairUseCase) relocateOrCancel(booking *entities.Booking, planned map[uint][]*entities.Booking, reason, cancelReason string, actor uint, dryRun bool) *entities.OutageImpact {
+	impact := &entities.OutageImpact{Booking: booking, Action: entities.OutageActionCancel}
+
+	candidates, err := uc.bookingUseCase.RelocationCandidates(booking)
+	if err != nil {
+		impact.Detail = err.Error()
+	}
+
+	for _, candidate := range candidates {
+		if overlapsPlanned(planned[candidate.ID], booking, candidate.BufferDuration()) {
+			continue
+		}
+		if !dryRun {
+			if err := uc.bookingUseCase.RelocateBooking(booking.ID, candidate.ID, actor, reason); err != nil {
+				impact.Detail = err.Error()
+				continue
+			}
+		}
+
+		impact.Action = entities.OutageActionRelocate
+		impact.TargetChair = candidate
+		impact.Detail = ""
+		planned[candidate.ID] = append(planned[candidate.ID], booking)
+		return impact
+	}
+
+	if impact.Detail == "" {
+		impact.Detail = "nenhuma outra cadeira livre no horário"
+	}
+	if !dryRun {
+		if err := uc.bookingUseCase.CancelForChairOutage(booking.ID, actor, cancelReason); err != nil {
+			log.Printf("Erro ao cancelar agendamento %d da cadeira indisponível: %v", booking.ID, err)
+			impact.Action = ""
+			impact.Detail = err.Error()
+		}
+	}
+	return impact
+}
+
+// notifyStaff envia o resumo da indisponibilidade aos admins e atendentes aprovados
+func (uc *ChairUseCase) notifyStaff(chair *entities.Chair, reason string, impacts []*entities.OutageImpact) {
+	for _, role := range []string{"admin", "atendente"} {
+		users, _, err := uc.userRepo.GetByRole(role, outageReportRecipientsLimit, 0)
+		if err != nil {
+			log.Printf("Erro ao buscar equipe para o resumo da cadeira %d: %v", chair.ID, err)
+			continue
+		}
+		for _, user := range users {
+			if user.Status != "aprovado" {
+				continue
+			}
+			if err := uc.emailRepo.SendChairOutageReport(user, chair, reason, impacts); err != nil {
+				log.Printf("Erro ao enviar resumo da cadeira %d para %s: %v", chair.ID, user.Email, err)
+			}
+		}
+	}
+}
+
+// overlapsPlanned verifica se o agendamento colide, com a folga de higienização, com
+// os agendamentos já destinados à cadeira nesta indisponibilidade
+func overlapsPlanned(planned []*entities.Booking, booking *entities.Booking, buffer time.Duration) bool {
+	for _, other := range planned {
+		if booking.StartTime.Before(other.EndTime.Add(buffer)) && other.StartTime.Before(booking.EndTime.Add(buffer)) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeMaintenance descreve a manutenção para a auditoria
+func describeMaintenance(maintenance *entities.ChairMaintenance) string {
+	return fmt.Sprintf("%s (%s a %s)", maintenance.Reason,
+		maintenance.StartTime.Format("02/01/2006 15:04"), maintenance.EndTime.Format("02/01/2006 15:04"))
+}
+
+// describeBreak descreve a pausa para a auditoria
+func describeBreak(chairBreak *entities.ChairBreak) string {
+	return fmt.Sprintf("%s (%s %s-%s)", chairBreak.Name, chairBreak.GetDayOfWeekName(), chairBreak.StartTime, chairBreak.EndTime)
+}
+
+// resolveLocation copia para a cadeira o nome da localidade cadastrada informada
+func (uc *ChairUseCase) resolveLocation(chair *entities.Chair) error {
+	if chair.LocationID == nil {
+		return nil
+	}
+
+	location, err := uc.locationRepo.GetByID(*chair.LocationID)
+	if err != nil {
+		return fmt.Errorf("localidade não encontrada: %w", err)
+	}
+	if !location.IsActive {
+		return errors.New("localidade não está ativa")
+	}
+
+	chair.Location = location.Name
+	return nil
+}
@@ -0,0 +1,316 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+	"agendamento-backend/pkg/ical"
+)
+
+// ClosureImportResult resume a importação de um arquivo .ics
+type ClosureImportResult struct {
+	Imported        []*entities.Closure
+	Skipped         int // eventos já importados ou inválidos
+	FlaggedBookings int
+}
+
+type ClosureUseCase struct {
+	closureRepo    repositories.ClosureRepository
+	bookingRepo    repositories.BookingRepository
+	locationRepo   repositories.LocationRepository
+	bookingUseCase *BookingUseCase
+	auditRepo      repositories.AuditLogRepository
+}
+
+func NewClosureUseCase(
+	closureRepo repositories.ClosureRepository,
+	bookingRepo repositories.BookingRepository,
+	locationRepo repositories.LocationRepository,
+	bookingUseCase *BookingUseCase,
+	auditRepo repositories.AuditLogRepository,
+) *ClosureUseCase {
+	return &ClosureUseCase{
+		closureRepo:    closureRepo,
+		bookingRepo:    bookingRepo,
+		locationRepo:   locationRepo,
+		bookingUseCase: bookingUseCase,
+		auditRepo:      auditRepo,
+	}
+}
+
+// CreateClosure cadastra um feriado ou fechamento e marca os agendamentos já
+// existentes no período para cancelamento em lote. Retorna quantos foram marcados
+func (uc *ClosureUseCase) CreateClosure(closure *entities.Closure, createdBy uint) (int, error) {
+	closure.Reason = strings.TrimSpace(closure.Reason)
+	if closure.Reason == "" {
+		return 0, errors.New("motivo do fechamento é obrigatório")
+	}
+	if err := closure.Validate(); err != nil {
+		return 0, err
+	}
+
+	filter := entities.LocationFilter{Name: closure.Location}
+	if closure.LocationID != nil {
+		filter.ID = *closure.LocationID
+	}
+	location, err := uc.resolveLocation(filter)
+	if err != nil {
+		return 0, err
+	}
+	closure.SetLocation(location)
+
+	closure.CreatedBy = &createdBy
+	if err := uc.closureRepo.Create(closure); err != nil {
+		return 0, fmt.Errorf("erro ao criar fechamento: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceClosure, &closure.ID)
+	auditLog.SetDescription(fmt.Sprintf("Fechamento cadastrado: %s (%s a %s, %s)", closure.Reason,
+		closure.StartDate.Format("02/01/2006"), closure.EndDate.Format("02/01/2006"), describeClosureLocation(closure)))
+	uc.auditRepo.Create(auditLog)
+
+	return uc.flagAffectedBookings(closure)
+}
+
+// ImportICS cadastra os eventos de um arquivo .ics como fechamentos da localidade
+// (ou de todas, com filtro vazio). Eventos já importados para a mesma localidade
+// (pelo UID) são ignorados
+func (uc *ClosureUseCase) ImportICS(r io.Reader, filter entities.LocationFilter, createdBy uint) (*ClosureImportResult, error) {
+	location, err := uc.resolveLocation(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := ical.ParseInLocation(r, location.TimeZone())
+	if err != nil {
+		return nil, fmt.Errorf("arquivo .ics inválido: %w", err)
+	}
+
+	result := &ClosureImportResult{}
+
+	for _, event := range events {
+		if event.Status == ical.StatusCancelled || event.Start.IsZero() {
+			result.Skipped++
+			continue
+		}
+
+		closure := closureFromEvent(event, location)
+		if event.UID != "" {
+			if _, err := uc.closureRepo.GetByExternalUID(event.UID, closure.LocationID); err == nil {
+				result.Skipped++
+				continue
+			}
+		}
+
+		flagged, err := uc.CreateClosure(closure, createdBy)
+		if err != nil {
+			log.Printf("Erro ao importar fechamento %q: %v", event.Summary, err)
+			result.Skipped++
+			continue
+		}
+
+		result.Imported = append(result.Imported, closure)
+		result.FlaggedBookings += flagged
+	}
+
+	return result, nil
+}
+
+// resolveLocation busca a localidade cadastrada do filtro, pelo ID ou pelo nome.
+// Filtro vazio retorna nil: o fechamento vale para todas as localidades
+func (uc *ClosureUseCase) resolveLocation(filter entities.LocationFilter) (*entities.Location, error) {
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	var location *entities.Location
+	var err error
+	if filter.ID != 0 {
+		location, err = uc.locationRepo.GetByID(filter.ID)
+	} else {
+		location, err = uc.locationRepo.GetByName(strings.TrimSpace(filter.Name))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrClosureLocationNotFound, err)
+	}
+	return location, nil
+}
+
+// closureFromEvent converte um evento em fechamento, contando os dias no fuso da
+// localidade. O DTEND de eventos de dia inteiro é exclusivo, então o último dia é o
+// anterior a ele
+func closureFromEvent(event ical.Event, location *entities.Location) *entities.Closure {
+	zone := location.TimeZone()
+	start, end := event.Start, event.End
+	if event.AllDay {
+		if end.After(start) {
+			end = end.AddDate(0, 0, -1)
+		}
+	} else {
+		start = start.In(zone)
+		end = end.Add(-time.Nanosecond).In(zone)
+		if end.Before(start) {
+			end = start
+		}
+	}
+
+	reason := strings.TrimSpace(event.Summary)
+	if reason == "" {
+		reason = "Fechamento importado"
+	}
+
+	closure := &entities.Closure{
+		StartDate:   time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC),
+		Reason:      reason,
+		Source:      entities.ClosureSourceICS,
+		ExternalUID: event.UID,
+	}
+	closure.SetLocation(location)
+	return closure
+}
+
+// flagAffectedBookings marca os agendamentos ativos do período e localidade do
+// fechamento. Os dias do fechamento são contados no fuso da localidade de cada
+// cadeira: a busca sobra um dia de cada lado e cada agendamento é conferido no seu
+func (uc *ClosureUseCase) flagAffectedBookings(closure *entities.Closure) (int, error) {
+	start, end := closure.Period(time.UTC)
+	bookings, err := uc.bookingRepo.GetActiveInPeriodByLocation(start.AddDate(0, 0, -1), end.AddDate(0, 0, 1), closure.LocationID)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao buscar agendamentos atingidos: %w", err)
+	}
+
+	zones := make(map[uint]*time.Location)
+	ids := make([]uint, 0, len(bookings))
+	for _, booking := range bookings {
+		zone, ok := zones[booking.ChairID]
+		if !ok {
+			if zone, err = chairTimeZone(uc.locationRepo, booking.ChairID); err != nil {
+				return 0, err
+			}
+			zones[booking.ChairID] = zone
+		}
+
+		start, end := closure.Period(zone)
+		if !booking.StartTime.Before(start) && booking.StartTime.Before(end) {
+			ids = append(ids, booking.ID)
+		}
+	}
+
+	if err := uc.bookingRepo.FlagForClosure(ids, closure.ID); err != nil {
+		return 0, fmt.Errorf("erro ao marcar agendamentos atingidos: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+// GetClosureByID busca um fechamento
+func (uc *ClosureUseCase) GetClosureByID(id uint) (*entities.Closure, error) {
+	closure, err := uc.closureRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("fechamento não encontrado: %w", err)
+	}
+	return closure, nil
+}
+
+// ListClosures lista os fechamentos do período, incluindo os globais quando filtrado por localidade
+func (uc *ClosureUseCase) ListClosures(from, to *time.Time, filter entities.LocationFilter) ([]*entities.Closure, error) {
+	location, err := uc.resolveLocation(filter)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return uc.closureRepo.List(from, to, nil)
+	}
+	return uc.closureRepo.List(from, to, &location.ID)
+}
+
+// DeleteClosure exclui um fechamento e desfaz a marcação dos agendamentos ainda não cancelados
+func (uc *ClosureUseCase) DeleteClosure(closureID, deletedBy uint) error {
+	closure, err := uc.closureRepo.GetByID(closureID)
+	if err != nil {
+		return fmt.Errorf("fechamento não encontrado: %w", err)
+	}
+
+	if err := uc.bookingRepo.ClearClosureFlag(closureID); err != nil {
+		return fmt.Errorf("erro ao desmarcar agendamentos: %w", err)
+	}
+
+	if err := uc.closureRepo.Delete(closureID); err != nil {
+		return fmt.Errorf("erro ao excluir fechamento: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceClosure, &closureID)
+	auditLog.SetDescription(fmt.Sprintf("Fechamento excluído: %s", closure.Reason))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetAffectedBookings lista os agendamentos marcados pelo fechamento que ainda estão ativos
+func (uc *ClosureUseCase) GetAffectedBookings(closureID uint) ([]*entities.Booking, error) {
+	if _, err := uc.closureRepo.GetByID(closureID); err != nil {
+		return nil, fmt.Errorf("fechamento não encontrado: %w", err)
+	}
+
+	bookings, err := uc.bookingRepo.GetByClosure(closureID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar agendamentos atingidos: %w", err)
+	}
+
+	active := make([]*entities.Booking, 0, len(bookings))
+	for _, booking := range bookings {
+		if booking.IsActive() {
+			active = append(active, booking)
+		}
+	}
+
+	return active, nil
+}
+
+// CancelAffectedBookings cancela em lote os agendamentos marcados pelo fechamento.
+// Cada usuário recebe o email de cancelamento com o motivo
+func (uc *ClosureUseCase) CancelAffectedBookings(closureID, cancelledBy uint, reason string) (int, error) {
+	closure, err := uc.closureRepo.GetByID(closureID)
+	if err != nil {
+		return 0, fmt.Errorf("fechamento não encontrado: %w", err)
+	}
+
+	bookings, err := uc.GetAffectedBookings(closureID)
+	if err != nil {
+		return 0, err
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		reason = closure.Reason
+	}
+
+	cancelled := 0
+	for _, booking := range bookings {
+		if err := uc.bookingUseCase.CancelForClosure(booking.ID, cancelledBy, reason); err != nil {
+			log.Printf("Erro ao cancelar agendamento %d do fechamento %d: %v", booking.ID, closureID, err)
+			continue
+		}
+		cancelled++
+	}
+
+	auditLog := entities.NewAuditLog(&cancelledBy, entities.ActionCancel, entities.ResourceClosure, &closureID)
+	auditLog.SetDescription(fmt.Sprintf("%d agendamento(s) cancelado(s) pelo fechamento: %s", cancelled, closure.Reason))
+	uc.auditRepo.Create(auditLog)
+
+	return cancelled, nil
+}
+
+// describeClosureLocation descreve o alcance do fechamento para a auditoria
+func describeClosureLocation(closure *entities.Closure) string {
+	if closure.IsGlobal() {
+		return "todas as localidades"
+	}
+	return closure.Location
+}
@@ -0,0 +1,175 @@
+package usecases
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type EmailVerificationUseCase struct {
+	userRepo           repositories.UserRepository
+	auditRepo          repositories.AuditLogRepository
+	emailRepo          repositories.EmailRepository
+	userUseCase        *UserUseCase
+	signer             ports.TokenSigner
+	ttl                time.Duration // validade do link enviado por email
+	autoApproveDomains []string      // domínios corporativos aprovados sem revisão manual
+}
+
+func NewEmailVerificationUseCase(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditLogRepository,
+	emailRepo repositories.EmailRepository,
+	userUseCase *UserUseCase,
+	signer ports.TokenSigner,
+	ttl time.Duration,
+	autoApproveDomains []string,
+) *EmailVerificationUseCase {
+	if ttl <= 0 {
+		ttl = entities.DefaultEmailVerificationTTL
+	}
+	return &EmailVerificationUseCase{
+		userRepo:           userRepo,
+		auditRepo:          auditRepo,
+		emailRepo:          emailRepo,
+		userUseCase:        userUseCase,
+		signer:             signer,
+		ttl:                ttl,
+		autoApproveDomains: autoApproveDomains,
+	}
+}
+
+// SendVerification envia, em segundo plano, o link de confirmação ao email de um
+// cadastro recém-criado
+func (uc *EmailVerificationUseCase) SendVerification(user *entities.User) {
+	go uc.sendLink(user)
+}
+
+// ResendVerification reenvia o link de confirmação da conta com o CPF ou email
+// informado. Como no pedido de redefinição de senha, nada é retornado, para não
+// revelar se a conta existe ou se o email já foi confirmado
+func (uc *EmailVerificationUseCase) ResendVerification(identifier, ipAddress, userAgent string) {
+	go uc.resendLink(identifier, ipAddress, userAgent)
+}
+
+// resendLink reenvia o link se o email da conta ainda não foi confirmado e o último
+// envio tiver mais de entities.EmailVerificationResendInterval
+func (uc *EmailVerificationUseCase) resendLink(identifier, ipAddress, userAgent string) {
+	user, err := findUserByIdentifier(uc.userRepo, identifier)
+	if err != nil || user.Email == "" || user.IsEmailVerified() {
+		return
+	}
+
+	sentAt := user.EmailVerificationSentAt
+	if sentAt != nil && time.Since(*sentAt) < entities.EmailVerificationResendInterval {
+		return
+	}
+
+	if uc.sendLink(user) {
+		uc.audit(user.ID, "Link de confirmação de email reenviado", ipAddress, userAgent)
+	}
+}
+
+// sendLink assina e envia o link de confirmação, registrando a hora do envio
+func (uc *EmailVerificationUseCase) sendLink(user *entities.User) bool {
+	now := time.Now()
+	expiresAt := now.Add(uc.ttl)
+	token := uc.signer.Sign(entities.EmailVerificationTokenPayload(user.ID, user.Email, expiresAt))
+
+	if err := uc.emailRepo.SendEmailVerification(user, token, expiresAt); err != nil {
+		log.Printf("Erro ao enviar link de confirmação de email ao usuário %d: %v", user.ID, err)
+		return false
+	}
+	if err := uc.userRepo.UpdateEmailVerificationSentAt(user.ID, now); err != nil {
+		log.Printf("Erro ao registrar envio da confirmação de email do usuário %d: %v", user.ID, err)
+	}
+	return true
+}
+
+// GetVerification busca a conta do link de confirmação, sem confirmar o email. O
+// link do email abre esta consulta; a confirmação só acontece por POST, para que
+// leitores de link e pré-carregamentos do cliente de email não confirmem (e aprovem)
+// o cadastro no lugar do dono do endereço
+func (uc *EmailVerificationUseCase) GetVerification(token string) (*entities.User, error) {
+	return uc.resolveToken(token, time.Now())
+}
+
+// VerifyEmail confirma o email pelo token do link. Confirmar de novo não é erro.
+// Cadastros pendentes de usuário comum com email de um domínio da lista de
+// aprovação automática são aprovados na confirmação; pedidos de atendente ou admin
+// continuam dependendo de um aprovador
+func (uc *EmailVerificationUseCase) VerifyEmail(token, ipAddress, userAgent string) (*entities.User, error) {
+	now := time.Now()
+	user, err := uc.resolveToken(token, now)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsEmailVerified() {
+		return user, nil
+	}
+
+	if err := uc.userRepo.MarkEmailVerified(user.ID, now); err != nil {
+		return nil, fmt.Errorf("erro ao confirmar email: %w", err)
+	}
+	user.EmailVerifiedAt = &now
+	uc.audit(user.ID, "Email confirmado pelo link enviado no cadastro", ipAddress, userAgent)
+
+	uc.autoApprove(user, ipAddress, userAgent)
+	return user, nil
+}
+
+// resolveToken verifica a assinatura e o prazo do token e busca a conta, que ainda
+// precisa ter o email para o qual o link foi emitido
+func (uc *EmailVerificationUseCase) resolveToken(token string, now time.Time) (*entities.User, error) {
+	payload, err := uc.signer.Verify(token)
+	if err != nil {
+		return nil, entities.ErrEmailVerificationInvalid
+	}
+	claims, err := entities.ParseEmailVerificationTokenPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !now.Before(claims.ExpiresAt) {
+		return nil, entities.ErrEmailVerificationInvalid
+	}
+
+	user, err := uc.userRepo.GetByID(claims.UserID)
+	if err != nil || !claims.MatchesEmail(user.Email) {
+		return nil, entities.ErrEmailVerificationInvalid
+	}
+	return user, nil
+}
+
+// autoApprove aprova o cadastro recém-confirmado quando o domínio do email está na
+// lista de aprovação automática
+func (uc *EmailVerificationUseCase) autoApprove(user *entities.User, ipAddress, userAgent string) {
+	if user.Status != "pendente" || user.RequestedRole != "usuario" {
+		return
+	}
+	if !entities.IsEmailDomainAllowed(user.Email, uc.autoApproveDomains) {
+		return
+	}
+
+	// Aprovador nulo: a aprovação é registrada como ação do sistema
+	if err := uc.userUseCase.ApproveUser(user.ID, nil); err != nil {
+		log.Printf("Erro ao aprovar automaticamente o usuário %d: %v", user.ID, err)
+		return
+	}
+	user.Status = "aprovado"
+	user.Role = user.RequestedRole
+
+	uc.audit(user.ID, "Cadastro aprovado automaticamente: email confirmado do domínio "+entities.EmailDomain(user.Email), ipAddress, userAgent)
+}
+
+// audit registra a ação na conta do próprio usuário
+func (uc *EmailVerificationUseCase) audit(userID uint, description, ipAddress, userAgent string) {
+	auditLog := entities.NewAuditLog(&userID, entities.ActionUpdate, entities.ResourceUser, &userID)
+	auditLog.SetDescription(description)
+	auditLog.SetRequestInfo(ipAddress, userAgent)
+	uc.auditRepo.Create(auditLog)
+}
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationUseCase_VerifyEmail(t *testing.T) {
+	setup := func(user *entities.User) (*EmailVerificationUseCase, *MockUserRepository, *MockAuditLogRepository) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", user.ID).Return(user, nil)
+		userRepo.On("MarkEmailVerified", user.ID, mock.Anything).Return(nil)
+		userRepo.On("Approve", user.ID, (*uint)(nil)).Return(nil)
+		auditRepo := new(MockAuditLogRepository)
+		auditRepo.On("Create", mock.Anything).Return(nil)
+		notifications := new(MockNotificationService)
+		notifications.On("SendUserApproval", mock.Anything).Return(nil).Maybe()
+
+		userUseCase := NewUserUseCase(userRepo, auditRepo, notifications, nil, nil, nil, nil)
+		uc := NewEmailVerificationUseCase(userRepo, auditRepo, nil, userUseCase, fakes.TokenSigner{}, time.Hour, []string{"empresa.com.br"})
+		return uc, userRepo, auditRepo
+	}
+	token := func(user *entities.User, expiresAt time.Time) string {
+		return fakes.TokenSigner{}.Sign(entities.EmailVerificationTokenPayload(user.ID, user.Email, expiresAt))
+	}
+
+	t.Run("Link válido confirma o email", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@gmail.com", Status: "pendente", RequestedRole: "usuario"}
+		uc, userRepo, _ := setup(user)
+
+		verified, err := uc.VerifyEmail(token(user, time.Now().Add(time.Hour)), "", "")
+		require.NoError(t, err)
+		assert.True(t, verified.IsEmailVerified())
+		assert.Equal(t, "pendente", verified.Status)
+		userRepo.AssertCalled(t, "MarkEmailVerified", uint(1), mock.Anything)
+		userRepo.AssertNotCalled(t, "Approve", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Domínio corporativo liberado é aprovado na confirmação", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@empresa.com.br", Status: "pendente", RequestedRole: "usuario"}
+		uc, userRepo, auditRepo := setup(user)
+
+		verified, err := uc.VerifyEmail(token(user, time.Now().Add(time.Hour)), "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "aprovado", verified.Status)
+		userRepo.AssertCalled(t, "Approve", uint(1), (*uint)(nil))
+		auditRepo.AssertCalled(t, "Create", mock.MatchedBy(func(log *entities.AuditLog) bool {
+			return log.Action == entities.ActionApprove && log.IsSystemAction()
+		}))
+	})
+
+	t.Run("Pedido de atendente continua dependendo de um aprovador", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@empresa.com.br", Status: "pendente", RequestedRole: "atendente"}
+		uc, userRepo, _ := setup(user)
+
+		verified, err := uc.VerifyEmail(token(user, time.Now().Add(time.Hour)), "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "pendente", verified.Status)
+		userRepo.AssertNotCalled(t, "Approve", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Abrir o link de novo não é erro", func(t *testing.T) {
+		verifiedAt := time.Now().Add(-time.Hour)
+		user := &entities.User{ID: 1, Email: "joao@gmail.com", Status: "pendente", EmailVerifiedAt: &verifiedAt}
+		uc, userRepo, _ := setup(user)
+
+		_, err := uc.VerifyEmail(token(user, time.Now().Add(time.Hour)), "", "")
+		require.NoError(t, err)
+		userRepo.AssertNotCalled(t, "MarkEmailVerified", mock.Anything, mock.Anything)
+	})
+
+	invalid := []struct {
+		name  string
+		token func(user *entities.User) string
+	}{
+		{"Link vencido", func(user *entities.User) string { return token(user, time.Now().Add(-time.Minute)) }},
+		{"Assinatura inválida", func(user *entities.User) string {
+			return entities.EmailVerificationTokenPayload(user.ID, user.Email, time.Now().Add(time.Hour))
+		}},
+		{"Email da conta trocado depois do envio", func(user *entities.User) string {
+			return fakes.TokenSigner{}.Sign(entities.EmailVerificationTokenPayload(user.ID, "joao@gmial.com", time.Now().Add(time.Hour)))
+		}},
+		{"Token de outro tipo", func(user *entities.User) string {
+			return fakes.TokenSigner{}.Sign(entities.FeedbackTokenPayload(user.ID, time.Now().Add(time.Hour)))
+		}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &entities.User{ID: 1, Email: "joao@gmail.com", Status: "pendente", RequestedRole: "usuario"}
+			uc, userRepo, _ := setup(user)
+
+			_, err := uc.VerifyEmail(tt.token(user), "", "")
+			assert.ErrorIs(t, err, entities.ErrEmailVerificationInvalid)
+			userRepo.AssertNotCalled(t, "MarkEmailVerified", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserUseCase_ApproveUser_EmailNotVerified(t *testing.T) {
+	userRepo := new(MockUserRepository)
+	userRepo.On("GetByID", uint(1)).Return(&entities.User{ID: 1, Status: "pendente"}, nil)
+	userUseCase := NewUserUseCase(userRepo, new(MockAuditLogRepository), new(MockNotificationService), nil, nil, nil, nil)
+
+	approvedBy := uint(2)
+	assert.ErrorIs(t, userUseCase.ApproveUser(1, &approvedBy), entities.ErrEmailNotVerified)
+	userRepo.AssertNotCalled(t, "Approve", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,237 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// feedbackPromptBatchSize limita os convites de avaliação enviados por execução
+const feedbackPromptBatchSize = 200
+
+type FeedbackUseCase struct {
+	feedbackRepo repositories.FeedbackRepository
+	bookingRepo  repositories.BookingRepository
+	emailRepo    repositories.EmailRepository
+	signer       ports.TokenSigner
+	validator    ports.Validator
+	window       time.Duration // prazo, contado do fim da sessão, para avaliar
+}
+
+func NewFeedbackUseCase(
+	feedbackRepo repositories.FeedbackRepository,
+	bookingRepo repositories.BookingRepository,
+	emailRepo repositories.EmailRepository,
+	signer ports.TokenSigner,
+	validator ports.Validator,
+	window time.Duration,
+) *FeedbackUseCase {
+	if window <= 0 {
+		window = entities.DefaultFeedbackWindow
+	}
+	return &FeedbackUseCase{
+		feedbackRepo: feedbackRepo,
+		bookingRepo:  bookingRepo,
+		emailRepo:    emailRepo,
+		signer:       signer,
+		validator:    validator,
+		window:       window,
+	}
+}
+
+// SatisfactionReport reúne as métricas de satisfação do período
+type SatisfactionReport struct {
+	Overall     *entities.SatisfactionStats
+	ByChair     []*entities.SatisfactionStats
+	ByTherapist []*entities.SatisfactionStats
+	ByLocation  []*entities.SatisfactionStats
+}
+
+// SubmitFeedback registra a avaliação do próprio usuário para uma sessão realizada
+func (uc *FeedbackUseCase) SubmitFeedback(bookingID, userID uint, feedback *entities.BookingFeedback) error {
+	booking, err := uc.bookingRepo.GetByID(bookingID)
+	if err != nil {
+		return fmt.Errorf("agendamento não encontrado: %w", err)
+	}
+	if booking.UserID != userID {
+		return errors.New("apenas quem fez a sessão pode avaliá-la")
+	}
+
+	feedback.Source = entities.FeedbackSourceApp
+	return uc.submit(booking, feedback)
+}
+
+// GetFeedbackInvite busca a sessão do link do convite, sem registrar nota. O link
+// do email abre esta consulta para preencher o formulário; a avaliação só é gravada
+// por POST, para que leitores de link e pré-carregamentos do cliente de email não
+// avaliem pelo usuário
+func (uc *FeedbackUseCase) GetFeedbackInvite(token string) (*entities.Booking, error) {
+	booking, err := uc.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := entities.CheckFeedbackEligibility(booking, time.Now(), uc.window); err != nil {
+		return nil, err
+	}
+	if exists, err := uc.feedbackRepo.ExistsForBooking(booking.ID); err != nil {
+		return nil, fmt.Errorf("erro ao verificar avaliação: %w", err)
+	} else if exists {
+		return nil, entities.ErrFeedbackAlreadySubmitted
+	}
+	return booking, nil
+}
+
+// SubmitFeedbackByToken registra a avaliação pelo link do convite, sem login
+func (uc *FeedbackUseCase) SubmitFeedbackByToken(token string, feedback *entities.BookingFeedback) (*entities.Booking, error) {
+	booking, err := uc.resolveToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	feedback.Source = entities.FeedbackSourceEmail
+	if err := uc.submit(booking, feedback); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// resolveToken verifica a assinatura e o prazo do token do convite e busca a sessão
+func (uc *FeedbackUseCase) resolveToken(token string) (*entities.Booking, error) {
+	payload, err := uc.signer.Verify(token)
+	if err != nil {
+		return nil, errors.New("link de avaliação inválido")
+	}
+
+	claims, err := entities.ParseFeedbackTokenPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(claims.ExpiresAt) {
+		return nil, errors.New("prazo para avaliar esta sessão encerrado")
+	}
+
+	booking, err := uc.bookingRepo.GetByID(claims.BookingID)
+	if err != nil {
+		return nil, fmt.Errorf("agendamento não encontrado: %w", err)
+	}
+	return booking, nil
+}
+
+// submit valida e grava a avaliação. Cada agendamento recebe uma única avaliação
+func (uc *FeedbackUseCase) submit(booking *entities.Booking, feedback *entities.BookingFeedback) error {
+	if err := entities.CheckFeedbackEligibility(booking, time.Now(), uc.window); err != nil {
+		return err
+	}
+
+	feedback.SetBooking(booking)
+	if err := uc.validator.ValidateStruct(feedback); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+	if err := feedback.Validate(); err != nil {
+		return err
+	}
+
+	if exists, err := uc.feedbackRepo.ExistsForBooking(booking.ID); err != nil {
+		return fmt.Errorf("erro ao verificar avaliação: %w", err)
+	} else if exists {
+		return entities.ErrFeedbackAlreadySubmitted
+	}
+
+	if err := uc.feedbackRepo.Create(feedback); err != nil {
+		if errors.Is(err, entities.ErrFeedbackAlreadySubmitted) {
+			return err
+		}
+		return fmt.Errorf("erro ao registrar avaliação: %w", err)
+	}
+	return nil
+}
+
+// GetBookingFeedback busca a avaliação de um agendamento
+func (uc *FeedbackUseCase) GetBookingFeedback(bookingID uint) (*entities.BookingFeedback, error) {
+	feedback, err := uc.feedbackRepo.GetByBooking(bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("avaliação não encontrada: %w", err)
+	}
+	return feedback, nil
+}
+
+// ListFeedbacks lista as avaliações com os comentários, para a equipe
+func (uc *FeedbackUseCase) ListFeedbacks(limit, offset int, filters map[string]interface{}) ([]*entities.BookingFeedback, int64, error) {
+	return uc.feedbackRepo.List(limit, offset, filters)
+}
+
+// GetSatisfactionReport agrega as avaliações no total e por cadeira, massoterapeuta
+// e localidade
+func (uc *FeedbackUseCase) GetSatisfactionReport(filters map[string]interface{}) (*SatisfactionReport, error) {
+	overall, err := uc.feedbackRepo.GetSatisfactionStats("", filters)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao calcular satisfação geral: %w", err)
+	}
+
+	report := &SatisfactionReport{Overall: &entities.SatisfactionStats{}}
+	if len(overall) > 0 {
+		report.Overall = overall[0]
+	}
+
+	groups := []struct {
+		groupBy string
+		target  *[]*entities.SatisfactionStats
+	}{
+		{entities.SatisfactionByChair, &report.ByChair},
+		{entities.SatisfactionByTherapist, &report.ByTherapist},
+		{entities.SatisfactionByLocation, &report.ByLocation},
+	}
+	for _, group := range groups {
+		stats, err := uc.feedbackRepo.GetSatisfactionStats(group.groupBy, filters)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao calcular satisfação por %s: %w", group.groupBy, err)
+		}
+		*group.target = stats
+	}
+
+	return report, nil
+}
+
+// SendFeedbackPrompts envia o convite de avaliação das sessões realizadas que ainda
+// estão no prazo. Sessões mais antigas que o prazo não recebem convite
+func (uc *FeedbackUseCase) SendFeedbackPrompts() error {
+	now := time.Now()
+
+	bookings, err := uc.feedbackRepo.GetBookingsAwaitingPrompt(now.Add(-uc.window), now, feedbackPromptBatchSize)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar sessões para avaliação: %w", err)
+	}
+
+	sentCount := 0
+	for _, booking := range bookings {
+		if booking.User.Email == "" {
+			continue
+		}
+
+		claimed, err := uc.feedbackRepo.MarkPromptSent(booking.ID, now)
+		if err != nil || !claimed {
+			continue
+		}
+
+		deadline := entities.FeedbackDeadline(booking, uc.window)
+		token := uc.signer.Sign(entities.FeedbackTokenPayload(booking.ID, deadline))
+		if err := uc.emailRepo.SendFeedbackRequest(&booking.User, booking, token, deadline); err != nil {
+			log.Printf("Erro ao enviar convite de avaliação do agendamento %d: %v", booking.ID, err)
+			uc.feedbackRepo.ClearPromptSent(booking.ID)
+			continue
+		}
+		sentCount++
+	}
+
+	if sentCount > 0 {
+		log.Printf("Enviados %d convites de avaliação", sentCount)
+	}
+
+	return nil
+}
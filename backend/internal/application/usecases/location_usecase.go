@@ -0,0 +1,215 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type LocationUseCase struct {
+	locationRepo repositories.LocationRepository
+	chairRepo    repositories.ChairRepository
+	userRepo     repositories.UserRepository
+	auditRepo    repositories.AuditLogRepository
+	validator    ports.Validator
+}
+
+func NewLocationUseCase(
+	locationRepo repositories.LocationRepository,
+	chairRepo repositories.ChairRepository,
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditLogRepository,
+	validator ports.Validator,
+) *LocationUseCase {
+	return &LocationUseCase{
+		locationRepo: locationRepo,
+		chairRepo:    chairRepo,
+		userRepo:     userRepo,
+		auditRepo:    auditRepo,
+		validator:    validator,
+	}
+}
+
+// CreateLocation cadastra uma nova localidade
+func (uc *LocationUseCase) CreateLocation(location *entities.Location, createdBy uint) error {
+	if err := uc.validateLocation(location); err != nil {
+		return err
+	}
+
+	if exists, err := uc.locationRepo.ExistsByName(location.Name); err != nil {
+		return fmt.Errorf("erro ao verificar nome: %w", err)
+	} else if exists {
+		return errors.New("já existe uma localidade com este nome")
+	}
+
+	location.IsActive = true
+	if err := uc.locationRepo.Create(location); err != nil {
+		return fmt.Errorf("erro ao criar localidade: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceLocation, &location.ID)
+	auditLog.SetDescription(fmt.Sprintf("Localidade %s criada (%s)", location.Name, location.Describe()))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetLocation busca localidade por ID
+func (uc *LocationUseCase) GetLocation(id uint) (*entities.Location, error) {
+	location, err := uc.locationRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("localidade não encontrada: %w", err)
+	}
+	return location, nil
+}
+
+// ListLocations lista as localidades, opcionalmente de um escritório
+func (uc *LocationUseCase) ListLocations(site string, includeInactive bool) ([]*entities.Location, error) {
+	return uc.locationRepo.List(strings.TrimSpace(site), includeInactive)
+}
+
+// UpdateLocation atualiza uma localidade. Renomear atualiza o nome exibido nas
+// cadeiras e nos fechamentos da localidade
+func (uc *LocationUseCase) UpdateLocation(location *entities.Location, updatedBy uint) error {
+	current, err := uc.locationRepo.GetByID(location.ID)
+	if err != nil {
+		return fmt.Errorf("localidade não encontrada: %w", err)
+	}
+
+	if err := uc.validateLocation(location); err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(location.Name, current.Name) {
+		if exists, err := uc.locationRepo.ExistsByName(location.Name); err != nil {
+			return fmt.Errorf("erro ao verificar nome: %w", err)
+		} else if exists {
+			return errors.New("já existe uma localidade com este nome")
+		}
+	}
+
+	location.CreatedAt = current.CreatedAt
+	if err := uc.locationRepo.Update(location); err != nil {
+		return fmt.Errorf("erro ao atualizar localidade: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceLocation, &location.ID)
+	auditLog.SetDescription(fmt.Sprintf("Localidade %s atualizada", location.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeleteLocation exclui uma localidade sem cadeiras vinculadas
+func (uc *LocationUseCase) DeleteLocation(id, deletedBy uint) error {
+	location, err := uc.locationRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("localidade não encontrada: %w", err)
+	}
+
+	chairs, err := uc.chairRepo.CountByLocationID(id)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar cadeiras da localidade: %w", err)
+	}
+	if chairs > 0 {
+		return fmt.Errorf("localidade possui %d cadeira(s) vinculada(s); mova-as ou desative a localidade", chairs)
+	}
+
+	if err := uc.locationRepo.Delete(id); err != nil {
+		return fmt.Errorf("erro ao excluir localidade: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceLocation, &id)
+	auditLog.SetDescription(fmt.Sprintf("Localidade %s excluída", location.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetAttendants lista os atendentes vinculados à localidade
+func (uc *LocationUseCase) GetAttendants(locationID uint) ([]*entities.User, error) {
+	if _, err := uc.locationRepo.GetByID(locationID); err != nil {
+		return nil, fmt.Errorf("localidade não encontrada: %w", err)
+	}
+	return uc.userRepo.GetByLocation(locationID)
+}
+
+// AssignAttendant vincula um atendente à localidade. A partir daí o dashboard e a
+// listagem de agendamentos dele ficam restritos às cadeiras dessa localidade
+func (uc *LocationUseCase) AssignAttendant(locationID, userID, assignedBy uint) error {
+	location, err := uc.locationRepo.GetByID(locationID)
+	if err != nil {
+		return fmt.Errorf("localidade não encontrada: %w", err)
+	}
+	if !location.IsActive {
+		return errors.New("localidade não está ativa")
+	}
+
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return fmt.Errorf("usuário não encontrado: %w", err)
+	}
+	if !user.IsAttendant() {
+		return errors.New("apenas atendentes podem ser vinculados a uma localidade")
+	}
+
+	if err := uc.userRepo.UpdateLocation(userID, &locationID); err != nil {
+		return fmt.Errorf("erro ao vincular atendente: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&assignedBy, entities.ActionUpdate, entities.ResourceUser, &userID)
+	auditLog.SetDescription(fmt.Sprintf("Atendente %s vinculado à localidade %s", user.Name, location.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// RemoveAttendant desfaz o vínculo do atendente com a localidade; ele volta a
+// atender todas as localidades
+func (uc *LocationUseCase) RemoveAttendant(locationID, userID, removedBy uint) error {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return fmt.Errorf("usuário não encontrado: %w", err)
+	}
+	if user.LocationID == nil || *user.LocationID != locationID {
+		return errors.New("atendente não está vinculado a esta localidade")
+	}
+
+	if err := uc.userRepo.UpdateLocation(userID, nil); err != nil {
+		return fmt.Errorf("erro ao desvincular atendente: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&removedBy, entities.ActionUpdate, entities.ResourceUser, &userID)
+	auditLog.SetDescription(fmt.Sprintf("Atendente %s desvinculado da localidade %d", user.Name, locationID))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// validateLocation normaliza os textos e valida a localidade
+func (uc *LocationUseCase) validateLocation(location *entities.Location) error {
+	location.Name = strings.TrimSpace(location.Name)
+	location.Site = strings.TrimSpace(location.Site)
+	location.Timezone = strings.TrimSpace(location.Timezone)
+
+	if err := uc.validator.ValidateStruct(location); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+	return location.Validate()
+}
+
+// chairTimeZone retorna o fuso da localidade da cadeira, em que são lidos os
+// horários das janelas, pausas, turnos e fechamentos. Cadeiras sem localidade
+// cadastrada usam o fuso padrão
+func chairTimeZone(locationRepo repositories.LocationRepository, chairID uint) (*time.Location, error) {
+	location, err := locationRepo.GetByChair(chairID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar localidade da cadeira: %w", err)
+	}
+	return location.TimeZone(), nil
+}
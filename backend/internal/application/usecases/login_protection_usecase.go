@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// LoginProtectionUseCase limita as tentativas de login com senha errada: atraso
+// progressivo e bloqueio temporário por CPF, e limite de falhas por IP
+type LoginProtectionUseCase struct {
+	attemptRepo repositories.LoginAttemptRepository
+	auditRepo   repositories.AuditLogRepository
+	emailRepo   repositories.EmailRepository
+	settings    entities.LoginProtectionSettings
+}
+
+func NewLoginProtectionUseCase(
+	attemptRepo repositories.LoginAttemptRepository,
+	auditRepo repositories.AuditLogRepository,
+	emailRepo repositories.EmailRepository,
+	settings entities.LoginProtectionSettings,
+) *LoginProtectionUseCase {
+	return &LoginProtectionUseCase{
+		attemptRepo: attemptRepo,
+		auditRepo:   auditRepo,
+		emailRepo:   emailRepo,
+		settings:    settings,
+	}
+}
+
+// CheckAllowed verifica, antes de conferir a senha, se o login do CPF pode ser
+// tentado agora. Uma recusa retorna *entities.LoginBlockedError
+func (uc *LoginProtectionUseCase) CheckAllowed(cpf, ipAddress string) error {
+	now := time.Now()
+
+	lockout, err := uc.attemptRepo.GetActiveLockout(cpf, now)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar bloqueio de login: %w", err)
+	}
+	if lockout != nil {
+		return &entities.LoginBlockedError{Locked: true, RetryAt: lockout.LockedUntil}
+	}
+
+	since := now.Add(-uc.settings.FailureWindow)
+	if uc.settings.MaxFailuresPerIP > 0 && ipAddress != "" {
+		count, err := uc.attemptRepo.CountFailuresByIP(ipAddress, since)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar tentativas de login: %w", err)
+		}
+		if count >= int64(uc.settings.MaxFailuresPerIP) {
+			return &entities.LoginBlockedError{RetryAt: now.Add(uc.settings.FailureWindow)}
+		}
+	}
+
+	failures, err := uc.attemptRepo.ListPendingFailures(cpf, since)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar tentativas de login: %w", err)
+	}
+	if len(failures) > 0 {
+		retryAt := failures[0].CreatedAt.Add(uc.settings.DelayAfter(len(failures)))
+		if now.Before(retryAt) {
+			return &entities.LoginBlockedError{RetryAt: retryAt}
+		}
+	}
+
+	return nil
+}
+
+// RecordFailure registra uma tentativa com CPF desconhecido (user nulo) ou senha
+// errada. Ao atingir o limite de falhas, bloqueia o login do CPF, avisa o dono da
+// conta por email e retorna *entities.LoginBlockedError
+func (uc *LoginProtectionUseCase) RecordFailure(cpf string, user *entities.User, ipAddress, userAgent string) error {
+	var userID *uint
+	description := "Tentativa de login com CPF não cadastrado: " + cpf
+	if user != nil {
+		userID = &user.ID
+		description = "Tentativa de login com senha inválida"
+	}
+
+	if err := uc.attemptRepo.CreateFailure(entities.NewLoginFailure(cpf, userID, ipAddress, userAgent)); err != nil {
+		return fmt.Errorf("erro ao registrar tentativa de login: %w", err)
+	}
+	uc.audit(userID, description, ipAddress, userAgent)
+
+	if uc.settings.MaxFailures <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	failures, err := uc.attemptRepo.ListPendingFailures(cpf, now.Add(-uc.settings.FailureWindow))
+	if err != nil {
+		return fmt.Errorf("erro ao verificar tentativas de login: %w", err)
+	}
+	if len(failures) < uc.settings.MaxFailures {
+		return nil
+	}
+
+	return uc.lock(cpf, user, len(failures), ipAddress, userAgent, now)
+}
+
+// RecordSuccess zera as falhas do CPF depois de uma senha certa
+func (uc *LoginProtectionUseCase) RecordSuccess(cpf string) error {
+	return uc.attemptRepo.ClearFailures(cpf, time.Now())
+}
+
+// GetActiveLockout busca o bloqueio em vigor do login do usuário. Retorna nil quando
+// não há
+func (uc *LoginProtectionUseCase) GetActiveLockout(user *entities.User) (*entities.LoginLockout, error) {
+	return uc.attemptRepo.GetActiveLockout(user.CPF, time.Now())
+}
+
+// Unlock desfaz o bloqueio do login do usuário e zera as falhas pendentes.
+// unlockedBy é o admin que desbloqueou; nulo na redefinição de senha. Retorna se
+// havia bloqueio em vigor
+func (uc *LoginProtectionUseCase) Unlock(user *entities.User, unlockedBy *uint, reason string) (bool, error) {
+	now := time.Now()
+	count, err := uc.attemptRepo.Unlock(user.CPF, unlockedBy, reason, now)
+	if err != nil {
+		return false, fmt.Errorf("erro ao desbloquear login: %w", err)
+	}
+	if err := uc.attemptRepo.ClearFailures(user.CPF, now); err != nil {
+		return false, fmt.Errorf("erro ao desbloquear login: %w", err)
+	}
+	return count > 0, nil
+}
+
+// lock bloqueia o login do CPF. As falhas que levaram ao bloqueio são zeradas, para
+// que a contagem recomece quando ele terminar
+func (uc *LoginProtectionUseCase) lock(cpf string, user *entities.User, failures int, ipAddress, userAgent string, now time.Time) error {
+	lockout := &entities.LoginLockout{
+		CPF:         cpf,
+		Failures:    failures,
+		IPAddress:   ipAddress,
+		LockedUntil: now.Add(uc.settings.LockoutDuration),
+	}
+	if user != nil {
+		lockout.UserID = &user.ID
+	}
+
+	if err := uc.attemptRepo.CreateLockout(lockout); err != nil {
+		return fmt.Errorf("erro ao bloquear login: %w", err)
+	}
+	if err := uc.attemptRepo.ClearFailures(cpf, now); err != nil {
+		log.Printf("Erro ao zerar tentativas de login do CPF bloqueado: %v", err)
+	}
+
+	uc.audit(lockout.UserID, fmt.Sprintf("Login bloqueado até %s após %d tentativas com senha inválida", lockout.LockedUntil.Format("02/01/2006 15:04"), failures), ipAddress, userAgent)
+
+	if user != nil && user.Email != "" {
+		go func() {
+			if err := uc.emailRepo.SendAccountLocked(user, lockout.LockedUntil, ipAddress); err != nil {
+				log.Printf("Erro ao enviar aviso de bloqueio de login ao usuário %d: %v", user.ID, err)
+			}
+		}()
+	}
+
+	return &entities.LoginBlockedError{Locked: true, RetryAt: lockout.LockedUntil}
+}
+
+// audit registra a falha de login; userID nulo quando o CPF não está cadastrado
+func (uc *LoginProtectionUseCase) audit(userID *uint, description, ipAddress, userAgent string) {
+	auditLog := entities.NewAuditLog(userID, entities.ActionLoginFailed, entities.ResourceAuth, userID)
+	auditLog.SetDescription(description)
+	auditLog.SetRequestInfo(ipAddress, userAgent)
+	uc.auditRepo.Create(auditLog)
+}
@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLoginAttemptRepository guarda falhas e bloqueios de login em memória
+type fakeLoginAttemptRepository struct {
+	failures []*entities.LoginFailure
+	lockouts []*entities.LoginLockout
+}
+
+func (r *fakeLoginAttemptRepository) CreateFailure(failure *entities.LoginFailure) error {
+	failure.ID = uint(len(r.failures) + 1)
+	if failure.CreatedAt.IsZero() {
+		failure.CreatedAt = time.Now()
+	}
+	r.failures = append(r.failures, failure)
+	return nil
+}
+
+func (r *fakeLoginAttemptRepository) ListPendingFailures(cpf string, since time.Time) ([]*entities.LoginFailure, error) {
+	var failures []*entities.LoginFailure
+	for i := len(r.failures) - 1; i >= 0; i-- {
+		failure := r.failures[i]
+		if failure.CPF == cpf && failure.ClearedAt == nil && !failure.CreatedAt.Before(since) {
+			failures = append(failures, failure)
+		}
+	}
+	return failures, nil
+}
+
+func (r *fakeLoginAttemptRepository) CountFailuresByIP(ipAddress string, since time.Time) (int64, error) {
+	var count int64
+	for _, failure := range r.failures {
+		if failure.IPAddress == ipAddress && !failure.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeLoginAttemptRepository) ClearFailures(cpf string, at time.Time) error {
+	for _, failure := range r.failures {
+		if failure.CPF == cpf && failure.ClearedAt == nil {
+			failure.ClearedAt = &at
+		}
+	}
+	return nil
+}
+
+func (r *fakeLoginAttemptRepository) CreateLockout(lockout *entities.LoginLockout) error {
+	lockout.ID = uint(len(r.lockouts) + 1)
+	r.lockouts = append(r.lockouts, lockout)
+	return nil
+}
+
+func (r *fakeLoginAttemptRepository) GetActiveLockout(cpf string, now time.Time) (*entities.LoginLockout, error) {
+	for _, lockout := range r.lockouts {
+		if lockout.CPF == cpf && lockout.IsActiveAt(now) {
+			return lockout, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeLoginAttemptRepository) Unlock(cpf string, unlockedBy *uint, reason string, at time.Time) (int64, error) {
+	var count int64
+	for _, lockout := range r.lockouts {
+		if lockout.CPF == cpf && lockout.IsActiveAt(at) {
+			lockout.UnlockedAt = &at
+			lockout.UnlockedBy = unlockedBy
+			lockout.UnlockReason = reason
+			count++
+		}
+	}
+	return count, nil
+}
+
+// age recua o horário das falhas registradas, como se o tempo tivesse passado
+func (r *fakeLoginAttemptRepository) age(d time.Duration) {
+	for _, failure := range r.failures {
+		failure.CreatedAt = failure.CreatedAt.Add(-d)
+	}
+}
+
+func newLoginProtectionTestUseCase() (*LoginProtectionUseCase, *fakeLoginAttemptRepository) {
+	attemptRepo := &fakeLoginAttemptRepository{}
+	auditRepo := new(MockAuditLogRepository)
+	auditRepo.On("Create", mock.Anything).Return(nil)
+	settings := entities.LoginProtectionSettings{
+		FailureWindow:    15 * time.Minute,
+		MaxFailures:      3,
+		LockoutDuration:  15 * time.Minute,
+		MaxFailuresPerIP: 5,
+	}
+	return NewLoginProtectionUseCase(attemptRepo, auditRepo, nil, settings), attemptRepo
+}
+
+func TestLoginProtectionUseCase(t *testing.T) {
+	const cpf = "12345678909"
+
+	t.Run("Sem falhas o login é liberado", func(t *testing.T) {
+		uc, _ := newLoginProtectionTestUseCase()
+		assert.NoError(t, uc.CheckAllowed(cpf, "10.0.0.1"))
+	})
+
+	t.Run("Falhas seguidas atrasam a próxima tentativa", func(t *testing.T) {
+		uc, attemptRepo := newLoginProtectionTestUseCase()
+		require.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+		assert.NoError(t, uc.CheckAllowed(cpf, "10.0.0.1"))
+
+		require.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+		var blocked *entities.LoginBlockedError
+		require.ErrorAs(t, uc.CheckAllowed(cpf, "10.0.0.1"), &blocked)
+		assert.False(t, blocked.Locked)
+
+		attemptRepo.age(2 * time.Second)
+		assert.NoError(t, uc.CheckAllowed(cpf, "10.0.0.1"))
+	})
+
+	t.Run("Limite de falhas bloqueia o CPF, mesmo não cadastrado", func(t *testing.T) {
+		uc, attemptRepo := newLoginProtectionTestUseCase()
+		require.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+		require.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+
+		var blocked *entities.LoginBlockedError
+		require.ErrorAs(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""), &blocked)
+		assert.True(t, blocked.Locked)
+		require.Len(t, attemptRepo.lockouts, 1)
+		assert.Equal(t, 3, attemptRepo.lockouts[0].Failures)
+
+		attemptRepo.age(time.Hour)
+		require.ErrorAs(t, uc.CheckAllowed(cpf, "10.0.0.2"), &blocked)
+		assert.True(t, blocked.Locked)
+	})
+
+	t.Run("Senha certa zera as falhas do CPF", func(t *testing.T) {
+		uc, _ := newLoginProtectionTestUseCase()
+		require.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+		require.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+		require.NoError(t, uc.RecordSuccess(cpf))
+
+		assert.NoError(t, uc.CheckAllowed(cpf, "10.0.0.1"))
+		assert.NoError(t, uc.RecordFailure(cpf, nil, "10.0.0.1", ""))
+	})
+
+	t.Run("Falhas de um IP em vários CPFs barram o IP", func(t *testing.T) {
+		uc, attemptRepo := newLoginProtectionTestUseCase()
+		for _, other := range []string{"1", "2", "3", "4", "5"} {
+			require.NoError(t, uc.RecordFailure(other, nil, "10.0.0.1", ""))
+		}
+		attemptRepo.age(time.Minute)
+
+		var blocked *entities.LoginBlockedError
+		require.ErrorAs(t, uc.CheckAllowed(cpf, "10.0.0.1"), &blocked)
+		assert.False(t, blocked.Locked)
+		assert.NoError(t, uc.CheckAllowed(cpf, "10.0.0.2"))
+	})
+
+	t.Run("Desbloqueio libera o login e zera as falhas", func(t *testing.T) {
+		uc, attemptRepo := newLoginProtectionTestUseCase()
+		user := &entities.User{ID: 1, CPF: cpf}
+		for i := 0; i < 3; i++ {
+			uc.RecordFailure(cpf, nil, "10.0.0.1", "")
+		}
+		require.Len(t, attemptRepo.lockouts, 1)
+
+		adminID := uint(9)
+		unlocked, err := uc.Unlock(user, &adminID, entities.LoginUnlockByAdmin)
+		require.NoError(t, err)
+		assert.True(t, unlocked)
+		assert.Equal(t, entities.LoginUnlockByAdmin, attemptRepo.lockouts[0].UnlockReason)
+		assert.NoError(t, uc.CheckAllowed(cpf, "10.0.0.2"))
+
+		unlocked, err = uc.Unlock(user, &adminID, entities.LoginUnlockByAdmin)
+		require.NoError(t, err)
+		assert.False(t, unlocked)
+	})
+}
@@ -13,6 +13,7 @@ type NotificationUseCase struct {
 	bookingRepo repositories.BookingRepository
 	userRepo    repositories.UserRepository
 	chairRepo   repositories.ChairRepository
+	presenceUC  *PresenceConfirmationUseCase
 }
 
 // NewNotificationUseCase cria uma nova instância do caso de uso de notificações
@@ -21,12 +22,14 @@ func NewNotificationUseCase(
 	bookingRepo repositories.BookingRepository,
 	userRepo repositories.UserRepository,
 	chairRepo repositories.ChairRepository,
+	presenceUC *PresenceConfirmationUseCase,
 ) *NotificationUseCase {
 	return &NotificationUseCase{
 		emailRepo:   emailRepo,
 		bookingRepo: bookingRepo,
 		userRepo:    userRepo,
 		chairRepo:   chairRepo,
+		presenceUC:  presenceUC,
 	}
 }
 
@@ -128,8 +131,15 @@ func (uc *NotificationUseCase) SendBookingReminder(bookingID uint) error {
 	booking.User = *user
 	booking.Chair = *chair
 
+	// Link assinado para o usuário confirmar presença ou cancelar pelo email
+	confirmationToken, err := uc.presenceUC.IssueToken(booking)
+	if err != nil {
+		log.Printf("Lembrete do agendamento %d sem link de confirmação: %v", bookingID, err)
+		confirmationToken = ""
+	}
+
 	// Enviar email
-	if err := uc.emailRepo.SendBookingReminder(user, booking); err != nil {
+	if err := uc.emailRepo.SendBookingReminder(user, booking, confirmationToken); err != nil {
 		log.Printf("Erro ao enviar lembrete para %s: %v", user.Email, err)
 		return fmt.Errorf("erro ao enviar lembrete: %v", err)
 	}
@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type PasswordResetUseCase struct {
+	resetRepo       repositories.PasswordResetRepository
+	userRepo        repositories.UserRepository
+	auditRepo       repositories.AuditLogRepository
+	emailRepo       repositories.EmailRepository
+	sessionUseCase  *RefreshTokenUseCase
+	loginProtection *LoginProtectionUseCase
+	passwordHasher  ports.PasswordHasher
+	ttl             time.Duration // validade do link enviado por email
+}
+
+func NewPasswordResetUseCase(
+	resetRepo repositories.PasswordResetRepository,
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditLogRepository,
+	emailRepo repositories.EmailRepository,
+	sessionUseCase *RefreshTokenUseCase,
+	loginProtection *LoginProtectionUseCase,
+	passwordHasher ports.PasswordHasher,
+	ttl time.Duration,
+) *PasswordResetUseCase {
+	if ttl <= 0 {
+		ttl = entities.DefaultPasswordResetTTL
+	}
+	return &PasswordResetUseCase{
+		resetRepo:       resetRepo,
+		userRepo:        userRepo,
+		auditRepo:       auditRepo,
+		emailRepo:       emailRepo,
+		sessionUseCase:  sessionUseCase,
+		loginProtection: loginProtection,
+		passwordHasher:  passwordHasher,
+		ttl:             ttl,
+	}
+}
+
+// RequestReset inicia a redefinição de senha da conta com o CPF ou email informado.
+// A busca e o envio acontecem em segundo plano e nada é retornado, para que nem a
+// resposta nem o tempo dela revelem se a conta existe
+func (uc *PasswordResetUseCase) RequestReset(identifier, ipAddress, userAgent string) {
+	go uc.sendResetLink(identifier, ipAddress, userAgent)
+}
+
+// sendResetLink envia o link de redefinição, se a conta existir e não tiver
+// atingido o limite de pedidos da última hora. O link novo invalida os anteriores
+func (uc *PasswordResetUseCase) sendResetLink(identifier, ipAddress, userAgent string) {
+	user, err := findUserByIdentifier(uc.userRepo, identifier)
+	if err != nil || user.Email == "" {
+		return
+	}
+
+	now := time.Now()
+	recent, err := uc.resetRepo.CountRequestedSince(user.ID, now.Add(-time.Hour))
+	if err != nil {
+		log.Printf("Erro ao verificar pedidos de redefinição de senha do usuário %d: %v", user.ID, err)
+		return
+	}
+	if recent >= entities.PasswordResetMaxPerHour {
+		uc.audit(user.ID, "Pedido de redefinição de senha ignorado: limite por hora atingido", ipAddress, userAgent)
+		return
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		log.Printf("Erro ao gerar token de redefinição de senha: %v", err)
+		return
+	}
+
+	if err := uc.resetRepo.InvalidateByUser(user.ID, now); err != nil {
+		log.Printf("Erro ao invalidar links de redefinição de senha do usuário %d: %v", user.ID, err)
+		return
+	}
+
+	reset := entities.NewPasswordResetToken(user.ID, token, ipAddress, now.Add(uc.ttl))
+	if err := uc.resetRepo.Create(reset); err != nil {
+		log.Printf("Erro ao registrar redefinição de senha do usuário %d: %v", user.ID, err)
+		return
+	}
+
+	if err := uc.emailRepo.SendPasswordReset(user, token, reset.ExpiresAt); err != nil {
+		log.Printf("Erro ao enviar link de redefinição de senha ao usuário %d: %v", user.ID, err)
+		return
+	}
+
+	uc.audit(user.ID, "Link de redefinição de senha enviado por email", ipAddress, userAgent)
+}
+
+// CheckToken confere se o link de redefinição ainda pode ser usado, sem gastá-lo.
+// A página do link usa a consulta para não pedir a nova senha de um link vencido
+func (uc *PasswordResetUseCase) CheckToken(token string) error {
+	reset, err := uc.resetRepo.GetByHash(entities.HashToken(token))
+	if err != nil || !reset.IsUsableAt(time.Now()) {
+		return entities.ErrPasswordResetInvalid
+	}
+	return nil
+}
+
+// ResetPassword troca a senha pelo token do link. O token vale uma única vez; ao
+// redefinir, os demais links pendentes são descartados, todas as sessões do
+// usuário são encerradas e o login, se bloqueado por senhas erradas, é liberado
+func (uc *PasswordResetUseCase) ResetPassword(token, newPassword, ipAddress, userAgent string) error {
+	if len(newPassword) < entities.MinPasswordLength {
+		return entities.ErrPasswordTooShort
+	}
+
+	now := time.Now()
+	reset, err := uc.resetRepo.GetByHash(entities.HashToken(token))
+	if err != nil || !reset.IsUsableAt(now) {
+		return entities.ErrPasswordResetInvalid
+	}
+
+	// Dois envios do mesmo link: só o primeiro consegue marcá-lo
+	claimed, err := uc.resetRepo.MarkUsed(reset.ID, now)
+	if err != nil {
+		return fmt.Errorf("erro ao redefinir senha: %w", err)
+	}
+	if !claimed {
+		return entities.ErrPasswordResetInvalid
+	}
+
+	user, err := uc.userRepo.GetByID(reset.UserID)
+	if err != nil {
+		return entities.ErrPasswordResetInvalid
+	}
+
+	hashedPassword, err := uc.passwordHasher.Hash(newPassword)
+	if err != nil {
+		return fmt.Errorf("erro ao processar senha: %w", err)
+	}
+	user.Password = hashedPassword
+	if err := uc.userRepo.Update(user); err != nil {
+		return fmt.Errorf("erro ao redefinir senha: %w", err)
+	}
+
+	if err := uc.resetRepo.InvalidateByUser(user.ID, now); err != nil {
+		log.Printf("Erro ao invalidar links de redefinição de senha do usuário %d: %v", user.ID, err)
+	}
+	if _, err := uc.sessionUseCase.RevokeAllSessions(user.ID, 0, entities.SessionRevokedReset); err != nil {
+		log.Printf("Erro ao encerrar sessões do usuário %d após redefinir a senha: %v", user.ID, err)
+	}
+	if _, err := uc.loginProtection.Unlock(user, nil, entities.LoginUnlockByPasswordReset); err != nil {
+		log.Printf("Erro ao desbloquear o login do usuário %d após redefinir a senha: %v", user.ID, err)
+	}
+
+	uc.audit(user.ID, "Senha redefinida pelo link enviado por email; sessões encerradas", ipAddress, userAgent)
+	return nil
+}
+
+// findUserByIdentifier busca a conta pelo email ou, sem @, pelo CPF com ou sem
+// pontuação
+func findUserByIdentifier(userRepo repositories.UserRepository, identifier string) (*entities.User, error) {
+	identifier = strings.TrimSpace(identifier)
+	if strings.Contains(identifier, "@") {
+		return userRepo.GetByEmail(identifier)
+	}
+
+	cpf := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, identifier)
+	if cpf == "" {
+		return nil, fmt.Errorf("CPF ou email inválido")
+	}
+	return userRepo.GetByCPF(cpf)
+}
+
+// audit registra a ação na conta do próprio usuário
+func (uc *PasswordResetUseCase) audit(userID uint, description, ipAddress, userAgent string) {
+	auditLog := entities.NewAuditLog(&userID, entities.ActionUpdate, entities.ResourceAuth, &userID)
+	auditLog.SetDescription(description)
+	auditLog.SetRequestInfo(ipAddress, userAgent)
+	uc.auditRepo.Create(auditLog)
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetUseCase_ResetPassword(t *testing.T) {
+	setup := func() (*PasswordResetUseCase, *fakes.PasswordResetRepository, *RefreshTokenUseCase, *MockUserRepository) {
+		resetRepo := &fakes.PasswordResetRepository{}
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(&entities.User{ID: 1, CPF: "12345678909", Status: "aprovado", Password: "hash-antigo"}, nil)
+		userRepo.On("Update", mock.Anything).Return(nil)
+		auditRepo := new(MockAuditLogRepository)
+		auditRepo.On("Create", mock.Anything).Return(nil)
+		hasher := new(MockPasswordHasher)
+		hasher.On("Hash", "nova-senha").Return("hash-novo", nil)
+
+		sessions := NewRefreshTokenUseCase(&fakeRefreshTokenRepository{}, &fakeUserSessionRepository{}, userRepo, time.Hour)
+		loginProtection, _ := newLoginProtectionTestUseCase()
+		uc := NewPasswordResetUseCase(resetRepo, userRepo, auditRepo, nil, sessions, loginProtection, hasher, time.Hour)
+		return uc, resetRepo, sessions, userRepo
+	}
+
+	t.Run("Link válido troca a senha e encerra as sessões", func(t *testing.T) {
+		uc, resetRepo, sessions, userRepo := setup()
+		issued, err := sessions.StartSession(1, entities.DeviceInfo{})
+		require.NoError(t, err)
+		require.NoError(t, resetRepo.Create(entities.NewPasswordResetToken(1, "token", "", time.Now().Add(time.Hour))))
+
+		require.NoError(t, uc.ResetPassword("token", "nova-senha", "10.0.0.1", "Mozilla/5.0"))
+
+		userRepo.AssertCalled(t, "Update", mock.MatchedBy(func(user *entities.User) bool {
+			return user.Password == "hash-novo"
+		}))
+		_, err = sessions.ValidateSession(issued.Session.ID, 1, entities.DeviceInfo{})
+		assert.ErrorIs(t, err, entities.ErrSessionRevoked)
+	})
+
+	t.Run("Redefinir a senha desbloqueia o login", func(t *testing.T) {
+		uc, resetRepo, _, _ := setup()
+		for i := 0; i < 3; i++ {
+			uc.loginProtection.RecordFailure("12345678909", nil, "10.0.0.1", "")
+		}
+		require.Error(t, uc.loginProtection.CheckAllowed("12345678909", ""))
+		require.NoError(t, resetRepo.Create(entities.NewPasswordResetToken(1, "token", "", time.Now().Add(time.Hour))))
+
+		require.NoError(t, uc.ResetPassword("token", "nova-senha", "", ""))
+		assert.NoError(t, uc.loginProtection.CheckAllowed("12345678909", ""))
+	})
+
+	t.Run("Link vale uma única vez", func(t *testing.T) {
+		uc, resetRepo, _, _ := setup()
+		require.NoError(t, resetRepo.Create(entities.NewPasswordResetToken(1, "token", "", time.Now().Add(time.Hour))))
+
+		require.NoError(t, uc.ResetPassword("token", "nova-senha", "", ""))
+		assert.ErrorIs(t, uc.ResetPassword("token", "nova-senha", "", ""), entities.ErrPasswordResetInvalid)
+	})
+
+	t.Run("Link vencido é recusado", func(t *testing.T) {
+		uc, resetRepo, _, userRepo := setup()
+		require.NoError(t, resetRepo.Create(entities.NewPasswordResetToken(1, "token", "", time.Now().Add(-time.Minute))))
+
+		assert.ErrorIs(t, uc.ResetPassword("token", "nova-senha", "", ""), entities.ErrPasswordResetInvalid)
+		userRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("Token desconhecido é recusado", func(t *testing.T) {
+		uc, _, _, _ := setup()
+		assert.ErrorIs(t, uc.ResetPassword("outro", "nova-senha", "", ""), entities.ErrPasswordResetInvalid)
+	})
+
+	t.Run("Senha curta é recusada sem gastar o link", func(t *testing.T) {
+		uc, resetRepo, _, _ := setup()
+		require.NoError(t, resetRepo.Create(entities.NewPasswordResetToken(1, "token", "", time.Now().Add(time.Hour))))
+
+		assert.ErrorIs(t, uc.ResetPassword("token", "123", "", ""), entities.ErrPasswordTooShort)
+		assert.Nil(t, resetRepo.Tokens[0].UsedAt)
+	})
+}
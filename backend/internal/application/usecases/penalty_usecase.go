@@ -0,0 +1,253 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type PenaltyUseCase struct {
+	penaltyRepo repositories.PenaltyRepository
+	userRepo    repositories.UserRepository
+	auditRepo   repositories.AuditLogRepository
+	emailRepo   repositories.EmailRepository
+	settings    entities.PenaltySettings
+}
+
+func NewPenaltyUseCase(
+	penaltyRepo repositories.PenaltyRepository,
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditLogRepository,
+	emailRepo repositories.EmailRepository,
+	settings entities.PenaltySettings,
+) *PenaltyUseCase {
+	return &PenaltyUseCase{
+		penaltyRepo: penaltyRepo,
+		userRepo:    userRepo,
+		auditRepo:   auditRepo,
+		emailRepo:   emailRepo,
+		settings:    settings,
+	}
+}
+
+// CheckBookingAllowed verifica se o usuário pode fazer novos agendamentos.
+// Uma suspensão em vigor retorna *entities.PolicyViolation
+func (uc *PenaltyUseCase) CheckBookingAllowed(userID uint) error {
+	suspension, err := uc.penaltyRepo.GetActiveSuspension(userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("erro ao verificar suspensão: %w", err)
+	}
+
+	if suspension != nil {
+		return entities.NewPolicyViolation(entities.PolicyViolationSuspended,
+			fmt.Sprintf("agendamentos suspensos até %s: %s", suspension.EndsAt.Format("02/01/2006 15:04"), suspension.Reason))
+	}
+
+	return nil
+}
+
+// RecordNoShow registra a falta do agendamento e reavalia a suspensão do usuário
+func (uc *PenaltyUseCase) RecordNoShow(booking *entities.Booking) error {
+	return uc.recordPenalty(booking, entities.PenaltyTypeNoShow, booking.StartTime)
+}
+
+// RecordCancellation registra o cancelamento como tardio quando ocorre dentro da
+// janela configurada. Cancelamentos com antecedência não geram penalidade
+func (uc *PenaltyUseCase) RecordCancellation(booking *entities.Booking, cancelledAt time.Time) error {
+	if !uc.settings.IsLateCancellation(booking.StartTime, cancelledAt) {
+		return nil
+	}
+	return uc.recordPenalty(booking, entities.PenaltyTypeLateCancellation, cancelledAt)
+}
+
+// recordPenalty grava a penalidade e suspende o usuário se algum limite for atingido
+func (uc *PenaltyUseCase) recordPenalty(booking *entities.Booking, penaltyType string, occurredAt time.Time) error {
+	penalty := &entities.BookingPenalty{
+		UserID:     booking.UserID,
+		BookingID:  booking.ID,
+		Type:       penaltyType,
+		OccurredAt: occurredAt,
+	}
+
+	if err := uc.penaltyRepo.CreatePenalty(penalty); err != nil {
+		return fmt.Errorf("erro ao registrar penalidade: %w", err)
+	}
+
+	// Log de auditoria (ação do sistema)
+	auditLog := entities.NewAuditLog(nil, entities.ActionCreate, entities.ResourcePenalty, &penalty.ID)
+	auditLog.SetDescription(fmt.Sprintf("Penalidade '%s' registrada para o usuário %d (agendamento %d)", penaltyType, booking.UserID, booking.ID))
+	uc.auditRepo.Create(auditLog)
+
+	return uc.evaluateSuspension(booking.UserID)
+}
+
+// evaluateSuspension conta as penalidades na janela e cria a suspensão quando necessário
+func (uc *PenaltyUseCase) evaluateSuspension(userID uint) error {
+	now := time.Now()
+
+	active, err := uc.penaltyRepo.GetActiveSuspension(userID, now)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar suspensão: %w", err)
+	}
+	if active != nil {
+		return nil
+	}
+
+	noShows, lateCancellations, err := uc.countInWindow(userID, now)
+	if err != nil {
+		return err
+	}
+
+	if !uc.settings.LimitReached(noShows, lateCancellations) || uc.settings.SuspensionDuration <= 0 {
+		return nil
+	}
+
+	suspension := &entities.BookingSuspension{
+		UserID: userID,
+		Reason: fmt.Sprintf("%d falta(s) e %d cancelamento(s) tardio(s) nos últimos %s",
+			noShows, lateCancellations, formatPolicyDuration(uc.settings.Window)),
+		NoShowCount:           int(noShows),
+		LateCancellationCount: int(lateCancellations),
+		StartsAt:              now,
+		EndsAt:                now.Add(uc.settings.SuspensionDuration),
+	}
+
+	if err := uc.penaltyRepo.CreateSuspension(suspension); err != nil {
+		return fmt.Errorf("erro ao registrar suspensão: %w", err)
+	}
+
+	// Log de auditoria (ação do sistema)
+	auditLog := entities.NewAuditLog(nil, entities.ActionCreate, entities.ResourcePenalty, &suspension.ID)
+	auditLog.SetDescription(fmt.Sprintf("Usuário %d suspenso até %s: %s", userID, suspension.EndsAt.Format("02/01/2006 15:04"), suspension.Reason))
+	uc.auditRepo.Create(auditLog)
+
+	// Enviar email de suspensão (não bloquear se falhar)
+	go func() {
+		user, err := uc.userRepo.GetByID(userID)
+		if err != nil {
+			return
+		}
+
+		if err := uc.emailRepo.SendBookingSuspension(user, suspension); err != nil {
+			fmt.Printf("Erro ao enviar email de suspensão: %v\n", err)
+		}
+	}()
+
+	return nil
+}
+
+// countInWindow conta faltas e cancelamentos tardios não perdoados na janela
+func (uc *PenaltyUseCase) countInWindow(userID uint, now time.Time) (int64, int64, error) {
+	since := now.Add(-uc.settings.Window)
+
+	noShows, err := uc.penaltyRepo.CountPenaltiesSince(userID, entities.PenaltyTypeNoShow, since)
+	if err != nil {
+		return 0, 0, fmt.Errorf("erro ao contar faltas: %w", err)
+	}
+
+	lateCancellations, err := uc.penaltyRepo.CountPenaltiesSince(userID, entities.PenaltyTypeLateCancellation, since)
+	if err != nil {
+		return 0, 0, fmt.Errorf("erro ao contar cancelamentos tardios: %w", err)
+	}
+
+	return noShows, lateCancellations, nil
+}
+
+// GetUserStatus retorna as penalidades da janela atual e a suspensão em vigor do usuário
+func (uc *PenaltyUseCase) GetUserStatus(userID uint) (*entities.PenaltyStatus, error) {
+	if _, err := uc.userRepo.GetByID(userID); err != nil {
+		return nil, fmt.Errorf("usuário não encontrado: %w", err)
+	}
+
+	now := time.Now()
+
+	noShows, lateCancellations, err := uc.countInWindow(userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	penalties, err := uc.penaltyRepo.GetPenaltiesByUserSince(userID, now.Add(-uc.settings.Window))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar penalidades: %w", err)
+	}
+
+	suspension, err := uc.penaltyRepo.GetActiveSuspension(userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar suspensão: %w", err)
+	}
+
+	return &entities.PenaltyStatus{
+		UserID:                userID,
+		Settings:              uc.settings,
+		NoShowCount:           noShows,
+		LateCancellationCount: lateCancellations,
+		Penalties:             penalties,
+		ActiveSuspension:      suspension,
+	}, nil
+}
+
+// GetUserSuspensions retorna o histórico de suspensões do usuário
+func (uc *PenaltyUseCase) GetUserSuspensions(userID uint) ([]*entities.BookingSuspension, error) {
+	return uc.penaltyRepo.GetSuspensionsByUser(userID)
+}
+
+// ListActiveSuspensions lista as suspensões em vigor
+func (uc *PenaltyUseCase) ListActiveSuspensions(limit, offset int) ([]*entities.BookingSuspension, int64, error) {
+	return uc.penaltyRepo.ListActiveSuspensions(time.Now(), limit, offset)
+}
+
+// LiftSuspension encerra a suspensão em vigor do usuário. Com clearPenalties, as
+// penalidades da janela são perdoadas para que a próxima ocorrência não suspenda de novo
+func (uc *PenaltyUseCase) LiftSuspension(userID, liftedBy uint, reason string, clearPenalties bool) (*entities.BookingSuspension, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, errors.New("motivo é obrigatório para encerrar a suspensão")
+	}
+
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("usuário não encontrado: %w", err)
+	}
+
+	now := time.Now()
+
+	suspension, err := uc.penaltyRepo.GetActiveSuspension(userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar suspensão: %w", err)
+	}
+	if suspension == nil {
+		return nil, fmt.Errorf("usuário não possui suspensão ativa")
+	}
+
+	suspension.Lift(liftedBy, reason, now)
+	if err := uc.penaltyRepo.UpdateSuspension(suspension); err != nil {
+		return nil, fmt.Errorf("erro ao encerrar suspensão: %w", err)
+	}
+
+	if clearPenalties {
+		if err := uc.penaltyRepo.WaivePenaltiesSince(userID, now.Add(-uc.settings.Window), liftedBy); err != nil {
+			return nil, fmt.Errorf("erro ao perdoar penalidades: %w", err)
+		}
+	}
+
+	// Log de auditoria
+	description := fmt.Sprintf("Suspensão do usuário %s encerrada: %s", user.Name, reason)
+	if clearPenalties {
+		description += " (penalidades perdoadas)"
+	}
+	auditLog := entities.NewAuditLog(&liftedBy, entities.ActionUpdate, entities.ResourcePenalty, &suspension.ID)
+	auditLog.SetDescription(description)
+	uc.auditRepo.Create(auditLog)
+
+	// Enviar email de liberação (não bloquear se falhar)
+	go func() {
+		if err := uc.emailRepo.SendSuspensionLifted(user, suspension); err != nil {
+			fmt.Printf("Erro ao enviar email de liberação de suspensão: %v\n", err)
+		}
+	}()
+
+	return suspension, nil
+}
@@ -0,0 +1,190 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type PresenceConfirmationUseCase struct {
+	confirmationRepo repositories.PresenceConfirmationRepository
+	bookingRepo      repositories.BookingRepository
+	bookingUseCase   *BookingUseCase
+	signer           ports.TokenSigner
+	cutoff           time.Duration // antecedência mínima da confirmação em relação ao início da sessão
+}
+
+func NewPresenceConfirmationUseCase(
+	confirmationRepo repositories.PresenceConfirmationRepository,
+	bookingRepo repositories.BookingRepository,
+	bookingUseCase *BookingUseCase,
+	signer ports.TokenSigner,
+	cutoff time.Duration,
+) *PresenceConfirmationUseCase {
+	return &PresenceConfirmationUseCase{
+		confirmationRepo: confirmationRepo,
+		bookingRepo:      bookingRepo,
+		bookingUseCase:   bookingUseCase,
+		signer:           signer,
+		cutoff:           cutoff,
+	}
+}
+
+// IssueToken gera o token assinado do link de confirmação enviado no lembrete.
+// O link vale até o prazo de confirmação (início da sessão menos a antecedência)
+func (uc *PresenceConfirmationUseCase) IssueToken(booking *entities.Booking) (string, error) {
+	expiresAt := booking.StartTime.Add(-uc.cutoff)
+	if !time.Now().Before(expiresAt) {
+		return "", errors.New("prazo de confirmação de presença já encerrado")
+	}
+
+	nonce, err := generateSecureToken()
+	if err != nil {
+		return "", err
+	}
+
+	confirmation := &entities.PresenceConfirmation{
+		BookingID: booking.ID,
+		Nonce:     nonce,
+		ExpiresAt: expiresAt,
+	}
+	if err := uc.confirmationRepo.Create(confirmation); err != nil {
+		return "", fmt.Errorf("erro ao registrar link de confirmação: %w", err)
+	}
+
+	return uc.signer.Sign(confirmation.TokenPayload()), nil
+}
+
+// ConfirmPresence confirma a presença pelo link do email, sem login
+func (uc *PresenceConfirmationUseCase) ConfirmPresence(token string) (*entities.Booking, error) {
+	confirmation, booking, err := uc.claim(token, entities.PresenceResultConfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.bookingUseCase.ConfirmPresence(booking.ID, booking.UserID); err != nil {
+		uc.confirmationRepo.ClearUsed(confirmation.ID)
+		return nil, err
+	}
+
+	booking.Status = entities.BookingStatusPresenceConfirmed
+	return booking, nil
+}
+
+// CancelBooking cancela o agendamento pelo link do email. Vale o mesmo prazo de
+// cancelamento e as mesmas penalidades de um cancelamento feito pelo usuário no sistema
+func (uc *PresenceConfirmationUseCase) CancelBooking(token string) (*entities.Booking, error) {
+	confirmation, booking, err := uc.claim(token, entities.PresenceResultCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.bookingUseCase.CancelBooking(booking.ID, booking.UserID, "Cancelado pelo link do lembrete"); err != nil {
+		uc.confirmationRepo.ClearUsed(confirmation.ID)
+		return nil, err
+	}
+
+	booking.Status = entities.BookingStatusCancelled
+	return booking, nil
+}
+
+// GetBooking busca o agendamento do link do lembrete, sem confirmar nem cancelar.
+// O link do email abre esta consulta; as ações só acontecem por POST, para que
+// leitores de link e pré-carregamentos do cliente de email não respondam pelo usuário
+func (uc *PresenceConfirmationUseCase) GetBooking(token string) (*entities.Booking, error) {
+	confirmation, booking, err := uc.resolve(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if confirmation.IsUsed() {
+		return nil, errors.New("link de confirmação já utilizado")
+	}
+	return booking, nil
+}
+
+// claim verifica o token e marca o link como usado antes de executar a ação
+func (uc *PresenceConfirmationUseCase) claim(token, result string) (*entities.PresenceConfirmation, *entities.Booking, error) {
+	now := time.Now()
+	confirmation, booking, err := uc.resolve(token, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claimed, err := uc.confirmationRepo.MarkUsed(confirmation.ID, result, now)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao registrar uso do link: %w", err)
+	}
+	if !claimed {
+		return nil, nil, errors.New("link de confirmação já utilizado")
+	}
+
+	return confirmation, booking, nil
+}
+
+// resolve verifica o token e busca o link e o agendamento, que precisa ainda
+// aguardar a confirmação de presença
+func (uc *PresenceConfirmationUseCase) resolve(token string, now time.Time) (*entities.PresenceConfirmation, *entities.Booking, error) {
+	payload, err := uc.signer.Verify(token)
+	if err != nil {
+		return nil, nil, errors.New("link de confirmação inválido")
+	}
+
+	claims, err := entities.ParsePresenceTokenPayload(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !now.Before(claims.ExpiresAt) {
+		return nil, nil, errors.New("prazo para responder pelo link encerrado")
+	}
+
+	confirmation, err := uc.confirmationRepo.GetByNonce(claims.Nonce)
+	if err != nil || confirmation.BookingID != claims.BookingID {
+		return nil, nil, errors.New("link de confirmação inválido")
+	}
+
+	booking, err := uc.bookingRepo.GetByID(confirmation.BookingID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("agendamento não encontrado: %w", err)
+	}
+	if booking.Status != entities.BookingStatusScheduled {
+		return nil, nil, errors.New("agendamento não aguarda mais confirmação de presença")
+	}
+
+	return confirmation, booking, nil
+}
+
+// ReleaseUnconfirmed libera os horários cujo link de confirmação não foi respondido até o prazo
+func (uc *PresenceConfirmationUseCase) ReleaseUnconfirmed() error {
+	now := time.Now()
+
+	confirmations, err := uc.confirmationRepo.GetPendingExpired(now)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar confirmações pendentes: %w", err)
+	}
+
+	releasedCount := 0
+	for _, confirmation := range confirmations {
+		claimed, err := uc.confirmationRepo.MarkUsed(confirmation.ID, entities.PresenceResultReleased, now)
+		if err != nil || !claimed {
+			continue
+		}
+
+		if err := uc.bookingUseCase.ReleaseUnconfirmedBooking(confirmation.BookingID, "Presença não confirmada até o prazo"); err != nil {
+			log.Printf("Erro ao liberar agendamento %d sem confirmação: %v", confirmation.BookingID, err)
+			continue
+		}
+		releasedCount++
+	}
+
+	if releasedCount > 0 {
+		log.Printf("Processadas %d confirmações de presença sem resposta", releasedCount)
+	}
+
+	return nil
+}
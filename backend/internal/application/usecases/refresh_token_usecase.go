@@ -0,0 +1,248 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// ErrSessionUserNotApproved indica que a sessão pertence a um usuário que deixou de
+// estar aprovado depois do login
+var ErrSessionUserNotApproved = errors.New("usuário não está aprovado")
+
+// RefreshTokenUseCase cuida das sessões de login e dos refresh tokens de cada uma
+type RefreshTokenUseCase struct {
+	refreshTokenRepo repositories.RefreshTokenRepository
+	sessionRepo      repositories.UserSessionRepository
+	userRepo         repositories.UserRepository
+	ttl              time.Duration // validade de cada refresh token emitido
+}
+
+func NewRefreshTokenUseCase(
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	sessionRepo repositories.UserSessionRepository,
+	userRepo repositories.UserRepository,
+	ttl time.Duration,
+) *RefreshTokenUseCase {
+	if ttl <= 0 {
+		ttl = entities.DefaultRefreshTokenTTL
+	}
+	return &RefreshTokenUseCase{
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+		userRepo:         userRepo,
+		ttl:              ttl,
+	}
+}
+
+// IssuedRefreshToken é um refresh token recém-emitido. TokenID é o identificador
+// secreto que vai no JWT; do banco só se recupera o hash dele
+type IssuedRefreshToken struct {
+	Session *entities.UserSession
+	Token   *entities.RefreshToken
+	TokenID string
+}
+
+// StartSession abre uma sessão no login e emite o primeiro refresh token dela.
+// Aproveita para apagar as sessões e os tokens já vencidos do usuário
+func (uc *RefreshTokenUseCase) StartSession(userID uint, device entities.DeviceInfo) (*IssuedRefreshToken, error) {
+	now := time.Now()
+	if err := uc.sessionRepo.DeleteExpiredByUser(userID, now); err != nil {
+		return nil, fmt.Errorf("erro ao limpar sessões vencidas: %w", err)
+	}
+	if err := uc.refreshTokenRepo.DeleteExpiredByUser(userID, now); err != nil {
+		return nil, fmt.Errorf("erro ao limpar sessões vencidas: %w", err)
+	}
+
+	familyID, err := generateSecureToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session := entities.NewUserSession(userID, familyID, device, now, now.Add(uc.ttl))
+	if err := uc.sessionRepo.Create(session); err != nil {
+		return nil, fmt.Errorf("erro ao registrar sessão: %w", err)
+	}
+	return uc.issue(session, device, now)
+}
+
+// Rotate troca um refresh token válido por um novo da mesma família. Um token que já
+// foi trocado e aparece de novo indica que foi copiado: a sessão inteira é encerrada
+// e ErrRefreshTokenReused é retornado
+func (uc *RefreshTokenUseCase) Rotate(userID uint, tokenID, familyID string, device entities.DeviceInfo) (*entities.User, *IssuedRefreshToken, error) {
+	now := time.Now()
+
+	stored, err := uc.find(userID, tokenID, familyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	session, err := uc.sessionRepo.GetByFamily(stored.FamilyID)
+	if err != nil || !session.IsActiveAt(now) || stored.IsRevoked() || stored.IsExpiredAt(now) {
+		return nil, nil, entities.ErrRefreshTokenInvalid
+	}
+	if stored.IsRotated() {
+		return nil, nil, uc.revokeReused(session, now)
+	}
+
+	user, err := uc.userRepo.GetByID(stored.UserID)
+	if err != nil {
+		return nil, nil, entities.ErrRefreshTokenInvalid
+	}
+	if user.Status != "aprovado" {
+		return nil, nil, ErrSessionUserNotApproved
+	}
+
+	// Dois usos simultâneos do mesmo token: só o primeiro consegue marcá-lo
+	claimed, err := uc.refreshTokenRepo.MarkRotated(stored.ID, now)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao renovar sessão: %w", err)
+	}
+	if !claimed {
+		return nil, nil, uc.revokeReused(session, now)
+	}
+
+	// A renovação estende a sessão e registra de onde veio o último acesso
+	session.SetDevice(device)
+	session.LastSeenAt = now
+	session.ExpiresAt = now.Add(uc.ttl)
+	if err := uc.sessionRepo.UpdateActivity(session); err != nil {
+		return nil, nil, fmt.Errorf("erro ao renovar sessão: %w", err)
+	}
+
+	// O nome do dispositivo vem do login; IP e navegador, da renovação atual
+	device.Name = session.DeviceName
+	issued, err := uc.issue(session, device, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, issued, nil
+}
+
+// Revoke encerra a sessão do refresh token, no logout. Repetir o logout de uma
+// sessão já encerrada não é erro
+func (uc *RefreshTokenUseCase) Revoke(userID uint, tokenID, familyID string) error {
+	stored, err := uc.find(userID, tokenID, familyID)
+	if err != nil {
+		return err
+	}
+
+	session, err := uc.sessionRepo.GetByFamily(stored.FamilyID)
+	if err != nil {
+		return entities.ErrRefreshTokenInvalid
+	}
+	if session.RevokedAt != nil {
+		return nil
+	}
+	return uc.revokeSession(session, entities.SessionRevokedLogout, time.Now())
+}
+
+// ValidateSession verifica, a cada requisição autenticada, se a sessão do token de
+// acesso continua aberta. O último acesso é atualizado no máximo a cada
+// entities.SessionTouchInterval
+func (uc *RefreshTokenUseCase) ValidateSession(sessionID, userID uint, device entities.DeviceInfo) (*entities.UserSession, error) {
+	now := time.Now()
+
+	session, err := uc.sessionRepo.GetByID(sessionID)
+	if err != nil || session.UserID != userID || !session.IsActiveAt(now) {
+		return nil, entities.ErrSessionRevoked
+	}
+
+	if session.NeedsTouch(now) {
+		session.SetDevice(device)
+		session.LastSeenAt = now
+		if err := uc.sessionRepo.UpdateActivity(session); err != nil {
+			return nil, fmt.Errorf("erro ao registrar acesso: %w", err)
+		}
+	}
+	return session, nil
+}
+
+// ListSessions lista as sessões abertas do usuário
+func (uc *RefreshTokenUseCase) ListSessions(userID uint) ([]*entities.UserSession, error) {
+	return uc.sessionRepo.ListActiveByUser(userID, time.Now())
+}
+
+// RevokeSession encerra uma sessão do próprio usuário
+func (uc *RefreshTokenUseCase) RevokeSession(userID, sessionID uint) error {
+	session, err := uc.sessionRepo.GetByID(sessionID)
+	if err != nil || session.UserID != userID {
+		return errors.New("sessão não encontrada")
+	}
+	if !session.IsActiveAt(time.Now()) {
+		return errors.New("sessão já encerrada")
+	}
+	return uc.revokeSession(session, entities.SessionRevokedByUser, time.Now())
+}
+
+// RevokeAllSessions encerra as sessões do usuário em todos os dispositivos, menos
+// exceptSessionID (0 = nenhuma). reason distingue o pedido do usuário do logout
+// forçado por um admin. Retorna quantas sessões foram encerradas
+func (uc *RefreshTokenUseCase) RevokeAllSessions(userID, exceptSessionID uint, reason string) (int64, error) {
+	now := time.Now()
+
+	exceptFamilyID := ""
+	if exceptSessionID != 0 {
+		session, err := uc.sessionRepo.GetByID(exceptSessionID)
+		if err != nil || session.UserID != userID {
+			return 0, errors.New("sessão não encontrada")
+		}
+		exceptFamilyID = session.FamilyID
+	}
+
+	count, err := uc.sessionRepo.RevokeByUser(userID, exceptSessionID, reason, now)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao encerrar sessões: %w", err)
+	}
+	if err := uc.refreshTokenRepo.RevokeByUser(userID, exceptFamilyID, reason, now); err != nil {
+		return 0, fmt.Errorf("erro ao encerrar sessões: %w", err)
+	}
+	return count, nil
+}
+
+// find busca o token e confere se ele pertence ao usuário e à família do JWT
+func (uc *RefreshTokenUseCase) find(userID uint, tokenID, familyID string) (*entities.RefreshToken, error) {
+	stored, err := uc.refreshTokenRepo.GetByHash(entities.HashToken(tokenID))
+	if err != nil {
+		return nil, entities.ErrRefreshTokenInvalid
+	}
+	if stored.UserID != userID || stored.FamilyID != familyID {
+		return nil, entities.ErrRefreshTokenInvalid
+	}
+	return stored, nil
+}
+
+// revokeReused encerra a sessão de um refresh token reutilizado
+func (uc *RefreshTokenUseCase) revokeReused(session *entities.UserSession, now time.Time) error {
+	if err := uc.revokeSession(session, entities.SessionRevokedReuse, now); err != nil {
+		return err
+	}
+	return entities.ErrRefreshTokenReused
+}
+
+// revokeSession encerra a sessão e revoga os refresh tokens dela
+func (uc *RefreshTokenUseCase) revokeSession(session *entities.UserSession, reason string, now time.Time) error {
+	if _, err := uc.sessionRepo.Revoke(session.ID, reason, now); err != nil {
+		return fmt.Errorf("erro ao encerrar sessão: %w", err)
+	}
+	if err := uc.refreshTokenRepo.RevokeFamily(session.FamilyID, reason, now); err != nil {
+		return fmt.Errorf("erro ao encerrar sessão: %w", err)
+	}
+	return nil
+}
+
+// issue grava um novo refresh token da sessão
+func (uc *RefreshTokenUseCase) issue(session *entities.UserSession, device entities.DeviceInfo, now time.Time) (*IssuedRefreshToken, error) {
+	tokenID, err := generateSecureToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token := entities.NewRefreshToken(session.UserID, session.FamilyID, tokenID, device, now.Add(uc.ttl))
+	if err := uc.refreshTokenRepo.Create(token); err != nil {
+		return nil, fmt.Errorf("erro ao registrar sessão: %w", err)
+	}
+	return &IssuedRefreshToken{Session: session, Token: token, TokenID: tokenID}, nil
+}
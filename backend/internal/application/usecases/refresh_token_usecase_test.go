@@ -0,0 +1,291 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRefreshTokenRepository guarda os tokens em memória, com as mesmas condições
+// de rotação e revogação do repositório real
+type fakeRefreshTokenRepository struct {
+	tokens []*entities.RefreshToken
+}
+
+func (r *fakeRefreshTokenRepository) Create(token *entities.RefreshToken) error {
+	token.ID = uint(len(r.tokens) + 1)
+	r.tokens = append(r.tokens, token)
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) GetByHash(tokenHash string) (*entities.RefreshToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			copied := *token
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (r *fakeRefreshTokenRepository) MarkRotated(id uint, rotatedAt time.Time) (bool, error) {
+	for _, token := range r.tokens {
+		if token.ID == id && token.RotatedAt == nil && token.RevokedAt == nil {
+			token.RotatedAt = &rotatedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeFamily(familyID, reason string, revokedAt time.Time) error {
+	for _, token := range r.tokens {
+		if token.FamilyID == familyID && token.RevokedAt == nil {
+			token.RevokedAt = &revokedAt
+			token.RevokedReason = reason
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) DeleteExpiredByUser(userID uint, before time.Time) error {
+	kept := r.tokens[:0]
+	for _, token := range r.tokens {
+		if token.UserID != userID || !token.ExpiresAt.Before(before) {
+			kept = append(kept, token)
+		}
+	}
+	r.tokens = kept
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeByUser(userID uint, exceptFamilyID, reason string, revokedAt time.Time) error {
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.FamilyID != exceptFamilyID && token.RevokedAt == nil {
+			token.RevokedAt = &revokedAt
+			token.RevokedReason = reason
+		}
+	}
+	return nil
+}
+
+// fakeUserSessionRepository guarda as sessões em memória
+type fakeUserSessionRepository struct {
+	sessions []*entities.UserSession
+}
+
+func (r *fakeUserSessionRepository) Create(session *entities.UserSession) error {
+	session.ID = uint(len(r.sessions) + 1)
+	r.sessions = append(r.sessions, session)
+	return nil
+}
+
+func (r *fakeUserSessionRepository) GetByID(id uint) (*entities.UserSession, error) {
+	for _, session := range r.sessions {
+		if session.ID == id {
+			copied := *session
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (r *fakeUserSessionRepository) GetByFamily(familyID string) (*entities.UserSession, error) {
+	for _, session := range r.sessions {
+		if session.FamilyID == familyID {
+			copied := *session
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (r *fakeUserSessionRepository) ListActiveByUser(userID uint, now time.Time) ([]*entities.UserSession, error) {
+	var sessions []*entities.UserSession
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.IsActiveAt(now) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (r *fakeUserSessionRepository) UpdateActivity(session *entities.UserSession) error {
+	for _, stored := range r.sessions {
+		if stored.ID == session.ID {
+			stored.UserAgent = session.UserAgent
+			stored.IPAddress = session.IPAddress
+			stored.LastSeenAt = session.LastSeenAt
+			stored.ExpiresAt = session.ExpiresAt
+		}
+	}
+	return nil
+}
+
+func (r *fakeUserSessionRepository) Revoke(id uint, reason string, revokedAt time.Time) (bool, error) {
+	for _, session := range r.sessions {
+		if session.ID == id && session.RevokedAt == nil {
+			session.RevokedAt = &revokedAt
+			session.RevokedReason = reason
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeUserSessionRepository) RevokeByUser(userID, exceptID uint, reason string, revokedAt time.Time) (int64, error) {
+	var count int64
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.ID != exceptID && session.RevokedAt == nil {
+			session.RevokedAt = &revokedAt
+			session.RevokedReason = reason
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeUserSessionRepository) DeleteExpiredByUser(userID uint, before time.Time) error {
+	return nil
+}
+
+func newRefreshTokenTestUseCase(status string) (*RefreshTokenUseCase, *fakeRefreshTokenRepository, *fakeUserSessionRepository) {
+	repo := &fakeRefreshTokenRepository{}
+	sessionRepo := &fakeUserSessionRepository{}
+	userRepo := new(MockUserRepository)
+	userRepo.On("GetByID", uint(1)).Return(&entities.User{ID: 1, Status: status}, nil)
+	return NewRefreshTokenUseCase(repo, sessionRepo, userRepo, time.Hour), repo, sessionRepo
+}
+
+func TestRefreshTokenUseCase_Rotate(t *testing.T) {
+	device := entities.DeviceInfo{Name: "Notebook", UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1"}
+
+	t.Run("Renovação emite novo token da mesma família", func(t *testing.T) {
+		uc, repo, _ := newRefreshTokenTestUseCase("aprovado")
+		session, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		user, rotated, err := uc.Rotate(1, session.TokenID, session.Token.FamilyID, entities.DeviceInfo{IPAddress: "10.0.0.2"})
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), user.ID)
+		assert.Equal(t, session.Token.FamilyID, rotated.Token.FamilyID)
+		assert.NotEqual(t, session.TokenID, rotated.TokenID)
+		assert.Equal(t, "Notebook", rotated.Token.DeviceName)
+		assert.Equal(t, "10.0.0.2", rotated.Token.IPAddress)
+		assert.NotNil(t, repo.tokens[0].RotatedAt)
+	})
+
+	t.Run("Reuso de token trocado revoga a família", func(t *testing.T) {
+		uc, repo, _ := newRefreshTokenTestUseCase("aprovado")
+		session, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+		_, rotated, err := uc.Rotate(1, session.TokenID, session.Token.FamilyID, device)
+		require.NoError(t, err)
+
+		_, _, err = uc.Rotate(1, session.TokenID, session.Token.FamilyID, device)
+		assert.ErrorIs(t, err, entities.ErrRefreshTokenReused)
+		for _, token := range repo.tokens {
+			assert.NotNil(t, token.RevokedAt)
+			assert.Equal(t, entities.SessionRevokedReuse, token.RevokedReason)
+		}
+
+		// O token legítimo mais recente também deixa de valer
+		_, _, err = uc.Rotate(1, rotated.TokenID, rotated.Token.FamilyID, device)
+		assert.ErrorIs(t, err, entities.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("Token de outra família é recusado", func(t *testing.T) {
+		uc, _, _ := newRefreshTokenTestUseCase("aprovado")
+		session, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		_, _, err = uc.Rotate(1, session.TokenID, "outra-familia", device)
+		assert.ErrorIs(t, err, entities.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("Usuário não aprovado não renova", func(t *testing.T) {
+		uc, _, _ := newRefreshTokenTestUseCase("suspenso")
+		session, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		_, _, err = uc.Rotate(1, session.TokenID, session.Token.FamilyID, device)
+		assert.ErrorIs(t, err, ErrSessionUserNotApproved)
+	})
+
+	t.Run("Logout encerra a sessão", func(t *testing.T) {
+		uc, _, _ := newRefreshTokenTestUseCase("aprovado")
+		session, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		require.NoError(t, uc.Revoke(1, session.TokenID, session.Token.FamilyID))
+		require.NoError(t, uc.Revoke(1, session.TokenID, session.Token.FamilyID))
+
+		_, _, err = uc.Rotate(1, session.TokenID, session.Token.FamilyID, device)
+		assert.ErrorIs(t, err, entities.ErrRefreshTokenInvalid)
+	})
+}
+
+func TestRefreshTokenUseCase_Sessions(t *testing.T) {
+	device := entities.DeviceInfo{Name: "Celular", UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1"}
+
+	t.Run("Sessão aberta vale para o token de acesso", func(t *testing.T) {
+		uc, _, _ := newRefreshTokenTestUseCase("aprovado")
+		issued, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		session, err := uc.ValidateSession(issued.Session.ID, 1, device)
+		require.NoError(t, err)
+		assert.Equal(t, "Celular", session.DeviceName)
+	})
+
+	t.Run("Sessão de outro usuário é recusada", func(t *testing.T) {
+		uc, _, _ := newRefreshTokenTestUseCase("aprovado")
+		issued, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		_, err = uc.ValidateSession(issued.Session.ID, 2, device)
+		assert.ErrorIs(t, err, entities.ErrSessionRevoked)
+		assert.Error(t, uc.RevokeSession(2, issued.Session.ID))
+	})
+
+	t.Run("Sessão encerrada derruba token de acesso e refresh token", func(t *testing.T) {
+		uc, _, _ := newRefreshTokenTestUseCase("aprovado")
+		issued, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		require.NoError(t, uc.RevokeSession(1, issued.Session.ID))
+
+		_, err = uc.ValidateSession(issued.Session.ID, 1, device)
+		assert.ErrorIs(t, err, entities.ErrSessionRevoked)
+		_, _, err = uc.Rotate(1, issued.TokenID, issued.Token.FamilyID, device)
+		assert.ErrorIs(t, err, entities.ErrRefreshTokenInvalid)
+		assert.Error(t, uc.RevokeSession(1, issued.Session.ID))
+	})
+
+	t.Run("Logout geral mantém apenas a sessão atual", func(t *testing.T) {
+		uc, _, sessionRepo := newRefreshTokenTestUseCase("aprovado")
+		current, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+		other, err := uc.StartSession(1, device)
+		require.NoError(t, err)
+
+		count, err := uc.RevokeAllSessions(1, current.Session.ID, entities.SessionRevokedAll)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		assert.Equal(t, entities.SessionRevokedAll, sessionRepo.sessions[1].RevokedReason)
+
+		_, err = uc.ValidateSession(current.Session.ID, 1, device)
+		assert.NoError(t, err)
+		_, _, err = uc.Rotate(1, other.TokenID, other.Token.FamilyID, device)
+		assert.ErrorIs(t, err, entities.ErrRefreshTokenInvalid)
+
+		sessions, err := uc.ListSessions(1)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+}
@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+type ServiceUseCase struct {
+	serviceRepo repositories.ServiceRepository
+	chairRepo   repositories.ChairRepository
+	auditRepo   repositories.AuditLogRepository
+	validator   ports.Validator
+}
+
+func NewServiceUseCase(
+	serviceRepo repositories.ServiceRepository,
+	chairRepo repositories.ChairRepository,
+	auditRepo repositories.AuditLogRepository,
+	validator ports.Validator,
+) *ServiceUseCase {
+	return &ServiceUseCase{
+		serviceRepo: serviceRepo,
+		chairRepo:   chairRepo,
+		auditRepo:   auditRepo,
+		validator:   validator,
+	}
+}
+
+// CreateService cria um novo serviço
+func (uc *ServiceUseCase) CreateService(service *entities.Service, createdBy uint) error {
+	// Validar dados
+	if err := uc.validator.ValidateStruct(service); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+
+	// Verificar se nome já existe
+	if exists, err := uc.serviceRepo.ExistsByName(service.Name); err != nil {
+		return fmt.Errorf("erro ao verificar nome: %w", err)
+	} else if exists {
+		return errors.New("já existe um serviço com este nome")
+	}
+
+	// Criar serviço
+	if err := uc.serviceRepo.Create(service); err != nil {
+		return fmt.Errorf("erro ao criar serviço: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceService, &service.ID)
+	auditLog.SetDescription(fmt.Sprintf("Serviço %s criado (%d minutos)", service.Name, service.DurationMinutes))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetServiceByID busca serviço por ID
+func (uc *ServiceUseCase) GetServiceByID(id uint) (*entities.Service, error) {
+	return uc.serviceRepo.GetByID(id)
+}
+
+// UpdateService atualiza um serviço
+func (uc *ServiceUseCase) UpdateService(service *entities.Service, updatedBy uint) error {
+	// Validar dados
+	if err := uc.validator.ValidateStruct(service); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+
+	// Buscar serviço atual para comparação
+	currentService, err := uc.serviceRepo.GetByID(service.ID)
+	if err != nil {
+		return fmt.Errorf("serviço não encontrado: %w", err)
+	}
+
+	// Verificar se nome mudou e se já existe
+	if service.Name != currentService.Name {
+		if exists, err := uc.serviceRepo.ExistsByName(service.Name); err != nil {
+			return fmt.Errorf("erro ao verificar nome: %w", err)
+		} else if exists {
+			return errors.New("já existe um serviço com este nome")
+		}
+	}
+
+	service.CreatedAt = currentService.CreatedAt
+
+	// Atualizar serviço
+	if err := uc.serviceRepo.Update(service); err != nil {
+		return fmt.Errorf("erro ao atualizar serviço: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceService, &service.ID)
+	auditLog.SetDescription(fmt.Sprintf("Serviço %s atualizado", service.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeleteService exclui um serviço
+func (uc *ServiceUseCase) DeleteService(serviceID, deletedBy uint) error {
+	service, err := uc.serviceRepo.GetByID(serviceID)
+	if err != nil {
+		return fmt.Errorf("serviço não encontrado: %w", err)
+	}
+
+	if err := uc.serviceRepo.Delete(serviceID); err != nil {
+		return fmt.Errorf("erro ao excluir serviço: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceService, &serviceID)
+	auditLog.SetDescription(fmt.Sprintf("Serviço %s excluído", service.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// ListServices lista serviços com paginação
+func (uc *ServiceUseCase) ListServices(limit, offset int, filters map[string]interface{}) ([]*entities.Service, int64, error) {
+	return uc.serviceRepo.List(limit, offset, filters)
+}
+
+// GetActiveServices busca serviços ativos
+func (uc *ServiceUseCase) GetActiveServices() ([]*entities.Service, error) {
+	return uc.serviceRepo.GetActive()
+}
+
+// GetChairServices busca os serviços oferecidos por uma cadeira
+func (uc *ServiceUseCase) GetChairServices(chairID uint) ([]*entities.Service, error) {
+	if _, err := uc.chairRepo.GetByID(chairID); err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	return uc.serviceRepo.GetByChair(chairID)
+}
+
+// SetChairServices define quais serviços uma cadeira oferece
+func (uc *ServiceUseCase) SetChairServices(chairID uint, serviceIDs []uint, setBy uint) error {
+	chair, err := uc.chairRepo.GetByID(chairID)
+	if err != nil {
+		return fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+
+	// Todos os serviços informados precisam existir
+	for _, serviceID := range serviceIDs {
+		if _, err := uc.serviceRepo.GetByID(serviceID); err != nil {
+			return fmt.Errorf("serviço %d não encontrado: %w", serviceID, err)
+		}
+	}
+
+	if err := uc.serviceRepo.SetChairServices(chairID, serviceIDs); err != nil {
+		return fmt.Errorf("erro ao definir serviços da cadeira: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&setBy, entities.ActionUpdate, entities.ResourceChair, &chairID)
+	auditLog.SetDescription(fmt.Sprintf("Serviços da cadeira %s atualizados (%d serviços)", chair.Name, len(serviceIDs)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// sessionDuration retorna a duração da sessão para o serviço na cadeira, validando
+// se ela o oferece. Sem serviço (nulo ou zero) vale a duração padrão. Disponibilidade
+// e agendamento usam a mesma regra para não divergirem sobre o tamanho da sessão
+func sessionDuration(serviceRepo repositories.ServiceRepository, chairID uint, serviceID *uint) (time.Duration, error) {
+	if serviceID == nil || *serviceID == 0 {
+		return entities.DefaultSessionDuration, nil
+	}
+
+	service, err := serviceRepo.GetByID(*serviceID)
+	if err != nil {
+		return 0, fmt.Errorf("serviço não encontrado: %w", err)
+	}
+	if !service.IsActive {
+		return 0, errors.New("serviço não está ativo")
+	}
+
+	supported, err := serviceRepo.ChairSupportsService(chairID, *serviceID)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao verificar serviços da cadeira: %w", err)
+	}
+	if !supported {
+		return 0, errors.New("cadeira não oferece o serviço selecionado")
+	}
+
+	return service.GetDuration(), nil
+}
@@ -0,0 +1,342 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// SlotEngine calcula os horários livres de várias cadeiras em vários dias. Os dados
+// do período (janelas, exceções, fechamentos, pausas, localidades, turnos e ausências
+// dos massoterapeutas, agendamentos e reservas) são
+// carregados em uma consulta por tipo, independente da quantidade de cadeiras e dias,
+// e os horários são montados em memória
+type SlotEngine struct {
+	availabilityRepo repositories.AvailabilityRepository
+	bookingRepo      repositories.BookingRepository
+	holdRepo         repositories.SlotHoldRepository
+	chairRepo        repositories.ChairRepository
+	closureRepo      repositories.ClosureRepository
+	locationRepo     repositories.LocationRepository
+	therapistRepo    repositories.TherapistRepository
+}
+
+func NewSlotEngine(
+	availabilityRepo repositories.AvailabilityRepository,
+	bookingRepo repositories.BookingRepository,
+	holdRepo repositories.SlotHoldRepository,
+	chairRepo repositories.ChairRepository,
+	closureRepo repositories.ClosureRepository,
+	locationRepo repositories.LocationRepository,
+	therapistRepo repositories.TherapistRepository,
+) *SlotEngine {
+	return &SlotEngine{
+		availabilityRepo: availabilityRepo,
+		bookingRepo:      bookingRepo,
+		holdRepo:         holdRepo,
+		chairRepo:        chairRepo,
+		closureRepo:      closureRepo,
+		locationRepo:     locationRepo,
+		therapistRepo:    therapistRepo,
+	}
+}
+
+// SlotRange descreve um cálculo: as cadeiras, o primeiro dia, a quantidade de dias
+// e a duração da sessão. Com IgnoreBookings o resultado é a capacidade bruta das
+// cadeiras, sem descontar agendamentos nem reservas
+type SlotRange struct {
+	Chairs         []*entities.Chair
+	From           time.Time
+	Days           int
+	Duration       time.Duration
+	IgnoreBookings bool
+}
+
+// ChairSlots guarda os horários livres de cada cadeira por data ("2006-01-02")
+type ChairSlots map[uint]map[string][]string
+
+// Compute calcula os horários livres do período para as cadeiras informadas
+func (e *SlotEngine) Compute(r SlotRange) (ChairSlots, error) {
+	result := make(ChairSlots, len(r.Chairs))
+	if len(r.Chairs) == 0 || r.Days <= 0 {
+		return result, nil
+	}
+
+	from := dayStart(r.From)
+	to := from.AddDate(0, 0, r.Days)
+
+	schedule, err := e.load(r.Chairs, from, to, !r.IgnoreBookings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, chair := range r.Chairs {
+		days := make(map[string][]string, r.Days)
+		for i := 0; i < r.Days; i++ {
+			date := from.AddDate(0, 0, i)
+			days[date.Format("2006-01-02")] = schedule.slotsOn(chair, date, r.Duration)
+		}
+		result[chair.ID] = days
+	}
+
+	return result, nil
+}
+
+// load busca os dados do período para as cadeiras
+func (e *SlotEngine) load(chairs []*entities.Chair, from, to time.Time, withBookings bool) (*slotSchedule, error) {
+	chairIDs := make([]uint, len(chairs))
+	var locationIDs []uint
+	for i, chair := range chairs {
+		chairIDs[i] = chair.ID
+		if chair.LocationID != nil {
+			locationIDs = append(locationIDs, *chair.LocationID)
+		}
+	}
+	lastDay := to.AddDate(0, 0, -1)
+
+	// Os dias de cada cadeira começam na meia-noite do fuso da localidade dela: a
+	// busca por instantes sobra um dia de cada lado para cobrir qualquer fuso
+	rangeStart, rangeEnd := from.AddDate(0, 0, -1), to.AddDate(0, 0, 1)
+
+	weekly, err := e.availabilityRepo.GetActiveByChairs(chairIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar disponibilidades: %w", err)
+	}
+	exceptions, err := e.availabilityRepo.GetExceptionsByChairs(chairIDs, from, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar exceções de disponibilidade: %w", err)
+	}
+	closures, err := e.closureRepo.List(&from, &lastDay, nil)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar fechamentos: %w", err)
+	}
+	breaks, err := e.chairRepo.GetBreaksByChairs(chairIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar pausas das cadeiras: %w", err)
+	}
+	maintenances, err := e.chairRepo.GetMaintenancesByChairsInRange(chairIDs, rangeStart, rangeEnd)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar manutenções das cadeiras: %w", err)
+	}
+	locations, err := e.locationRepo.GetByIDs(locationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar localidades: %w", err)
+	}
+	shifts, err := e.therapistRepo.GetShiftsByChairs(chairIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar turnos dos massoterapeutas: %w", err)
+	}
+	absences, err := e.therapistRepo.GetAbsencesInRange(shiftTherapistIDs(shifts), from, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar ausências dos massoterapeutas: %w", err)
+	}
+
+	var bookings []*entities.Booking
+	var holds []*entities.SlotHold
+	if withBookings {
+		bookings, err = e.bookingRepo.GetOccupyingByChairsInRange(chairIDs, rangeStart, rangeEnd)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar agendamentos: %w", err)
+		}
+		holds, err = e.holdRepo.GetActiveByChairsInRange(chairIDs, rangeStart, rangeEnd, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar reservas de horário: %w", err)
+		}
+	}
+
+	schedule := newSlotSchedule(weekly, exceptions, closures, breaks, bookings, holds)
+	schedule.setLocations(locations)
+	schedule.setTherapists(shifts, absences)
+	schedule.setMaintenances(maintenances)
+	return schedule, nil
+}
+
+// slotSchedule guarda os dados do período agrupados por cadeira
+type slotSchedule struct {
+	weekly       map[uint][]*entities.Availability
+	exceptions   map[uint][]*entities.AvailabilityException
+	breaks       map[uint][]*entities.ChairBreak
+	maintenances map[uint][]*entities.ChairMaintenance
+	bookings     map[uint][]*entities.Booking
+	holds        map[uint][]*entities.SlotHold
+	closures     []*entities.Closure
+	locations    map[uint]*entities.Location
+	shifts       map[uint][]*entities.TherapistShift
+	absences     []*entities.TherapistAbsence
+}
+
+func newSlotSchedule(
+	weekly []*entities.Availability,
+	exceptions []*entities.AvailabilityException,
+	closures []*entities.Closure,
+	breaks []*entities.ChairBreak,
+	bookings []*entities.Booking,
+	holds []*entities.SlotHold,
+) *slotSchedule {
+	schedule := &slotSchedule{
+		weekly:     make(map[uint][]*entities.Availability),
+		exceptions: make(map[uint][]*entities.AvailabilityException),
+		breaks:     make(map[uint][]*entities.ChairBreak),
+		bookings:   make(map[uint][]*entities.Booking),
+		holds:      make(map[uint][]*entities.SlotHold),
+		closures:   closures,
+		locations:  make(map[uint]*entities.Location),
+		shifts:     make(map[uint][]*entities.TherapistShift),
+	}
+	for _, availability := range weekly {
+		schedule.weekly[availability.ChairID] = append(schedule.weekly[availability.ChairID], availability)
+	}
+	for _, exception := range exceptions {
+		schedule.exceptions[exception.ChairID] = append(schedule.exceptions[exception.ChairID], exception)
+	}
+	for _, chairBreak := range breaks {
+		schedule.breaks[chairBreak.ChairID] = append(schedule.breaks[chairBreak.ChairID], chairBreak)
+	}
+	for _, booking := range bookings {
+		schedule.bookings[booking.ChairID] = append(schedule.bookings[booking.ChairID], booking)
+	}
+	for _, hold := range holds {
+		schedule.holds[hold.ChairID] = append(schedule.holds[hold.ChairID], hold)
+	}
+	return schedule
+}
+
+// setLocations registra as localidades das cadeiras, cujo horário de funcionamento limita os horários
+func (s *slotSchedule) setLocations(locations []*entities.Location) {
+	for _, location := range locations {
+		s.locations[location.ID] = location
+	}
+}
+
+// location retorna a localidade cadastrada da cadeira, ou nil quando não há
+func (s *slotSchedule) location(chair *entities.Chair) *entities.Location {
+	if chair.LocationID == nil {
+		return nil
+	}
+	return s.locations[*chair.LocationID]
+}
+
+// setMaintenances registra as manutenções programadas por cadeira
+func (s *slotSchedule) setMaintenances(maintenances []*entities.ChairMaintenance) {
+	s.maintenances = make(map[uint][]*entities.ChairMaintenance)
+	for _, maintenance := range maintenances {
+		s.maintenances[maintenance.ChairID] = append(s.maintenances[maintenance.ChairID], maintenance)
+	}
+}
+
+// setTherapists registra os turnos por cadeira e as ausências dos massoterapeutas
+func (s *slotSchedule) setTherapists(shifts []*entities.TherapistShift, absences []*entities.TherapistAbsence) {
+	for _, shift := range shifts {
+		s.shifts[shift.ChairID] = append(s.shifts[shift.ChairID], shift)
+	}
+	s.absences = absences
+}
+
+// slotsOn calcula os horários livres da cadeira no dia. Fechamentos da localidade
+// zeram o dia e prevalecem sobre as exceções, como nas consultas do repositório.
+// Sessões fora do horário de funcionamento da localidade não são oferecidas, nem,
+// em cadeiras com turnos cadastrados, sessões sem massoterapeuta de plantão.
+// Horários em manutenção programada também ficam de fora
+func (s *slotSchedule) slotsOn(chair *entities.Chair, date time.Time, duration time.Duration) []string {
+	for _, closure := range s.closures {
+		if closure.AppliesToLocation(chair.LocationID) && closure.CoversDate(date) {
+			return nil
+		}
+	}
+
+	var windows []*entities.Availability
+	for _, availability := range s.weekly[chair.ID] {
+		if availability.IsValidForDate(date) {
+			windows = append(windows, availability)
+		}
+	}
+	windows = entities.ApplyAvailabilityExceptions(windows, s.exceptions[chair.ID], date)
+	if len(windows) == 0 {
+		return nil
+	}
+
+	// Agendamentos, reservas e manutenções são lidos no fuso da localidade da cadeira
+	location := s.location(chair)
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location.TimeZone())
+	end := start.AddDate(0, 0, 1)
+	var bookings []*entities.Booking
+	for _, booking := range s.bookings[chair.ID] {
+		if !booking.StartTime.Before(start) && booking.StartTime.Before(end) {
+			bookings = append(bookings, booking)
+		}
+	}
+	var holds []*entities.SlotHold
+	for _, hold := range s.holds[chair.ID] {
+		if !hold.StartTime.Before(start) && hold.StartTime.Before(end) {
+			holds = append(holds, hold)
+		}
+	}
+
+	slots := buildAvailableSlots(windows, bookings, holds, s.breaks[chair.ID], start, duration, chair.BufferDuration(), 0)
+	slots = filterMaintenances(slots, s.maintenances[chair.ID], start, duration)
+	if location != nil {
+		slots = filterSlots(slots, duration, location.IsOpenBetween)
+	}
+	return filterOnDuty(slots, s.shifts[chair.ID], s.absences, date, duration)
+}
+
+// filterOnDuty mantém os horários em que algum massoterapeuta está de plantão na
+// cadeira durante a sessão inteira. Sem turnos, a cadeira não depende de massoterapeuta
+func filterOnDuty(slots []string, shifts []*entities.TherapistShift, absences []*entities.TherapistAbsence, date time.Time, duration time.Duration) []string {
+	if len(shifts) == 0 {
+		return slots
+	}
+	return filterSlots(slots, duration, func(startMinute, endMinute int) bool {
+		_, ok := entities.OnDutyTherapist(shifts, absences, date, startMinute, endMinute)
+		return ok
+	})
+}
+
+// filterMaintenances descarta os horários cuja sessão cai em manutenção da cadeira
+func filterMaintenances(slots []string, maintenances []*entities.ChairMaintenance, date time.Time, duration time.Duration) []string {
+	if len(maintenances) == 0 {
+		return slots
+	}
+	return filterSlots(slots, duration, func(startMinute, endMinute int) bool {
+		for _, maintenance := range maintenances {
+			if maintenance.OverlapsOnDay(date, startMinute, endMinute) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// filterSlots mantém os horários cuja sessão (em minutos desde a meia-noite) é aceita por keep
+func filterSlots(slots []string, duration time.Duration, keep func(startMinute, endMinute int) bool) []string {
+	length := int(duration.Minutes())
+	kept := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		parsed, _ := time.Parse("15:04", slot)
+		start := parsed.Hour()*60 + parsed.Minute()
+		if keep(start, start+length) {
+			kept = append(kept, slot)
+		}
+	}
+	return kept
+}
+
+// shiftTherapistIDs retorna os massoterapeutas dos turnos, sem repetição
+func shiftTherapistIDs(shifts []*entities.TherapistShift) []uint {
+	seen := make(map[uint]bool)
+	var ids []uint
+	for _, shift := range shifts {
+		if !seen[shift.TherapistID] {
+			seen[shift.TherapistID] = true
+			ids = append(ids, shift.TherapistID)
+		}
+	}
+	return ids
+}
+
+// dayStart retorna a meia-noite do dia no fuso da própria data
+func dayStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
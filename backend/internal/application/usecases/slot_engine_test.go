@@ -0,0 +1,217 @@
+package usecases
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotSchedule_SlotsOn(t *testing.T) {
+	monday := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	// Sem os dados da localidade carregados, os agendamentos são lidos no fuso padrão
+	localMonday := time.Date(2025, 1, 13, 0, 0, 0, 0, (*entities.Location)(nil).TimeZone())
+	terreo, sala2 := uint(1), uint(2)
+	chair := &entities.Chair{ID: 1, Location: "Térreo", LocationID: &terreo, BufferMinutes: 10}
+	weekly := []*entities.Availability{
+		{ChairID: 1, DayOfWeek: 1, StartTime: "09:00", EndTime: "11:00", IsActive: true},
+	}
+
+	tests := []struct {
+		name       string
+		exceptions []*entities.AvailabilityException
+		closures   []*entities.Closure
+		bookings   []*entities.Booking
+		expected   []string
+	}{
+		{
+			name:     "Janela semanal sem agendamentos",
+			expected: []string{"09:00", "09:40", "10:20"},
+		},
+		{
+			name: "Agendamento bloqueia o horário e o intervalo de higienização",
+			bookings: []*entities.Booking{
+				{ID: 7, ChairID: 1, StartTime: localMonday.Add(9*time.Hour + 40*time.Minute), EndTime: localMonday.Add(10*time.Hour + 10*time.Minute), Status: "agendado"},
+			},
+			expected: []string{"09:00", "10:20"},
+		},
+		{
+			name: "Exceção substitui a janela semanal",
+			exceptions: []*entities.AvailabilityException{
+				{ChairID: 1, Date: monday, Type: entities.AvailabilityExceptionReplace, StartTime: "14:00", EndTime: "15:00"},
+			},
+			expected: []string{"14:00"},
+		},
+		{
+			name: "Fechamento da localidade prevalece sobre a exceção",
+			exceptions: []*entities.AvailabilityException{
+				{ChairID: 1, Date: monday, Type: entities.AvailabilityExceptionExtra, StartTime: "14:00", EndTime: "15:00"},
+			},
+			closures: []*entities.Closure{{LocationID: &terreo, Location: "Térreo", StartDate: monday, EndDate: monday}},
+			expected: nil,
+		},
+		{
+			name:     "Fechamento de outra localidade não afeta a cadeira",
+			closures: []*entities.Closure{{LocationID: &sala2, Location: "Sala 2", StartDate: monday, EndDate: monday}},
+			expected: []string{"09:00", "09:40", "10:20"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := newSlotSchedule(weekly, tt.exceptions, tt.closures, nil, tt.bookings, nil)
+			assert.Equal(t, tt.expected, schedule.slotsOn(chair, monday, 30*time.Minute))
+		})
+	}
+}
+
+func TestSlotSchedule_SlotsOn_WeekdayWithoutWindow(t *testing.T) {
+	tuesday := time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)
+	weekly := []*entities.Availability{
+		{ChairID: 1, DayOfWeek: 1, StartTime: "09:00", EndTime: "11:00", IsActive: true},
+	}
+
+	schedule := newSlotSchedule(weekly, nil, nil, nil, nil, nil)
+
+	assert.Empty(t, schedule.slotsOn(&entities.Chair{ID: 1}, tuesday, 30*time.Minute))
+}
+
+func TestSlotSchedule_SlotsOn_OpeningHours(t *testing.T) {
+	monday := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	locationID := uint(3)
+	weekly := []*entities.Availability{
+		{ChairID: 1, DayOfWeek: 1, StartTime: "07:00", EndTime: "09:00", IsActive: true},
+	}
+
+	schedule := newSlotSchedule(weekly, nil, nil, nil, nil, nil)
+	schedule.setLocations([]*entities.Location{{ID: locationID, OpensAt: "08:00", ClosesAt: "18:00"}})
+
+	slots := schedule.slotsOn(&entities.Chair{ID: 1, LocationID: &locationID}, monday, 30*time.Minute)
+
+	assert.Equal(t, []string{"08:00", "08:30"}, slots)
+}
+
+func TestSlotSchedule_SlotsOn_LocationTimeZone(t *testing.T) {
+	monday := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	locationID := uint(4)
+	chair := &entities.Chair{ID: 1, LocationID: &locationID}
+	weekly := []*entities.Availability{
+		{ChairID: 1, DayOfWeek: 1, StartTime: "09:00", EndTime: "11:00", IsActive: true},
+	}
+	// 13:00 UTC são 09:00 em Manaus (GMT-4) e 10:00 no fuso padrão (GMT-3)
+	bookings := []*entities.Booking{
+		{ID: 1, ChairID: 1, StartTime: monday.Add(13 * time.Hour), EndTime: monday.Add(13*time.Hour + 30*time.Minute), Status: "agendado"},
+	}
+
+	schedule := newSlotSchedule(weekly, nil, nil, nil, bookings, nil)
+	schedule.setLocations([]*entities.Location{{ID: locationID, Timezone: "America/Manaus"}})
+
+	assert.Equal(t, []string{"09:30", "10:00", "10:30"}, schedule.slotsOn(chair, monday, 30*time.Minute))
+}
+
+func TestSlotSchedule_SlotsOn_Maintenance(t *testing.T) {
+	monday := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	localMonday := time.Date(2025, 1, 13, 0, 0, 0, 0, (*entities.Location)(nil).TimeZone())
+	weekly := []*entities.Availability{
+		{ChairID: 1, DayOfWeek: 1, StartTime: "09:00", EndTime: "11:00", IsActive: true},
+	}
+
+	schedule := newSlotSchedule(weekly, nil, nil, nil, nil, nil)
+	schedule.setMaintenances([]*entities.ChairMaintenance{
+		{ChairID: 1, StartTime: localMonday.Add(9*time.Hour + 45*time.Minute), EndTime: localMonday.Add(10*time.Hour + 30*time.Minute)},
+		{ChairID: 2, StartTime: localMonday, EndTime: localMonday.Add(24 * time.Hour)},
+	})
+
+	slots := schedule.slotsOn(&entities.Chair{ID: 1}, monday, 30*time.Minute)
+
+	assert.Equal(t, []string{"09:00", "10:30"}, slots)
+}
+
+func TestSlotSchedule_SlotsOn_TherapistShifts(t *testing.T) {
+	monday := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	ana := &entities.Therapist{ID: 1, Name: "Ana", IsActive: true}
+	weekly := []*entities.Availability{
+		{ChairID: 1, DayOfWeek: 1, StartTime: "08:00", EndTime: "11:00", IsActive: true},
+	}
+	shifts := []*entities.TherapistShift{
+		{TherapistID: 1, ChairID: 1, DayOfWeek: 1, StartTime: "09:00", EndTime: "10:00", Therapist: ana},
+	}
+
+	tests := []struct {
+		name     string
+		shifts   []*entities.TherapistShift
+		absences []*entities.TherapistAbsence
+		expected []string
+	}{
+		{
+			name:     "Cadeira sem turnos não depende de massoterapeuta",
+			expected: []string{"08:00", "08:30", "09:00", "09:30", "10:00", "10:30"},
+		},
+		{
+			name:     "Só os horários cobertos pelo turno",
+			shifts:   shifts,
+			expected: []string{"09:00", "09:30"},
+		},
+		{
+			name:     "Massoterapeuta ausente no dia",
+			shifts:   shifts,
+			absences: []*entities.TherapistAbsence{{TherapistID: 1, StartDate: monday, EndDate: monday.AddDate(0, 0, 4)}},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := newSlotSchedule(weekly, nil, nil, nil, nil, nil)
+			schedule.setTherapists(tt.shifts, tt.absences)
+			assert.Equal(t, tt.expected, schedule.slotsOn(&entities.Chair{ID: 1}, monday, 30*time.Minute))
+		})
+	}
+}
+
+// BenchmarkSlotSchedule mede o cálculo em memória de 20 cadeiras em 30 dias, com
+// duas janelas por dia útil, uma pausa diária e quatro agendamentos por cadeira e dia
+func BenchmarkSlotSchedule(b *testing.B) {
+	const chairCount, days = 20, 30
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	lunch := &entities.ChairBreak{Name: "Almoço", StartTime: "12:00", EndTime: "13:00"}
+
+	var chairs []*entities.Chair
+	var weekly []*entities.Availability
+	var breaks []*entities.ChairBreak
+	var bookings []*entities.Booking
+	var bookingID uint
+	for id := uint(1); id <= chairCount; id++ {
+		chairs = append(chairs, &entities.Chair{ID: id, Location: fmt.Sprintf("Andar %d", id%3), BufferMinutes: 5})
+		for day := 1; day <= 5; day++ {
+			weekly = append(weekly,
+				&entities.Availability{ChairID: id, DayOfWeek: day, StartTime: "08:00", EndTime: "12:00", IsActive: true},
+				&entities.Availability{ChairID: id, DayOfWeek: day, StartTime: "13:00", EndTime: "18:00", IsActive: true},
+			)
+		}
+		chairBreak := *lunch
+		chairBreak.ChairID = id
+		breaks = append(breaks, &chairBreak)
+		for i := 0; i < days; i++ {
+			for _, hour := range []int{8, 10, 14, 16} {
+				start := from.AddDate(0, 0, i).Add(time.Duration(hour) * time.Hour)
+				bookingID++
+				bookings = append(bookings, &entities.Booking{ID: bookingID, ChairID: id, StartTime: start, EndTime: start.Add(30 * time.Minute), Status: "agendado"})
+			}
+		}
+	}
+	closures := []*entities.Closure{{StartDate: from.AddDate(0, 0, 10), EndDate: from.AddDate(0, 0, 10)}}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		schedule := newSlotSchedule(weekly, nil, closures, breaks, bookings, nil)
+		for _, chair := range chairs {
+			for i := 0; i < days; i++ {
+				schedule.slotsOn(chair, from.AddDate(0, 0, i), 30*time.Minute)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// SlotHoldTTL é o tempo que um horário fica reservado enquanto o usuário conclui o agendamento
+const SlotHoldTTL = 10 * time.Minute
+
+type SlotHoldUseCase struct {
+	holdRepo       repositories.SlotHoldRepository
+	userRepo       repositories.UserRepository
+	chairRepo      repositories.ChairRepository
+	bookingUseCase *BookingUseCase
+	penaltyUseCase *PenaltyUseCase
+}
+
+func NewSlotHoldUseCase(
+	holdRepo repositories.SlotHoldRepository,
+	userRepo repositories.UserRepository,
+	chairRepo repositories.ChairRepository,
+	bookingUseCase *BookingUseCase,
+	penaltyUseCase *PenaltyUseCase,
+) *SlotHoldUseCase {
+	return &SlotHoldUseCase{
+		holdRepo:       holdRepo,
+		userRepo:       userRepo,
+		chairRepo:      chairRepo,
+		bookingUseCase: bookingUseCase,
+		penaltyUseCase: penaltyUseCase,
+	}
+}
+
+// HoldSlot reserva o horário para o usuário por SlotHoldTTL. O horário passa pelas
+// mesmas verificações de um agendamento; reservas anteriores do usuário são liberadas
+func (uc *SlotHoldUseCase) HoldSlot(userID, chairID uint, serviceID *uint, startTime time.Time) (*entities.SlotHold, error) {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("usuário não encontrado: %w", err)
+	}
+	if user.Status != "aprovado" {
+		return nil, errors.New("usuário não está aprovado")
+	}
+
+	if err := uc.penaltyUseCase.CheckBookingAllowed(userID); err != nil {
+		return nil, err
+	}
+
+	chair, err := uc.chairRepo.GetByID(chairID)
+	if err != nil {
+		return nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	if chair.Status != "ativa" {
+		return nil, errors.New("cadeira não está disponível")
+	}
+
+	// Reservas vencidas ainda marcadas como ativas bloqueariam a restrição do banco
+	now := time.Now()
+	if _, err := uc.holdRepo.ExpireStale(now); err != nil {
+		return nil, fmt.Errorf("erro ao expirar reservas vencidas: %w", err)
+	}
+
+	// Cada usuário segura um horário por vez
+	if err := uc.holdRepo.ReleaseActiveByUser(userID); err != nil {
+		return nil, fmt.Errorf("erro ao liberar reservas anteriores: %w", err)
+	}
+
+	candidate := &entities.Booking{
+		UserID:    userID,
+		ChairID:   chairID,
+		ServiceID: serviceID,
+		StartTime: startTime,
+	}
+	if err := uc.bookingUseCase.checkAvailability(candidate, ""); err != nil {
+		return nil, err
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		return nil, err
+	}
+
+	hold := &entities.SlotHold{
+		Token:     token,
+		UserID:    userID,
+		ChairID:   chairID,
+		ServiceID: serviceID,
+		StartTime: candidate.StartTime,
+		EndTime:   candidate.EndTime,
+		ExpiresAt: now.Add(SlotHoldTTL),
+		Status:    entities.SlotHoldStatusActive,
+		Chair:     chair,
+	}
+
+	if err := uc.holdRepo.Create(hold); err != nil {
+		if errors.Is(err, entities.ErrSlotHeld) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao reservar horário: %w", err)
+	}
+
+	return hold, nil
+}
+
+// GetHold retorna a reserva do usuário pelo token
+func (uc *SlotHoldUseCase) GetHold(token string, userID uint) (*entities.SlotHold, error) {
+	hold, err := uc.holdRepo.GetByToken(token)
+	if err != nil || hold.UserID != userID {
+		return nil, errors.New("reserva de horário não encontrada")
+	}
+	return hold, nil
+}
+
+// ReleaseHold devolve o horário reservado antes do prazo
+func (uc *SlotHoldUseCase) ReleaseHold(token string, userID uint) error {
+	hold, err := uc.GetHold(token, userID)
+	if err != nil {
+		return err
+	}
+
+	if !hold.IsActiveAt(time.Now()) {
+		return errors.New("reserva de horário não está mais ativa")
+	}
+
+	hold.Release()
+	if err := uc.holdRepo.Update(hold); err != nil {
+		return fmt.Errorf("erro ao liberar reserva: %w", err)
+	}
+
+	return nil
+}
+
+// ExpireHolds marca como expiradas as reservas cujo prazo passou. As consultas já
+// ignoram reservas vencidas; a rotina mantém o status coerente para relatórios
+func (uc *SlotHoldUseCase) ExpireHolds() error {
+	expired, err := uc.holdRepo.ExpireStale(time.Now())
+	if err != nil {
+		return fmt.Errorf("erro ao expirar reservas: %w", err)
+	}
+
+	if expired > 0 {
+		log.Printf("Expiradas %d reservas de horário", expired)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// TenantUseCase gerencia o cadastro de clientes da plataforma (apenas super admins).
+// O repositório de auditoria deve ser o do escopo da plataforma
+type TenantUseCase struct {
+	tenantRepo  repositories.TenantRepository
+	auditRepo   repositories.AuditLogRepository
+	provisioner ports.TenantProvisioner
+	validator   ports.Validator
+}
+
+func NewTenantUseCase(
+	tenantRepo repositories.TenantRepository,
+	auditRepo repositories.AuditLogRepository,
+	provisioner ports.TenantProvisioner,
+	validator ports.Validator,
+) *TenantUseCase {
+	return &TenantUseCase{
+		tenantRepo:  tenantRepo,
+		auditRepo:   auditRepo,
+		provisioner: provisioner,
+		validator:   validator,
+	}
+}
+
+// CreateTenant cadastra um tenant e o primeiro admin dele. Os dados do admin são
+// validados antes, para não deixar um tenant sem ninguém que o administre
+func (uc *TenantUseCase) CreateTenant(tenant *entities.Tenant, admin *entities.User, createdBy uint) error {
+	if err := uc.validateTenant(tenant); err != nil {
+		return err
+	}
+	if err := uc.validator.ValidateStruct(admin); err != nil {
+		return fmt.Errorf("dados do admin inválidos: %w", err)
+	}
+
+	if exists, err := uc.tenantRepo.ExistsBySlug(tenant.Slug); err != nil {
+		return fmt.Errorf("erro ao verificar slug: %w", err)
+	} else if exists {
+		return errors.New("já existe um tenant com este slug")
+	}
+
+	tenant.IsActive = true
+	if err := uc.tenantRepo.Create(tenant); err != nil {
+		return fmt.Errorf("erro ao criar tenant: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceTenant, &tenant.ID)
+	auditLog.SetDescription(fmt.Sprintf("Tenant %s (%s) criado", tenant.Name, tenant.Slug))
+	uc.auditRepo.Create(auditLog)
+
+	if err := uc.provisioner.CreateUser(tenant.ID, admin, &createdBy); err != nil {
+		return fmt.Errorf("tenant criado, mas houve erro ao criar o admin (cadastre-o novamente): %w", err)
+	}
+	return nil
+}
+
+// CreateTenantAdmin cadastra mais um admin em um tenant ativo
+func (uc *TenantUseCase) CreateTenantAdmin(tenantID uint, admin *entities.User, createdBy uint) error {
+	tenant, err := uc.GetTenant(tenantID)
+	if err != nil {
+		return err
+	}
+	if !tenant.IsActive {
+		return errors.New("tenant está desativado")
+	}
+
+	if err := uc.provisioner.CreateUser(tenant.ID, admin, &createdBy); err != nil {
+		return err
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceTenant, &tenant.ID)
+	auditLog.SetDescription(fmt.Sprintf("Admin %s cadastrado no tenant %s", admin.Email, tenant.Slug))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetTenant busca tenant por ID
+func (uc *TenantUseCase) GetTenant(id uint) (*entities.Tenant, error) {
+	tenant, err := uc.tenantRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("tenant não encontrado: %w", err)
+	}
+	return tenant, nil
+}
+
+// GetTenantBySlug busca tenant pelo subdomínio
+func (uc *TenantUseCase) GetTenantBySlug(slug string) (*entities.Tenant, error) {
+	tenant, err := uc.tenantRepo.GetBySlug(slug)
+	if err != nil {
+		return nil, fmt.Errorf("tenant não encontrado: %w", err)
+	}
+	return tenant, nil
+}
+
+// ListTenants lista os tenants, opcionalmente incluindo os desativados
+func (uc *TenantUseCase) ListTenants(includeInactive bool) ([]*entities.Tenant, error) {
+	return uc.tenantRepo.List(includeInactive)
+}
+
+// UpdateTenant atualiza nome e situação do tenant. O slug não muda: ele está nos
+// links já enviados por email e nos tokens emitidos
+func (uc *TenantUseCase) UpdateTenant(tenant *entities.Tenant, updatedBy uint) error {
+	current, err := uc.GetTenant(tenant.ID)
+	if err != nil {
+		return err
+	}
+
+	tenant.Slug = current.Slug
+	tenant.CreatedAt = current.CreatedAt
+	if err := uc.validateTenant(tenant); err != nil {
+		return err
+	}
+
+	if err := uc.tenantRepo.Update(tenant); err != nil {
+		return fmt.Errorf("erro ao atualizar tenant: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceTenant, &tenant.ID)
+	description := fmt.Sprintf("Tenant %s atualizado", tenant.Slug)
+	if current.IsActive && !tenant.IsActive {
+		uc.provisioner.Release(tenant.ID)
+		description = fmt.Sprintf("Tenant %s desativado", tenant.Slug)
+	} else if !current.IsActive && tenant.IsActive {
+		description = fmt.Sprintf("Tenant %s reativado", tenant.Slug)
+	}
+	auditLog.SetDescription(description)
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// validateTenant valida os campos e o slug do tenant
+func (uc *TenantUseCase) validateTenant(tenant *entities.Tenant) error {
+	if err := uc.validator.ValidateStruct(tenant); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+	return tenant.Validate()
+}
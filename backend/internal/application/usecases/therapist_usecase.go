@@ -0,0 +1,393 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// MaxTherapistHoursDays limita o período do relatório de horas de um massoterapeuta
+const MaxTherapistHoursDays = 92
+
+type TherapistUseCase struct {
+	therapistRepo repositories.TherapistRepository
+	chairRepo     repositories.ChairRepository
+	userRepo      repositories.UserRepository
+	bookingRepo   repositories.BookingRepository
+	auditRepo     repositories.AuditLogRepository
+	validator     ports.Validator
+}
+
+func NewTherapistUseCase(
+	therapistRepo repositories.TherapistRepository,
+	chairRepo repositories.ChairRepository,
+	userRepo repositories.UserRepository,
+	bookingRepo repositories.BookingRepository,
+	auditRepo repositories.AuditLogRepository,
+	validator ports.Validator,
+) *TherapistUseCase {
+	return &TherapistUseCase{
+		therapistRepo: therapistRepo,
+		chairRepo:     chairRepo,
+		userRepo:      userRepo,
+		bookingRepo:   bookingRepo,
+		auditRepo:     auditRepo,
+		validator:     validator,
+	}
+}
+
+// CreateTherapist cadastra um massoterapeuta
+func (uc *TherapistUseCase) CreateTherapist(therapist *entities.Therapist, createdBy uint) error {
+	if err := uc.validateTherapist(therapist); err != nil {
+		return err
+	}
+
+	therapist.IsActive = true
+	if err := uc.therapistRepo.Create(therapist); err != nil {
+		return fmt.Errorf("erro ao criar massoterapeuta: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceTherapist, &therapist.ID)
+	auditLog.SetDescription(fmt.Sprintf("Massoterapeuta %s cadastrado", therapist.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetTherapist busca massoterapeuta por ID, com os turnos
+func (uc *TherapistUseCase) GetTherapist(id uint) (*entities.Therapist, error) {
+	therapist, err := uc.therapistRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+	return therapist, nil
+}
+
+// ListTherapists lista os massoterapeutas
+func (uc *TherapistUseCase) ListTherapists(includeInactive bool) ([]*entities.Therapist, error) {
+	return uc.therapistRepo.List(includeInactive)
+}
+
+// UpdateTherapist atualiza os dados do massoterapeuta. Desativá-lo retira os turnos
+// dele da geração de horários sem apagá-los
+func (uc *TherapistUseCase) UpdateTherapist(therapist *entities.Therapist, updatedBy uint) error {
+	current, err := uc.therapistRepo.GetByID(therapist.ID)
+	if err != nil {
+		return fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+
+	if err := uc.validateTherapist(therapist); err != nil {
+		return err
+	}
+
+	therapist.CreatedAt = current.CreatedAt
+	if err := uc.therapistRepo.Update(therapist); err != nil {
+		return fmt.Errorf("erro ao atualizar massoterapeuta: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceTherapist, &therapist.ID)
+	auditLog.SetDescription(fmt.Sprintf("Massoterapeuta %s atualizado", therapist.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeleteTherapist exclui um massoterapeuta sem turnos cadastrados
+func (uc *TherapistUseCase) DeleteTherapist(id, deletedBy uint) error {
+	therapist, err := uc.therapistRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+
+	if len(therapist.Shifts) > 0 {
+		return fmt.Errorf("massoterapeuta possui %d turno(s) cadastrado(s); remova-os ou desative o massoterapeuta", len(therapist.Shifts))
+	}
+
+	if err := uc.therapistRepo.Delete(id); err != nil {
+		return fmt.Errorf("erro ao excluir massoterapeuta: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceTherapist, &id)
+	auditLog.SetDescription(fmt.Sprintf("Massoterapeuta %s excluído", therapist.Name))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetShifts lista os turnos do massoterapeuta
+func (uc *TherapistUseCase) GetShifts(therapistID uint) ([]*entities.TherapistShift, error) {
+	if _, err := uc.therapistRepo.GetByID(therapistID); err != nil {
+		return nil, fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+	return uc.therapistRepo.GetShiftsByTherapist(therapistID)
+}
+
+// CreateShift aloca o massoterapeuta em uma cadeira em um turno semanal. A partir
+// do primeiro turno da cadeira, só os horários cobertos por turnos são oferecidos
+func (uc *TherapistUseCase) CreateShift(shift *entities.TherapistShift, createdBy uint) error {
+	therapist, chair, err := uc.validateShift(shift, nil)
+	if err != nil {
+		return err
+	}
+
+	shift.CreatedBy = &createdBy
+	if err := uc.therapistRepo.CreateShift(shift); err != nil {
+		return fmt.Errorf("erro ao criar turno: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceTherapist, &therapist.ID)
+	auditLog.SetDescription(fmt.Sprintf("Turno de %s na cadeira %s: %s", therapist.Name, chair.Name, describeShift(shift)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// UpdateShift atualiza cadeira, dia, horário ou validade de um turno
+func (uc *TherapistUseCase) UpdateShift(shift *entities.TherapistShift, updatedBy uint) error {
+	current, err := uc.therapistRepo.GetShiftByID(shift.ID)
+	if err != nil || current.TherapistID != shift.TherapistID {
+		return errors.New("turno não encontrado")
+	}
+
+	therapist, chair, err := uc.validateShift(shift, &shift.ID)
+	if err != nil {
+		return err
+	}
+
+	shift.CreatedBy = current.CreatedBy
+	shift.CreatedAt = current.CreatedAt
+	if err := uc.therapistRepo.UpdateShift(shift); err != nil {
+		return fmt.Errorf("erro ao atualizar turno: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&updatedBy, entities.ActionUpdate, entities.ResourceTherapist, &therapist.ID)
+	auditLog.SetDescription(fmt.Sprintf("Turno de %s atualizado na cadeira %s: %s", therapist.Name, chair.Name, describeShift(shift)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// DeleteShift remove um turno. Agendamentos já feitos no horário são mantidos
+func (uc *TherapistUseCase) DeleteShift(therapistID, shiftID, deletedBy uint) error {
+	shift, err := uc.therapistRepo.GetShiftByID(shiftID)
+	if err != nil || shift.TherapistID != therapistID {
+		return errors.New("turno não encontrado")
+	}
+
+	if err := uc.therapistRepo.DeleteShift(shiftID); err != nil {
+		return fmt.Errorf("erro ao excluir turno: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceTherapist, &therapistID)
+	auditLog.SetDescription(fmt.Sprintf("Turno excluído na cadeira %d: %s", shift.ChairID, describeShift(shift)))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetAbsences lista as ausências do massoterapeuta
+func (uc *TherapistUseCase) GetAbsences(therapistID uint) ([]*entities.TherapistAbsence, error) {
+	if _, err := uc.therapistRepo.GetByID(therapistID); err != nil {
+		return nil, fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+	return uc.therapistRepo.GetAbsencesByTherapist(therapistID)
+}
+
+// CreateAbsence registra uma ausência e retorna os agendamentos do massoterapeuta
+// no período, que precisam ser remanejados pela equipe
+func (uc *TherapistUseCase) CreateAbsence(absence *entities.TherapistAbsence, createdBy uint) ([]*entities.Booking, error) {
+	therapist, err := uc.therapistRepo.GetByID(absence.TherapistID)
+	if err != nil {
+		return nil, fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+
+	absence.Reason = strings.TrimSpace(absence.Reason)
+	if err := uc.validator.ValidateStruct(absence); err != nil {
+		return nil, fmt.Errorf("dados inválidos: %w", err)
+	}
+	if err := absence.Validate(); err != nil {
+		return nil, err
+	}
+
+	absence.CreatedBy = &createdBy
+	if err := uc.therapistRepo.CreateAbsence(absence); err != nil {
+		return nil, fmt.Errorf("erro ao registrar ausência: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&createdBy, entities.ActionCreate, entities.ResourceTherapist, &therapist.ID)
+	auditLog.SetDescription(fmt.Sprintf("Ausência de %s de %s a %s", therapist.Name,
+		absence.StartDate.Format("02/01/2006"), absence.EndDate.Format("02/01/2006")))
+	uc.auditRepo.Create(auditLog)
+
+	affected, err := uc.bookingRepo.GetOccupyingByTherapistInRange(therapist.ID, absence.StartDate, absence.EndDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("ausência registrada, mas houve erro ao buscar agendamentos afetados: %w", err)
+	}
+	return affected, nil
+}
+
+// DeleteAbsence remove uma ausência; os turnos do período voltam a ser oferecidos
+func (uc *TherapistUseCase) DeleteAbsence(therapistID, absenceID, deletedBy uint) error {
+	absence, err := uc.therapistRepo.GetAbsenceByID(absenceID)
+	if err != nil || absence.TherapistID != therapistID {
+		return errors.New("ausência não encontrada")
+	}
+
+	if err := uc.therapistRepo.DeleteAbsence(absenceID); err != nil {
+		return fmt.Errorf("erro ao excluir ausência: %w", err)
+	}
+
+	auditLog := entities.NewAuditLog(&deletedBy, entities.ActionDelete, entities.ResourceTherapist, &therapistID)
+	auditLog.SetDescription(fmt.Sprintf("Ausência de %s a %s excluída",
+		absence.StartDate.Format("02/01/2006"), absence.EndDate.Format("02/01/2006")))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetTherapistHours soma as horas de escala, de ausência e de sessões agendadas do
+// massoterapeuta entre as datas (inclusivas), para o planejamento das horas contratadas
+func (uc *TherapistUseCase) GetTherapistHours(therapistID uint, startDate, endDate time.Time) (*dtos.TherapistHoursResponse, error) {
+	therapist, err := uc.therapistRepo.GetByID(therapistID)
+	if err != nil {
+		return nil, fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+
+	startDate, endDate = dayStart(startDate), dayStart(endDate)
+	if endDate.Before(startDate) {
+		return nil, errors.New("data de fim deve ser igual ou posterior à data de início")
+	}
+	days := int(endDate.Sub(startDate).Hours()/24) + 1
+	if days > MaxTherapistHoursDays {
+		return nil, fmt.Errorf("período máximo do relatório é de %d dias", MaxTherapistHoursDays)
+	}
+
+	absences, err := uc.therapistRepo.GetAbsencesInRange([]uint{therapistID}, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar ausências: %w", err)
+	}
+	bookings, err := uc.bookingRepo.GetOccupyingByTherapistInRange(therapistID, startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar agendamentos: %w", err)
+	}
+
+	response := &dtos.TherapistHoursResponse{
+		TherapistID:   therapist.ID,
+		TherapistName: therapist.Name,
+		StartDate:     startDate.Format("2006-01-02"),
+		EndDate:       endDate.Format("2006-01-02"),
+		Sessions:      len(bookings),
+	}
+
+	var scheduled, absent, booked int
+	for i := 0; i < days; i++ {
+		date := startDate.AddDate(0, 0, i)
+		for j := range therapist.Shifts {
+			shift := &therapist.Shifts[j]
+			if !shift.IsValidForDate(date) {
+				continue
+			}
+			if isAbsentOn(absences, date) {
+				absent += shift.Minutes()
+			} else {
+				scheduled += shift.Minutes()
+			}
+		}
+	}
+	for _, booking := range bookings {
+		booked += int(booking.SessionDuration().Minutes())
+	}
+
+	response.ScheduledHours = float64(scheduled) / 60
+	response.AbsentHours = float64(absent) / 60
+	response.BookedHours = float64(booked) / 60
+	if scheduled > 0 {
+		response.Utilization = float64(booked) / float64(scheduled) * 100
+	}
+
+	return response, nil
+}
+
+// validateTherapist normaliza os textos, valida o massoterapeuta e a conta vinculada
+func (uc *TherapistUseCase) validateTherapist(therapist *entities.Therapist) error {
+	therapist.Name = strings.TrimSpace(therapist.Name)
+	therapist.Email = strings.TrimSpace(therapist.Email)
+	therapist.Phone = strings.TrimSpace(therapist.Phone)
+
+	if err := uc.validator.ValidateStruct(therapist); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+
+	if therapist.UserID != nil {
+		if _, err := uc.userRepo.GetByID(*therapist.UserID); err != nil {
+			return fmt.Errorf("usuário vinculado não encontrado: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateShift valida o turno e impede que o massoterapeuta fique em dois turnos
+// ao mesmo tempo ou que dois massoterapeutas dividam a cadeira no mesmo horário
+func (uc *TherapistUseCase) validateShift(shift *entities.TherapistShift, excludeID *uint) (*entities.Therapist, *entities.Chair, error) {
+	if err := uc.validator.ValidateStruct(shift); err != nil {
+		return nil, nil, fmt.Errorf("dados inválidos: %w", err)
+	}
+	if err := shift.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	therapist, err := uc.therapistRepo.GetByID(shift.TherapistID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("massoterapeuta não encontrado: %w", err)
+	}
+	chair, err := uc.chairRepo.GetByID(shift.ChairID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+
+	for i := range therapist.Shifts {
+		other := &therapist.Shifts[i]
+		if excludeID != nil && other.ID == *excludeID {
+			continue
+		}
+		if shift.Overlaps(other) {
+			return nil, nil, fmt.Errorf("turno sobrepõe outro turno do massoterapeuta (%s)", describeShift(other))
+		}
+	}
+
+	chairShifts, err := uc.therapistRepo.GetShiftsByChairs([]uint{shift.ChairID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao verificar turnos da cadeira: %w", err)
+	}
+	for _, other := range chairShifts {
+		if excludeID != nil && other.ID == *excludeID {
+			continue
+		}
+		if other.TherapistID != shift.TherapistID && shift.Overlaps(other) {
+			return nil, nil, fmt.Errorf("cadeira já tem massoterapeuta neste horário (%s)", describeShift(other))
+		}
+	}
+
+	return therapist, chair, nil
+}
+
+// describeShift descreve o turno para mensagens e auditoria
+func describeShift(shift *entities.TherapistShift) string {
+	return fmt.Sprintf("%s %s-%s", shift.GetDayOfWeekName(), shift.StartTime, shift.EndTime)
+}
+
+// isAbsentOn verifica se alguma das ausências cobre a data
+func isAbsentOn(absences []*entities.TherapistAbsence, date time.Time) bool {
+	for _, absence := range absences {
+		if absence.CoversDate(date) {
+			return true
+		}
+	}
+	return false
+}
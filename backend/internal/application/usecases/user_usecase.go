@@ -149,6 +149,14 @@ func (uc *UserUseCase) UpdateUser(user *entities.User, updatedBy uint) error {
 		}
 	}
 
+	// A confirmação do email não vem do corpo da requisição; trocar o email exige
+	// confirmar o novo endereço
+	user.EmailVerificationSentAt = currentUser.EmailVerificationSentAt
+	user.EmailVerifiedAt = currentUser.EmailVerifiedAt
+	if user.Email != currentUser.Email {
+		user.EmailVerifiedAt = nil
+	}
+
 	// Se senha foi alterada, fazer hash
 	if user.Password != "" && user.Password != currentUser.Password {
 		hashedPassword, err := uc.passwordHasher.Hash(user.Password)
@@ -173,8 +181,9 @@ func (uc *UserUseCase) UpdateUser(user *entities.User, updatedBy uint) error {
 	return nil
 }
 
-// ApproveUser aprova um usuário
-func (uc *UserUseCase) ApproveUser(userID, approvedBy uint) error {
+// ApproveUser aprova um usuário. approvedBy é quem aprovou; nulo na aprovação
+// automática pelo sistema
+func (uc *UserUseCase) ApproveUser(userID uint, approvedBy *uint) error {
 	user, err := uc.userRepo.GetByID(userID)
 	if err != nil {
 		return fmt.Errorf("usuário não encontrado: %w", err)
@@ -184,12 +193,18 @@ func (uc *UserUseCase) ApproveUser(userID, approvedBy uint) error {
 		return errors.New("usuário não está pendente de aprovação")
 	}
 
+	// Aprovar um email não confirmado mandaria o aviso de aprovação a um endereço
+	// possivelmente digitado errado
+	if !user.IsEmailVerified() {
+		return entities.ErrEmailNotVerified
+	}
+
 	if err := uc.userRepo.Approve(userID, approvedBy); err != nil {
 		return fmt.Errorf("erro ao aprovar usuário: %w", err)
 	}
 
 	// Log de auditoria
-	auditLog := entities.NewAuditLog(&approvedBy, entities.ActionApprove, entities.ResourceUser, &userID)
+	auditLog := entities.NewAuditLog(approvedBy, entities.ActionApprove, entities.ResourceUser, &userID)
 	auditLog.SetDescription(fmt.Sprintf("Usuário %s aprovado", user.Name))
 	uc.auditRepo.Create(auditLog)
 
@@ -247,9 +262,16 @@ func (uc *UserUseCase) ListUsers(limit, offset int, filters map[string]interface
 	return uc.userRepo.List(limit, offset, filters)
 }
 
-// GetPendingApprovals busca usuários pendentes de aprovação
-func (uc *UserUseCase) GetPendingApprovals(limit, offset int) ([]*entities.User, int64, error) {
-	return uc.userRepo.GetByStatus("pendente", limit, offset)
+// GetPendingApprovals busca usuários pendentes de aprovação. emailVerified, se
+// informado, traz só os cadastros com (true) ou sem (false) email confirmado
+func (uc *UserUseCase) GetPendingApprovals(limit, offset int, emailVerified *bool) ([]*entities.User, int64, error) {
+	if emailVerified == nil {
+		return uc.userRepo.GetByStatus("pendente", limit, offset)
+	}
+	return uc.userRepo.List(limit, offset, map[string]interface{}{
+		"status":         "pendente",
+		"email_verified": *emailVerified,
+	})
 }
 
 // GetPendingUsers busca todos os usuários pendentes de aprovação
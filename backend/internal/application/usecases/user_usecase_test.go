@@ -71,7 +71,7 @@ func (m *MockUserRepository) GetByStatus(status string, limit, offset int) ([]*e
 	return args.Get(0).([]*entities.User), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockUserRepository) Approve(id uint, approvedBy uint) error {
+func (m *MockUserRepository) Approve(id uint, approvedBy *uint) error {
 	args := m.Called(id, approvedBy)
 	return args.Error(0)
 }
@@ -96,6 +96,39 @@ func (m *MockUserRepository) UpdateLastLogin(id uint) error {
 	return args.Error(0)
 }
 
+func (m *MockUserRepository) UpdateCalendarToken(id uint, token string) error {
+	args := m.Called(id, token)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) MarkEmailVerified(id uint, verifiedAt time.Time) error {
+	args := m.Called(id, verifiedAt)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) UpdateEmailVerificationSentAt(id uint, sentAt time.Time) error {
+	args := m.Called(id, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) UpdateLocation(id uint, locationID *uint) error {
+	args := m.Called(id, locationID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetByLocation(locationID uint) ([]*entities.User, error) {
+	args := m.Called(locationID)
+	return args.Get(0).([]*entities.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByCalendarToken(token string) (*entities.User, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+
 func (m *MockUserRepository) ExistsByEmail(email string) (bool, error) {
 	args := m.Called(email)
 	return args.Bool(0), args.Error(1)
@@ -0,0 +1,335 @@
+package usecases
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/ports"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// WaitlistOfferTTL é o prazo que o usuário tem para aceitar uma vaga ofertada
+const WaitlistOfferTTL = 15 * time.Minute
+
+type WaitlistUseCase struct {
+	waitlistRepo   repositories.WaitlistRepository
+	chairRepo      repositories.ChairRepository
+	auditRepo      repositories.AuditLogRepository
+	bookingUseCase *BookingUseCase
+	validator      ports.Validator
+}
+
+func NewWaitlistUseCase(
+	waitlistRepo repositories.WaitlistRepository,
+	chairRepo repositories.ChairRepository,
+	auditRepo repositories.AuditLogRepository,
+	bookingUseCase *BookingUseCase,
+	validator ports.Validator,
+) *WaitlistUseCase {
+	return &WaitlistUseCase{
+		waitlistRepo:   waitlistRepo,
+		chairRepo:      chairRepo,
+		auditRepo:      auditRepo,
+		bookingUseCase: bookingUseCase,
+		validator:      validator,
+	}
+}
+
+// JoinWaitlist coloca o usuário na fila de uma cadeira para uma data e janela de horário
+func (uc *WaitlistUseCase) JoinWaitlist(entry *entities.WaitlistEntry) error {
+	// Validar dados
+	if err := uc.validator.ValidateStruct(entry); err != nil {
+		return fmt.Errorf("dados inválidos: %w", err)
+	}
+
+	// Validar janela de horário
+	windowStart, err := time.Parse("15:04", entry.WindowStart)
+	if err != nil {
+		return errors.New("horário inicial da janela inválido")
+	}
+	windowEnd, err := time.Parse("15:04", entry.WindowEnd)
+	if err != nil {
+		return errors.New("horário final da janela inválido")
+	}
+	if entry.WindowEnd != "00:00" && !windowEnd.After(windowStart) {
+		return errors.New("horário final da janela deve ser posterior ao inicial")
+	}
+
+	// A data não pode estar no passado
+	today := time.Now().Format("2006-01-02")
+	if entry.Date.Format("2006-01-02") < today {
+		return errors.New("não é possível entrar na lista de espera para datas passadas")
+	}
+
+	// Verificar se cadeira existe e está ativa
+	chair, err := uc.chairRepo.GetByID(entry.ChairID)
+	if err != nil {
+		return fmt.Errorf("cadeira não encontrada: %w", err)
+	}
+	if !chair.IsActive() {
+		return errors.New("cadeira não está disponível")
+	}
+
+	// O serviço desejado precisa ser oferecido pela cadeira
+	if _, err := uc.bookingUseCase.resolveSessionDuration(entry.ChairID, entry.ServiceID); err != nil {
+		return err
+	}
+
+	// Evitar entradas duplicadas na mesma fila
+	exists, err := uc.waitlistRepo.HasOpenEntry(entry.UserID, entry.ChairID, entry.Date)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar lista de espera: %w", err)
+	}
+	if exists {
+		return errors.New("usuário já está na lista de espera desta cadeira para esta data")
+	}
+
+	entry.Status = entities.WaitlistStatusWaiting
+	if err := uc.waitlistRepo.Create(entry); err != nil {
+		return fmt.Errorf("erro ao entrar na lista de espera: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&entry.UserID, entities.ActionCreate, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription(fmt.Sprintf("Entrada na lista de espera da cadeira %s em %s (%s-%s)",
+		chair.Name, entry.Date.Format("02/01/2006"), entry.WindowStart, entry.WindowEnd))
+	uc.auditRepo.Create(auditLog)
+
+	return nil
+}
+
+// GetUserEntries busca as entradas de lista de espera de um usuário
+func (uc *WaitlistUseCase) GetUserEntries(userID uint) ([]*entities.WaitlistEntry, error) {
+	return uc.waitlistRepo.GetByUser(userID)
+}
+
+// GetChairQueue busca a fila de uma cadeira em uma data
+func (uc *WaitlistUseCase) GetChairQueue(chairID uint, date time.Time) ([]*entities.WaitlistEntry, error) {
+	return uc.waitlistRepo.GetByChairAndDate(chairID, date)
+}
+
+// LeaveWaitlist remove uma entrada da fila. Apenas o dono da entrada ou a equipe podem removê-la
+func (uc *WaitlistUseCase) LeaveWaitlist(entryID, requestedBy uint, canManage bool) error {
+	entry, err := uc.waitlistRepo.GetByID(entryID)
+	if err != nil {
+		return fmt.Errorf("entrada da lista de espera não encontrada: %w", err)
+	}
+
+	if entry.UserID != requestedBy && !canManage {
+		return errors.New("sem permissão para remover esta entrada")
+	}
+
+	if !entry.IsOpen() {
+		return errors.New("entrada da lista de espera já foi encerrada")
+	}
+
+	hadOffer := entry.Status == entities.WaitlistStatusOffered
+	offeredStart := entry.OfferedStart
+
+	entry.Cancel()
+	if err := uc.waitlistRepo.Update(entry); err != nil {
+		return fmt.Errorf("erro ao sair da lista de espera: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&requestedBy, entities.ActionCancel, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription("Entrada removida da lista de espera")
+	uc.auditRepo.Create(auditLog)
+
+	// Uma oferta pendente passa para o próximo da fila
+	if hadOffer && offeredStart != nil {
+		go uc.passOfferOn(entry, *offeredStart)
+	}
+
+	return nil
+}
+
+// GetOffer busca a vaga ofertada pelo token do link, sem aceitá-la. O link do email
+// abre esta consulta; o aceite só acontece por POST, para que leitores de link e
+// pré-carregamentos do cliente de email não tomem a vaga no lugar do usuário
+func (uc *WaitlistUseCase) GetOffer(token string) (*entities.WaitlistEntry, error) {
+	entry, err := uc.findPendingOffer(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.IsOfferExpired(time.Now()) {
+		return nil, errors.New("prazo para aceitar a oferta expirou")
+	}
+
+	return entry, nil
+}
+
+// AcceptOffer confirma a vaga ofertada pelo link enviado por email e cria o agendamento,
+// consumindo a reserva temporária criada com a oferta. Se o usuário não puder mais
+// agendar, a entrada é encerrada e a vaga segue para o próximo da fila
+func (uc *WaitlistUseCase) AcceptOffer(token string) (*entities.Booking, error) {
+	entry, err := uc.findPendingOffer(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.IsOfferExpired(time.Now()) {
+		uc.expireOffer(entry)
+		return nil, errors.New("prazo para aceitar a oferta expirou")
+	}
+
+	// Dois envios do mesmo link: só o primeiro consegue usar a oferta
+	if err := uc.claimOffer(entry); err != nil {
+		return nil, err
+	}
+	offeredStart := *entry.OfferedStart
+
+	booking := &entities.Booking{
+		UserID:    entry.UserID,
+		ChairID:   entry.ChairID,
+		ServiceID: entry.ServiceID,
+		StartTime: offeredStart,
+		Status:    "agendado",
+		Notes:     "Agendado pela lista de espera",
+	}
+
+	// A reserva da oferta foi criada com o hash do token do link
+	if err := uc.bookingUseCase.CreateBookingWithHold(booking, entry.UserID, entities.HashToken(token)); err != nil {
+		entry.Expire()
+		if updateErr := uc.waitlistRepo.Update(entry); updateErr != nil {
+			log.Printf("Erro ao encerrar a entrada %d da lista de espera após falha no aceite: %v", entry.ID, updateErr)
+		}
+
+		auditLog := entities.NewAuditLog(&entry.UserID, entities.ActionUpdate, entities.ResourceWaitlist, &entry.ID)
+		auditLog.SetDescription(fmt.Sprintf("Oferta da lista de espera encerrada sem agendamento: %v", err))
+		uc.auditRepo.Create(auditLog)
+
+		go uc.passOfferOn(entry, offeredStart)
+		return nil, fmt.Errorf("não foi possível confirmar a vaga: %w", err)
+	}
+
+	entry.Fulfill(booking.ID)
+	if err := uc.waitlistRepo.Update(entry); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar lista de espera: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&entry.UserID, entities.ActionConfirm, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription(fmt.Sprintf("Oferta da lista de espera aceita (agendamento %d)", booking.ID))
+	uc.auditRepo.Create(auditLog)
+
+	return booking, nil
+}
+
+// DeclineOffer recusa a vaga ofertada, encerra a entrada e repassa a vaga ao próximo da fila
+func (uc *WaitlistUseCase) DeclineOffer(token string) error {
+	entry, err := uc.findPendingOffer(token)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.claimOffer(entry); err != nil {
+		return err
+	}
+
+	offeredStart := *entry.OfferedStart
+	entry.Cancel()
+	if err := uc.waitlistRepo.Update(entry); err != nil {
+		return fmt.Errorf("erro ao recusar oferta: %w", err)
+	}
+
+	// Log de auditoria
+	auditLog := entities.NewAuditLog(&entry.UserID, entities.ActionReject, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription("Oferta da lista de espera recusada")
+	uc.auditRepo.Create(auditLog)
+
+	go uc.passOfferOn(entry, offeredStart)
+
+	return nil
+}
+
+// findPendingOffer busca a entrada com oferta pendente pelo token do link. Só o hash
+// do token fica gravado
+func (uc *WaitlistUseCase) findPendingOffer(token string) (*entities.WaitlistEntry, error) {
+	entry, err := uc.waitlistRepo.GetByOfferTokenHash(entities.HashToken(token))
+	if err != nil {
+		return nil, errors.New("oferta não encontrada ou já utilizada")
+	}
+
+	if entry.Status != entities.WaitlistStatusOffered || entry.OfferedStart == nil || entry.OfferTokenHash == nil {
+		return nil, errors.New("oferta não está mais disponível")
+	}
+
+	return entry, nil
+}
+
+// claimOffer consome o token da oferta antes de agir sobre ela. Falha se outro envio
+// do mesmo link chegou antes
+func (uc *WaitlistUseCase) claimOffer(entry *entities.WaitlistEntry) error {
+	claimed, err := uc.waitlistRepo.ClaimOffer(entry.ID, *entry.OfferTokenHash)
+	if err != nil {
+		return fmt.Errorf("erro ao registrar resposta da oferta: %w", err)
+	}
+	if !claimed {
+		return errors.New("oferta não está mais disponível")
+	}
+	entry.OfferTokenHash = nil
+	return nil
+}
+
+// ExpireOffers encerra ofertas não aceitas no prazo e repassa as vagas (executado pelo scheduler)
+func (uc *WaitlistUseCase) ExpireOffers() error {
+	entries, err := uc.waitlistRepo.GetExpiredOffers(time.Now())
+	if err != nil {
+		return fmt.Errorf("erro ao buscar ofertas expiradas: %w", err)
+	}
+
+	for _, entry := range entries {
+		uc.expireOffer(entry)
+	}
+
+	return nil
+}
+
+// expireOffer encerra a entrada com oferta vencida e oferece a vaga ao próximo
+func (uc *WaitlistUseCase) expireOffer(entry *entities.WaitlistEntry) {
+	if entry.OfferedStart == nil {
+		return
+	}
+	offeredStart := *entry.OfferedStart
+
+	entry.Expire()
+	if err := uc.waitlistRepo.Update(entry); err != nil {
+		fmt.Printf("Erro ao expirar oferta da lista de espera: %v\n", err)
+		return
+	}
+
+	// Log de auditoria (ação do sistema)
+	auditLog := entities.NewAuditLog(nil, entities.ActionUpdate, entities.ResourceWaitlist, &entry.ID)
+	auditLog.SetDescription("Oferta da lista de espera expirada sem aceite")
+	uc.auditRepo.Create(auditLog)
+
+	uc.passOfferOn(entry, offeredStart)
+}
+
+// passOfferOn repassa uma vaga que foi ofertada e não utilizada. A reserva da oferta é
+// liberada antes, e o intervalo repassado é o da sessão que havia sido ofertada
+func (uc *WaitlistUseCase) passOfferOn(entry *entities.WaitlistEntry, offeredStart time.Time) {
+	uc.bookingUseCase.releaseWaitlistOfferHold(entry.ID)
+
+	duration, err := uc.bookingUseCase.resolveSessionDuration(entry.ChairID, entry.ServiceID)
+	if err != nil {
+		duration = entities.DefaultSessionDuration
+	}
+	uc.bookingUseCase.ReleaseSlotToWaitlist(entry.ChairID, offeredStart, offeredStart.Add(duration))
+}
+
+// generateSecureToken gera um token aleatório para links enviados por email
+func generateSecureToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("erro ao gerar token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
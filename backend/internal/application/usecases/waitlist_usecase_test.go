@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitlistUseCase_OfferHoldsSlot(t *testing.T) {
+	first := &entities.User{ID: 1, Name: "Ana", Role: "usuario", Status: "aprovado"}
+	second := &entities.User{ID: 2, Name: "Bruno", Role: "usuario", Status: "aprovado"}
+	other := &entities.User{ID: 3, Name: "Carla", Role: "usuario", Status: "aprovado"}
+
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day()+3, 0, 0, 0, 0, time.Local)
+	startTime := day.Add(10 * time.Hour)
+	endTime := startTime.Add(entities.DefaultSessionDuration)
+
+	// setup oferta o horário ao primeiro da fila e devolve o token do link enviado.
+	// O primeiro usuário é uma cópia, que o teste pode alterar
+	setup := func(t *testing.T) (*bookingTestEnv, *WaitlistUseCase, string, *entities.User) {
+		firstUser := *first
+		env := newBookingTestEnv([]*entities.User{&firstUser, second, other})
+		for i, user := range []*entities.User{first, second} {
+			env.waitlist.Add(&entities.WaitlistEntry{
+				ID:          uint(i + 1),
+				UserID:      user.ID,
+				ChairID:     env.chair.ID,
+				Date:        day,
+				WindowStart: "08:00",
+				WindowEnd:   "18:00",
+				Preference:  entities.WaitlistPreferenceOffer,
+				Status:      entities.WaitlistStatusWaiting,
+			})
+		}
+		uc := NewWaitlistUseCase(env.waitlist, env.chairRepo, env.auditRepo, env.uc, env.validator)
+
+		// O horário liberado é ofertado ao primeiro da fila
+		env.uc.ReleaseSlotToWaitlist(env.chair.ID, startTime, endTime)
+		offer := fakes.Receive(t, env.email.WaitlistOffers)
+		require.Equal(t, uint(1), offer.Entry.ID)
+		require.NotEmpty(t, offer.Token)
+		return env, uc, offer.Token, &firstUser
+	}
+
+	t.Run("Vaga ofertada não pode ser agendada por outro usuário", func(t *testing.T) {
+		env, uc, token, _ := setup(t)
+
+		hold := env.holdRepo.ActiveOfferHold(1)
+		require.NotNil(t, hold)
+		assert.Equal(t, first.ID, hold.UserID)
+		assert.True(t, hold.StartTime.Equal(startTime))
+		assert.True(t, hold.EndTime.Equal(endTime))
+
+		err := env.uc.CreateBooking(&entities.Booking{UserID: other.ID, ChairID: env.chair.ID, StartTime: startTime}, other.ID)
+		assert.ErrorIs(t, err, entities.ErrSlotHeld)
+
+		// O aceite consome a reserva da oferta
+		booking, err := uc.AcceptOffer(token)
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, booking.UserID)
+		assert.Nil(t, env.holdRepo.ActiveOfferHold(1))
+
+		consumed, err := env.holdRepo.GetByToken(hold.Token)
+		require.NoError(t, err)
+		assert.Equal(t, entities.SlotHoldStatusConsumed, consumed.Status)
+		assert.Equal(t, &booking.ID, consumed.BookingID)
+		assert.Equal(t, entities.WaitlistStatusFulfilled, env.waitlist.Get(1).Status)
+	})
+
+	t.Run("Recusa libera a reserva e oferta ao próximo", func(t *testing.T) {
+		env, uc, token, _ := setup(t)
+		declined := env.holdRepo.ActiveOfferHold(1)
+		require.NotNil(t, declined)
+
+		require.NoError(t, uc.DeclineOffer(token))
+
+		offer := fakes.Receive(t, env.email.WaitlistOffers)
+		assert.Equal(t, uint(2), offer.Entry.ID)
+
+		released, err := env.holdRepo.GetByToken(declined.Token)
+		require.NoError(t, err)
+		assert.Equal(t, entities.SlotHoldStatusReleased, released.Status)
+
+		hold := env.holdRepo.ActiveOfferHold(2)
+		require.NotNil(t, hold)
+		assert.Equal(t, second.ID, hold.UserID)
+	})
+
+	t.Run("Oferta expirada libera a reserva e oferta ao próximo", func(t *testing.T) {
+		env, uc, token, _ := setup(t)
+		expired := env.holdRepo.ActiveOfferHold(1)
+		require.NotNil(t, expired)
+
+		entry := env.waitlist.Get(1)
+		past := time.Now().Add(-time.Minute)
+		entry.OfferExpiresAt = &past
+		require.NoError(t, env.waitlist.Update(entry))
+
+		_, err := uc.AcceptOffer(token)
+		assert.Error(t, err)
+
+		offer := fakes.Receive(t, env.email.WaitlistOffers)
+		assert.Equal(t, uint(2), offer.Entry.ID)
+
+		released, err := env.holdRepo.GetByToken(expired.Token)
+		require.NoError(t, err)
+		assert.Equal(t, entities.SlotHoldStatusReleased, released.Status)
+		assert.NotNil(t, env.holdRepo.ActiveOfferHold(2))
+	})
+	t.Run("Só o hash do token fica gravado", func(t *testing.T) {
+		env, _, token, _ := setup(t)
+
+		entry := env.waitlist.Get(1)
+		require.NotNil(t, entry.OfferTokenHash)
+		assert.Equal(t, entities.HashToken(token), *entry.OfferTokenHash)
+		assert.NotEqual(t, token, env.holdRepo.ActiveOfferHold(1).Token)
+	})
+
+	t.Run("O mesmo link não aceita a vaga duas vezes", func(t *testing.T) {
+		env, uc, token, _ := setup(t)
+
+		_, err := uc.AcceptOffer(token)
+		require.NoError(t, err)
+		_, err = uc.AcceptOffer(token)
+		assert.Error(t, err)
+		assert.Error(t, uc.DeclineOffer(token))
+
+		bookings, _, err := env.bookingRepo.GetByChair(env.chair.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, bookings, 1)
+		assert.Equal(t, entities.WaitlistStatusFulfilled, env.waitlist.Get(1).Status)
+	})
+
+	t.Run("Quem não pode mais agendar perde a oferta e a vaga segue para o próximo", func(t *testing.T) {
+		env, uc, token, firstUser := setup(t)
+		firstUser.Status = "pendente"
+
+		_, err := uc.AcceptOffer(token)
+		assert.Error(t, err)
+
+		offer := fakes.Receive(t, env.email.WaitlistOffers)
+		assert.Equal(t, uint(2), offer.Entry.ID)
+		assert.Equal(t, entities.WaitlistStatusExpired, env.waitlist.Get(1).Status)
+		assert.Nil(t, env.holdRepo.ActiveOfferHold(1))
+
+		hold := env.holdRepo.ActiveOfferHold(2)
+		require.NotNil(t, hold)
+		assert.Equal(t, second.ID, hold.UserID)
+	})
+}
+
+func TestBookingUseCase_ReleaseSlotToWaitlist_SkipsUsersWhoCannotBook(t *testing.T) {
+	pending := &entities.User{ID: 1, Name: "Ana", Role: "usuario", Status: "pendente"}
+	approved := &entities.User{ID: 2, Name: "Bruno", Role: "usuario", Status: "aprovado"}
+
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day()+3, 0, 0, 0, 0, time.Local)
+	startTime := day.Add(10 * time.Hour)
+
+	env := newBookingTestEnv([]*entities.User{pending, approved})
+	for i, user := range []*entities.User{pending, approved} {
+		env.waitlist.Add(&entities.WaitlistEntry{
+			ID:          uint(i + 1),
+			UserID:      user.ID,
+			ChairID:     env.chair.ID,
+			Date:        day,
+			WindowStart: "08:00",
+			WindowEnd:   "18:00",
+			Preference:  entities.WaitlistPreferenceOffer,
+			Status:      entities.WaitlistStatusWaiting,
+		})
+	}
+
+	env.uc.ReleaseSlotToWaitlist(env.chair.ID, startTime, startTime.Add(entities.DefaultSessionDuration))
+
+	offer := fakes.Receive(t, env.email.WaitlistOffers)
+	assert.Equal(t, uint(2), offer.Entry.ID)
+	assert.Equal(t, entities.WaitlistStatusWaiting, env.waitlist.Get(1).Status)
+	assert.Nil(t, env.holdRepo.ActiveOfferHold(1))
+}
@@ -6,6 +6,7 @@ import (
 
 type AuditLog struct {
 	ID          uint      `json:"id" gorm:"primaryKey"`
+	TenantID    uint      `json:"-" gorm:"not null;default:0;index"`
 	UserID      *uint     `json:"user_id" gorm:"index"`
 	Action      string    `json:"action" gorm:"size:100;not null" validate:"required"`
 	Resource    string    `json:"resource" gorm:"size:100;not null" validate:"required"`
@@ -33,6 +34,7 @@ const (
 	ActionDelete = "DELETE"
 	ActionLogin  = "LOGIN"
 	ActionLogout = "LOGOUT"
+	ActionLoginFailed = "LOGIN_FAILED"
 	ActionApprove = "APPROVE"
 	ActionReject  = "REJECT"
 	ActionCancel  = "CANCEL"
@@ -46,6 +48,14 @@ const (
 	ResourceBooking     = "BOOKING"
 	ResourceAvailability = "AVAILABILITY"
 	ResourceAuth        = "AUTH"
+	ResourceService     = "SERVICE"
+	ResourceWaitlist    = "WAITLIST"
+	ResourcePolicy      = "POLICY"
+	ResourcePenalty     = "PENALTY"
+	ResourceClosure     = "CLOSURE"
+	ResourceLocation    = "LOCATION"
+	ResourceTherapist   = "THERAPIST"
+	ResourceTenant      = "TENANT"
 )
 
 // NewAuditLog cria um novo log de auditoria
@@ -99,6 +109,8 @@ func (a *AuditLog) GetActionDescription() string {
 		return "Login"
 	case ActionLogout:
 		return "Logout"
+	case ActionLoginFailed:
+		return "Falha de login"
 	case ActionApprove:
 		return "Aprovação"
 	case ActionReject:
@@ -125,6 +137,22 @@ func (a *AuditLog) GetResourceDescription() string {
 		return "Disponibilidade"
 	case ResourceAuth:
 		return "Autenticação"
+	case ResourceService:
+		return "Serviço"
+	case ResourceWaitlist:
+		return "Lista de espera"
+	case ResourcePolicy:
+		return "Política de agendamento"
+	case ResourcePenalty:
+		return "Penalidade"
+	case ResourceClosure:
+		return "Fechamento"
+	case ResourceLocation:
+		return "Localidade"
+	case ResourceTherapist:
+		return "Massoterapeuta"
+	case ResourceTenant:
+		return "Tenant"
 	default:
 		return a.Resource
 	}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
 	"gorm.io/gorm"
@@ -8,6 +10,7 @@ import (
 
 type Availability struct {
 	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
 	ChairID   uint           `json:"chair_id" gorm:"not null" validate:"required"`
 	DayOfWeek int            `json:"day_of_week" gorm:"not null" validate:"required,min=0,max=6"` // 0=Domingo, 6=Sábado
 	StartTime string         `json:"start_time" gorm:"size:5;not null" validate:"required"`       // Formato HH:MM
@@ -80,6 +83,72 @@ func (a *Availability) GetTimeSlots() ([]string, error) {
 	return slots, nil
 }
 
+// GetTimeSlotsForDuration retorna os horários de início em que uma sessão da
+// duração informada cabe inteira dentro da janela de disponibilidade
+func (a *Availability) GetTimeSlotsForDuration(duration time.Duration) ([]string, error) {
+	return a.GetTimeSlotsWithBreaks(duration, 0, nil)
+}
+
+// GetTimeSlotsWithBreaks retorna os horários de início da janela descontando as
+// pausas da cadeira. Sessões consecutivas ficam separadas pela folga de higienização
+// e, depois de cada pausa, a contagem recomeça no fim dela
+func (a *Availability) GetTimeSlotsWithBreaks(duration, buffer time.Duration, breaks []*ChairBreak) ([]string, error) {
+	if duration <= 0 {
+		duration = DefaultSessionDuration
+	}
+
+	start, err := clockMinutes(a.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Janelas que terminam à meia-noite vão até o fim do dia
+	end, err := clockMinutes(clockEnd(a.EndTime))
+	if err != nil {
+		return nil, err
+	}
+
+	type segment struct{ start, end int }
+	segments := []segment{{start, end}}
+	for _, pause := range breaks {
+		if pause.DayOfWeek != nil && *pause.DayOfWeek != a.DayOfWeek {
+			continue
+		}
+		pauseStart, pauseEnd, err := pause.minutes()
+		if err != nil {
+			continue
+		}
+
+		var remaining []segment
+		for _, seg := range segments {
+			if pauseEnd <= seg.start || pauseStart >= seg.end {
+				remaining = append(remaining, seg)
+				continue
+			}
+			if pauseStart > seg.start {
+				remaining = append(remaining, segment{seg.start, pauseStart})
+			}
+			if pauseEnd < seg.end {
+				remaining = append(remaining, segment{pauseEnd, seg.end})
+			}
+		}
+		segments = remaining
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].start < segments[j].start })
+
+	length := int(duration.Minutes())
+	step := length + int(buffer.Minutes())
+
+	var slots []string
+	for _, seg := range segments {
+		for current := seg.start; current+length <= seg.end; current += step {
+			slots = append(slots, fmt.Sprintf("%02d:%02d", current/60, current%60))
+		}
+	}
+
+	return slots, nil
+}
+
 // GetDayOfWeekName retorna o nome do dia da semana
 func (a *Availability) GetDayOfWeekName() string {
 	days := []string{"Domingo", "Segunda", "Terça", "Quarta", "Quinta", "Sexta", "Sábado"}
@@ -0,0 +1,123 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Tipos de exceção de disponibilidade
+const (
+	AvailabilityExceptionClosed  = "fechado"    // cadeira fechada o dia inteiro
+	AvailabilityExceptionReplace = "substituir" // horário especial no lugar das janelas semanais
+	AvailabilityExceptionExtra   = "adicional"  // janela extra somada às janelas semanais
+)
+
+// AvailabilityException sobrepõe a disponibilidade semanal de uma cadeira em uma data.
+// Exceções do tipo fechado não têm horário
+type AvailabilityException struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
+	ChairID   uint           `json:"chair_id" gorm:"not null;index:idx_availability_exception_chair_date" validate:"required"`
+	Date      time.Time      `json:"date" gorm:"type:date;not null;index:idx_availability_exception_chair_date" validate:"required"`
+	Type      string         `json:"type" gorm:"size:20;not null" validate:"required,oneof=fechado substituir adicional"`
+	StartTime string         `json:"start_time" gorm:"size:5"` // Formato HH:MM
+	EndTime   string         `json:"end_time" gorm:"size:5"`   // Formato HH:MM
+	Reason    string         `json:"reason" gorm:"size:255"`
+	CreatedBy *uint          `json:"created_by,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	Chair *Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (AvailabilityException) TableName() string {
+	return "availability_exceptions"
+}
+
+// Validate verifica se o horário acompanha o tipo da exceção
+func (e *AvailabilityException) Validate() error {
+	switch e.Type {
+	case AvailabilityExceptionClosed:
+		if e.StartTime != "" || e.EndTime != "" {
+			return errors.New("exceção de fechamento não deve informar horário")
+		}
+	case AvailabilityExceptionReplace, AvailabilityExceptionExtra:
+		if e.StartTime == "" || e.EndTime == "" {
+			return errors.New("horário de início e fim são obrigatórios para esta exceção")
+		}
+	default:
+		return errors.New("tipo de exceção inválido (use fechado, substituir ou adicional)")
+	}
+	return nil
+}
+
+// IsClosed verifica se a exceção fecha a cadeira o dia inteiro
+func (e *AvailabilityException) IsClosed() bool {
+	return e.Type == AvailabilityExceptionClosed
+}
+
+// IsOnDate verifica se a exceção vale para o dia do calendário informado
+func (e *AvailabilityException) IsOnDate(date time.Time) bool {
+	return e.Date.Year() == date.Year() && e.Date.Month() == date.Month() && e.Date.Day() == date.Day()
+}
+
+// Overlaps verifica se o horário da exceção sobrepõe o de outra exceção
+func (e *AvailabilityException) Overlaps(other *AvailabilityException) bool {
+	if e.IsClosed() || other.IsClosed() {
+		return false
+	}
+	return e.StartTime < clockEnd(other.EndTime) && other.StartTime < clockEnd(e.EndTime)
+}
+
+// toAvailability representa o horário da exceção como uma janela da data
+func (e *AvailabilityException) toAvailability(date time.Time) *Availability {
+	return &Availability{
+		ChairID:   e.ChairID,
+		DayOfWeek: int(date.Weekday()),
+		StartTime: e.StartTime,
+		EndTime:   e.EndTime,
+		IsActive:  true,
+	}
+}
+
+// ApplyAvailabilityExceptions calcula as janelas efetivas da cadeira na data. Um
+// fechamento zera o dia; horários especiais substituem as janelas semanais; janelas
+// adicionais são somadas ao que restar
+func ApplyAvailabilityExceptions(weekly []*Availability, exceptions []*AvailabilityException, date time.Time) []*Availability {
+	var replacements, extras []*Availability
+	for _, exception := range exceptions {
+		if !exception.IsOnDate(date) {
+			continue
+		}
+		switch exception.Type {
+		case AvailabilityExceptionClosed:
+			return nil
+		case AvailabilityExceptionReplace:
+			replacements = append(replacements, exception.toAvailability(date))
+		case AvailabilityExceptionExtra:
+			extras = append(extras, exception.toAvailability(date))
+		}
+	}
+
+	windows := weekly
+	if len(replacements) > 0 {
+		windows = replacements
+	}
+
+	effective := make([]*Availability, 0, len(windows)+len(extras))
+	effective = append(effective, windows...)
+	return append(effective, extras...)
+}
+
+// clockEnd trata o fim "00:00" como meia-noite do fim do dia na comparação de horários
+func clockEnd(end string) string {
+	if end == "00:00" {
+		return "24:00"
+	}
+	return end
+}
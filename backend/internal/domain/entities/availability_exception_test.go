@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailabilityException_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		exception AvailabilityException
+		wantErr   bool
+	}{
+		{"Fechado sem horário", AvailabilityException{Type: AvailabilityExceptionClosed}, false},
+		{"Fechado com horário", AvailabilityException{Type: AvailabilityExceptionClosed, StartTime: "09:00", EndTime: "12:00"}, true},
+		{"Horário especial completo", AvailabilityException{Type: AvailabilityExceptionReplace, StartTime: "09:00", EndTime: "12:00"}, false},
+		{"Janela adicional sem fim", AvailabilityException{Type: AvailabilityExceptionExtra, StartTime: "18:00"}, true},
+		{"Tipo inválido", AvailabilityException{Type: "feriado"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.exception.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAvailabilityException_Overlaps(t *testing.T) {
+	morning := &AvailabilityException{Type: AvailabilityExceptionExtra, StartTime: "08:00", EndTime: "10:00"}
+
+	tests := []struct {
+		name     string
+		other    *AvailabilityException
+		expected bool
+	}{
+		{"Sobreposição parcial", &AvailabilityException{Type: AvailabilityExceptionExtra, StartTime: "09:30", EndTime: "11:00"}, true},
+		{"Logo depois", &AvailabilityException{Type: AvailabilityExceptionExtra, StartTime: "10:00", EndTime: "11:00"}, false},
+		{"Até meia-noite", &AvailabilityException{Type: AvailabilityExceptionReplace, StartTime: "07:00", EndTime: "00:00"}, true},
+		{"Fechamento não tem horário", &AvailabilityException{Type: AvailabilityExceptionClosed}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, morning.Overlaps(tt.other))
+		})
+	}
+}
+
+func TestApplyAvailabilityExceptions(t *testing.T) {
+	friday := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	weekly := []*Availability{
+		{ChairID: 1, DayOfWeek: 5, StartTime: "09:00", EndTime: "12:00", IsActive: true},
+		{ChairID: 1, DayOfWeek: 5, StartTime: "13:00", EndTime: "18:00", IsActive: true},
+	}
+
+	tests := []struct {
+		name       string
+		exceptions []*AvailabilityException
+		expected   []string
+	}{
+		{"Sem exceções mantém o semanal", nil, []string{"09:00-12:00", "13:00-18:00"}},
+		{"Fechamento zera o dia", []*AvailabilityException{
+			{Date: friday, Type: AvailabilityExceptionClosed},
+		}, nil},
+		{"Horário reduzido substitui o semanal", []*AvailabilityException{
+			{Date: friday, Type: AvailabilityExceptionReplace, StartTime: "09:00", EndTime: "13:00"},
+		}, []string{"09:00-13:00"}},
+		{"Janela adicional soma ao semanal", []*AvailabilityException{
+			{Date: friday, Type: AvailabilityExceptionExtra, StartTime: "18:00", EndTime: "20:00"},
+		}, []string{"09:00-12:00", "13:00-18:00", "18:00-20:00"}},
+		{"Exceção de outra data é ignorada", []*AvailabilityException{
+			{Date: friday.AddDate(0, 0, 7), Type: AvailabilityExceptionClosed},
+		}, []string{"09:00-12:00", "13:00-18:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var windows []string
+			for _, availability := range ApplyAvailabilityExceptions(weekly, tt.exceptions, friday) {
+				assert.True(t, availability.IsValidForDate(friday))
+				windows = append(windows, availability.StartTime+"-"+availability.EndTime)
+			}
+			assert.Equal(t, tt.expected, windows)
+		})
+	}
+}
@@ -371,3 +371,61 @@ func TestAvailability_TimeSlotEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestAvailability_GetTimeSlotsForDuration(t *testing.T) {
+	tests := []struct {
+		name      string
+		startTime string
+		endTime   string
+		duration  time.Duration
+		expected  []string
+	}{
+		{"Sessões de 15 minutos", "08:00", "09:00", 15 * time.Minute, []string{"08:00", "08:15", "08:30", "08:45"}},
+		{"Sessões de 60 minutos", "08:00", "11:00", time.Hour, []string{"08:00", "09:00", "10:00"}},
+		{"Sessão precisa caber inteira", "08:00", "09:30", time.Hour, []string{"08:00"}},
+		{"Janela menor que a sessão", "08:00", "08:30", time.Hour, nil},
+		{"Janela terminando à meia-noite", "22:00", "00:00", time.Hour, []string{"22:00", "23:00"}},
+		{"Duração inválida usa padrão", "08:00", "09:00", 0, []string{"08:00", "08:30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			availability := &Availability{StartTime: tt.startTime, EndTime: tt.endTime}
+			slots, err := availability.GetTimeSlotsForDuration(tt.duration)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, slots)
+		})
+	}
+}
+
+func TestAvailability_GetTimeSlotsWithBreaks(t *testing.T) {
+	monday := 1
+	tuesday := 2
+	lunch := &ChairBreak{Name: "Almoço", StartTime: "12:00", EndTime: "13:00"}
+
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		buffer   time.Duration
+		breaks   []*ChairBreak
+		expected []string
+	}{
+		{"Folga entre sessões", "09:00", "11:00", 10 * time.Minute, nil, []string{"09:00", "09:40", "10:20"}},
+		{"Folga não precisa caber depois da última sessão", "09:00", "10:10", 10 * time.Minute, nil, []string{"09:00", "09:40"}},
+		{"Pausa divide a janela", "11:00", "14:00", 0, []*ChairBreak{lunch}, []string{"11:00", "11:30", "13:00", "13:30"}},
+		{"Contagem recomeça no fim da pausa", "11:00", "14:00", 10 * time.Minute, []*ChairBreak{lunch}, []string{"11:00", "13:00"}},
+		{"Pausa de outro dia é ignorada", "12:00", "13:00", 0, []*ChairBreak{{Name: "Reunião", DayOfWeek: &tuesday, StartTime: "12:00", EndTime: "13:00"}}, []string{"12:00", "12:30"}},
+		{"Pausa do mesmo dia é aplicada", "12:00", "13:00", 0, []*ChairBreak{{Name: "Reunião", DayOfWeek: &monday, StartTime: "12:00", EndTime: "12:30"}}, []string{"12:30"}},
+		{"Pausa cobrindo a janela inteira", "12:00", "13:00", 0, []*ChairBreak{lunch}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			availability := &Availability{DayOfWeek: monday, StartTime: tt.start, EndTime: tt.end}
+			slots, err := availability.GetTimeSlotsWithBreaks(30*time.Minute, tt.buffer, tt.breaks)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, slots)
+		})
+	}
+}
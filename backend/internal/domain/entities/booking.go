@@ -1,15 +1,26 @@
 package entities
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// DefaultCancellationCutoff é a antecedência mínima padrão para cancelar um agendamento
+const DefaultCancellationCutoff = 3 * time.Hour
+
+// ErrBookingConflict indica que a cadeira já está ocupada no horário. Também é
+// retornado quando a restrição de exclusão do banco barra uma gravação concorrente
+var ErrBookingConflict = errors.New("já existe um agendamento para este horário nesta cadeira")
+
 type Booking struct {
 	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
 	UserID    uint           `json:"user_id" gorm:"not null" validate:"required"`
 	ChairID   uint           `json:"chair_id" gorm:"not null" validate:"required"`
+	ServiceID *uint          `json:"service_id" gorm:"index"`
 	StartTime time.Time      `json:"start_time" gorm:"not null" validate:"required"`
 	EndTime   time.Time      `json:"end_time" gorm:"not null" validate:"required"`
 	Status    string         `json:"status" gorm:"size:20;default:'agendado'" validate:"oneof=agendado presenca_confirmada cancelado realizado falta"`
@@ -18,9 +29,25 @@ type Booking struct {
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
 
+	// SEQUENCE do convite .ics, incrementado a cada reagendamento
+	CalendarSequence int `json:"-" gorm:"not null;default:0"`
+
+	// Fechamento (feriado ou recesso) cadastrado depois do agendamento, que o
+	// marca para cancelamento em lote
+	ClosureID *uint `json:"closure_id,omitempty" gorm:"index"`
+
+	// Massoterapeuta de plantão na cadeira no horário da sessão. Vazio em cadeiras
+	// sem turnos cadastrados
+	TherapistID *uint `json:"therapist_id,omitempty" gorm:"index"`
+
+	// Envio do convite de avaliação da sessão realizada
+	FeedbackRequestedAt *time.Time `json:"-" gorm:"index"`
+
 	// Relacionamentos
-	User  User  `json:"user,omitempty" gorm:"foreignKey:UserID"`
-	Chair Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID"`
+	User      User       `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	Chair     Chair      `json:"chair,omitempty" gorm:"foreignKey:ChairID"`
+	Service   *Service   `json:"service,omitempty" gorm:"foreignKey:ServiceID"`
+	Therapist *Therapist `json:"therapist,omitempty" gorm:"foreignKey:TherapistID"`
 }
 
 // TableName especifica o nome da tabela
@@ -34,27 +61,36 @@ func (b *Booking) BeforeCreate(tx *gorm.DB) error {
 		b.Status = "agendado"
 	}
 
-	// Garantir que a sessão seja de 30 minutos
-	if !b.EndTime.IsZero() && !b.StartTime.IsZero() {
-		duration := b.EndTime.Sub(b.StartTime)
-		if duration != 30*time.Minute {
-			b.EndTime = b.StartTime.Add(30 * time.Minute)
-		}
-	}
+	b.fillEndTime()
 
 	return nil
 }
 
-// BeforeUpdate hook executado antes de atualizar um agendamento
-func (b *Booking) BeforeUpdate(tx *gorm.DB) error {
-	// Garantir que a sessão seja de 30 minutos
-	if !b.EndTime.IsZero() && !b.StartTime.IsZero() {
-		duration := b.EndTime.Sub(b.StartTime)
-		if duration != 30*time.Minute {
-			b.EndTime = b.StartTime.Add(30 * time.Minute)
-		}
+// fillEndTime completa o horário de fim quando o agendamento chega sem um intervalo
+// válido. O fim informado é a duração reservada e não é recalculado depois: editar a
+// duração do serviço não altera agendamentos existentes
+func (b *Booking) fillEndTime() {
+	if b.StartTime.IsZero() || b.EndTime.After(b.StartTime) {
+		return
 	}
-	return nil
+	b.EndTime = b.StartTime.Add(b.SessionDuration())
+}
+
+// CalendarUID identifica o agendamento nos convites e feeds de calendário
+func (b *Booking) CalendarUID() string {
+	return fmt.Sprintf("agendamento-%d@agendamento-massagens", b.ID)
+}
+
+// SessionDuration retorna a duração reservada para a sessão. Sem um intervalo gravado,
+// vale a duração do serviço carregado ou a padrão
+func (b *Booking) SessionDuration() time.Duration {
+	if b.EndTime.After(b.StartTime) {
+		return b.EndTime.Sub(b.StartTime)
+	}
+	if b.Service != nil && b.Service.ID != 0 {
+		return b.Service.GetDuration()
+	}
+	return DefaultSessionDuration
 }
 
 // IsActive verifica se o agendamento está ativo
@@ -77,12 +113,15 @@ func (b *Booking) IsPresenceConfirmed() bool {
 	return b.Status == "presenca_confirmada"
 }
 
-// CanBeCancelled verifica se o agendamento pode ser cancelado
+// CanBeCancelled verifica se o agendamento pode ser cancelado com o prazo padrão
 func (b *Booking) CanBeCancelled() bool {
-	// Pode ser cancelado até 3 horas antes do horário
-	now := time.Now()
-	threeHoursBefore := b.StartTime.Add(-3 * time.Hour)
-	return b.IsActive() && now.Before(threeHoursBefore)
+	return b.CanBeCancelledWithin(DefaultCancellationCutoff)
+}
+
+// CanBeCancelledWithin verifica se o agendamento pode ser cancelado respeitando a
+// antecedência mínima informada (definida pela política de agendamento)
+func (b *Booking) CanBeCancelledWithin(cutoff time.Duration) bool {
+	return b.IsActive() && time.Now().Before(b.StartTime.Add(-cutoff))
 }
 
 // Cancel cancela o agendamento
@@ -0,0 +1,188 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Escala de 0 a 10 da pergunta de recomendação, usada no cálculo do NPS
+const (
+	FeedbackMinRating       = 0
+	FeedbackMaxRating       = 10
+	FeedbackPromoterRating  = 9 // notas 9 e 10 são de promotores
+	FeedbackDetractorRating = 6 // notas até 6 são de detratores
+)
+
+// DefaultFeedbackWindow é o prazo padrão, contado do fim da sessão, para avaliá-la
+const DefaultFeedbackWindow = 7 * 24 * time.Hour
+
+// Origem da avaliação
+const (
+	FeedbackSourceApp   = "sistema" // enviada logado no sistema
+	FeedbackSourceEmail = "email"   // enviada pelo link do convite
+)
+
+// Agrupamentos das métricas de satisfação
+const (
+	SatisfactionByChair     = "cadeira"
+	SatisfactionByTherapist = "massoterapeuta"
+	SatisfactionByLocation  = "localidade"
+)
+
+// ErrFeedbackAlreadySubmitted indica que o agendamento já foi avaliado. Também é
+// retornado quando o índice único do banco barra uma segunda avaliação concorrente
+var ErrFeedbackAlreadySubmitted = errors.New("este agendamento já foi avaliado")
+
+// feedbackTokenPrefix diferencia o token do convite de avaliação dos demais tokens
+// assinados com o mesmo segredo
+const feedbackTokenPrefix = "avaliacao"
+
+// BookingFeedback é a avaliação de uma sessão realizada, uma por agendamento.
+// Cadeira, localidade e massoterapeuta são copiados do agendamento no envio, para que
+// as métricas não mudem quando a cadeira troca de localidade ou de plantão
+type BookingFeedback struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	TenantID       uint      `json:"-" gorm:"not null;default:0;index"`
+	BookingID      uint      `json:"booking_id" gorm:"not null;uniqueIndex" validate:"required"`
+	UserID         uint      `json:"user_id" gorm:"not null;index" validate:"required"`
+	ChairID        uint      `json:"chair_id" gorm:"not null;index" validate:"required"`
+	LocationID     *uint     `json:"location_id,omitempty" gorm:"index"`
+	TherapistID    *uint     `json:"therapist_id,omitempty" gorm:"index"`
+	Rating         int       `json:"rating" gorm:"not null" validate:"min=0,max=10"`
+	Comments       string    `json:"comments" gorm:"size:1000" validate:"max=1000"`
+	ChairIssue     bool      `json:"chair_issue" gorm:"not null;default:false"`     // problema com a cadeira
+	TherapistIssue bool      `json:"therapist_issue" gorm:"not null;default:false"` // problema com o atendimento
+	Source         string    `json:"source" gorm:"size:20;not null;default:'sistema'"`
+	CreatedAt      time.Time `json:"created_at" gorm:"index"`
+
+	// Relacionamentos
+	Booking   *Booking   `json:"booking,omitempty" gorm:"foreignKey:BookingID" validate:"-"`
+	User      *User      `json:"user,omitempty" gorm:"foreignKey:UserID" validate:"-"`
+	Chair     *Chair     `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+	Location  *Location  `json:"location,omitempty" gorm:"foreignKey:LocationID" validate:"-"`
+	Therapist *Therapist `json:"therapist,omitempty" gorm:"foreignKey:TherapistID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (BookingFeedback) TableName() string {
+	return "booking_feedbacks"
+}
+
+// SetBooking vincula a avaliação ao agendamento, copiando dele o autor, a cadeira,
+// a localidade da cadeira e o massoterapeuta
+func (f *BookingFeedback) SetBooking(booking *Booking) {
+	f.BookingID = booking.ID
+	f.UserID = booking.UserID
+	f.ChairID = booking.ChairID
+	f.LocationID = booking.Chair.LocationID
+	f.TherapistID = booking.TherapistID
+}
+
+// Validate verifica a nota e se o problema apontado faz sentido para a sessão
+func (f *BookingFeedback) Validate() error {
+	if f.Rating < FeedbackMinRating || f.Rating > FeedbackMaxRating {
+		return fmt.Errorf("nota deve estar entre %d e %d", FeedbackMinRating, FeedbackMaxRating)
+	}
+	if f.TherapistIssue && f.TherapistID == nil {
+		return errors.New("sessão sem massoterapeuta registrado")
+	}
+	return nil
+}
+
+// IsPromoter verifica se a nota é de promotor
+func (f *BookingFeedback) IsPromoter() bool {
+	return f.Rating >= FeedbackPromoterRating
+}
+
+// IsDetractor verifica se a nota é de detrator
+func (f *BookingFeedback) IsDetractor() bool {
+	return f.Rating <= FeedbackDetractorRating
+}
+
+// FeedbackDeadline é o fim do prazo para avaliar a sessão
+func FeedbackDeadline(booking *Booking, window time.Duration) time.Time {
+	return booking.EndTime.Add(window)
+}
+
+// CheckFeedbackEligibility verifica se o agendamento pode ser avaliado: só sessões
+// realizadas, dentro do prazo contado do fim da sessão
+func CheckFeedbackEligibility(booking *Booking, now time.Time, window time.Duration) error {
+	if booking.Status != BookingStatusCompleted {
+		return errors.New("apenas sessões realizadas podem ser avaliadas")
+	}
+	if !now.Before(FeedbackDeadline(booking, window)) {
+		return errors.New("prazo para avaliar esta sessão encerrado")
+	}
+	return nil
+}
+
+// FeedbackTokenPayload monta o conteúdo assinado no token do convite de avaliação
+func FeedbackTokenPayload(bookingID uint, expiresAt time.Time) string {
+	return fmt.Sprintf("%s:%d:%d", feedbackTokenPrefix, bookingID, expiresAt.Unix())
+}
+
+// FeedbackTokenClaims é o conteúdo de um token de avaliação já verificado
+type FeedbackTokenClaims struct {
+	BookingID uint
+	ExpiresAt time.Time
+}
+
+// ParseFeedbackTokenPayload interpreta o conteúdo gerado por FeedbackTokenPayload
+func ParseFeedbackTokenPayload(payload string) (*FeedbackTokenClaims, error) {
+	parts := strings.Split(payload, ":")
+	if len(parts) != 3 || parts[0] != feedbackTokenPrefix {
+		return nil, errors.New("token de avaliação inválido")
+	}
+
+	bookingID, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, errors.New("token de avaliação inválido")
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, errors.New("token de avaliação inválido")
+	}
+
+	return &FeedbackTokenClaims{
+		BookingID: uint(bookingID),
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}, nil
+}
+
+// SatisfactionStats resume as avaliações de um grupo (cadeira, massoterapeuta ou
+// localidade) ou do tenant inteiro. Não é persistido: vem da agregação das avaliações
+type SatisfactionStats struct {
+	GroupID         uint
+	GroupName       string
+	Responses       int64
+	RatingSum       int64
+	Promoters       int64
+	Detractors      int64
+	ChairIssues     int64
+	TherapistIssues int64
+}
+
+// Passives conta as notas 7 e 8, de quem não é promotor nem detrator
+func (s *SatisfactionStats) Passives() int64 {
+	return s.Responses - s.Promoters - s.Detractors
+}
+
+// AverageRating é a nota média do grupo
+func (s *SatisfactionStats) AverageRating() float64 {
+	if s.Responses == 0 {
+		return 0
+	}
+	return float64(s.RatingSum) / float64(s.Responses)
+}
+
+// NPS é o percentual de promotores menos o de detratores, de -100 a 100
+func (s *SatisfactionStats) NPS() float64 {
+	if s.Responses == 0 {
+		return 0
+	}
+	return float64(s.Promoters-s.Detractors) / float64(s.Responses) * 100
+}
@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingFeedback_Validate(t *testing.T) {
+	therapistID := uint(3)
+
+	tests := []struct {
+		name     string
+		feedback BookingFeedback
+		wantErr  bool
+	}{
+		{"Nota mínima", BookingFeedback{Rating: 0}, false},
+		{"Nota máxima", BookingFeedback{Rating: 10}, false},
+		{"Nota acima da escala", BookingFeedback{Rating: 11}, true},
+		{"Nota negativa", BookingFeedback{Rating: -1}, true},
+		{"Problema com massoterapeuta registrado", BookingFeedback{Rating: 5, TherapistIssue: true, TherapistID: &therapistID}, false},
+		{"Problema com massoterapeuta sem plantão", BookingFeedback{Rating: 5, TherapistIssue: true}, true},
+		{"Problema com a cadeira", BookingFeedback{Rating: 3, ChairIssue: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.feedback.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBookingFeedback_Category(t *testing.T) {
+	tests := []struct {
+		rating    int
+		promoter  bool
+		detractor bool
+	}{
+		{10, true, false},
+		{9, true, false},
+		{8, false, false},
+		{7, false, false},
+		{6, false, true},
+		{0, false, true},
+	}
+
+	for _, tt := range tests {
+		feedback := &BookingFeedback{Rating: tt.rating}
+		assert.Equal(t, tt.promoter, feedback.IsPromoter(), "nota %d", tt.rating)
+		assert.Equal(t, tt.detractor, feedback.IsDetractor(), "nota %d", tt.rating)
+	}
+}
+
+func TestCheckFeedbackEligibility(t *testing.T) {
+	end := time.Date(2025, 3, 10, 10, 30, 0, 0, time.UTC)
+	window := 7 * 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		status  string
+		now     time.Time
+		wantErr bool
+	}{
+		{"Sessão realizada no prazo", BookingStatusCompleted, end.Add(time.Hour), false},
+		{"Último instante antes do prazo", BookingStatusCompleted, end.Add(window - time.Second), false},
+		{"Prazo encerrado", BookingStatusCompleted, end.Add(window), true},
+		{"Sessão ainda agendada", BookingStatusScheduled, end.Add(time.Hour), true},
+		{"Sessão com falta", BookingStatusNoShow, end.Add(time.Hour), true},
+		{"Sessão cancelada", BookingStatusCancelled, end.Add(time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := &Booking{Status: tt.status, EndTime: end}
+			err := CheckFeedbackEligibility(booking, tt.now, window)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBookingFeedback_SetBooking(t *testing.T) {
+	locationID, therapistID := uint(4), uint(3)
+	booking := &Booking{
+		ID: 10, UserID: 7, ChairID: 2, TherapistID: &therapistID,
+		Chair: Chair{ID: 2, LocationID: &locationID},
+	}
+
+	feedback := &BookingFeedback{Rating: 9}
+	feedback.SetBooking(booking)
+
+	assert.Equal(t, uint(10), feedback.BookingID)
+	assert.Equal(t, uint(7), feedback.UserID)
+	assert.Equal(t, uint(2), feedback.ChairID)
+	assert.Equal(t, &locationID, feedback.LocationID)
+	assert.Equal(t, &therapistID, feedback.TherapistID)
+}
+
+func TestFeedbackTokenPayload(t *testing.T) {
+	expiresAt := time.Date(2025, 3, 17, 10, 30, 0, 0, time.UTC)
+
+	claims, err := ParseFeedbackTokenPayload(FeedbackTokenPayload(42, expiresAt))
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), claims.BookingID)
+	assert.True(t, claims.ExpiresAt.Equal(expiresAt))
+
+	invalid := []string{
+		"",
+		"42:abc:1742207400",         // token de confirmação de presença
+		"avaliacao:x:1742207400",    // agendamento inválido
+		"avaliacao:42",              // sem prazo
+		"confirmacao:42:1742207400", // outro prefixo
+	}
+	for _, payload := range invalid {
+		_, err := ParseFeedbackTokenPayload(payload)
+		assert.Error(t, err, payload)
+	}
+}
+
+func TestSatisfactionStats(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    SatisfactionStats
+		passives int64
+		average  float64
+		nps      float64
+	}{
+		{"Sem respostas", SatisfactionStats{}, 0, 0, 0},
+		{"Só promotores", SatisfactionStats{Responses: 4, RatingSum: 38, Promoters: 4}, 0, 9.5, 100},
+		{"Só detratores", SatisfactionStats{Responses: 2, RatingSum: 6, Detractors: 2}, 0, 3, -100},
+		{"Mistura", SatisfactionStats{Responses: 10, RatingSum: 75, Promoters: 5, Detractors: 2}, 3, 7.5, 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.passives, tt.stats.Passives())
+			assert.InDelta(t, tt.average, tt.stats.AverageRating(), 0.001)
+			assert.InDelta(t, tt.nps, tt.stats.NPS(), 0.001)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Códigos de violação de política retornados para o cliente
+const (
+	PolicyViolationMaxActiveBookings  = "MAX_ACTIVE_BOOKINGS"
+	PolicyViolationBookingHorizon     = "BOOKING_HORIZON_EXCEEDED"
+	PolicyViolationMinLeadTime        = "MIN_LEAD_TIME"
+	PolicyViolationCancellationCutoff = "CANCELLATION_CUTOFF"
+	PolicyViolationDailyLimit         = "DAILY_LIMIT_REACHED"
+	PolicyViolationWeeklyLimit        = "WEEKLY_LIMIT_REACHED"
+)
+
+// BookingPolicy guarda regras de agendamento para um escopo (role, setor e/ou cadeira).
+// Campos de regra nulos herdam o valor de políticas menos específicas; zero significa sem limite
+type BookingPolicy struct {
+	ID          uint   `json:"id" gorm:"primaryKey"`
+	TenantID    uint   `json:"-" gorm:"not null;default:0;index"`
+	Name        string `json:"name" gorm:"size:100;not null" validate:"required,min=2,max=100"`
+	Description string `json:"description" gorm:"size:255"`
+
+	// Escopo (vazio/nulo = qualquer)
+	Role    string `json:"role" gorm:"size:20;index" validate:"omitempty,oneof=usuario atendente admin"`
+	Sector  string `json:"sector" gorm:"size:100;index"`
+	ChairID *uint  `json:"chair_id" gorm:"index"`
+
+	// Regras
+	MaxActiveBookings         *int `json:"max_active_bookings" validate:"omitempty,min=0"`
+	BookingHorizonDays        *int `json:"booking_horizon_days" validate:"omitempty,min=0"`
+	MinLeadTimeMinutes        *int `json:"min_lead_time_minutes" validate:"omitempty,min=0"`
+	CancellationCutoffMinutes *int `json:"cancellation_cutoff_minutes" validate:"omitempty,min=0"`
+	MaxDailyBookings          *int `json:"max_daily_bookings" validate:"omitempty,min=0"`
+	MaxWeeklyBookings         *int `json:"max_weekly_bookings" validate:"omitempty,min=0"`
+
+	IsActive  bool           `json:"is_active" gorm:"not null"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	Chair *Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (BookingPolicy) TableName() string {
+	return "booking_policies"
+}
+
+// Matches verifica se a política se aplica ao usuário e à cadeira
+func (p *BookingPolicy) Matches(role, sector string, chairID uint) bool {
+	if !p.IsActive {
+		return false
+	}
+	if p.Role != "" && p.Role != role {
+		return false
+	}
+	if p.Sector != "" && !strings.EqualFold(p.Sector, sector) {
+		return false
+	}
+	if p.ChairID != nil && *p.ChairID != chairID {
+		return false
+	}
+	return true
+}
+
+// Specificity indica o quão específico é o escopo. Cadeira pesa mais que setor,
+// que pesa mais que role, para que a regra mais próxima do caso prevaleça
+func (p *BookingPolicy) Specificity() int {
+	score := 0
+	if p.ChairID != nil {
+		score += 4
+	}
+	if p.Sector != "" {
+		score += 2
+	}
+	if p.Role != "" {
+		score++
+	}
+	return score
+}
+
+// PolicyRules é o conjunto efetivo de regras após combinar as políticas aplicáveis.
+// Zero desativa o limite correspondente
+type PolicyRules struct {
+	MaxActiveBookings  int           `json:"max_active_bookings"`
+	BookingHorizon     time.Duration `json:"booking_horizon"`
+	MinLeadTime        time.Duration `json:"min_lead_time"`
+	CancellationCutoff time.Duration `json:"cancellation_cutoff"`
+	MaxDailyBookings   int           `json:"max_daily_bookings"`
+	MaxWeeklyBookings  int           `json:"max_weekly_bookings"`
+}
+
+// DefaultPolicyRules reproduz as regras usadas antes das políticas configuráveis:
+// um agendamento ativo por usuário e cancelamento até 3 horas antes
+func DefaultPolicyRules() PolicyRules {
+	return PolicyRules{
+		MaxActiveBookings:  1,
+		CancellationCutoff: DefaultCancellationCutoff,
+	}
+}
+
+// ApplyTo sobrescreve nas regras os campos definidos pela política
+func (p *BookingPolicy) ApplyTo(rules *PolicyRules) {
+	if p.MaxActiveBookings != nil {
+		rules.MaxActiveBookings = *p.MaxActiveBookings
+	}
+	if p.BookingHorizonDays != nil {
+		rules.BookingHorizon = time.Duration(*p.BookingHorizonDays) * 24 * time.Hour
+	}
+	if p.MinLeadTimeMinutes != nil {
+		rules.MinLeadTime = time.Duration(*p.MinLeadTimeMinutes) * time.Minute
+	}
+	if p.CancellationCutoffMinutes != nil {
+		rules.CancellationCutoff = time.Duration(*p.CancellationCutoffMinutes) * time.Minute
+	}
+	if p.MaxDailyBookings != nil {
+		rules.MaxDailyBookings = *p.MaxDailyBookings
+	}
+	if p.MaxWeeklyBookings != nil {
+		rules.MaxWeeklyBookings = *p.MaxWeeklyBookings
+	}
+}
+
+// PolicyViolation descreve uma regra de política violada com código estável para o cliente
+type PolicyViolation struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implementa a interface error
+func (v *PolicyViolation) Error() string {
+	return v.Message
+}
+
+// NewPolicyViolation cria uma violação de política
+func NewPolicyViolation(code, message string) *PolicyViolation {
+	return &PolicyViolation{Code: code, Message: message}
+}
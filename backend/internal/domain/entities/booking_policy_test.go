@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func TestBookingPolicy_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   BookingPolicy
+		role     string
+		sector   string
+		chairID  uint
+		expected bool
+	}{
+		{"Global se aplica a todos", BookingPolicy{IsActive: true}, "usuario", "TI", 1, true},
+		{"Inativa não se aplica", BookingPolicy{IsActive: false}, "usuario", "TI", 1, false},
+		{"Role igual", BookingPolicy{IsActive: true, Role: "usuario"}, "usuario", "TI", 1, true},
+		{"Role diferente", BookingPolicy{IsActive: true, Role: "atendente"}, "usuario", "TI", 1, false},
+		{"Setor sem diferenciar maiúsculas", BookingPolicy{IsActive: true, Sector: "ti"}, "usuario", "TI", 1, true},
+		{"Setor diferente", BookingPolicy{IsActive: true, Sector: "RH"}, "usuario", "TI", 1, false},
+		{"Cadeira igual", BookingPolicy{IsActive: true, ChairID: uintPtr(1)}, "usuario", "TI", 1, true},
+		{"Cadeira diferente", BookingPolicy{IsActive: true, ChairID: uintPtr(2)}, "usuario", "TI", 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.Matches(tt.role, tt.sector, tt.chairID))
+		})
+	}
+}
+
+func TestBookingPolicy_Specificity(t *testing.T) {
+	global := &BookingPolicy{}
+	role := &BookingPolicy{Role: "usuario"}
+	sector := &BookingPolicy{Sector: "TI"}
+	chair := &BookingPolicy{ChairID: uintPtr(1)}
+
+	assert.Less(t, global.Specificity(), role.Specificity())
+	assert.Less(t, role.Specificity(), sector.Specificity())
+	assert.Less(t, sector.Specificity(), chair.Specificity())
+}
+
+func TestBookingPolicy_ApplyTo(t *testing.T) {
+	rules := DefaultPolicyRules()
+	assert.Equal(t, 1, rules.MaxActiveBookings)
+	assert.Equal(t, DefaultCancellationCutoff, rules.CancellationCutoff)
+
+	// Campos nulos herdam o valor anterior
+	sectorPolicy := &BookingPolicy{MaxActiveBookings: intPtr(2), BookingHorizonDays: intPtr(7)}
+	sectorPolicy.ApplyTo(&rules)
+
+	assert.Equal(t, 2, rules.MaxActiveBookings)
+	assert.Equal(t, 7*24*time.Hour, rules.BookingHorizon)
+	assert.Equal(t, DefaultCancellationCutoff, rules.CancellationCutoff)
+
+	chairPolicy := &BookingPolicy{
+		MinLeadTimeMinutes:        intPtr(60),
+		CancellationCutoffMinutes: intPtr(30),
+		MaxDailyBookings:          intPtr(1),
+		MaxWeeklyBookings:         intPtr(3),
+	}
+	chairPolicy.ApplyTo(&rules)
+
+	assert.Equal(t, 2, rules.MaxActiveBookings)
+	assert.Equal(t, time.Hour, rules.MinLeadTime)
+	assert.Equal(t, 30*time.Minute, rules.CancellationCutoff)
+	assert.Equal(t, 1, rules.MaxDailyBookings)
+	assert.Equal(t, 3, rules.MaxWeeklyBookings)
+}
+
+func TestPolicyViolation_Error(t *testing.T) {
+	violation := NewPolicyViolation(PolicyViolationDailyLimit, "limite diário atingido")
+
+	var err error = violation
+	assert.Equal(t, "limite diário atingido", err.Error())
+	assert.Equal(t, PolicyViolationDailyLimit, violation.Code)
+}
+
+func TestBookingPolicy_TableName(t *testing.T) {
+	policy := &BookingPolicy{}
+	assert.Equal(t, "booking_policies", policy.TableName())
+}
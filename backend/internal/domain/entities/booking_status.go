@@ -0,0 +1,115 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// Status de um agendamento
+const (
+	BookingStatusScheduled         = "agendado"
+	BookingStatusPresenceConfirmed = "presenca_confirmada"
+	BookingStatusCancelled         = "cancelado"
+	BookingStatusCompleted         = "realizado"
+	BookingStatusNoShow            = "falta"
+)
+
+// Origem de uma mudança de status
+const (
+	StatusSourceUser      = "usuario"
+	StatusSourceAttendant = "atendente"
+	StatusSourceAdmin     = "admin"
+	StatusSourceScheduler = "sistema"
+)
+
+// bookingTransitions lista, para cada status, os status que podem vir em seguida.
+// Cancelado, realizado e falta são finais
+var bookingTransitions = map[string][]string{
+	BookingStatusScheduled: {
+		BookingStatusPresenceConfirmed,
+		BookingStatusCancelled,
+		BookingStatusCompleted,
+		BookingStatusNoShow,
+	},
+	BookingStatusPresenceConfirmed: {
+		BookingStatusCancelled,
+		BookingStatusCompleted,
+		BookingStatusNoShow,
+	},
+}
+
+// CanTransitionBookingStatus verifica se a mudança de status é permitida
+func CanTransitionBookingStatus(from, to string) bool {
+	for _, allowed := range bookingTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBookingTransition retorna erro quando a mudança de status não é permitida
+func ValidateBookingTransition(from, to string) error {
+	if !CanTransitionBookingStatus(from, to) {
+		return fmt.Errorf("transição de status inválida: %s -> %s", from, to)
+	}
+	return nil
+}
+
+// BookingActor identifica quem provocou uma mudança de status.
+// UserID nulo indica uma ação automática do sistema
+type BookingActor struct {
+	UserID *uint
+	Source string
+}
+
+// NewBookingActor cria o ator a partir do usuário e da sua role
+func NewBookingActor(userID uint, role string) BookingActor {
+	source := StatusSourceUser
+	switch role {
+	case "atendente":
+		source = StatusSourceAttendant
+	case "admin":
+		source = StatusSourceAdmin
+	}
+	return BookingActor{UserID: &userID, Source: source}
+}
+
+// SystemBookingActor retorna o ator usado pelas rotinas automáticas do scheduler
+func SystemBookingActor() BookingActor {
+	return BookingActor{Source: StatusSourceScheduler}
+}
+
+// BookingStatusHistory registra cada mudança de status de um agendamento
+type BookingStatusHistory struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	TenantID   uint      `json:"-" gorm:"not null;default:0;index"`
+	BookingID  uint      `json:"booking_id" gorm:"not null;index" validate:"required"`
+	FromStatus string    `json:"from_status" gorm:"size:20"` // vazio na criação
+	ToStatus   string    `json:"to_status" gorm:"size:20;not null" validate:"required"`
+	ActorID    *uint     `json:"actor_id" gorm:"index"`
+	Source     string    `json:"source" gorm:"size:20;not null" validate:"oneof=usuario atendente admin sistema"`
+	Reason     string    `json:"reason" gorm:"size:500"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Relacionamentos
+	Actor *User `json:"actor,omitempty" gorm:"foreignKey:ActorID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (BookingStatusHistory) TableName() string {
+	return "booking_status_history"
+}
+
+// NewBookingStatusHistory cria o registro de uma mudança de status
+func NewBookingStatusHistory(bookingID uint, from, to string, actor BookingActor, reason string) *BookingStatusHistory {
+	return &BookingStatusHistory{
+		BookingID:  bookingID,
+		FromStatus: from,
+		ToStatus:   to,
+		ActorID:    actor.UserID,
+		Source:     actor.Source,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransitionBookingStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected bool
+	}{
+		{"Agendado para presença confirmada", BookingStatusScheduled, BookingStatusPresenceConfirmed, true},
+		{"Agendado para cancelado", BookingStatusScheduled, BookingStatusCancelled, true},
+		{"Agendado para falta", BookingStatusScheduled, BookingStatusNoShow, true},
+		{"Presença confirmada para realizado", BookingStatusPresenceConfirmed, BookingStatusCompleted, true},
+		{"Presença confirmada para agendado", BookingStatusPresenceConfirmed, BookingStatusScheduled, false},
+		{"Cancelado é final", BookingStatusCancelled, BookingStatusScheduled, false},
+		{"Realizado é final", BookingStatusCompleted, BookingStatusCancelled, false},
+		{"Falta é final", BookingStatusNoShow, BookingStatusCompleted, false},
+		{"Mesmo status", BookingStatusScheduled, BookingStatusScheduled, false},
+		{"Status desconhecido", "inexistente", BookingStatusCancelled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CanTransitionBookingStatus(tt.from, tt.to))
+		})
+	}
+}
+
+func TestValidateBookingTransition(t *testing.T) {
+	assert.NoError(t, ValidateBookingTransition(BookingStatusScheduled, BookingStatusCancelled))
+
+	err := ValidateBookingTransition(BookingStatusCancelled, BookingStatusCompleted)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cancelado -> realizado")
+}
+
+func TestNewBookingActor(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		expected string
+	}{
+		{"Usuário comum", "usuario", StatusSourceUser},
+		{"Atendente", "atendente", StatusSourceAttendant},
+		{"Admin", "admin", StatusSourceAdmin},
+		{"Role desconhecida", "", StatusSourceUser},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor := NewBookingActor(7, tt.role)
+			assert.Equal(t, tt.expected, actor.Source)
+			assert.Equal(t, uint(7), *actor.UserID)
+		})
+	}
+
+	system := SystemBookingActor()
+	assert.Nil(t, system.UserID)
+	assert.Equal(t, StatusSourceScheduler, system.Source)
+}
+
+func TestNewBookingStatusHistory(t *testing.T) {
+	actor := NewBookingActor(3, "atendente")
+	entry := NewBookingStatusHistory(10, BookingStatusScheduled, BookingStatusCancelled, actor, "Cadeira em manutenção")
+
+	assert.Equal(t, uint(10), entry.BookingID)
+	assert.Equal(t, BookingStatusScheduled, entry.FromStatus)
+	assert.Equal(t, BookingStatusCancelled, entry.ToStatus)
+	assert.Equal(t, uint(3), *entry.ActorID)
+	assert.Equal(t, StatusSourceAttendant, entry.Source)
+	assert.Equal(t, "Cadeira em manutenção", entry.Reason)
+	assert.False(t, entry.CreatedAt.IsZero())
+	assert.Equal(t, "booking_status_history", entry.TableName())
+}
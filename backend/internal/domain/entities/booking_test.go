@@ -329,3 +329,76 @@ func TestBooking_TableName(t *testing.T) {
 	booking := &Booking{}
 	assert.Equal(t, "bookings", booking.TableName())
 }
+
+func TestBooking_SessionDuration(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		booking  *Booking
+		expected time.Duration
+	}{
+		{
+			name:     "Intervalo gravado prevalece sobre o serviço editado",
+			booking:  &Booking{StartTime: start, EndTime: start.Add(30 * time.Minute), Service: &Service{ID: 1, DurationMinutes: 60}},
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "Sem término usa a duração do serviço",
+			booking:  &Booking{StartTime: start, Service: &Service{ID: 1, DurationMinutes: 60}},
+			expected: time.Hour,
+		},
+		{
+			name:     "Sem serviço usa o intervalo gravado",
+			booking:  &Booking{StartTime: start, EndTime: start.Add(45 * time.Minute)},
+			expected: 45 * time.Minute,
+		},
+		{
+			name:     "Sem serviço e sem término usa padrão",
+			booking:  &Booking{StartTime: start},
+			expected: DefaultSessionDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.booking.SessionDuration())
+		})
+	}
+}
+
+func TestBooking_BeforeCreate_EndTime(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Fim informado é mantido mesmo com outro serviço carregado", func(t *testing.T) {
+		booking := &Booking{StartTime: start, EndTime: start.Add(30 * time.Minute), Service: &Service{ID: 1, DurationMinutes: 60}}
+		assert.NoError(t, booking.BeforeCreate(nil))
+		assert.Equal(t, start.Add(30*time.Minute), booking.EndTime)
+	})
+
+	t.Run("Sem fim válido usa a duração do serviço", func(t *testing.T) {
+		booking := &Booking{StartTime: start, EndTime: start, Service: &Service{ID: 1, DurationMinutes: 60}}
+		assert.NoError(t, booking.BeforeCreate(nil))
+		assert.Equal(t, start.Add(time.Hour), booking.EndTime)
+	})
+
+	t.Run("Sem fim e sem serviço usa a duração padrão", func(t *testing.T) {
+		booking := &Booking{StartTime: start}
+		assert.NoError(t, booking.BeforeCreate(nil))
+		assert.Equal(t, start.Add(DefaultSessionDuration), booking.EndTime)
+	})
+}
+
+func TestBooking_CanBeCancelledWithin(t *testing.T) {
+	booking := &Booking{
+		StartTime: time.Now().Add(2 * time.Hour),
+		Status:    "agendado",
+	}
+
+	assert.True(t, booking.CanBeCancelledWithin(time.Hour))
+	assert.False(t, booking.CanBeCancelledWithin(3*time.Hour))
+	assert.True(t, booking.CanBeCancelledWithin(0))
+
+	booking.Status = "realizado"
+	assert.False(t, booking.CanBeCancelledWithin(0))
+}
@@ -8,6 +8,7 @@ import (
 
 type Chair struct {
 	ID          uint           `json:"id" gorm:"primaryKey"`
+	TenantID    uint           `json:"-" gorm:"not null;default:0;index"`
 	Name        string         `json:"name" gorm:"size:100;not null" validate:"required,min=2,max=100"`
 	Description string         `json:"description" gorm:"size:255"`
 	Location    string         `json:"location" gorm:"size:100;not null" validate:"required,min=2,max=100"`
@@ -16,9 +17,16 @@ type Chair struct {
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
 
+	// Minutos reservados à higienização da cadeira depois de cada sessão
+	BufferMinutes int `json:"buffer_minutes" gorm:"not null;default:0" validate:"min=0,max=120"`
+
+	// Localidade cadastrada; Location guarda uma cópia do nome dela
+	LocationID *uint `json:"location_id,omitempty" gorm:"index"`
+
 	// Relacionamentos
 	Bookings       []Booking       `json:"bookings,omitempty" gorm:"foreignKey:ChairID"`
 	Availabilities []Availability  `json:"availabilities,omitempty" gorm:"foreignKey:ChairID"`
+	Services       []Service       `json:"services,omitempty" gorm:"many2many:chair_services;"`
 }
 
 // TableName especifica o nome da tabela
@@ -53,3 +61,18 @@ func (c *Chair) SetInactive() {
 func (c *Chair) SetActive() {
 	c.Status = "ativa"
 }
+
+// BufferDuration retorna a folga de higienização entre sessões da cadeira
+func (c *Chair) BufferDuration() time.Duration {
+	return time.Duration(c.BufferMinutes) * time.Minute
+}
+
+// SupportsService verifica se a cadeira oferece o serviço informado
+func (c *Chair) SupportsService(serviceID uint) bool {
+	for _, service := range c.Services {
+		if service.ID == serviceID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ErrNoChairAvailable indica que nenhuma cadeira atende ao horário pedido no agendamento sem cadeira definida
+var ErrNoChairAvailable = errors.New("nenhuma cadeira disponível para este horário")
+
+// RankChairsByLoad ordena as cadeiras candidatas da menos para a mais ocupada,
+// distribuindo os agendamentos sem cadeira definida. Empates ficam com a cadeira
+// de menor ID para que a escolha seja previsível
+func RankChairsByLoad(chairs []*Chair, loads map[uint]int64) []*Chair {
+	ranked := make([]*Chair, len(chairs))
+	copy(ranked, chairs)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if loads[ranked[i].ID] != loads[ranked[j].ID] {
+			return loads[ranked[i].ID] < loads[ranked[j].ID]
+		}
+		return ranked[i].ID < ranked[j].ID
+	})
+
+	return ranked
+}
+
+// MatchesLocation verifica se a cadeira fica na localidade informada. Localidade
+// vazia aceita qualquer cadeira
+func (c *Chair) MatchesLocation(location string) bool {
+	return location == "" || strings.EqualFold(strings.TrimSpace(c.Location), strings.TrimSpace(location))
+}
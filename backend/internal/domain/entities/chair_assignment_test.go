@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankChairsByLoad(t *testing.T) {
+	chairs := []*Chair{{ID: 3}, {ID: 1}, {ID: 2}}
+
+	tests := []struct {
+		name     string
+		loads    map[uint]int64
+		expected []uint
+	}{
+		{"Menos ocupada primeiro", map[uint]int64{1: 5, 2: 1, 3: 3}, []uint{2, 3, 1}},
+		{"Empate decidido pelo menor ID", map[uint]int64{1: 2, 2: 2, 3: 2}, []uint{1, 2, 3}},
+		{"Cadeira sem agendamentos tem carga zero", map[uint]int64{1: 1, 2: 1}, []uint{3, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := RankChairsByLoad(chairs, tt.loads)
+			ids := make([]uint, len(ranked))
+			for i, chair := range ranked {
+				ids[i] = chair.ID
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+
+	// A lista original não é alterada
+	assert.Equal(t, uint(3), chairs[0].ID)
+}
+
+func TestChair_MatchesLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		expected bool
+	}{
+		{"Sem filtro", "", true},
+		{"Mesma localidade", "Térreo", true},
+		{"Maiúsculas e espaços", " TÉRREO ", true},
+		{"Outra localidade", "2º andar", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chair := &Chair{Location: "Térreo"}
+			assert.Equal(t, tt.expected, chair.MatchesLocation(tt.location))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ChairBreak é uma pausa nomeada da cadeira (almoço, higienização geral) em que
+// nenhuma sessão é oferecida. Sem dia da semana a pausa se repete todos os dias
+type ChairBreak struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
+	ChairID   uint           `json:"chair_id" gorm:"not null;index" validate:"required"`
+	Name      string         `json:"name" gorm:"size:100;not null" validate:"required,min=2,max=100"`
+	DayOfWeek *int           `json:"day_of_week,omitempty" validate:"omitempty,min=0,max=6"` // 0=Domingo, 6=Sábado
+	StartTime string         `json:"start_time" gorm:"size:5;not null" validate:"required"`  // Formato HH:MM
+	EndTime   string         `json:"end_time" gorm:"size:5;not null" validate:"required"`    // Formato HH:MM
+	CreatedBy *uint          `json:"created_by,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	Chair *Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (ChairBreak) TableName() string {
+	return "chair_breaks"
+}
+
+// Validate verifica o formato dos horários e se o fim é posterior ao início
+func (b *ChairBreak) Validate() error {
+	start, end, err := b.minutes()
+	if err != nil {
+		return errors.New("horário da pausa inválido. Use o formato HH:MM (exemplo: 12:00)")
+	}
+	if end <= start {
+		return errors.New("fim da pausa deve ser posterior ao início")
+	}
+	return nil
+}
+
+// AppliesOn verifica se a pausa vale para o dia da semana da data
+func (b *ChairBreak) AppliesOn(date time.Time) bool {
+	return b.DayOfWeek == nil || *b.DayOfWeek == int(date.Weekday())
+}
+
+// Overlaps verifica se duas pausas da cadeira coincidem em algum dia e horário
+func (b *ChairBreak) Overlaps(other *ChairBreak) bool {
+	if b.DayOfWeek != nil && other.DayOfWeek != nil && *b.DayOfWeek != *other.DayOfWeek {
+		return false
+	}
+	return b.StartTime < clockEnd(other.EndTime) && other.StartTime < clockEnd(b.EndTime)
+}
+
+// OverlapsPeriod verifica se a pausa corta o período informado, comparando os
+// horários no relógio local do período
+func (b *ChairBreak) OverlapsPeriod(start, end time.Time) bool {
+	if !b.AppliesOn(start) {
+		return false
+	}
+	breakStart, breakEnd, err := b.minutes()
+	if err != nil {
+		return false
+	}
+	periodStart := start.Hour()*60 + start.Minute()
+	periodEnd := periodStart + int(end.Sub(start).Minutes())
+	return periodStart < breakEnd && breakStart < periodEnd
+}
+
+// GetDayOfWeekName retorna o nome do dia da semana ou "Todos os dias"
+func (b *ChairBreak) GetDayOfWeekName() string {
+	if b.DayOfWeek == nil {
+		return "Todos os dias"
+	}
+	return (&Availability{DayOfWeek: *b.DayOfWeek}).GetDayOfWeekName()
+}
+
+// minutes retorna início e fim da pausa em minutos desde a meia-noite
+func (b *ChairBreak) minutes() (int, int, error) {
+	start, err := clockMinutes(b.StartTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := clockMinutes(clockEnd(b.EndTime))
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// clockMinutes converte um horário HH:MM (aceitando "24:00") em minutos desde a meia-noite
+func clockMinutes(clock string) (int, error) {
+	if clock == "24:00" {
+		return 24 * 60, nil
+	}
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
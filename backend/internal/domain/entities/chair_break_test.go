@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChairBreak_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		startTime string
+		endTime   string
+		wantErr   bool
+	}{
+		{"Pausa válida", "12:00", "13:00", false},
+		{"Pausa até a meia-noite", "23:00", "00:00", false},
+		{"Fim antes do início", "13:00", "12:00", true},
+		{"Fim igual ao início", "12:00", "12:00", true},
+		{"Formato inválido", "12h", "13:00", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chairBreak := &ChairBreak{StartTime: tt.startTime, EndTime: tt.endTime}
+			err := chairBreak.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChairBreak_Overlaps(t *testing.T) {
+	monday := 1
+	tuesday := 2
+
+	tests := []struct {
+		name     string
+		a        *ChairBreak
+		b        *ChairBreak
+		expected bool
+	}{
+		{"Mesmo horário todos os dias", &ChairBreak{StartTime: "12:00", EndTime: "13:00"}, &ChairBreak{StartTime: "12:30", EndTime: "13:30"}, true},
+		{"Horários encostados", &ChairBreak{StartTime: "12:00", EndTime: "13:00"}, &ChairBreak{StartTime: "13:00", EndTime: "13:30"}, false},
+		{"Dias diferentes", &ChairBreak{DayOfWeek: &monday, StartTime: "12:00", EndTime: "13:00"}, &ChairBreak{DayOfWeek: &tuesday, StartTime: "12:00", EndTime: "13:00"}, false},
+		{"Pausa diária e pausa de um dia", &ChairBreak{StartTime: "12:00", EndTime: "13:00"}, &ChairBreak{DayOfWeek: &tuesday, StartTime: "12:30", EndTime: "12:45"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.a.Overlaps(tt.b))
+		})
+	}
+}
+
+func TestChairBreak_OverlapsPeriod(t *testing.T) {
+	monday := 1
+	lunch := &ChairBreak{DayOfWeek: &monday, StartTime: "12:00", EndTime: "13:00"}
+	day := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC) // segunda-feira
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		expected bool
+	}{
+		{"Sessão antes da pausa", day.Add(11*time.Hour + 30*time.Minute), false},
+		{"Sessão invadindo a pausa", day.Add(11*time.Hour + 45*time.Minute), true},
+		{"Sessão depois da pausa", day.Add(13 * time.Hour), false},
+		{"Outro dia da semana", day.AddDate(0, 0, 1).Add(12 * time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, lunch.OverlapsPeriod(tt.start, tt.start.Add(30*time.Minute)))
+		})
+	}
+}
+
+func TestChairBreak_GetDayOfWeekName(t *testing.T) {
+	friday := 5
+	assert.Equal(t, "Todos os dias", (&ChairBreak{}).GetDayOfWeekName())
+	assert.Equal(t, "Sexta", (&ChairBreak{DayOfWeek: &friday}).GetDayOfWeekName())
+}
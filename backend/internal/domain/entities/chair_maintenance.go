@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Destino de um agendamento atingido pela indisponibilidade da cadeira
+const (
+	OutageActionRelocate = "transferir"
+	OutageActionCancel   = "cancelar"
+)
+
+// ChairMaintenance é uma janela programada em que a cadeira fica fora de serviço.
+// Durante a janela nenhuma sessão é oferecida na cadeira; os agendamentos que já
+// existiam são transferidos para outra cadeira ou cancelados no cadastro
+type ChairMaintenance struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
+	ChairID   uint           `json:"chair_id" gorm:"not null;index" validate:"required"`
+	StartTime time.Time      `json:"start_time" gorm:"not null;index" validate:"required"`
+	EndTime   time.Time      `json:"end_time" gorm:"not null;index" validate:"required"`
+	Reason    string         `json:"reason" gorm:"size:255;not null" validate:"required,min=2,max=255"`
+	CreatedBy *uint          `json:"created_by,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Resultado do cadastro: agendamentos transferidos e cancelados
+	RelocatedBookings int `json:"relocated_bookings" gorm:"not null;default:0"`
+	CancelledBookings int `json:"cancelled_bookings" gorm:"not null;default:0"`
+
+	// Relacionamentos
+	Chair *Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (ChairMaintenance) TableName() string {
+	return "chair_maintenances"
+}
+
+// Validate verifica se o fim da janela é posterior ao início
+func (m *ChairMaintenance) Validate() error {
+	if m.StartTime.IsZero() || m.EndTime.IsZero() {
+		return errors.New("início e fim da manutenção são obrigatórios")
+	}
+	if !m.EndTime.After(m.StartTime) {
+		return errors.New("fim da manutenção deve ser posterior ao início")
+	}
+	return nil
+}
+
+// OverlapsPeriod verifica se a manutenção ocupa algum instante do período
+func (m *ChairMaintenance) OverlapsPeriod(start, end time.Time) bool {
+	return m.StartTime.Before(end) && start.Before(m.EndTime)
+}
+
+// OverlapsOnDay verifica se a manutenção ocupa algum minuto do intervalo
+// [startMinute, endMinute) do dia, lendo os horários no fuso de date (o da
+// localidade da cadeira), como os agendamentos no cálculo de horários livres
+func (m *ChairMaintenance) OverlapsOnDay(date time.Time, startMinute, endMinute int) bool {
+	startTime, endTime := m.StartTime.In(date.Location()), m.EndTime.In(date.Location())
+	day := closureDay(date)
+	first, last := closureDay(startTime), closureDay(endTime)
+	if day.Before(first) || day.After(last) {
+		return false
+	}
+
+	from, to := 0, 24*60
+	if day.Equal(first) {
+		from = startTime.Hour()*60 + startTime.Minute()
+	}
+	if day.Equal(last) {
+		to = endTime.Hour()*60 + endTime.Minute()
+	}
+	return startMinute < to && from < endMinute
+}
+
+// OutageImpact descreve o destino de um agendamento atingido por manutenção ou
+// desativação da cadeira. Não é persistido: resume o processamento e a prévia
+type OutageImpact struct {
+	Booking     *Booking
+	Action      string // transferir ou cancelar
+	TargetChair *Chair // cadeira de destino, quando transferido
+	Detail      string // por que não foi possível transferir
+}
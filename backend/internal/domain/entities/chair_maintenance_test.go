@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChairMaintenance_Validate(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		end     time.Time
+		wantErr bool
+	}{
+		{"Janela válida", start.Add(2 * time.Hour), false},
+		{"Fim igual ao início", start, true},
+		{"Fim antes do início", start.Add(-time.Hour), true},
+		{"Sem fim", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maintenance := &ChairMaintenance{StartTime: start, EndTime: tt.end}
+			err := maintenance.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChairMaintenance_OverlapsOnDay(t *testing.T) {
+	// Manutenção de segunda 14:00 até terça 10:00
+	maintenance := &ChairMaintenance{
+		StartTime: time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 3, 11, 10, 0, 0, 0, time.UTC),
+	}
+	monday := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		start    int
+		expected bool
+	}{
+		{"Antes do início no primeiro dia", monday, 13 * 60, false},
+		{"Invadindo o início", monday, 13*60 + 45, true},
+		{"Noite do primeiro dia", monday, 22 * 60, true},
+		{"Manhã do último dia", monday.AddDate(0, 0, 1), 9 * 60, true},
+		{"Depois do fim no último dia", monday.AddDate(0, 0, 1), 10 * 60, false},
+		{"Dia seguinte ao fim", monday.AddDate(0, 0, 2), 9 * 60, false},
+		{"Dia anterior ao início", monday.AddDate(0, 0, -1), 15 * 60, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, maintenance.OverlapsOnDay(tt.date, tt.start, tt.start+30))
+		})
+	}
+}
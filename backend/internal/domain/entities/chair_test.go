@@ -197,3 +197,17 @@ func TestChair_StatusTransitions(t *testing.T) {
 	assert.True(t, chair.IsActive())
 	assert.True(t, chair.IsAvailable())
 }
+
+func TestChair_SupportsService(t *testing.T) {
+	chair := &Chair{
+		ID:       1,
+		Name:     "Cadeira Teste",
+		Status:   "ativa",
+		Services: []Service{{ID: 1}, {ID: 3}},
+	}
+
+	assert.True(t, chair.SupportsService(1))
+	assert.True(t, chair.SupportsService(3))
+	assert.False(t, chair.SupportsService(2))
+	assert.False(t, (&Chair{}).SupportsService(1))
+}
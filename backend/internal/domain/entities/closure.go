@@ -0,0 +1,108 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Origem de um fechamento
+const (
+	ClosureSourceManual = "manual"
+	ClosureSourceICS    = "ics"
+)
+
+// ErrClosureLocationNotFound indica que a localidade informada no fechamento não está cadastrada
+var ErrClosureLocationNotFound = errors.New("localidade do fechamento não encontrada")
+
+// Closure representa um feriado ou fechamento do escritório. Sem LocationID vale para
+// todas as cadeiras; com LocationID, apenas para as cadeiras daquela localidade.
+// StartDate e EndDate são inclusivos
+type Closure struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	TenantID    uint           `json:"-" gorm:"not null;default:0;index"`
+	Location    string         `json:"location" gorm:"size:100"`
+	StartDate   time.Time      `json:"start_date" gorm:"type:date;not null;index" validate:"required"`
+	EndDate     time.Time      `json:"end_date" gorm:"type:date;not null;index" validate:"required"`
+	Reason      string         `json:"reason" gorm:"size:255;not null" validate:"required,min=2,max=255"`
+	Source      string         `json:"source" gorm:"size:20;not null;default:'manual'" validate:"oneof=manual ics"`
+	ExternalUID string         `json:"-" gorm:"size:255;index"` // UID do evento importado, evita duplicar na reimportação
+	CreatedBy   *uint          `json:"created_by,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Localidade cadastrada; Location guarda uma cópia do nome dela
+	LocationID *uint `json:"location_id,omitempty" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (Closure) TableName() string {
+	return "closures"
+}
+
+// BeforeCreate hook executado antes de criar um fechamento
+func (c *Closure) BeforeCreate(tx *gorm.DB) error {
+	if c.Source == "" {
+		c.Source = ClosureSourceManual
+	}
+	return nil
+}
+
+// Validate verifica o intervalo de datas
+func (c *Closure) Validate() error {
+	if c.StartDate.IsZero() || c.EndDate.IsZero() {
+		return errors.New("datas de início e fim são obrigatórias")
+	}
+	if closureDay(c.EndDate).Before(closureDay(c.StartDate)) {
+		return errors.New("data de fim deve ser igual ou posterior à data de início")
+	}
+	return nil
+}
+
+// IsGlobal verifica se o fechamento vale para todas as localidades
+func (c *Closure) IsGlobal() bool {
+	return c.LocationID == nil
+}
+
+// SetLocation restringe o fechamento à localidade, ou o torna global quando nil
+func (c *Closure) SetLocation(location *Location) {
+	if location == nil {
+		c.LocationID = nil
+		c.Location = ""
+		return
+	}
+	c.LocationID = &location.ID
+	c.Location = location.Name
+}
+
+// AppliesToLocation verifica se o fechamento atinge as cadeiras da localidade
+// cadastrada. Cadeiras sem localidade só são atingidas por fechamentos globais
+func (c *Closure) AppliesToLocation(locationID *uint) bool {
+	return c.IsGlobal() || (locationID != nil && *c.LocationID == *locationID)
+}
+
+// CoversDate verifica se a data (dia do calendário) está dentro do fechamento
+func (c *Closure) CoversDate(date time.Time) bool {
+	day := closureDay(date)
+	return !day.Before(closureDay(c.StartDate)) && !day.After(closureDay(c.EndDate))
+}
+
+// Period retorna o intervalo ocupado pelo fechamento no fuso da localidade, da
+// meia-noite local do primeiro dia até a meia-noite local seguinte ao último dia
+func (c *Closure) Period(zone *time.Location) (time.Time, time.Time) {
+	start := time.Date(c.StartDate.Year(), c.StartDate.Month(), c.StartDate.Day(), 0, 0, 0, 0, zone)
+	end := time.Date(c.EndDate.Year(), c.EndDate.Month(), c.EndDate.Day()+1, 0, 0, 0, 0, zone)
+	return start, end
+}
+
+// Days retorna a quantidade de dias do fechamento
+func (c *Closure) Days() int {
+	return int(closureDay(c.EndDate).Sub(closureDay(c.StartDate)).Hours()/24) + 1
+}
+
+// closureDay reduz a data ao dia do calendário, sem horário nem fuso
+func closureDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
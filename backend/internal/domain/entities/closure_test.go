@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosure_Validate(t *testing.T) {
+	day := time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		closure Closure
+		wantErr bool
+	}{
+		{"Um único dia", Closure{StartDate: day, EndDate: day}, false},
+		{"Vários dias", Closure{StartDate: day, EndDate: day.AddDate(0, 0, 7)}, false},
+		{"Fim antes do início", Closure{StartDate: day, EndDate: day.AddDate(0, 0, -1)}, true},
+		{"Sem datas", Closure{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.closure.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClosure_AppliesToLocation(t *testing.T) {
+	sede, filial := uint(1), uint(2)
+
+	tests := []struct {
+		name     string
+		closure  Closure
+		location *uint
+		expected bool
+	}{
+		{"Global vale para qualquer localidade", Closure{}, &sede, true},
+		{"Global vale para cadeira sem localidade", Closure{}, nil, true},
+		{"Mesma localidade, mesmo com o nome antigo", Closure{LocationID: &sede, Location: "Sede - Antigo"}, &sede, true},
+		{"Outra localidade", Closure{LocationID: &filial, Location: "Filial"}, &sede, false},
+		{"Cadeira sem localidade", Closure{LocationID: &sede, Location: "Sede - Térreo"}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.closure.AppliesToLocation(tt.location))
+		})
+	}
+}
+
+func TestClosure_SetLocation(t *testing.T) {
+	closure := &Closure{}
+	closure.SetLocation(&Location{ID: 3, Name: "Filial"})
+	assert.Equal(t, uint(3), *closure.LocationID)
+	assert.Equal(t, "Filial", closure.Location)
+	assert.False(t, closure.IsGlobal())
+
+	closure.SetLocation(nil)
+	assert.Nil(t, closure.LocationID)
+	assert.Empty(t, closure.Location)
+	assert.True(t, closure.IsGlobal())
+}
+
+func TestClosure_CoversDate(t *testing.T) {
+	closure := &Closure{
+		StartDate: time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected bool
+	}{
+		{"Primeiro dia", time.Date(2025, 12, 24, 8, 0, 0, 0, time.UTC), true},
+		{"Último dia no fim da tarde", time.Date(2025, 12, 26, 18, 0, 0, 0, time.UTC), true},
+		{"Dia anterior", time.Date(2025, 12, 23, 23, 0, 0, 0, time.UTC), false},
+		{"Dia seguinte", time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, closure.CoversDate(tt.date))
+		})
+	}
+}
+
+func TestClosure_Period(t *testing.T) {
+	closure := &Closure{
+		StartDate: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	start, end := closure.Period((*Location)(nil).TimeZone())
+
+	assert.True(t, time.Date(2025, 12, 25, 3, 0, 0, 0, time.UTC).Equal(start))
+	assert.True(t, time.Date(2025, 12, 26, 3, 0, 0, 0, time.UTC).Equal(end))
+	assert.Equal(t, 1, closure.Days())
+
+	// Localidades em outro fuso começam o fechamento na própria meia-noite
+	manaus := (&Location{Timezone: "America/Manaus"}).TimeZone()
+	start, end = closure.Period(manaus)
+
+	assert.True(t, time.Date(2025, 12, 25, 4, 0, 0, 0, time.UTC).Equal(start))
+	assert.True(t, time.Date(2025, 12, 26, 4, 0, 0, 0, time.UTC).Equal(end))
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultEmailVerificationTTL é a validade padrão do link de confirmação de email
+const DefaultEmailVerificationTTL = 48 * time.Hour
+
+// EmailVerificationResendInterval é o intervalo mínimo entre dois envios do link
+// de confirmação para a mesma conta
+const EmailVerificationResendInterval = 2 * time.Minute
+
+var (
+	// ErrEmailVerificationInvalid indica link adulterado, vencido ou emitido para um
+	// email que não é mais o da conta
+	ErrEmailVerificationInvalid = errors.New("link de confirmação inválido ou expirado")
+
+	// ErrEmailNotVerified indica cadastro cujo email ainda não foi confirmado
+	ErrEmailNotVerified = errors.New("o usuário ainda não confirmou o email")
+)
+
+// emailVerificationTokenPrefix diferencia o token de confirmação de email dos
+// demais tokens assinados com o mesmo segredo
+const emailVerificationTokenPrefix = "email"
+
+// EmailVerificationTokenPayload monta o conteúdo assinado no link de confirmação.
+// Leva um resumo do endereço, e não o endereço: se o email da conta mudar, os
+// links enviados ao endereço anterior deixam de valer
+func EmailVerificationTokenPayload(userID uint, email string, expiresAt time.Time) string {
+	return fmt.Sprintf("%s:%d:%s:%d", emailVerificationTokenPrefix, userID, emailDigest(email), expiresAt.Unix())
+}
+
+// EmailVerificationTokenClaims é o conteúdo de um token de confirmação já verificado
+type EmailVerificationTokenClaims struct {
+	UserID      uint
+	EmailDigest string
+	ExpiresAt   time.Time
+}
+
+// MatchesEmail verifica se o link foi emitido para o endereço informado
+func (c *EmailVerificationTokenClaims) MatchesEmail(email string) bool {
+	return c.EmailDigest == emailDigest(email)
+}
+
+// ParseEmailVerificationTokenPayload interpreta o conteúdo gerado por
+// EmailVerificationTokenPayload
+func ParseEmailVerificationTokenPayload(payload string) (*EmailVerificationTokenClaims, error) {
+	parts := strings.Split(payload, ":")
+	if len(parts) != 4 || parts[0] != emailVerificationTokenPrefix || parts[2] == "" {
+		return nil, ErrEmailVerificationInvalid
+	}
+
+	userID, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, ErrEmailVerificationInvalid
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, ErrEmailVerificationInvalid
+	}
+
+	return &EmailVerificationTokenClaims{
+		UserID:      uint(userID),
+		EmailDigest: parts[2],
+		ExpiresAt:   time.Unix(expiresAt, 0),
+	}, nil
+}
+
+// EmailDomain retorna o domínio do endereço, em minúsculas
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+// IsEmailDomainAllowed verifica se o domínio do email está na lista. Subdomínios
+// não entram: "rh.empresa.com.br" não é aceito por "empresa.com.br"
+func IsEmailDomainAllowed(email string, domains []string) bool {
+	domain := EmailDomain(email)
+	if domain == "" {
+		return false
+	}
+	for _, allowed := range domains {
+		if strings.ToLower(strings.TrimSpace(allowed)) == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// emailDigest resume o endereço, sem diferenciar maiúsculas, para o token
+func emailDigest(email string) string {
+	return HashToken(strings.ToLower(strings.TrimSpace(email)))[:16]
+}
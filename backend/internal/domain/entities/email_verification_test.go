@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationTokenPayload(t *testing.T) {
+	expiresAt := time.Date(2025, 3, 17, 10, 30, 0, 0, time.UTC)
+
+	claims, err := ParseEmailVerificationTokenPayload(EmailVerificationTokenPayload(42, "joao@empresa.com.br", expiresAt))
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), claims.UserID)
+	assert.True(t, claims.ExpiresAt.Equal(expiresAt))
+	assert.True(t, claims.MatchesEmail("joao@empresa.com.br"))
+	assert.True(t, claims.MatchesEmail(" Joao@Empresa.com.br"))
+	assert.False(t, claims.MatchesEmail("joao@empresa.com"))
+
+	invalid := []string{
+		"",
+		"avaliacao:42:1742207400",       // token de avaliação
+		"email:x:abc:1742207400",        // usuário inválido
+		"email:42::1742207400",          // sem resumo do email
+		"email:42:abc",                  // sem prazo
+		"confirmacao:42:abc:1742207400", // outro prefixo
+	}
+	for _, payload := range invalid {
+		_, err := ParseEmailVerificationTokenPayload(payload)
+		assert.ErrorIs(t, err, ErrEmailVerificationInvalid, payload)
+	}
+}
+
+func TestIsEmailDomainAllowed(t *testing.T) {
+	domains := []string{"empresa.com.br", " Parceira.com "}
+
+	tests := []struct {
+		name     string
+		email    string
+		expected bool
+	}{
+		{"Domínio da lista", "joao@empresa.com.br", true},
+		{"Domínio em maiúsculas", "joao@EMPRESA.com.br", true},
+		{"Domínio da lista com espaços", "maria@parceira.com", true},
+		{"Subdomínio não entra", "joao@rh.empresa.com.br", false},
+		{"Domínio fora da lista", "joao@gmail.com", false},
+		{"Sem arroba", "joao", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsEmailDomainAllowed(tt.email, domains))
+		})
+	}
+
+	assert.False(t, IsEmailDomainAllowed("joao@empresa.com.br", nil))
+}
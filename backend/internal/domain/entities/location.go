@@ -0,0 +1,139 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+	_ "time/tzdata" // fusos das localidades independentes do sistema operacional
+
+	"gorm.io/gorm"
+)
+
+// DefaultLocationTimezone é o fuso usado quando a localidade não informa outro
+const DefaultLocationTimezone = "America/Sao_Paulo"
+
+// Location representa uma localidade onde ficam as cadeiras (escritório, prédio,
+// andar e sala). O nome identifica a localidade e é copiado para Chair.Location,
+// que continua sendo usado em emails, convites e fechamentos
+type Location struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
+	Name      string         `json:"name" gorm:"size:100;not null;index" validate:"required,min=2,max=100"`
+	Site      string         `json:"site" gorm:"size:100;not null;index" validate:"required,min=2,max=100"` // escritório ou unidade
+	Building  string         `json:"building" gorm:"size:100" validate:"max=100"`
+	Floor     string         `json:"floor" gorm:"size:50" validate:"max=50"`
+	Room      string         `json:"room" gorm:"size:50" validate:"max=50"`
+	Address   string         `json:"address" gorm:"size:255" validate:"max=255"`
+	Timezone  string         `json:"timezone" gorm:"size:64;not null;default:'America/Sao_Paulo'"`
+	OpensAt   string         `json:"opens_at" gorm:"size:5"`  // Formato HH:MM; vazio = sem horário de funcionamento
+	ClosesAt  string         `json:"closes_at" gorm:"size:5"` // Formato HH:MM
+	IsActive  bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (Location) TableName() string {
+	return "locations"
+}
+
+// Validate verifica o fuso e o horário de funcionamento
+func (l *Location) Validate() error {
+	if l.Timezone == "" {
+		l.Timezone = DefaultLocationTimezone
+	}
+	if _, err := time.LoadLocation(l.Timezone); err != nil {
+		return errors.New("fuso horário inválido. Use um nome IANA (exemplo: America/Sao_Paulo)")
+	}
+
+	if l.OpensAt == "" && l.ClosesAt == "" {
+		return nil
+	}
+	if l.OpensAt == "" || l.ClosesAt == "" {
+		return errors.New("informe abertura e fechamento do horário de funcionamento")
+	}
+	opens, closes, err := l.openingMinutes()
+	if err != nil {
+		return errors.New("horário de funcionamento inválido. Use o formato HH:MM (exemplo: 08:00)")
+	}
+	if closes <= opens {
+		return errors.New("fechamento deve ser posterior à abertura")
+	}
+	return nil
+}
+
+// TimeZone retorna o fuso da localidade, em que são lidos os horários das janelas,
+// pausas, turnos e fechamentos das cadeiras dela. Localidade nula (cadeira sem
+// localidade cadastrada) ou com fuso inválido usa o padrão
+func (l *Location) TimeZone() *time.Location {
+	if l != nil && l.Timezone != "" {
+		if zone, err := time.LoadLocation(l.Timezone); err == nil {
+			return zone
+		}
+	}
+	zone, _ := time.LoadLocation(DefaultLocationTimezone)
+	return zone
+}
+
+// HasOpeningHours verifica se a localidade restringe o horário de funcionamento
+func (l *Location) HasOpeningHours() bool {
+	return l.OpensAt != "" && l.ClosesAt != ""
+}
+
+// IsOpenBetween verifica se o período (em minutos desde a meia-noite) cabe no
+// horário de funcionamento. Sem horário cadastrado a localidade está sempre aberta
+func (l *Location) IsOpenBetween(startMinute, endMinute int) bool {
+	if !l.HasOpeningHours() {
+		return true
+	}
+	opens, closes, err := l.openingMinutes()
+	if err != nil {
+		return true
+	}
+	return startMinute >= opens && endMinute <= closes
+}
+
+// Describe monta o endereço interno da localidade (escritório, prédio, andar e sala)
+func (l *Location) Describe() string {
+	var parts []string
+	for _, part := range []string{l.Site, l.Building, l.Floor, l.Room} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " - ")
+}
+
+// openingMinutes retorna abertura e fechamento em minutos desde a meia-noite
+func (l *Location) openingMinutes() (int, int, error) {
+	opens, err := clockMinutes(l.OpensAt)
+	if err != nil {
+		return 0, 0, err
+	}
+	closes, err := clockMinutes(clockEnd(l.ClosesAt))
+	if err != nil {
+		return 0, 0, err
+	}
+	return opens, closes, nil
+}
+
+// LocationFilter restringe consultas às cadeiras de uma localidade, pelo cadastro
+// (ID) ou pelo nome. Filtro vazio aceita todas as cadeiras
+type LocationFilter struct {
+	ID   uint
+	Name string
+}
+
+// IsEmpty verifica se o filtro não restringe nada
+func (f LocationFilter) IsEmpty() bool {
+	return f.ID == 0 && strings.TrimSpace(f.Name) == ""
+}
+
+// Matches verifica se a cadeira atende ao filtro
+func (f LocationFilter) Matches(chair *Chair) bool {
+	if f.ID != 0 && (chair.LocationID == nil || *chair.LocationID != f.ID) {
+		return false
+	}
+	return chair.MatchesLocation(f.Name)
+}
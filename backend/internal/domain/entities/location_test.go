@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocation_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		location Location
+		wantErr  bool
+	}{
+		{"Sem horário de funcionamento", Location{Timezone: "America/Sao_Paulo"}, false},
+		{"Com horário de funcionamento", Location{Timezone: "America/Manaus", OpensAt: "08:00", ClosesAt: "18:00"}, false},
+		{"Fuso vazio assume o padrão", Location{}, false},
+		{"Fuso inexistente", Location{Timezone: "America/Atlantida"}, true},
+		{"Só abertura informada", Location{OpensAt: "08:00"}, true},
+		{"Fechamento antes da abertura", Location{OpensAt: "18:00", ClosesAt: "08:00"}, true},
+		{"Horário mal formatado", Location{OpensAt: "8h", ClosesAt: "18h"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.location.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLocation_IsOpenBetween(t *testing.T) {
+	open := Location{OpensAt: "08:00", ClosesAt: "18:00"}
+
+	tests := []struct {
+		name     string
+		location Location
+		start    int
+		end      int
+		expected bool
+	}{
+		{"Dentro do horário", open, 9 * 60, 9*60 + 30, true},
+		{"Termina no fechamento", open, 17*60 + 30, 18 * 60, true},
+		{"Começa antes da abertura", open, 7*60 + 45, 8*60 + 15, false},
+		{"Passa do fechamento", open, 17*60 + 45, 18*60 + 15, false},
+		{"Sem horário cadastrado", Location{}, 6 * 60, 23 * 60, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.location.IsOpenBetween(tt.start, tt.end))
+		})
+	}
+}
+
+func TestLocation_Describe(t *testing.T) {
+	location := Location{Site: "São Paulo", Building: "Torre A", Floor: "3º andar", Room: " "}
+
+	assert.Equal(t, "São Paulo - Torre A - 3º andar", location.Describe())
+}
+
+func TestLocationFilter_Matches(t *testing.T) {
+	locationID := uint(2)
+	chair := &Chair{Location: "Térreo - Sala 1", LocationID: &locationID}
+	legacy := &Chair{Location: "Térreo - Sala 1"}
+
+	tests := []struct {
+		name     string
+		filter   LocationFilter
+		chair    *Chair
+		expected bool
+	}{
+		{"Filtro vazio", LocationFilter{}, chair, true},
+		{"Mesma localidade cadastrada", LocationFilter{ID: 2}, chair, true},
+		{"Outra localidade cadastrada", LocationFilter{ID: 3}, chair, false},
+		{"Cadeira sem localidade cadastrada", LocationFilter{ID: 2}, legacy, false},
+		{"Pelo nome", LocationFilter{Name: "térreo - sala 1"}, legacy, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Matches(tt.chair))
+		})
+	}
+}
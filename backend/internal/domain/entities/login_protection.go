@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// Atraso progressivo entre tentativas: depois das primeiras falhas seguidas, cada
+// nova tentativa do mesmo CPF só é aceita após um intervalo que dobra a cada erro
+const (
+	LoginDelayFreeFailures = 2 // falhas toleradas antes do primeiro atraso
+	LoginDelayBase         = time.Second
+	LoginDelayMax          = 30 * time.Second
+)
+
+// Motivos de desbloqueio do login antes do prazo
+const (
+	LoginUnlockByAdmin         = "admin"
+	LoginUnlockByPasswordReset = "senha"
+)
+
+// LoginProtectionSettings define a janela de contagem das senhas erradas, o limite
+// por CPF que bloqueia a conta e o limite por IP. Limite zero desativa o bloqueio
+// correspondente
+type LoginProtectionSettings struct {
+	FailureWindow    time.Duration
+	MaxFailures      int
+	LockoutDuration  time.Duration
+	MaxFailuresPerIP int
+}
+
+// DefaultLoginProtectionSettings retorna os valores usados quando nada é configurado
+func DefaultLoginProtectionSettings() LoginProtectionSettings {
+	return LoginProtectionSettings{
+		FailureWindow:    15 * time.Minute,
+		MaxFailures:      5,
+		LockoutDuration:  15 * time.Minute,
+		MaxFailuresPerIP: 20,
+	}
+}
+
+// DelayAfter retorna quanto a próxima tentativa deve esperar depois de failures
+// falhas seguidas: nada até LoginDelayFreeFailures, depois 1s, 2s, 4s... até
+// LoginDelayMax
+func (s LoginProtectionSettings) DelayAfter(failures int) time.Duration {
+	if failures < LoginDelayFreeFailures {
+		return 0
+	}
+	delay := LoginDelayBase
+	for i := LoginDelayFreeFailures; i < failures; i++ {
+		delay *= 2
+		if delay >= LoginDelayMax {
+			return LoginDelayMax
+		}
+	}
+	return delay
+}
+
+// LoginFailure registra uma tentativa de login com CPF desconhecido ou senha
+// errada. O CPF é o digitado, exista ou não a conta, para que CPFs inexistentes se
+// comportem como os cadastrados. Falhas zeradas (login certo ou desbloqueio)
+// deixam de contar para o CPF, mas continuam contando para o IP
+type LoginFailure struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	TenantID  uint       `json:"-" gorm:"not null;default:0;index"`
+	CPF       string     `json:"cpf" gorm:"size:20;not null;index"`
+	UserID    *uint      `json:"user_id,omitempty" gorm:"index"` // nulo quando o CPF não está cadastrado
+	IPAddress string     `json:"ip_address" gorm:"size:45;index"`
+	UserAgent string     `json:"user_agent" gorm:"size:255"`
+	ClearedAt *time.Time `json:"cleared_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (LoginFailure) TableName() string {
+	return "login_failures"
+}
+
+// NewLoginFailure cria o registro de uma tentativa de login que falhou
+func NewLoginFailure(cpf string, userID *uint, ipAddress, userAgent string) *LoginFailure {
+	return &LoginFailure{
+		CPF:       truncate(cpf, 20),
+		UserID:    userID,
+		IPAddress: truncate(ipAddress, 45),
+		UserAgent: truncate(userAgent, 255),
+	}
+}
+
+// LoginLockout bloqueia o login do CPF até LockedUntil, depois de falhas demais.
+// Pode ser desfeito antes do prazo por um admin ou pela redefinição da senha
+type LoginLockout struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	TenantID     uint       `json:"-" gorm:"not null;default:0;index"`
+	CPF          string     `json:"cpf" gorm:"size:20;not null;index"`
+	UserID       *uint      `json:"user_id,omitempty" gorm:"index"`
+	Failures     int        `json:"failures"`
+	IPAddress    string     `json:"ip_address" gorm:"size:45"` // IP da falha que bloqueou
+	LockedUntil  time.Time  `json:"locked_until" gorm:"not null;index"`
+	UnlockedAt   *time.Time `json:"unlocked_at,omitempty"`
+	UnlockedBy   *uint      `json:"unlocked_by,omitempty"`
+	UnlockReason string     `json:"unlock_reason,omitempty" gorm:"size:20"` // um dos LoginUnlockBy*
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TableName especifica o nome da tabela
+func (LoginLockout) TableName() string {
+	return "login_lockouts"
+}
+
+// IsActiveAt verifica se o bloqueio está em vigor no instante informado
+func (l *LoginLockout) IsActiveAt(now time.Time) bool {
+	return l.UnlockedAt == nil && now.Before(l.LockedUntil)
+}
+
+// LoginBlockedError é retornado quando a tentativa de login é recusada antes de
+// conferir a senha: conta bloqueada, atraso progressivo ou IP com falhas demais
+type LoginBlockedError struct {
+	Locked  bool // conta bloqueada por excesso de falhas
+	RetryAt time.Time
+}
+
+func (e *LoginBlockedError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("Conta bloqueada temporariamente por excesso de tentativas. Tente novamente às %s ou redefina sua senha", e.RetryAt.Format("15:04"))
+	}
+	return "Muitas tentativas de login. Aguarde alguns segundos e tente novamente"
+}
+
+// RetryAfter retorna quanto falta, em segundos arredondados para cima, para a
+// próxima tentativa ser aceita
+func (e *LoginBlockedError) RetryAfter(now time.Time) int {
+	wait := e.RetryAt.Sub(now)
+	if wait <= 0 {
+		return 0
+	}
+	return int((wait + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginProtectionSettings_DelayAfter(t *testing.T) {
+	settings := DefaultLoginProtectionSettings()
+
+	tests := []struct {
+		name     string
+		failures int
+		expected time.Duration
+	}{
+		{"Sem falhas", 0, 0},
+		{"Primeira falha", 1, 0},
+		{"Segunda falha", 2, time.Second},
+		{"Terceira falha", 3, 2 * time.Second},
+		{"Quarta falha", 4, 4 * time.Second},
+		{"Teto do atraso", 20, LoginDelayMax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, settings.DelayAfter(tt.failures))
+		})
+	}
+}
+
+func TestLoginLockout_IsActiveAt(t *testing.T) {
+	now := time.Date(2025, 3, 17, 10, 0, 0, 0, time.UTC)
+	unlockedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		lockout  LoginLockout
+		expected bool
+	}{
+		{"Dentro do prazo", LoginLockout{LockedUntil: now.Add(time.Minute)}, true},
+		{"Prazo encerrado", LoginLockout{LockedUntil: now}, false},
+		{"Desbloqueado antes do prazo", LoginLockout{LockedUntil: now.Add(time.Minute), UnlockedAt: &unlockedAt}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.lockout.IsActiveAt(now))
+		})
+	}
+}
+
+func TestLoginBlockedError_RetryAfter(t *testing.T) {
+	now := time.Date(2025, 3, 17, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 2, (&LoginBlockedError{RetryAt: now.Add(1500 * time.Millisecond)}).RetryAfter(now))
+	assert.Equal(t, 0, (&LoginBlockedError{RetryAt: now.Add(-time.Second)}).RetryAfter(now))
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultPasswordResetTTL é a validade padrão do link de redefinição de senha
+const DefaultPasswordResetTTL = time.Hour
+
+// PasswordResetMaxPerHour limita os links enviados a um mesmo usuário por hora, para
+// que o endpoint público não vire uma forma de encher a caixa de alguém
+const PasswordResetMaxPerHour = 3
+
+// MinPasswordLength é o tamanho mínimo de senha, o mesmo exigido no cadastro
+const MinPasswordLength = 6
+
+var (
+	// ErrPasswordResetInvalid indica link desconhecido, vencido ou já utilizado
+	ErrPasswordResetInvalid = errors.New("link de redefinição de senha inválido ou expirado")
+
+	// ErrPasswordTooShort indica senha nova abaixo do tamanho mínimo
+	ErrPasswordTooShort = fmt.Errorf("a senha deve ter pelo menos %d caracteres", MinPasswordLength)
+)
+
+// PasswordResetToken é um pedido de redefinição de senha. Só o hash do token enviado
+// por email é gravado, e cada token vale uma única vez
+type PasswordResetToken struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	TenantID    uint       `json:"-" gorm:"not null;default:0;index"`
+	UserID      uint       `json:"user_id" gorm:"not null;index"`
+	TokenHash   string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt   time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt      *time.Time `json:"used_at,omitempty"` // também preenchido quando um pedido mais novo o substitui
+	RequestedIP string     `json:"requested_ip" gorm:"size:45"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
+// NewPasswordResetToken cria o pedido de redefinição, guardando apenas o hash do token
+func NewPasswordResetToken(userID uint, token, requestedIP string, expiresAt time.Time) *PasswordResetToken {
+	return &PasswordResetToken{
+		UserID:      userID,
+		TokenHash:   HashToken(token),
+		ExpiresAt:   expiresAt,
+		RequestedIP: truncate(requestedIP, 45),
+	}
+}
+
+// IsUsableAt verifica se o token ainda pode redefinir a senha
+func (t *PasswordResetToken) IsUsableAt(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
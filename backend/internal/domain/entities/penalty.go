@@ -0,0 +1,133 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Tipos de penalidade registrados para o usuário
+const (
+	PenaltyTypeNoShow           = "falta"
+	PenaltyTypeLateCancellation = "cancelamento_tardio"
+)
+
+// PolicyViolationSuspended é retornado quando o usuário está com o direito de agendar suspenso
+const PolicyViolationSuspended = "BOOKING_SUSPENDED"
+
+// BookingPenalty registra uma falta ou cancelamento tardio de um agendamento
+type BookingPenalty struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	TenantID   uint       `json:"-" gorm:"not null;default:0;index"`
+	UserID     uint       `json:"user_id" gorm:"not null;index" validate:"required"`
+	BookingID  uint       `json:"booking_id" gorm:"not null;uniqueIndex:idx_penalty_booking_type" validate:"required"`
+	Type       string     `json:"type" gorm:"size:30;not null;uniqueIndex:idx_penalty_booking_type" validate:"oneof=falta cancelamento_tardio"`
+	OccurredAt time.Time  `json:"occurred_at" gorm:"not null;index" validate:"required"`
+	WaivedAt   *time.Time `json:"waived_at,omitempty"`
+	WaivedBy   *uint      `json:"waived_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+
+	// Relacionamentos
+	Booking *Booking `json:"booking,omitempty" gorm:"foreignKey:BookingID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (BookingPenalty) TableName() string {
+	return "booking_penalties"
+}
+
+// IsWaived verifica se a penalidade foi perdoada por um admin
+func (p *BookingPenalty) IsWaived() bool {
+	return p.WaivedAt != nil
+}
+
+// BookingSuspension bloqueia novos agendamentos do usuário até EndsAt.
+// CreatedBy nulo indica suspensão automática por acúmulo de penalidades
+type BookingSuspension struct {
+	ID                    uint           `json:"id" gorm:"primaryKey"`
+	TenantID              uint           `json:"-" gorm:"not null;default:0;index"`
+	UserID                uint           `json:"user_id" gorm:"not null;index" validate:"required"`
+	Reason                string         `json:"reason" gorm:"size:500;not null" validate:"required"`
+	NoShowCount           int            `json:"no_show_count"`
+	LateCancellationCount int            `json:"late_cancellation_count"`
+	StartsAt              time.Time      `json:"starts_at" gorm:"not null" validate:"required"`
+	EndsAt                time.Time      `json:"ends_at" gorm:"not null;index" validate:"required"`
+	CreatedBy             *uint          `json:"created_by,omitempty"`
+	LiftedAt              *time.Time     `json:"lifted_at,omitempty"`
+	LiftedBy              *uint          `json:"lifted_by,omitempty"`
+	LiftReason            string         `json:"lift_reason,omitempty" gorm:"size:500"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (BookingSuspension) TableName() string {
+	return "booking_suspensions"
+}
+
+// IsActiveAt verifica se a suspensão está em vigor no instante informado
+func (s *BookingSuspension) IsActiveAt(now time.Time) bool {
+	return s.LiftedAt == nil && !now.Before(s.StartsAt) && now.Before(s.EndsAt)
+}
+
+// Lift encerra a suspensão antes do prazo por decisão de um admin
+func (s *BookingSuspension) Lift(liftedBy uint, reason string, now time.Time) {
+	s.LiftedAt = &now
+	s.LiftedBy = &liftedBy
+	s.LiftReason = reason
+}
+
+// PenaltySettings define a janela de contagem, os limites e a duração da suspensão.
+// Limite zero desativa a contagem daquele tipo de penalidade
+type PenaltySettings struct {
+	Window                 time.Duration
+	NoShowLimit            int
+	LateCancellationLimit  int
+	LateCancellationWindow time.Duration
+	SuspensionDuration     time.Duration
+}
+
+// DefaultPenaltySettings retorna os valores usados quando nada é configurado
+func DefaultPenaltySettings() PenaltySettings {
+	return PenaltySettings{
+		Window:                 30 * 24 * time.Hour,
+		NoShowLimit:            3,
+		LateCancellationLimit:  3,
+		LateCancellationWindow: 24 * time.Hour,
+		SuspensionDuration:     14 * 24 * time.Hour,
+	}
+}
+
+// IsLateCancellation verifica se o cancelamento ocorreu dentro da janela de
+// cancelamento tardio, ou seja, perto demais do início da sessão
+func (s PenaltySettings) IsLateCancellation(startTime, cancelledAt time.Time) bool {
+	if s.LateCancellationWindow <= 0 || !cancelledAt.Before(startTime) {
+		return false
+	}
+	return startTime.Sub(cancelledAt) < s.LateCancellationWindow
+}
+
+// LimitReached verifica se as penalidades contadas na janela atingem algum limite
+func (s PenaltySettings) LimitReached(noShows, lateCancellations int64) bool {
+	if s.NoShowLimit > 0 && noShows >= int64(s.NoShowLimit) {
+		return true
+	}
+	if s.LateCancellationLimit > 0 && lateCancellations >= int64(s.LateCancellationLimit) {
+		return true
+	}
+	return false
+}
+
+// PenaltyStatus resume as penalidades do usuário na janela atual
+type PenaltyStatus struct {
+	UserID                uint
+	Settings              PenaltySettings
+	NoShowCount           int64
+	LateCancellationCount int64
+	Penalties             []*BookingPenalty
+	ActiveSuspension      *BookingSuspension
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPenaltySettings_IsLateCancellation(t *testing.T) {
+	settings := DefaultPenaltySettings()
+	start := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		cancelledAt time.Time
+		expected    bool
+	}{
+		{"Cancelado com antecedência", start.Add(-48 * time.Hour), false},
+		{"Cancelado exatamente no limite", start.Add(-24 * time.Hour), false},
+		{"Cancelado dentro da janela", start.Add(-5 * time.Hour), true},
+		{"Cancelado após o início", start.Add(10 * time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, settings.IsLateCancellation(start, tt.cancelledAt))
+		})
+	}
+
+	settings.LateCancellationWindow = 0
+	assert.False(t, settings.IsLateCancellation(start, start.Add(-time.Hour)))
+}
+
+func TestPenaltySettings_LimitReached(t *testing.T) {
+	settings := PenaltySettings{NoShowLimit: 3, LateCancellationLimit: 2}
+
+	tests := []struct {
+		name              string
+		noShows           int64
+		lateCancellations int64
+		expected          bool
+	}{
+		{"Abaixo dos limites", 2, 1, false},
+		{"Limite de faltas", 3, 0, true},
+		{"Limite de cancelamentos tardios", 0, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, settings.LimitReached(tt.noShows, tt.lateCancellations))
+		})
+	}
+
+	// Limite zero desativa a contagem
+	disabled := PenaltySettings{}
+	assert.False(t, disabled.LimitReached(10, 10))
+}
+
+func TestBookingSuspension_IsActiveAt(t *testing.T) {
+	now := time.Now()
+	suspension := &BookingSuspension{
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+	}
+
+	assert.True(t, suspension.IsActiveAt(now))
+	assert.False(t, suspension.IsActiveAt(now.Add(2*time.Hour)))
+	assert.False(t, suspension.IsActiveAt(now.Add(-2*time.Hour)))
+
+	suspension.Lift(1, "Falta justificada", now)
+	assert.False(t, suspension.IsActiveAt(now))
+	assert.Equal(t, uint(1), *suspension.LiftedBy)
+	assert.Equal(t, "Falta justificada", suspension.LiftReason)
+}
+
+func TestBookingPenalty_IsWaived(t *testing.T) {
+	penalty := &BookingPenalty{Type: PenaltyTypeNoShow}
+	assert.False(t, penalty.IsWaived())
+
+	now := time.Now()
+	penalty.WaivedAt = &now
+	assert.True(t, penalty.IsWaived())
+}
+
+func TestPenalty_TableNames(t *testing.T) {
+	assert.Equal(t, "booking_penalties", BookingPenalty{}.TableName())
+	assert.Equal(t, "booking_suspensions", BookingSuspension{}.TableName())
+}
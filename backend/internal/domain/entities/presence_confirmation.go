@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Resultados de uma solicitação de confirmação de presença
+const (
+	PresenceResultConfirmed = "confirmado"
+	PresenceResultCancelled = "cancelado"
+	PresenceResultReleased  = "liberado" // prazo encerrado sem resposta, horário liberado
+)
+
+// PresenceConfirmation registra o link de confirmação de presença enviado no lembrete.
+// O token assinado carrega o agendamento, o nonce e o prazo; o registro garante o uso único
+type PresenceConfirmation struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	TenantID  uint       `json:"-" gorm:"not null;default:0;index"`
+	BookingID uint       `json:"booking_id" gorm:"not null;index" validate:"required"`
+	Nonce     string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt time.Time  `json:"expires_at" gorm:"not null;index" validate:"required"`
+	UsedAt    *time.Time `json:"used_at,omitempty" gorm:"index"`
+	Result    string     `json:"result,omitempty" gorm:"size:20"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// Relacionamentos
+	Booking *Booking `json:"booking,omitempty" gorm:"foreignKey:BookingID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (PresenceConfirmation) TableName() string {
+	return "presence_confirmations"
+}
+
+// IsUsed verifica se o link já foi utilizado ou encerrado
+func (p *PresenceConfirmation) IsUsed() bool {
+	return p.UsedAt != nil
+}
+
+// IsExpiredAt verifica se o prazo de resposta passou
+func (p *PresenceConfirmation) IsExpiredAt(now time.Time) bool {
+	return !now.Before(p.ExpiresAt)
+}
+
+// TokenPayload monta o conteúdo assinado no token do link
+func (p *PresenceConfirmation) TokenPayload() string {
+	return fmt.Sprintf("%d:%s:%d", p.BookingID, p.Nonce, p.ExpiresAt.Unix())
+}
+
+// PresenceTokenClaims é o conteúdo de um token de confirmação já verificado
+type PresenceTokenClaims struct {
+	BookingID uint
+	Nonce     string
+	ExpiresAt time.Time
+}
+
+// ParsePresenceTokenPayload interpreta o conteúdo gerado por TokenPayload
+func ParsePresenceTokenPayload(payload string) (*PresenceTokenClaims, error) {
+	parts := strings.Split(payload, ":")
+	if len(parts) != 3 || parts[1] == "" {
+		return nil, errors.New("token de confirmação inválido")
+	}
+
+	bookingID, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return nil, errors.New("token de confirmação inválido")
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, errors.New("token de confirmação inválido")
+	}
+
+	return &PresenceTokenClaims{
+		BookingID: uint(bookingID),
+		Nonce:     parts[1],
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenceConfirmation_TokenPayloadRoundTrip(t *testing.T) {
+	confirmation := &PresenceConfirmation{
+		BookingID: 42,
+		Nonce:     "abc123",
+		ExpiresAt: time.Date(2025, 1, 15, 8, 0, 0, 0, time.UTC),
+	}
+
+	claims, err := ParsePresenceTokenPayload(confirmation.TokenPayload())
+	assert.NoError(t, err)
+	assert.Equal(t, uint(42), claims.BookingID)
+	assert.Equal(t, "abc123", claims.Nonce)
+	assert.True(t, claims.ExpiresAt.Equal(confirmation.ExpiresAt))
+}
+
+func TestParsePresenceTokenPayload_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{"Vazio", ""},
+		{"Partes faltando", "42:abc"},
+		{"Agendamento inválido", "x:abc:1700000000"},
+		{"Nonce vazio", "42::1700000000"},
+		{"Prazo inválido", "42:abc:amanha"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePresenceTokenPayload(tt.payload)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPresenceConfirmation_State(t *testing.T) {
+	now := time.Date(2025, 1, 15, 8, 0, 0, 0, time.UTC)
+	confirmation := &PresenceConfirmation{ExpiresAt: now}
+
+	assert.False(t, confirmation.IsUsed())
+	assert.False(t, confirmation.IsExpiredAt(now.Add(-time.Minute)))
+	assert.True(t, confirmation.IsExpiredAt(now))
+
+	confirmation.UsedAt = &now
+	assert.True(t, confirmation.IsUsed())
+}
+
+func TestPresenceConfirmation_TableName(t *testing.T) {
+	assert.Equal(t, "presence_confirmations", PresenceConfirmation{}.TableName())
+}
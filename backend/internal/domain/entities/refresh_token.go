@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// DefaultRefreshTokenTTL é a validade padrão de cada refresh token emitido
+const DefaultRefreshTokenTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrRefreshTokenInvalid indica token desconhecido, vencido ou de sessão encerrada
+	ErrRefreshTokenInvalid = errors.New("refresh token inválido ou expirado")
+
+	// ErrRefreshTokenReused indica que um token já trocado foi usado de novo. Como só
+	// um dos dois usos pode ser legítimo, a sessão inteira é encerrada
+	ErrRefreshTokenReused = errors.New("refresh token já utilizado; a sessão foi encerrada por segurança")
+)
+
+// RefreshToken registra um refresh token emitido. Só o hash do identificador do
+// token é gravado. Todos os tokens obtidos a partir de um mesmo login formam uma
+// família, ligada à sessão (UserSession) pelo FamilyID: a cada renovação o token
+// usado é marcado como trocado e um novo é emitido na mesma família
+type RefreshToken struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	TenantID      uint       `json:"-" gorm:"not null;default:0;index"`
+	UserID        uint       `json:"user_id" gorm:"not null;index"`
+	FamilyID      string     `json:"family_id" gorm:"size:64;not null;index"`
+	TokenHash     string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	DeviceName    string     `json:"device_name" gorm:"size:100"`
+	UserAgent     string     `json:"user_agent" gorm:"size:255"`
+	IPAddress     string     `json:"ip_address" gorm:"size:45"`
+	ExpiresAt     time.Time  `json:"expires_at" gorm:"not null;index"`
+	RotatedAt     *time.Time `json:"rotated_at,omitempty"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty" gorm:"index"`
+	RevokedReason string     `json:"revoked_reason,omitempty" gorm:"size:20"` // um dos SessionRevoked*
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// TableName especifica o nome da tabela
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// DeviceInfo identifica o dispositivo de onde veio o login ou a renovação
+type DeviceInfo struct {
+	Name      string // nome informado pelo aplicativo, opcional
+	UserAgent string
+	IPAddress string
+}
+
+// NewRefreshToken cria o registro de um token da família, guardando apenas o hash
+// do identificador
+func NewRefreshToken(userID uint, familyID, tokenID string, device DeviceInfo, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		UserID:     userID,
+		FamilyID:   familyID,
+		TokenHash:  HashToken(tokenID),
+		DeviceName: truncate(device.Name, 100),
+		UserAgent:  truncate(device.UserAgent, 255),
+		IPAddress:  truncate(device.IPAddress, 45),
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// IsRevoked verifica se a sessão do token foi encerrada
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsRotated verifica se o token já foi trocado por outro
+func (t *RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// IsExpiredAt verifica se a validade do token terminou
+func (t *RefreshToken) IsExpiredAt(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// HashToken calcula o hash SHA-256, em hexadecimal, gravado no lugar de um token
+// secreto. Os tokens têm entropia alta, então não precisam de hash lento com sal
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncate corta o texto no limite da coluna
+func truncate(value string, size int) string {
+	runes := []rune(value)
+	if len(runes) <= size {
+		return value
+	}
+	return string(runes[:size])
+}
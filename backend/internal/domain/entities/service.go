@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultSessionDuration é a duração usada quando o agendamento não referencia um serviço
+const DefaultSessionDuration = 30 * time.Minute
+
+type Service struct {
+	ID              uint           `json:"id" gorm:"primaryKey"`
+	TenantID        uint           `json:"-" gorm:"not null;default:0;index;uniqueIndex:idx_services_tenant_name"`
+	Name            string         `json:"name" gorm:"size:100;not null;uniqueIndex:idx_services_tenant_name" validate:"required,min=2,max=100"`
+	Description     string         `json:"description" gorm:"size:255"`
+	DurationMinutes int            `json:"duration_minutes" gorm:"not null;default:30" validate:"required,min=5,max=240"`
+	IsActive        bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	Chairs []Chair `json:"chairs,omitempty" gorm:"many2many:chair_services;"`
+}
+
+// TableName especifica o nome da tabela
+func (Service) TableName() string {
+	return "services"
+}
+
+// BeforeCreate hook executado antes de criar um serviço
+func (s *Service) BeforeCreate(tx *gorm.DB) error {
+	if s.DurationMinutes <= 0 {
+		s.DurationMinutes = int(DefaultSessionDuration.Minutes())
+	}
+	return nil
+}
+
+// GetDuration retorna a duração da sessão do serviço
+func (s *Service) GetDuration() time.Duration {
+	if s.DurationMinutes <= 0 {
+		return DefaultSessionDuration
+	}
+	return time.Duration(s.DurationMinutes) * time.Minute
+}
+
+// Activate ativa o serviço
+func (s *Service) Activate() {
+	s.IsActive = true
+}
+
+// Deactivate desativa o serviço
+func (s *Service) Deactivate() {
+	s.IsActive = false
+}
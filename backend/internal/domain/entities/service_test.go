@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_GetDuration(t *testing.T) {
+	tests := []struct {
+		name            string
+		durationMinutes int
+		expected        time.Duration
+	}{
+		{"Sessão rápida", 15, 15 * time.Minute},
+		{"Sessão padrão", 30, 30 * time.Minute},
+		{"Sessão longa", 60, time.Hour},
+		{"Duração zerada usa padrão", 0, DefaultSessionDuration},
+		{"Duração negativa usa padrão", -10, DefaultSessionDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &Service{DurationMinutes: tt.durationMinutes}
+			assert.Equal(t, tt.expected, service.GetDuration())
+		})
+	}
+}
+
+func TestService_ActivateDeactivate(t *testing.T) {
+	service := &Service{Name: "Sessão padrão", DurationMinutes: 30}
+
+	service.Activate()
+	assert.True(t, service.IsActive)
+
+	service.Deactivate()
+	assert.False(t, service.IsActive)
+}
+
+func TestService_TableName(t *testing.T) {
+	service := &Service{}
+	assert.Equal(t, "services", service.TableName())
+}
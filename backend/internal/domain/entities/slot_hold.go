@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// Status de uma reserva temporária de horário
+const (
+	SlotHoldStatusActive   = "ativo"
+	SlotHoldStatusConsumed = "consumido"
+	SlotHoldStatusReleased = "liberado"
+	SlotHoldStatusExpired  = "expirado"
+)
+
+// ErrSlotHeld indica que o horário está reservado temporariamente por outro usuário
+var ErrSlotHeld = errors.New("horário reservado temporariamente por outro usuário")
+
+// ErrSlotHoldInactive indica que a reserva apresentada já expirou ou foi utilizada
+var ErrSlotHoldInactive = errors.New("reserva de horário expirada ou já utilizada")
+
+// SlotHold segura um horário de uma cadeira enquanto o usuário conclui o agendamento.
+// O token é apresentado na criação do agendamento e a reserva vale até ExpiresAt.
+// Vagas ofertadas pela lista de espera ficam reservadas com o token da oferta
+type SlotHold struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	TenantID  uint      `json:"-" gorm:"not null;default:0;index"`
+	Token     string    `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	UserID    uint      `json:"user_id" gorm:"not null;index" validate:"required"`
+	ChairID   uint      `json:"chair_id" gorm:"not null;index" validate:"required"`
+	ServiceID *uint     `json:"service_id"`
+	StartTime time.Time `json:"start_time" gorm:"not null" validate:"required"`
+	EndTime   time.Time `json:"end_time" gorm:"not null" validate:"required"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null;index" validate:"required"`
+	Status    string    `json:"status" gorm:"size:20;not null;index" validate:"oneof=ativo consumido liberado expirado"`
+	BookingID *uint     `json:"booking_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Entrada da lista de espera cuja oferta a reserva guarda
+	WaitlistEntryID *uint `json:"waitlist_entry_id,omitempty" gorm:"index"`
+
+	// Relacionamentos
+	Chair *Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (SlotHold) TableName() string {
+	return "slot_holds"
+}
+
+// IsActiveAt verifica se a reserva ainda segura o horário no instante informado
+func (h *SlotHold) IsActiveAt(now time.Time) bool {
+	return h.Status == SlotHoldStatusActive && now.Before(h.ExpiresAt)
+}
+
+// Overlaps verifica se a reserva sobrepõe o intervalo informado
+func (h *SlotHold) Overlaps(start, end time.Time) bool {
+	return h.StartTime.Before(end) && start.Before(h.EndTime)
+}
+
+// Covers verifica se o agendamento corresponde exatamente ao horário reservado
+func (h *SlotHold) Covers(booking *Booking) bool {
+	if h.UserID != booking.UserID || h.ChairID != booking.ChairID || !h.StartTime.Equal(booking.StartTime) {
+		return false
+	}
+
+	holdService, bookingService := uint(0), uint(0)
+	if h.ServiceID != nil {
+		holdService = *h.ServiceID
+	}
+	if booking.ServiceID != nil {
+		bookingService = *booking.ServiceID
+	}
+	return holdService == bookingService
+}
+
+// Consume encerra a reserva após a criação do agendamento
+func (h *SlotHold) Consume(bookingID uint) {
+	h.Status = SlotHoldStatusConsumed
+	h.BookingID = &bookingID
+}
+
+// Release devolve o horário antes do prazo, a pedido do usuário
+func (h *SlotHold) Release() {
+	h.Status = SlotHoldStatusReleased
+}
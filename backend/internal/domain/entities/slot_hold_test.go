@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotHold_IsActiveAt(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		hold     SlotHold
+		expected bool
+	}{
+		{"Ativa dentro do prazo", SlotHold{Status: SlotHoldStatusActive, ExpiresAt: now.Add(time.Minute)}, true},
+		{"Ativa com prazo vencido", SlotHold{Status: SlotHoldStatusActive, ExpiresAt: now}, false},
+		{"Consumida", SlotHold{Status: SlotHoldStatusConsumed, ExpiresAt: now.Add(time.Minute)}, false},
+		{"Liberada", SlotHold{Status: SlotHoldStatusReleased, ExpiresAt: now.Add(time.Minute)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.hold.IsActiveAt(now))
+		})
+	}
+}
+
+func TestSlotHold_Overlaps(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	hold := &SlotHold{StartTime: start, EndTime: start.Add(30 * time.Minute)}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected bool
+	}{
+		{"Mesmo horário", start, start.Add(30 * time.Minute), true},
+		{"Sobreposição parcial", start.Add(15 * time.Minute), start.Add(45 * time.Minute), true},
+		{"Logo depois", start.Add(30 * time.Minute), start.Add(time.Hour), false},
+		{"Logo antes", start.Add(-30 * time.Minute), start, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hold.Overlaps(tt.start, tt.end))
+		})
+	}
+}
+
+func TestSlotHold_Covers(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	hold := &SlotHold{UserID: 1, ChairID: 2, ServiceID: uintPtr(3), StartTime: start}
+
+	tests := []struct {
+		name     string
+		booking  *Booking
+		expected bool
+	}{
+		{"Mesmo horário e serviço", &Booking{UserID: 1, ChairID: 2, ServiceID: uintPtr(3), StartTime: start}, true},
+		{"Outro usuário", &Booking{UserID: 9, ChairID: 2, ServiceID: uintPtr(3), StartTime: start}, false},
+		{"Outra cadeira", &Booking{UserID: 1, ChairID: 9, ServiceID: uintPtr(3), StartTime: start}, false},
+		{"Outro horário", &Booking{UserID: 1, ChairID: 2, ServiceID: uintPtr(3), StartTime: start.Add(time.Hour)}, false},
+		{"Sem serviço", &Booking{UserID: 1, ChairID: 2, StartTime: start}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hold.Covers(tt.booking))
+		})
+	}
+}
+
+func TestSlotHold_Lifecycle(t *testing.T) {
+	hold := &SlotHold{Status: SlotHoldStatusActive, ExpiresAt: time.Now().Add(time.Minute)}
+	assert.True(t, hold.IsActiveAt(time.Now()))
+
+	hold.Consume(42)
+	assert.Equal(t, SlotHoldStatusConsumed, hold.Status)
+	assert.Equal(t, uint(42), *hold.BookingID)
+	assert.False(t, hold.IsActiveAt(time.Now()))
+
+	released := &SlotHold{Status: SlotHoldStatusActive}
+	released.Release()
+	assert.Equal(t, SlotHoldStatusReleased, released.Status)
+}
+
+func TestSlotHold_TableName(t *testing.T) {
+	assert.Equal(t, "slot_holds", SlotHold{}.TableName())
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PlatformTenantID identifica o escopo da plataforma: os super admins e a auditoria
+// do cadastro de tenants ficam com tenant_id zero, fora de qualquer cliente
+const PlatformTenantID uint = 0
+
+// tenantSlugPattern aceita um rótulo de DNS: letras minúsculas, dígitos e hífens,
+// sem hífen nas pontas
+var tenantSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// reservedTenantSlugs são subdomínios que não podem identificar clientes
+var reservedTenantSlugs = map[string]bool{
+	"www":      true,
+	"api":      true,
+	"admin":    true,
+	"platform": true,
+}
+
+// Tenant representa um cliente do sistema. Todos os dados de negócio (usuários,
+// cadeiras, agendamentos, disponibilidades, auditoria...) carregam o tenant_id do
+// cliente; o slug é o subdomínio pelo qual o cliente acessa a API
+type Tenant struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	Name      string         `json:"name" gorm:"size:100;not null" validate:"required,min=2,max=100"`
+	Slug      string         `json:"slug" gorm:"size:63;not null;uniqueIndex" validate:"required,min=2,max=63"`
+	IsActive  bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (Tenant) TableName() string {
+	return "tenants"
+}
+
+// Validate verifica se o slug pode ser usado como subdomínio
+func (t *Tenant) Validate() error {
+	if !tenantSlugPattern.MatchString(t.Slug) {
+		return errors.New("slug inválido. Use letras minúsculas, números e hífens (exemplo: empresa-abc)")
+	}
+	if reservedTenantSlugs[t.Slug] {
+		return errors.New("slug reservado pelo sistema")
+	}
+	return nil
+}
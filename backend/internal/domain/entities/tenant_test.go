@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenant_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		slug    string
+		wantErr bool
+	}{
+		{"Slug simples", "acme", false},
+		{"Slug com hífen e número", "empresa-2", false},
+		{"Letras maiúsculas", "Acme", true},
+		{"Hífen no início", "-acme", true},
+		{"Hífen no fim", "acme-", true},
+		{"Ponto no slug", "acme.sa", true},
+		{"Slug reservado", "www", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &Tenant{Name: "Cliente", Slug: tt.slug}
+			err := tenant.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrNoTherapistAvailable indica que a cadeira tem turnos cadastrados, mas nenhum
+// massoterapeuta está de plantão (ou presente) durante a sessão inteira
+var ErrNoTherapistAvailable = errors.New("nenhum massoterapeuta disponível para este horário")
+
+// Therapist representa um massoterapeuta. Os turnos definem em quais cadeiras e
+// horários ele atende; as ausências retiram dias inteiros da escala
+type Therapist struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	TenantID  uint           `json:"-" gorm:"not null;default:0;index"`
+	Name      string         `json:"name" gorm:"size:100;not null" validate:"required,min=2,max=100"`
+	Email     string         `json:"email" gorm:"size:255;index" validate:"omitempty,email"`
+	Phone     string         `json:"phone" gorm:"size:20" validate:"max=20"`
+	UserID    *uint          `json:"user_id,omitempty" gorm:"index"` // conta do massoterapeuta no sistema, se houver
+	IsActive  bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	Shifts []TherapistShift `json:"shifts,omitempty" gorm:"foreignKey:TherapistID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (Therapist) TableName() string {
+	return "therapists"
+}
+
+// TherapistShift é o turno semanal de um massoterapeuta em uma cadeira. O turno é
+// ao mesmo tempo o horário de trabalho e a alocação na cadeira: a partir do primeiro
+// turno cadastrado, a cadeira só oferece sessões cobertas por algum turno
+type TherapistShift struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	TenantID    uint           `json:"-" gorm:"not null;default:0;index"`
+	TherapistID uint           `json:"therapist_id" gorm:"not null;index" validate:"required"`
+	ChairID     uint           `json:"chair_id" gorm:"not null;index" validate:"required"`
+	DayOfWeek   int            `json:"day_of_week" gorm:"not null" validate:"min=0,max=6"`    // 0=Domingo, 6=Sábado
+	StartTime   string         `json:"start_time" gorm:"size:5;not null" validate:"required"` // Formato HH:MM
+	EndTime     string         `json:"end_time" gorm:"size:5;not null" validate:"required"`   // Formato HH:MM
+	ValidFrom   *time.Time     `json:"valid_from,omitempty" gorm:"type:date"`
+	ValidTo     *time.Time     `json:"valid_to,omitempty" gorm:"type:date"`
+	CreatedBy   *uint          `json:"created_by,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	Therapist *Therapist `json:"therapist,omitempty" gorm:"foreignKey:TherapistID" validate:"-"`
+	Chair     *Chair     `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (TherapistShift) TableName() string {
+	return "therapist_shifts"
+}
+
+// Validate verifica os horários e o período de validade do turno
+func (s *TherapistShift) Validate() error {
+	start, end, err := s.minutes()
+	if err != nil {
+		return errors.New("horário do turno inválido. Use o formato HH:MM (exemplo: 08:00)")
+	}
+	if end <= start {
+		return errors.New("fim do turno deve ser posterior ao início")
+	}
+	if s.ValidFrom != nil && s.ValidTo != nil && closureDay(*s.ValidTo).Before(closureDay(*s.ValidFrom)) {
+		return errors.New("fim da validade deve ser igual ou posterior ao início")
+	}
+	return nil
+}
+
+// IsValidForDate verifica se o turno vale no dia da semana e no período de validade da data
+func (s *TherapistShift) IsValidForDate(date time.Time) bool {
+	if int(date.Weekday()) != s.DayOfWeek {
+		return false
+	}
+	day := closureDay(date)
+	if s.ValidFrom != nil && day.Before(closureDay(*s.ValidFrom)) {
+		return false
+	}
+	if s.ValidTo != nil && day.After(closureDay(*s.ValidTo)) {
+		return false
+	}
+	return true
+}
+
+// CoversSession verifica se a sessão, em minutos desde a meia-noite da data, cabe
+// inteira no turno
+func (s *TherapistShift) CoversSession(date time.Time, startMinute, endMinute int) bool {
+	if !s.IsValidForDate(date) {
+		return false
+	}
+	start, end, err := s.minutes()
+	if err != nil {
+		return false
+	}
+	return startMinute >= start && endMinute <= end
+}
+
+// Overlaps verifica se dois turnos coincidem em dia, horário e período de validade
+func (s *TherapistShift) Overlaps(other *TherapistShift) bool {
+	if s.DayOfWeek != other.DayOfWeek {
+		return false
+	}
+	if s.ValidTo != nil && other.ValidFrom != nil && closureDay(*s.ValidTo).Before(closureDay(*other.ValidFrom)) {
+		return false
+	}
+	if other.ValidTo != nil && s.ValidFrom != nil && closureDay(*other.ValidTo).Before(closureDay(*s.ValidFrom)) {
+		return false
+	}
+	return s.StartTime < clockEnd(other.EndTime) && other.StartTime < clockEnd(s.EndTime)
+}
+
+// Minutes retorna a duração do turno em minutos
+func (s *TherapistShift) Minutes() int {
+	start, end, err := s.minutes()
+	if err != nil {
+		return 0
+	}
+	return end - start
+}
+
+// GetDayOfWeekName retorna o nome do dia da semana do turno
+func (s *TherapistShift) GetDayOfWeekName() string {
+	return (&Availability{DayOfWeek: s.DayOfWeek}).GetDayOfWeekName()
+}
+
+// minutes retorna início e fim do turno em minutos desde a meia-noite
+func (s *TherapistShift) minutes() (int, int, error) {
+	start, err := clockMinutes(s.StartTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := clockMinutes(clockEnd(s.EndTime))
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// TherapistAbsence é uma ausência do massoterapeuta (férias, folga, atestado).
+// StartDate e EndDate são inclusivos
+type TherapistAbsence struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	TenantID    uint           `json:"-" gorm:"not null;default:0;index"`
+	TherapistID uint           `json:"therapist_id" gorm:"not null;index" validate:"required"`
+	StartDate   time.Time      `json:"start_date" gorm:"type:date;not null;index" validate:"required"`
+	EndDate     time.Time      `json:"end_date" gorm:"type:date;not null;index" validate:"required"`
+	Reason      string         `json:"reason" gorm:"size:255" validate:"max=255"`
+	CreatedBy   *uint          `json:"created_by,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (TherapistAbsence) TableName() string {
+	return "therapist_absences"
+}
+
+// Validate verifica o intervalo de datas da ausência
+func (a *TherapistAbsence) Validate() error {
+	if a.StartDate.IsZero() || a.EndDate.IsZero() {
+		return errors.New("datas de início e fim são obrigatórias")
+	}
+	if closureDay(a.EndDate).Before(closureDay(a.StartDate)) {
+		return errors.New("data de fim deve ser igual ou posterior à data de início")
+	}
+	return nil
+}
+
+// CoversDate verifica se a data (dia do calendário) está dentro da ausência
+func (a *TherapistAbsence) CoversDate(date time.Time) bool {
+	day := closureDay(date)
+	return !day.Before(closureDay(a.StartDate)) && !day.After(closureDay(a.EndDate))
+}
+
+// OnDutyTherapist retorna o massoterapeuta ativo, sem ausência na data, cujo turno
+// cobre a sessão inteira (minutos desde a meia-noite). Os turnos devem ser da mesma
+// cadeira e trazer o massoterapeuta carregado
+func OnDutyTherapist(shifts []*TherapistShift, absences []*TherapistAbsence, date time.Time, startMinute, endMinute int) (uint, bool) {
+	for _, shift := range shifts {
+		if shift.Therapist == nil || !shift.Therapist.IsActive {
+			continue
+		}
+		if !shift.CoversSession(date, startMinute, endMinute) {
+			continue
+		}
+		if isAbsent(absences, shift.TherapistID, date) {
+			continue
+		}
+		return shift.TherapistID, true
+	}
+	return 0, false
+}
+
+// isAbsent verifica se o massoterapeuta tem ausência cadastrada na data
+func isAbsent(absences []*TherapistAbsence, therapistID uint, date time.Time) bool {
+	for _, absence := range absences {
+		if absence.TherapistID == therapistID && absence.CoversDate(date) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTherapistShift_Validate(t *testing.T) {
+	from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name    string
+		shift   TherapistShift
+		wantErr bool
+	}{
+		{"Turno válido", TherapistShift{StartTime: "08:00", EndTime: "12:00"}, false},
+		{"Turno até a meia-noite", TherapistShift{StartTime: "18:00", EndTime: "00:00"}, false},
+		{"Fim antes do início", TherapistShift{StartTime: "12:00", EndTime: "08:00"}, true},
+		{"Formato inválido", TherapistShift{StartTime: "8h", EndTime: "12:00"}, true},
+		{"Validade invertida", TherapistShift{StartTime: "08:00", EndTime: "12:00", ValidFrom: &from, ValidTo: &before}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.shift.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTherapistShift_Overlaps(t *testing.T) {
+	january := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		a        *TherapistShift
+		b        *TherapistShift
+		expected bool
+	}{
+		{"Mesmo dia e horário sobreposto", &TherapistShift{DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00"}, &TherapistShift{DayOfWeek: 1, StartTime: "11:00", EndTime: "15:00"}, true},
+		{"Turnos encostados", &TherapistShift{DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00"}, &TherapistShift{DayOfWeek: 1, StartTime: "12:00", EndTime: "16:00"}, false},
+		{"Dias diferentes", &TherapistShift{DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00"}, &TherapistShift{DayOfWeek: 2, StartTime: "08:00", EndTime: "12:00"}, false},
+		{"Validades sem interseção", &TherapistShift{DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00", ValidTo: &january}, &TherapistShift{DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00", ValidFrom: &february}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.a.Overlaps(tt.b))
+		})
+	}
+}
+
+func TestOnDutyTherapist(t *testing.T) {
+	monday := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	ana := &Therapist{ID: 1, Name: "Ana", IsActive: true}
+	bruno := &Therapist{ID: 2, Name: "Bruno", IsActive: true}
+	inactive := &Therapist{ID: 3, Name: "Carla", IsActive: false}
+	shifts := []*TherapistShift{
+		{TherapistID: 1, ChairID: 1, DayOfWeek: 1, StartTime: "08:00", EndTime: "12:00", Therapist: ana},
+		{TherapistID: 2, ChairID: 1, DayOfWeek: 1, StartTime: "13:00", EndTime: "17:00", Therapist: bruno},
+		{TherapistID: 3, ChairID: 1, DayOfWeek: 1, StartTime: "17:00", EndTime: "20:00", Therapist: inactive},
+	}
+
+	tests := []struct {
+		name       string
+		absences   []*TherapistAbsence
+		start      int
+		end        int
+		expectedID uint
+		expectedOK bool
+	}{
+		{"Sessão no turno da manhã", nil, 9 * 60, 9*60 + 30, 1, true},
+		{"Sessão no turno da tarde", nil, 14 * 60, 14*60 + 30, 2, true},
+		{"Sessão atravessa o fim do turno", nil, 11*60 + 45, 12*60 + 15, 0, false},
+		{"Sessão no intervalo entre turnos", nil, 12 * 60, 12*60 + 30, 0, false},
+		{"Massoterapeuta ausente no dia", []*TherapistAbsence{{TherapistID: 1, StartDate: monday, EndDate: monday}}, 9 * 60, 9*60 + 30, 0, false},
+		{"Ausência de outro massoterapeuta", []*TherapistAbsence{{TherapistID: 2, StartDate: monday, EndDate: monday}}, 9 * 60, 9*60 + 30, 1, true},
+		{"Massoterapeuta inativo", nil, 18 * 60, 18*60 + 30, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := OnDutyTherapist(shifts, tt.absences, monday, tt.start, tt.end)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
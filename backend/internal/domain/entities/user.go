@@ -6,12 +6,13 @@ import (
 
 type User struct {
 	ID            uint       `json:"id"`
+	TenantID      uint       `json:"-" gorm:"not null;default:0;index"`
 	Name          string     `json:"name" validate:"required,min=2,max=60"`
 	CPF           string     `json:"cpf" validate:"required,cpf"`
 	Email         string     `json:"email" validate:"required,email"`
 	Phone         string     `json:"phone" validate:"required,min=10,max=20"`
 	Password      string     `json:"-" validate:"required,min=6"`
-	Role          string     `json:"role" validate:"oneof=usuario atendente admin"`
+	Role          string     `json:"role" validate:"oneof=usuario atendente admin super_admin"`
 	RequestedRole string     `json:"requested_role" validate:"oneof=usuario atendente admin"`
 	Status        string     `json:"status" validate:"oneof=pendente aprovado reprovado"`
 	Function      string     `json:"function"`
@@ -24,6 +25,11 @@ type User struct {
 	UpdatedAt     time.Time  `json:"updated_at"`
 	DeletedAt     *time.Time `json:"-"`
 	LastLogin     *time.Time `json:"last_login"`
+	CalendarToken *string    `json:"-" gorm:"size:64;uniqueIndex"`       // segredo da URL do feed iCal
+	LocationID    *uint      `json:"location_id,omitempty" gorm:"index"` // localidade atendida (atendentes); nulo = todas
+
+	EmailVerifiedAt         *time.Time `json:"email_verified_at"` // nulo enquanto o email não for confirmado pelo link
+	EmailVerificationSentAt *time.Time `json:"-"`                 // último envio do link de confirmação
 
 	// Relacionamentos
 	Bookings []Booking `json:"bookings,omitempty"`
@@ -34,6 +40,12 @@ func (u *User) IsAdmin() bool {
 	return u.Role == "admin"
 }
 
+// IsSuperAdmin verifica se o usuário administra a plataforma (cadastro de tenants).
+// Super admins não pertencem a nenhum tenant
+func (u *User) IsSuperAdmin() bool {
+	return u.Role == "super_admin"
+}
+
 // IsAttendant verifica se o usuário é atendente
 func (u *User) IsAttendant() bool {
 	return u.Role == "atendente"
@@ -64,6 +76,11 @@ func (u *User) IsApproved() bool {
 	return u.Status == "aprovado"
 }
 
+// IsEmailVerified verifica se o usuário confirmou o email pelo link enviado
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
 // SetDefaultValues define valores padrão para campos obrigatórios
 func (u *User) SetDefaultValues() {
 	if u.Role == "" {
@@ -76,3 +93,9 @@ func (u *User) SetDefaultValues() {
 		u.Status = "pendente"
 	}
 }
+
+// AttendsLocation verifica se o atendente responde pela localidade. Atendentes sem
+// localidade atribuída (e admins) respondem por todas
+func (u *User) AttendsLocation(locationID uint) bool {
+	return u.IsAdmin() || u.LocationID == nil || *u.LocationID == locationID
+}
@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// SessionTouchInterval é o intervalo mínimo entre duas atualizações do último acesso
+// da sessão, para não gravar no banco a cada requisição
+const SessionTouchInterval = 5 * time.Minute
+
+// Motivos de encerramento de uma sessão, gravados também nos refresh tokens dela
+const (
+	SessionRevokedLogout  = "logout"
+	SessionRevokedReuse   = "reuso"     // refresh token já trocado foi apresentado de novo
+	SessionRevokedByUser  = "encerrada" // encerrada pelo usuário na lista de sessões
+	SessionRevokedAll     = "todas"     // logout de todos os dispositivos
+	SessionRevokedByAdmin = "admin"     // logout forçado por um administrador
+	SessionRevokedReset   = "senha"     // senha redefinida pelo link enviado por email
+)
+
+// ErrSessionRevoked indica sessão encerrada ou vencida. Os tokens de acesso dela deixam
+// de valer imediatamente, sem esperar o vencimento do JWT
+var ErrSessionRevoked = errors.New("sessão encerrada")
+
+// UserSession é uma sessão de login: começa no login, é renovada a cada troca de refresh
+// token e termina no logout, no reuso de token ou quando encerrada pelo usuário ou por um
+// admin. Os tokens de acesso carregam o ID da sessão, verificado a cada requisição
+type UserSession struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	TenantID      uint       `json:"-" gorm:"not null;default:0;index"`
+	UserID        uint       `json:"user_id" gorm:"not null;index"`
+	FamilyID      string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	DeviceName    string     `json:"device_name" gorm:"size:100"`
+	UserAgent     string     `json:"user_agent" gorm:"size:255"`
+	IPAddress     string     `json:"ip_address" gorm:"size:45"`
+	LastSeenAt    time.Time  `json:"last_seen_at"`
+	ExpiresAt     time.Time  `json:"expires_at" gorm:"not null;index"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty" gorm:"index"`
+	RevokedReason string     `json:"revoked_reason,omitempty" gorm:"size:20"`
+	CreatedAt     time.Time  `json:"created_at"`
+
+	// Relacionamentos
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName especifica o nome da tabela
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
+
+// NewUserSession cria a sessão de um login
+func NewUserSession(userID uint, familyID string, device DeviceInfo, now, expiresAt time.Time) *UserSession {
+	session := &UserSession{
+		UserID:     userID,
+		FamilyID:   familyID,
+		DeviceName: truncate(device.Name, 100),
+		LastSeenAt: now,
+		ExpiresAt:  expiresAt,
+	}
+	session.SetDevice(device)
+	return session
+}
+
+// SetDevice atualiza o navegador e o IP do último acesso. O nome do dispositivo é o
+// informado no login
+func (s *UserSession) SetDevice(device DeviceInfo) {
+	s.UserAgent = truncate(device.UserAgent, 255)
+	s.IPAddress = truncate(device.IPAddress, 45)
+}
+
+// IsActiveAt verifica se a sessão não foi encerrada nem venceu
+func (s *UserSession) IsActiveAt(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// NeedsTouch verifica se o último acesso registrado já está defasado
+func (s *UserSession) NeedsTouch(now time.Time) bool {
+	return now.Sub(s.LastSeenAt) >= SessionTouchInterval
+}
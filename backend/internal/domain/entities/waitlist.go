@@ -0,0 +1,138 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Preferências de promoção da lista de espera
+const (
+	WaitlistPreferenceOffer    = "oferta"     // recebe um link para aceitar a vaga
+	WaitlistPreferenceAutoBook = "automatico" // agendado direto quando a vaga abre
+)
+
+// Status de uma entrada na lista de espera
+const (
+	WaitlistStatusWaiting   = "aguardando"
+	WaitlistStatusOffered   = "ofertado"
+	WaitlistStatusFulfilled = "atendido"
+	WaitlistStatusExpired   = "expirado"
+	WaitlistStatusCancelled = "cancelado"
+)
+
+type WaitlistEntry struct {
+	ID             uint           `json:"id" gorm:"primaryKey"`
+	TenantID       uint           `json:"-" gorm:"not null;default:0;index"`
+	UserID         uint           `json:"user_id" gorm:"not null;index" validate:"required"`
+	ChairID        uint           `json:"chair_id" gorm:"not null;index:idx_waitlist_chair_date" validate:"required"`
+	ServiceID      *uint          `json:"service_id"`
+	Date           time.Time      `json:"date" gorm:"type:date;not null;index:idx_waitlist_chair_date" validate:"required"`
+	WindowStart    string         `json:"window_start" gorm:"size:5;not null" validate:"required"`
+	WindowEnd      string         `json:"window_end" gorm:"size:5;not null" validate:"required"`
+	Preference     string         `json:"preference" gorm:"size:20;not null;default:'oferta'" validate:"oneof=oferta automatico"`
+	Status         string         `json:"status" gorm:"size:20;not null;default:'aguardando'" validate:"oneof=aguardando ofertado atendido expirado cancelado"`
+	OfferTokenHash *string        `json:"-" gorm:"size:64;uniqueIndex"` // hash do token do link; o token só vai no email
+	OfferedStart   *time.Time     `json:"offered_start,omitempty"`
+	OfferExpiresAt *time.Time     `json:"offer_expires_at,omitempty"`
+	BookingID      *uint          `json:"booking_id,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relacionamentos
+	User  User  `json:"user,omitempty" gorm:"foreignKey:UserID" validate:"-"`
+	Chair Chair `json:"chair,omitempty" gorm:"foreignKey:ChairID" validate:"-"`
+}
+
+// TableName especifica o nome da tabela
+func (WaitlistEntry) TableName() string {
+	return "waitlist_entries"
+}
+
+// BeforeCreate hook executado antes de criar uma entrada na lista de espera
+func (w *WaitlistEntry) BeforeCreate(tx *gorm.DB) error {
+	if w.Status == "" {
+		w.Status = WaitlistStatusWaiting
+	}
+	if w.Preference == "" {
+		w.Preference = WaitlistPreferenceOffer
+	}
+	return nil
+}
+
+// IsWaiting verifica se a entrada ainda aguarda uma vaga
+func (w *WaitlistEntry) IsWaiting() bool {
+	return w.Status == WaitlistStatusWaiting
+}
+
+// IsOpen verifica se a entrada ainda está ativa na fila (aguardando ou com oferta pendente)
+func (w *WaitlistEntry) IsOpen() bool {
+	return w.Status == WaitlistStatusWaiting || w.Status == WaitlistStatusOffered
+}
+
+// WantsAutoBook verifica se o usuário prefere ser agendado automaticamente
+func (w *WaitlistEntry) WantsAutoBook() bool {
+	return w.Preference == WaitlistPreferenceAutoBook
+}
+
+// IsOfferExpired verifica se a oferta de vaga expirou
+func (w *WaitlistEntry) IsOfferExpired(now time.Time) bool {
+	return w.Status == WaitlistStatusOffered && w.OfferExpiresAt != nil && !now.Before(*w.OfferExpiresAt)
+}
+
+// CoversSlot verifica se uma sessão iniciando em start com a duração informada
+// cai inteira dentro da janela de horário desejada na data da entrada
+func (w *WaitlistEntry) CoversSlot(start time.Time, duration time.Duration) bool {
+	if start.Format("2006-01-02") != w.Date.Format("2006-01-02") {
+		return false
+	}
+
+	end := start.Add(duration)
+	if end.Format("2006-01-02") != start.Format("2006-01-02") && end.Format("15:04") != "00:00" {
+		return false
+	}
+
+	slotStart := start.Format("15:04")
+	slotEnd := end.Format("15:04")
+	if slotEnd == "00:00" {
+		slotEnd = "24:00"
+	}
+
+	windowEnd := w.WindowEnd
+	if windowEnd == "00:00" {
+		windowEnd = "24:00"
+	}
+
+	// Comparação lexicográfica funciona para o formato HH:MM
+	return slotStart >= w.WindowStart && slotEnd <= windowEnd
+}
+
+// Offer registra a oferta de uma vaga com prazo para aceite. Só o hash do token do
+// link é gravado
+func (w *WaitlistEntry) Offer(start time.Time, token string, expiresAt time.Time) {
+	tokenHash := HashToken(token)
+	w.Status = WaitlistStatusOffered
+	w.OfferedStart = &start
+	w.OfferTokenHash = &tokenHash
+	w.OfferExpiresAt = &expiresAt
+}
+
+// Fulfill marca a entrada como atendida pelo agendamento informado
+func (w *WaitlistEntry) Fulfill(bookingID uint) {
+	w.Status = WaitlistStatusFulfilled
+	w.BookingID = &bookingID
+	w.OfferTokenHash = nil
+}
+
+// Expire encerra a entrada após a oferta não ser aceita a tempo
+func (w *WaitlistEntry) Expire() {
+	w.Status = WaitlistStatusExpired
+	w.OfferTokenHash = nil
+}
+
+// Cancel remove o usuário da fila
+func (w *WaitlistEntry) Cancel() {
+	w.Status = WaitlistStatusCancelled
+	w.OfferTokenHash = nil
+}
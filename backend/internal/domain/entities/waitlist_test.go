@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitlistEntry_CoversSlot(t *testing.T) {
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	entry := &WaitlistEntry{Date: date, WindowStart: "12:00", WindowEnd: "14:00"}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		duration time.Duration
+		expected bool
+	}{
+		{"Dentro da janela", time.Date(2025, 1, 15, 12, 30, 0, 0, time.UTC), 30 * time.Minute, true},
+		{"Termina no fim da janela", time.Date(2025, 1, 15, 13, 30, 0, 0, time.UTC), 30 * time.Minute, true},
+		{"Ultrapassa o fim da janela", time.Date(2025, 1, 15, 13, 30, 0, 0, time.UTC), time.Hour, false},
+		{"Antes da janela", time.Date(2025, 1, 15, 11, 30, 0, 0, time.UTC), 30 * time.Minute, false},
+		{"Outra data", time.Date(2025, 1, 16, 12, 30, 0, 0, time.UTC), 30 * time.Minute, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, entry.CoversSlot(tt.start, tt.duration))
+		})
+	}
+}
+
+func TestWaitlistEntry_CoversSlotUntilMidnight(t *testing.T) {
+	entry := &WaitlistEntry{
+		Date:        time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		WindowStart: "22:00",
+		WindowEnd:   "00:00",
+	}
+
+	assert.True(t, entry.CoversSlot(time.Date(2025, 1, 15, 23, 30, 0, 0, time.UTC), 30*time.Minute))
+	assert.False(t, entry.CoversSlot(time.Date(2025, 1, 15, 23, 45, 0, 0, time.UTC), 30*time.Minute))
+}
+
+func TestWaitlistEntry_OfferLifecycle(t *testing.T) {
+	entry := &WaitlistEntry{Status: WaitlistStatusWaiting}
+	start := time.Now().Add(2 * time.Hour)
+	expiresAt := time.Now().Add(15 * time.Minute)
+
+	entry.Offer(start, "token", expiresAt)
+	assert.Equal(t, WaitlistStatusOffered, entry.Status)
+	assert.Equal(t, HashToken("token"), *entry.OfferTokenHash)
+	assert.True(t, entry.IsOpen())
+	assert.False(t, entry.IsOfferExpired(time.Now()))
+	assert.True(t, entry.IsOfferExpired(expiresAt))
+
+	entry.Fulfill(10)
+	assert.Equal(t, WaitlistStatusFulfilled, entry.Status)
+	assert.Equal(t, uint(10), *entry.BookingID)
+	assert.Nil(t, entry.OfferTokenHash)
+	assert.False(t, entry.IsOpen())
+}
+
+func TestWaitlistEntry_WantsAutoBook(t *testing.T) {
+	assert.True(t, (&WaitlistEntry{Preference: WaitlistPreferenceAutoBook}).WantsAutoBook())
+	assert.False(t, (&WaitlistEntry{Preference: WaitlistPreferenceOffer}).WantsAutoBook())
+}
+
+func TestWaitlistEntry_TableName(t *testing.T) {
+	entry := &WaitlistEntry{}
+	assert.Equal(t, "waitlist_entries", entry.TableName())
+}
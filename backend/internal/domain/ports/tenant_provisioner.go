@@ -0,0 +1,13 @@
+package ports
+
+import "agendamento-backend/internal/domain/entities"
+
+// TenantProvisioner define a interface entre o cadastro de tenants e a aplicação de
+// cada tenant (repositórios restritos ao tenant, rotas e rotinas automáticas)
+type TenantProvisioner interface {
+	// CreateUser cadastra o usuário dentro do tenant, pelo caso de uso de usuários dele
+	CreateUser(tenantID uint, user *entities.User, createdBy *uint) error
+
+	// Release encerra a aplicação do tenant desativado, incluindo as rotinas automáticas
+	Release(tenantID uint)
+}
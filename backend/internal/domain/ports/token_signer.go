@@ -0,0 +1,10 @@
+package ports
+
+// TokenSigner define a interface para tokens assinados enviados em links de email
+type TokenSigner interface {
+	// Sign assina o conteúdo e retorna o token
+	Sign(payload string) string
+
+	// Verify confere a assinatura do token e retorna o conteúdo original
+	Verify(token string) (string, error)
+}
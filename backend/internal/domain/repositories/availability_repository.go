@@ -26,12 +26,24 @@ type AvailabilityRepository interface {
 	// Validações
 	HasConflict(chairID uint, dayOfWeek int, startTime, endTime string, excludeID *uint) (bool, error)
 	IsChairAvailableAtTime(chairID uint, dateTime time.Time) (bool, error)
+	IsChairAvailableForPeriod(chairID uint, startTime, endTime time.Time) (bool, error)
 
 	// Operações de status
 	Activate(id uint) error
 	Deactivate(id uint) error
 	SetValidityPeriod(id uint, validFrom, validTo *time.Time) error
 
+	// Exceções por data (sobrepõem as janelas semanais da cadeira)
+	CreateException(exception *entities.AvailabilityException) error
+	GetExceptionByID(id uint) (*entities.AvailabilityException, error)
+	UpdateException(exception *entities.AvailabilityException) error
+	DeleteException(id uint) error
+	GetExceptionsByChair(chairID uint, from, to *time.Time) ([]*entities.AvailabilityException, error)
+
+	// Carga em lote para o cálculo de horários em memória
+	GetActiveByChairs(chairIDs []uint) ([]*entities.Availability, error)
+	GetExceptionsByChairs(chairIDs []uint, from, to time.Time) ([]*entities.AvailabilityException, error)
+
 	// Estatísticas
 	CountByChair(chairID uint) (int64, error)
 	CountActive() (int64, error)
@@ -0,0 +1,15 @@
+package repositories
+
+import "agendamento-backend/internal/domain/entities"
+
+type BookingPolicyRepository interface {
+	// CRUD básico
+	Create(policy *entities.BookingPolicy) error
+	GetByID(id uint) (*entities.BookingPolicy, error)
+	Update(policy *entities.BookingPolicy) error
+	Delete(id uint) error
+
+	// Listagem e filtros
+	List(limit, offset int, filters map[string]interface{}) ([]*entities.BookingPolicy, int64, error)
+	GetActive() ([]*entities.BookingPolicy, error)
+}
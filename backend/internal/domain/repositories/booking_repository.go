@@ -8,6 +8,7 @@ import (
 type BookingRepository interface {
 	// CRUD básico
 	Create(booking *entities.Booking) error
+	CreateWithHold(booking *entities.Booking, hold *entities.SlotHold, now time.Time) error
 	GetByID(id uint) (*entities.Booking, error)
 	Update(booking *entities.Booking) error
 	Delete(id uint) error
@@ -25,23 +26,43 @@ type BookingRepository interface {
 	GetConflictingBookings(chairID uint, startTime, endTime time.Time) ([]*entities.Booking, error)
 	GetUpcomingBookings(userID uint, limit int) ([]*entities.Booking, error)
 	GetTodayBookings() ([]*entities.Booking, error)
+	GetScheduledEndedBefore(before time.Time) ([]*entities.Booking, error)
+	GetOccupyingByChairsInRange(chairIDs []uint, startTime, endTime time.Time) ([]*entities.Booking, error)
+	GetOccupyingByTherapistInRange(therapistID uint, startTime, endTime time.Time) ([]*entities.Booking, error)
+
+	// Agendamentos atingidos por feriados e fechamentos (localidade nula = todas as localidades)
+	GetActiveInPeriodByLocation(startTime, endTime time.Time, locationID *uint) ([]*entities.Booking, error)
+	FlagForClosure(bookingIDs []uint, closureID uint) error
+	ClearClosureFlag(closureID uint) error
+	GetByClosure(closureID uint) ([]*entities.Booking, error)
+
+	// Agendamentos atingidos por manutenção ou desativação da cadeira (endTime nil = sem fim)
+	GetActiveByChairInPeriod(chairID uint, startTime time.Time, endTime *time.Time) ([]*entities.Booking, error)
 
 	// Validações de conflito
 	HasConflict(chairID uint, startTime, endTime time.Time, excludeBookingID *uint) (bool, error)
-	CanBook(userID, chairID uint, startTime time.Time) (bool, string, error)
 	HasActiveBooking(userID uint) (bool, error)
 
-	// Operações de status
-	Cancel(id uint, cancelledBy uint, reason string) error
-	Complete(id uint, completedBy uint) error
-	ConfirmPresence(id uint, confirmedBy uint) error
-	MarkAsNoShow(id uint, markedBy uint) error
+	// Contadores usados pelas políticas de agendamento
+	CountActiveByUser(userID uint, excludeBookingID *uint) (int64, error)
+	CountUserBookingsInRange(userID uint, startTime, endTime time.Time, excludeBookingID *uint) (int64, error)
+
+	// Operações de status (validam a transição e gravam o histórico)
+	Cancel(id uint, actor entities.BookingActor, reason string) error
+	Complete(id uint, actor entities.BookingActor) error
+	ConfirmPresence(id uint, actor entities.BookingActor) error
+	MarkAsNoShow(id uint, actor entities.BookingActor, reason string) error
+
+	// Histórico de status
+	CreateStatusHistory(entry *entities.BookingStatusHistory) error
+	GetStatusHistory(bookingID uint) ([]*entities.BookingStatusHistory, error)
 
 	// Estatísticas
 	CountByStatus(status string) (int64, error)
 	CountByUser(userID uint) (int64, error)
 	CountByChair(chairID uint) (int64, error)
 	CountByDate(date time.Time) (int64, error)
+	CountActiveByChairsInRange(chairIDs []uint, startTime, endTime time.Time) (map[uint]int64, error)
 	CountTotal() (int64, error)
 
 	// Relatórios
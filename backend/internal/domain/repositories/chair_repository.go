@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"agendamento-backend/internal/domain/entities"
 )
 
@@ -22,9 +24,26 @@ type ChairRepository interface {
 	ChangeStatus(id uint, newStatus string, changedBy uint) error
 	GetAvailableChairs() ([]*entities.Chair, error)
 
+	// Pausas
+	CreateBreak(chairBreak *entities.ChairBreak) error
+	GetBreakByID(id uint) (*entities.ChairBreak, error)
+	UpdateBreak(chairBreak *entities.ChairBreak) error
+	DeleteBreak(id uint) error
+	GetBreaksByChair(chairID uint) ([]*entities.ChairBreak, error)
+	GetBreaksByChairs(chairIDs []uint) ([]*entities.ChairBreak, error)
+
+	// Manutenções programadas
+	CreateMaintenance(maintenance *entities.ChairMaintenance) error
+	GetMaintenanceByID(id uint) (*entities.ChairMaintenance, error)
+	UpdateMaintenance(maintenance *entities.ChairMaintenance) error
+	DeleteMaintenance(id uint) error
+	GetMaintenancesByChair(chairID uint) ([]*entities.ChairMaintenance, error)
+	GetMaintenancesByChairsInRange(chairIDs []uint, startTime, endTime time.Time) ([]*entities.ChairMaintenance, error)
+	HasMaintenanceInPeriod(chairID uint, startTime, endTime time.Time) (bool, error)
+
 	// Validações
 	ExistsByName(name string) (bool, error)
-	ExistsByLocation(location string) (bool, error)
+	CountByLocationID(locationID uint) (int64, error)
 
 	// Estatísticas
 	CountByStatus(status string) (int64, error)
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type ClosureRepository interface {
+	// CRUD básico
+	Create(closure *entities.Closure) error
+	GetByID(id uint) (*entities.Closure, error)
+	Delete(id uint) error
+
+	// Listagem e filtros (datas nulas não limitam o período; localidade nula lista todos)
+	List(from, to *time.Time, locationID *uint) ([]*entities.Closure, error)
+	GetByExternalUID(externalUID string, locationID *uint) (*entities.Closure, error)
+}
@@ -1,6 +1,10 @@
 package repositories
 
-import "agendamento-backend/internal/domain/entities"
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+)
 
 // EmailRepository define a interface para envio de emails
 type EmailRepository interface {
@@ -10,8 +14,35 @@ type EmailRepository interface {
 	// SendBookingCancellation envia email de cancelamento de agendamento
 	SendBookingCancellation(user *entities.User, booking *entities.Booking, reason string) error
 
-	// SendBookingReminder envia lembrete de agendamento
-	SendBookingReminder(user *entities.User, booking *entities.Booking) error
+	// SendBookingRescheduled envia o novo horário com o convite .ics atualizado
+	SendBookingRescheduled(user *entities.User, booking *entities.Booking) error
+
+	// SendBookingRelocated avisa que o agendamento foi transferido para outra cadeira
+	// no mesmo horário, com o motivo e o convite .ics atualizado
+	SendBookingRelocated(user *entities.User, booking *entities.Booking, reason string) error
+
+	// SendChairOutageReport envia à equipe o resumo dos agendamentos transferidos e
+	// cancelados por manutenção ou desativação da cadeira
+	SendChairOutageReport(user *entities.User, chair *entities.Chair, reason string, impacts []*entities.OutageImpact) error
+
+	// SendBookingReminder envia lembrete de agendamento. Com token, o email traz
+	// os links para confirmar presença ou cancelar
+	SendBookingReminder(user *entities.User, booking *entities.Booking, confirmationToken string) error
+
+	// SendFeedbackRequest convida o usuário a avaliar a sessão realizada até o prazo.
+	// O token assinado permite dar a nota direto pelos links do email, sem login
+	SendFeedbackRequest(user *entities.User, booking *entities.Booking, feedbackToken string, deadline time.Time) error
+
+	// SendPasswordReset envia o link de redefinição de senha, válido até expiresAt
+	SendPasswordReset(user *entities.User, resetToken string, expiresAt time.Time) error
+
+	// SendAccountLocked avisa que o login da conta foi bloqueado até lockedUntil por
+	// excesso de senhas erradas, vindas do IP informado
+	SendAccountLocked(user *entities.User, lockedUntil time.Time, ipAddress string) error
+
+	// SendEmailVerification envia o link de confirmação do email do cadastro, válido
+	// até expiresAt
+	SendEmailVerification(user *entities.User, verificationToken string, expiresAt time.Time) error
 
 	// SendUserApproval envia notificação de aprovação de cadastro
 	SendUserApproval(user *entities.User) error
@@ -21,4 +52,13 @@ type EmailRepository interface {
 
 	// SendRoleChangeNotification envia notificação de alteração de role
 	SendRoleChangeNotification(user *entities.User, newRole string) error
+
+	// SendWaitlistOffer envia a oferta de vaga da lista de espera com link de aceite
+	SendWaitlistOffer(user *entities.User, entry *entities.WaitlistEntry, chair *entities.Chair, offerToken string) error
+
+	// SendBookingSuspension avisa o usuário que o direito de agendar foi suspenso
+	SendBookingSuspension(user *entities.User, suspension *entities.BookingSuspension) error
+
+	// SendSuspensionLifted avisa o usuário que a suspensão foi encerrada por um admin
+	SendSuspensionLifted(user *entities.User, suspension *entities.BookingSuspension) error
 }
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type FeedbackRepository interface {
+	// CRUD básico
+	Create(feedback *entities.BookingFeedback) error
+	GetByBooking(bookingID uint) (*entities.BookingFeedback, error)
+	ExistsForBooking(bookingID uint) (bool, error)
+	List(limit, offset int, filters map[string]interface{}) ([]*entities.BookingFeedback, int64, error)
+
+	// Convites: sessões realizadas no período que ainda não receberam o convite.
+	// MarkPromptSent só grava se o convite ainda não foi registrado
+	GetBookingsAwaitingPrompt(endedAfter, endedBefore time.Time, limit int) ([]*entities.Booking, error)
+	MarkPromptSent(bookingID uint, sentAt time.Time) (bool, error)
+	ClearPromptSent(bookingID uint) error
+
+	// Métricas de satisfação. groupBy vazio resume todas as avaliações em uma linha
+	GetSatisfactionStats(groupBy string, filters map[string]interface{}) ([]*entities.SatisfactionStats, error)
+}
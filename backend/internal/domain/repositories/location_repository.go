@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+)
+
+type LocationRepository interface {
+	// CRUD básico
+	Create(location *entities.Location) error
+	GetByID(id uint) (*entities.Location, error)
+	GetByName(name string) (*entities.Location, error)
+	Update(location *entities.Location) error
+	Delete(id uint) error
+
+	// Listagem e filtros
+	List(site string, includeInactive bool) ([]*entities.Location, error)
+	GetByIDs(ids []uint) ([]*entities.Location, error)
+	GetByChair(chairID uint) (*entities.Location, error) // nil quando a cadeira não tem localidade cadastrada
+
+	// Validações
+	ExistsByName(name string) (bool, error)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+)
+
+type LoginAttemptRepository interface {
+	// Falhas
+	CreateFailure(failure *entities.LoginFailure) error
+	ListPendingFailures(cpf string, since time.Time) ([]*entities.LoginFailure, error)
+	CountFailuresByIP(ipAddress string, since time.Time) (int64, error)
+	ClearFailures(cpf string, at time.Time) error
+
+	// Bloqueios
+	CreateLockout(lockout *entities.LoginLockout) error
+	GetActiveLockout(cpf string, now time.Time) (*entities.LoginLockout, error)
+	Unlock(cpf string, unlockedBy *uint, reason string, at time.Time) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type PasswordResetRepository interface {
+	// CRUD básico. Os tokens são buscados pelo hash, nunca pelo valor enviado por email
+	Create(token *entities.PasswordResetToken) error
+	GetByHash(tokenHash string) (*entities.PasswordResetToken, error)
+	CountRequestedSince(userID uint, since time.Time) (int64, error)
+
+	// Uso: MarkUsed só grava se o token ainda não foi usado, para que dois envios do
+	// mesmo link não redefinam a senha duas vezes. InvalidateByUser descarta os links
+	// pendentes do usuário
+	MarkUsed(id uint, usedAt time.Time) (bool, error)
+	InvalidateByUser(userID uint, usedAt time.Time) error
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type PenaltyRepository interface {
+	// Penalidades
+	CreatePenalty(penalty *entities.BookingPenalty) error
+	GetPenaltiesByUserSince(userID uint, since time.Time) ([]*entities.BookingPenalty, error)
+	CountPenaltiesSince(userID uint, penaltyType string, since time.Time) (int64, error)
+	WaivePenaltiesSince(userID uint, since time.Time, waivedBy uint) error
+
+	// Suspensões
+	CreateSuspension(suspension *entities.BookingSuspension) error
+	UpdateSuspension(suspension *entities.BookingSuspension) error
+	GetActiveSuspension(userID uint, now time.Time) (*entities.BookingSuspension, error)
+	GetSuspensionsByUser(userID uint) ([]*entities.BookingSuspension, error)
+	ListActiveSuspensions(now time.Time, limit, offset int) ([]*entities.BookingSuspension, int64, error)
+}
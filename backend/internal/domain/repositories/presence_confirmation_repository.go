@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type PresenceConfirmationRepository interface {
+	// CRUD básico
+	Create(confirmation *entities.PresenceConfirmation) error
+	GetByNonce(nonce string) (*entities.PresenceConfirmation, error)
+
+	// Uso único: MarkUsed só grava se o link ainda não foi usado
+	MarkUsed(id uint, result string, usedAt time.Time) (bool, error)
+	ClearUsed(id uint) error
+
+	// Links sem resposta cujo prazo terminou
+	GetPendingExpired(now time.Time) ([]*entities.PresenceConfirmation, error)
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type RefreshTokenRepository interface {
+	// CRUD básico. Os tokens são buscados pelo hash, nunca pelo valor emitido
+	Create(token *entities.RefreshToken) error
+	GetByHash(tokenHash string) (*entities.RefreshToken, error)
+
+	// Rotação: MarkRotated só grava se o token ainda não foi trocado nem revogado,
+	// para que dois usos simultâneos do mesmo token não gerem duas renovações
+	MarkRotated(id uint, rotatedAt time.Time) (bool, error)
+
+	// Revogação: encerra os tokens ainda não revogados da família ou de todas as
+	// famílias do usuário, menos exceptFamilyID (vazio = nenhuma)
+	RevokeFamily(familyID, reason string, revokedAt time.Time) error
+	RevokeByUser(userID uint, exceptFamilyID, reason string, revokedAt time.Time) error
+
+	// Limpeza dos tokens vencidos do usuário
+	DeleteExpiredByUser(userID uint, before time.Time) error
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+)
+
+type ServiceRepository interface {
+	// CRUD básico
+	Create(service *entities.Service) error
+	GetByID(id uint) (*entities.Service, error)
+	Update(service *entities.Service) error
+	Delete(id uint) error
+
+	// Listagem e filtros
+	List(limit, offset int, filters map[string]interface{}) ([]*entities.Service, int64, error)
+	GetActive() ([]*entities.Service, error)
+
+	// Serviços oferecidos por cadeira
+	GetByChair(chairID uint) ([]*entities.Service, error)
+	SetChairServices(chairID uint, serviceIDs []uint) error
+	ChairSupportsService(chairID, serviceID uint) (bool, error)
+	GetChairIDsByService(serviceID uint) ([]uint, error)
+
+	// Validações
+	ExistsByName(name string) (bool, error)
+
+	// Estatísticas
+	CountTotal() (int64, error)
+}
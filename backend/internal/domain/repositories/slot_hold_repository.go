@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type SlotHoldRepository interface {
+	// CRUD básico
+	Create(hold *entities.SlotHold) error
+	GetByToken(token string) (*entities.SlotHold, error)
+	Update(hold *entities.SlotHold) error
+
+	// Consultas de reservas em vigor
+	GetActiveByChairAndDate(chairID uint, date time.Time, now time.Time) ([]*entities.SlotHold, error)
+	HasActiveHold(chairID uint, startTime, endTime time.Time, now time.Time, excludeToken string) (bool, error)
+	GetActiveByChairsInRange(chairIDs []uint, startTime, endTime time.Time, now time.Time) ([]*entities.SlotHold, error)
+
+	// Encerramento
+	ReleaseActiveByUser(userID uint) error
+	ReleaseActiveByWaitlistEntry(entryID uint) error
+	ExpireStale(now time.Time) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+)
+
+type TenantRepository interface {
+	// CRUD básico
+	Create(tenant *entities.Tenant) error
+	GetByID(id uint) (*entities.Tenant, error)
+	GetBySlug(slug string) (*entities.Tenant, error)
+	Update(tenant *entities.Tenant) error
+
+	// Listagem e filtros
+	List(includeInactive bool) ([]*entities.Tenant, error)
+
+	// Validações
+	ExistsBySlug(slug string) (bool, error)
+}
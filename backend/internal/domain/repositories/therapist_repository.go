@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type TherapistRepository interface {
+	// CRUD básico
+	Create(therapist *entities.Therapist) error
+	GetByID(id uint) (*entities.Therapist, error)
+	Update(therapist *entities.Therapist) error
+	Delete(id uint) error
+
+	// Listagem e filtros
+	List(includeInactive bool) ([]*entities.Therapist, error)
+
+	// Turnos (com o massoterapeuta carregado)
+	CreateShift(shift *entities.TherapistShift) error
+	GetShiftByID(id uint) (*entities.TherapistShift, error)
+	UpdateShift(shift *entities.TherapistShift) error
+	DeleteShift(id uint) error
+	GetShiftsByTherapist(therapistID uint) ([]*entities.TherapistShift, error)
+	GetShiftsByChairs(chairIDs []uint) ([]*entities.TherapistShift, error)
+
+	// Ausências
+	CreateAbsence(absence *entities.TherapistAbsence) error
+	GetAbsenceByID(id uint) (*entities.TherapistAbsence, error)
+	DeleteAbsence(id uint) error
+	GetAbsencesByTherapist(therapistID uint) ([]*entities.TherapistAbsence, error)
+	GetAbsencesInRange(therapistIDs []uint, startDate, endDate time.Time) ([]*entities.TherapistAbsence, error)
+}
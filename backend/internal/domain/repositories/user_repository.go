@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"agendamento-backend/internal/domain/entities"
 )
 
@@ -21,11 +23,17 @@ type UserRepository interface {
 	GetByStatus(status string, limit, offset int) ([]*entities.User, int64, error)
 
 	// Operações específicas
-	Approve(id uint, approvedBy uint) error
+	Approve(id uint, approvedBy *uint) error
 	Reject(id uint, rejectedBy uint, reason string) error
 	ChangeRole(id uint, newRole string, changedBy uint) error
 	ChangeStatus(id uint, newStatus string, changedBy uint) error
 	UpdateLastLogin(id uint) error
+	UpdateCalendarToken(id uint, token string) error
+	GetByCalendarToken(token string) (*entities.User, error)
+	UpdateLocation(id uint, locationID *uint) error
+	GetByLocation(locationID uint) ([]*entities.User, error)
+	MarkEmailVerified(id uint, verifiedAt time.Time) error
+	UpdateEmailVerificationSentAt(id uint, sentAt time.Time) error
 
 	// Validações
 	ExistsByEmail(email string) (bool, error)
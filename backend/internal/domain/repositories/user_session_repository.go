@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type UserSessionRepository interface {
+	// CRUD básico
+	Create(session *entities.UserSession) error
+	GetByID(id uint) (*entities.UserSession, error)
+	GetByFamily(familyID string) (*entities.UserSession, error)
+	ListActiveByUser(userID uint, now time.Time) ([]*entities.UserSession, error)
+
+	// UpdateActivity grava o último acesso, o navegador, o IP e a validade da sessão
+	UpdateActivity(session *entities.UserSession) error
+
+	// Encerramento. Revoke retorna false se a sessão já estava encerrada;
+	// RevokeByUser encerra todas as sessões ativas do usuário, menos exceptID (0 = nenhuma)
+	Revoke(id uint, reason string, revokedAt time.Time) (bool, error)
+	RevokeByUser(userID, exceptID uint, reason string, revokedAt time.Time) (int64, error)
+
+	// Limpeza das sessões vencidas do usuário
+	DeleteExpiredByUser(userID uint, before time.Time) error
+}
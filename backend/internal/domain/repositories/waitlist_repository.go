@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"time"
+)
+
+type WaitlistRepository interface {
+	// CRUD básico
+	Create(entry *entities.WaitlistEntry) error
+	GetByID(id uint) (*entities.WaitlistEntry, error)
+	Update(entry *entities.WaitlistEntry) error
+
+	// Consultas da fila
+	GetByUser(userID uint) ([]*entities.WaitlistEntry, error)
+	GetByChairAndDate(chairID uint, date time.Time) ([]*entities.WaitlistEntry, error)
+	GetWaitingForChairAndDate(chairID uint, date time.Time) ([]*entities.WaitlistEntry, error)
+	GetByOfferTokenHash(tokenHash string) (*entities.WaitlistEntry, error)
+	GetExpiredOffers(now time.Time) ([]*entities.WaitlistEntry, error)
+
+	// Oferta: ClaimOffer só grava se a oferta ainda estiver pendente com o mesmo
+	// token, para que dois envios do mesmo link não usem a vaga duas vezes
+	ClaimOffer(id uint, tokenHash string) (bool, error)
+
+	// Validações
+	HasOpenEntry(userID, chairID uint, date time.Time) (bool, error)
+}
@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"agendamento-backend/internal/domain/ports"
+)
+
+// HMACTokenSigner implementa TokenSigner com HMAC-SHA256. O token tem o formato
+// conteúdo.assinatura, ambos em base64 seguro para URL
+type HMACTokenSigner struct {
+	secret []byte
+}
+
+// NewHMACTokenSigner cria uma nova instância do HMACTokenSigner
+func NewHMACTokenSigner(secret string) ports.TokenSigner {
+	return &HMACTokenSigner{secret: []byte(secret)}
+}
+
+// Sign assina o conteúdo
+func (s *HMACTokenSigner) Sign(payload string) string {
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.signature(encoded))
+}
+
+// Verify confere a assinatura e devolve o conteúdo
+func (s *HMACTokenSigner) Verify(token string) (string, error) {
+	encoded, sig, found := strings.Cut(token, ".")
+	if !found {
+		return "", errors.New("token malformado")
+	}
+
+	provided, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return "", errors.New("token malformado")
+	}
+
+	if !hmac.Equal(provided, s.signature(encoded)) {
+		return "", errors.New("assinatura do token inválida")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.New("token malformado")
+	}
+
+	return string(payload), nil
+}
+
+// signature calcula o HMAC do conteúdo codificado
+func (s *HMACTokenSigner) signature(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
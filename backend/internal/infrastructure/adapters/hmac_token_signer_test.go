@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHMACTokenSigner_SignAndVerify(t *testing.T) {
+	signer := NewHMACTokenSigner("segredo")
+
+	token := signer.Sign("42:abc:1700000000")
+	assert.NotContains(t, token, "42:abc")
+
+	payload, err := signer.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "42:abc:1700000000", payload)
+}
+
+func TestHMACTokenSigner_Verify_Invalid(t *testing.T) {
+	signer := NewHMACTokenSigner("segredo")
+	token := signer.Sign("42:abc:1700000000")
+	encoded, sig, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Sem assinatura", encoded},
+		{"Conteúdo alterado", signer.Sign("43:abc:1700000000")[:len(encoded)] + "." + sig},
+		{"Assinatura alterada", encoded + "." + sig[:len(sig)-2] + "AA"},
+		{"Outro segredo", NewHMACTokenSigner("outro").Sign("42:abc:1700000000")},
+		{"Vazio", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := signer.Verify(tt.token)
+			assert.Error(t, err)
+		})
+	}
+}
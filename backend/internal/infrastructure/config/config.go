@@ -1,18 +1,27 @@
 package config
 
 import (
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Config representa a configuração da aplicação
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Email    EmailConfig
-	Logging  LoggingConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	Auth      AuthConfig
+	Email     EmailConfig
+	Logging   LoggingConfig
+	Penalty   PenaltyConfig
+	Scheduler SchedulerConfig
+	Presence  PresenceConfig
+	Feedback  FeedbackConfig
+	Slots     SlotsConfig
+	Tenancy   TenancyConfig
 }
 
 // ServerConfig configurações do servidor
@@ -21,6 +30,7 @@ type ServerConfig struct {
 	Mode         string
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	AppURL       string // endereço público usado nos links enviados aos usuários
 }
 
 // DatabaseConfig configurações do banco de dados
@@ -39,8 +49,22 @@ type DatabaseConfig struct {
 
 // JWTConfig configurações do JWT
 type JWTConfig struct {
-	Secret     string
-	Expiration time.Duration
+	Secret            string
+	Expiration        time.Duration
+	RefreshExpiration time.Duration // validade de cada refresh token
+}
+
+// AuthConfig configurações da recuperação de acesso e da confirmação de email
+type AuthConfig struct {
+	PasswordResetTTL        time.Duration // validade do link de redefinição de senha
+	EmailVerificationTTL    time.Duration // validade do link de confirmação de email
+	EmailAutoApproveDomains []string      // domínios cujos cadastros são aprovados ao confirmar o email
+
+	// Proteção contra tentativas de senha em série
+	LoginFailureWindow    time.Duration // janela de contagem das senhas erradas
+	LoginMaxFailures      int           // senhas erradas seguidas de um CPF até o bloqueio; 0 = sem bloqueio
+	LoginLockoutDuration  time.Duration // duração do bloqueio
+	LoginMaxFailuresPerIP int           // senhas erradas de um IP, em qualquer CPF, na janela; 0 = sem limite
 }
 
 // EmailConfig configurações de email
@@ -53,6 +77,62 @@ type EmailConfig struct {
 	FromName     string
 }
 
+// PenaltyConfig configurações de penalidades por faltas e cancelamentos tardios
+type PenaltyConfig struct {
+	Window                 time.Duration
+	NoShowLimit            int
+	LateCancellationLimit  int
+	LateCancellationWindow time.Duration
+	SuspensionDuration     time.Duration
+}
+
+// SchedulerConfig configurações das rotinas automáticas
+type SchedulerConfig struct {
+	NoShowGracePeriod time.Duration // tolerância após o fim da sessão antes de marcar falta
+}
+
+// PresenceConfig configurações da confirmação de presença pelo link do lembrete
+type PresenceConfig struct {
+	ConfirmationCutoff time.Duration // antecedência até a qual a presença pode ser confirmada
+	TokenSecret        string
+}
+
+// FeedbackConfig configurações da avaliação das sessões realizadas
+type FeedbackConfig struct {
+	Window time.Duration // prazo, após o fim da sessão, para avaliar; também limita os convites
+}
+
+// SlotsConfig configurações da consulta de horários disponíveis
+type SlotsConfig struct {
+	HorizonDays int // quantos dias à frente os horários podem ser consultados
+}
+
+// TenancyConfig configurações do isolamento por cliente (tenant)
+type TenancyConfig struct {
+	BaseDomain  string // domínio cujos subdomínios identificam os tenants; vazio = só pelo token
+	DefaultSlug string // tenant das requisições sem subdomínio nem token
+}
+
+// AppURL devolve o endereço público do tenant: o subdomínio dele no domínio base,
+// mantendo esquema e porta de appURL. O tenant padrão, ou qualquer tenant quando não há
+// domínio base, usa appURL
+func (t TenancyConfig) AppURL(appURL, slug string) string {
+	if t.BaseDomain == "" || slug == t.DefaultSlug {
+		return appURL
+	}
+
+	parsed, err := url.Parse(appURL)
+	if err != nil || parsed.Host == "" {
+		return appURL
+	}
+	host := slug + "." + t.BaseDomain
+	if port := parsed.Port(); port != "" {
+		host += ":" + port
+	}
+	parsed.Host = host
+	return parsed.String()
+}
+
 // LoggingConfig configurações de logging
 type LoggingConfig struct {
 	Level  string
@@ -61,12 +141,15 @@ type LoggingConfig struct {
 
 // Load carrega a configuração da aplicação
 func Load() *Config {
+	jwtSecret := getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production")
+
 	return &Config{
 		Server: ServerConfig{
 			Port:         getEnv("PORT", "8080"),
 			Mode:         getEnv("GIN_MODE", "release"),
 			ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 30*time.Second),
 			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 30*time.Second),
+			AppURL:       strings.TrimRight(getEnv("APP_URL", "http://localhost:8080"), "/"),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -81,8 +164,18 @@ func Load() *Config {
 			ConnMaxLifetime: getDurationEnv("DB_CONN_MAX_LIFETIME", time.Hour),
 		},
 		JWT: JWTConfig{
-			Secret:     getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production"),
-			Expiration: getDurationEnv("JWT_EXPIRATION", 24*time.Hour),
+			Secret:            jwtSecret,
+			Expiration:        getDurationEnv("JWT_EXPIRATION", 24*time.Hour),
+			RefreshExpiration: getDurationEnv("REFRESH_TOKEN_EXPIRATION", 7*24*time.Hour),
+		},
+		Auth: AuthConfig{
+			PasswordResetTTL:        getDurationEnv("PASSWORD_RESET_TTL", time.Hour),
+			EmailVerificationTTL:    getDurationEnv("EMAIL_VERIFICATION_TTL", 48*time.Hour),
+			EmailAutoApproveDomains: getListEnv("EMAIL_AUTO_APPROVE_DOMAINS"),
+			LoginFailureWindow:      getDurationEnv("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			LoginMaxFailures:        getIntEnv("LOGIN_MAX_FAILURES", 5),
+			LoginLockoutDuration:    getDurationEnv("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			LoginMaxFailuresPerIP:   getIntEnv("LOGIN_MAX_FAILURES_PER_IP", 20),
 		},
 		Email: EmailConfig{
 			SMTPHost:     getEnv("SMTP_HOST", "smtp.gmail.com"),
@@ -96,6 +189,30 @@ func Load() *Config {
 			Level:  getEnv("LOG_LEVEL", "info"),
 			Format: getEnv("LOG_FORMAT", "json"),
 		},
+		Penalty: PenaltyConfig{
+			Window:                 getDurationEnv("PENALTY_WINDOW", 30*24*time.Hour),
+			NoShowLimit:            getIntEnv("PENALTY_NO_SHOW_LIMIT", 3),
+			LateCancellationLimit:  getIntEnv("PENALTY_LATE_CANCELLATION_LIMIT", 3),
+			LateCancellationWindow: getDurationEnv("PENALTY_LATE_CANCELLATION_WINDOW", 24*time.Hour),
+			SuspensionDuration:     getDurationEnv("PENALTY_SUSPENSION_DURATION", 14*24*time.Hour),
+		},
+		Scheduler: SchedulerConfig{
+			NoShowGracePeriod: getDurationEnv("NO_SHOW_GRACE_PERIOD", 2*time.Hour),
+		},
+		Presence: PresenceConfig{
+			ConfirmationCutoff: getDurationEnv("PRESENCE_CONFIRMATION_CUTOFF", 2*time.Hour),
+			TokenSecret:        getEnv("PRESENCE_TOKEN_SECRET", jwtSecret),
+		},
+		Feedback: FeedbackConfig{
+			Window: getDurationEnv("FEEDBACK_WINDOW", 7*24*time.Hour),
+		},
+		Slots: SlotsConfig{
+			HorizonDays: getIntEnv("SLOT_HORIZON_DAYS", 15),
+		},
+		Tenancy: TenancyConfig{
+			BaseDomain:  strings.ToLower(getEnv("TENANT_BASE_DOMAIN", "")),
+			DefaultSlug: getEnv("TENANT_DEFAULT_SLUG", "principal"),
+		},
 	}
 }
 
@@ -117,6 +234,17 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getListEnv obtém uma variável de ambiente com valores separados por vírgula
+func getListEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // getDurationEnv obtém uma variável de ambiente como duração ou retorna o valor padrão
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -8,6 +9,7 @@ import (
 
 	"agendamento-backend/internal/application/usecases"
 	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/infrastructure/tenancy"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -60,6 +62,11 @@ func NewDatabase(config *Config) (*Database, error) {
 		return nil, fmt.Errorf("falha ao conectar com o banco de dados: %w", err)
 	}
 
+	// Isolamento por tenant nas conexões criadas com tenancy.Scope
+	if err := db.Use(tenancy.Plugin{}); err != nil {
+		return nil, fmt.Errorf("falha ao registrar isolamento por tenant: %w", err)
+	}
+
 	// Configurar pool de conexões
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -87,19 +94,77 @@ func GetConfigFromEnv() *Config {
 	}
 }
 
+// tenantModels são as tabelas com coluna tenant_id, na ordem de migração
+var tenantModels = []interface{}{
+	&entities.User{},
+	&entities.Location{},
+	&entities.Chair{},
+	&entities.Service{},
+	&entities.Availability{},
+	&entities.AvailabilityException{},
+	&entities.ChairBreak{},
+	&entities.ChairMaintenance{},
+	&entities.Closure{},
+	&entities.Therapist{},
+	&entities.TherapistShift{},
+	&entities.TherapistAbsence{},
+	&entities.Booking{},
+	&entities.BookingStatusHistory{},
+	&entities.WaitlistEntry{},
+	&entities.SlotHold{},
+	&entities.PresenceConfirmation{},
+	&entities.BookingFeedback{},
+	&entities.UserSession{},
+	&entities.RefreshToken{},
+	&entities.PasswordResetToken{},
+	&entities.LoginFailure{},
+	&entities.LoginLockout{},
+	&entities.BookingPolicy{},
+	&entities.BookingPenalty{},
+	&entities.BookingSuspension{},
+	&entities.AuditLog{},
+}
+
 // AutoMigrate executa as migrações automáticas
 func (d *Database) AutoMigrate() error {
-	err := d.DB.AutoMigrate(
-		&entities.User{},
-		&entities.Chair{},
-		&entities.Availability{},
-		&entities.Booking{},
-		&entities.AuditLog{},
-	)
+	// Bancos anteriores à confirmação de email ainda não têm a coluna
+	hadEmailVerification := d.DB.Migrator().HasColumn(&entities.User{}, "EmailVerifiedAt")
+
+	err := d.DB.AutoMigrate(append([]interface{}{&entities.Tenant{}}, tenantModels...)...)
 	if err != nil {
 		return fmt.Errorf("falha ao executar migrações: %w", err)
 	}
 
+	if err := d.ensureBookingOverlapConstraint(); err != nil {
+		return err
+	}
+
+	if err := d.ensureSlotHoldOverlapConstraint(); err != nil {
+		return err
+	}
+
+	if err := d.dropGlobalServiceNameIndex(); err != nil {
+		return err
+	}
+
+	if err := d.linkChairLocations(); err != nil {
+		return err
+	}
+
+	if err := d.linkClosureLocations(); err != nil {
+		return err
+	}
+
+	if err := d.hashWaitlistOfferTokens(); err != nil {
+		return err
+	}
+
+	if !hadEmailVerification {
+		if err := d.markExistingEmailsVerified(); err != nil {
+			return err
+		}
+	}
+
 	log.Println("Migrações executadas com sucesso")
 	return nil
 }
@@ -122,6 +187,234 @@ func (d *Database) Ping() error {
 	return sqlDB.Ping()
 }
 
+// ensureBookingOverlapConstraint cria a restrição de exclusão que impede dois agendamentos
+// ativos na mesma cadeira com horários sobrepostos. É a garantia final contra requisições
+// simultâneas sobre o intervalo da sessão. Folga, pausas e manutenções ficam com o
+// repositório de agendamentos, que bloqueia a cadeira na transação antes de gravar
+func (d *Database) ensureBookingOverlapConstraint() error {
+	created, err := d.ensureExclusionConstraint("bookings_no_overlap", `ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap
+		EXCLUDE USING gist (chair_id WITH =, tstzrange(start_time, end_time, '[)') WITH &&)
+		WHERE (status IN ('agendado', 'presenca_confirmada') AND deleted_at IS NULL)`)
+	if err != nil {
+		return fmt.Errorf("falha ao criar restrição de sobreposição de agendamentos (verifique agendamentos ativos sobrepostos): %w", err)
+	}
+
+	if created {
+		log.Println("Restrição de sobreposição de agendamentos criada")
+	}
+	return nil
+}
+
+// ensureSlotHoldOverlapConstraint impede duas reservas temporárias ativas sobrepostas na
+// mesma cadeira. Reservas vencidas continuam 'ativo' até a rotina de expiração, por isso
+// a criação de reservas expira as vencidas antes de gravar
+func (d *Database) ensureSlotHoldOverlapConstraint() error {
+	created, err := d.ensureExclusionConstraint("slot_holds_no_overlap", `ALTER TABLE slot_holds ADD CONSTRAINT slot_holds_no_overlap
+		EXCLUDE USING gist (chair_id WITH =, tstzrange(start_time, end_time, '[)') WITH &&)
+		WHERE (status = 'ativo')`)
+	if err != nil {
+		return fmt.Errorf("falha ao criar restrição de sobreposição de reservas: %w", err)
+	}
+
+	if created {
+		log.Println("Restrição de sobreposição de reservas criada")
+	}
+	return nil
+}
+
+// dropGlobalServiceNameIndex remove o índice único antigo do nome do serviço: o nome
+// passou a ser único apenas dentro de cada tenant (idx_services_tenant_name)
+func (d *Database) dropGlobalServiceNameIndex() error {
+	migrator := d.DB.Migrator()
+	if !migrator.HasIndex(&entities.Service{}, "idx_services_name") {
+		return nil
+	}
+
+	if err := migrator.DropIndex(&entities.Service{}, "idx_services_name"); err != nil {
+		return fmt.Errorf("falha ao remover índice único global do nome do serviço: %w", err)
+	}
+	log.Println("Índice único global do nome do serviço removido")
+	return nil
+}
+
+// linkChairLocations cadastra como localidade cada texto livre de Chair.Location ainda
+// sem cadastro e vincula as cadeiras a ela, dentro do tenant de cada cadeira. Roda a
+// cada inicialização, mas só encontra trabalho em bancos criados antes do cadastro de
+// localidades
+func (d *Database) linkChairLocations() error {
+	var pending []struct {
+		TenantID uint
+		Location string
+	}
+	if err := d.DB.Model(&entities.Chair{}).Where("location_id IS NULL AND location <> ''").
+		Distinct("tenant_id", "location").Scan(&pending).Error; err != nil {
+		return fmt.Errorf("falha ao buscar localidades das cadeiras: %w", err)
+	}
+
+	for _, chair := range pending {
+		location := entities.Location{TenantID: chair.TenantID, Name: chair.Location, Site: chair.Location, Timezone: entities.DefaultLocationTimezone, IsActive: true}
+		if err := d.DB.Where("tenant_id = ? AND LOWER(name) = LOWER(?)", chair.TenantID, chair.Location).FirstOrCreate(&location).Error; err != nil {
+			return fmt.Errorf("falha ao cadastrar localidade %s: %w", chair.Location, err)
+		}
+		if err := d.DB.Model(&entities.Chair{}).Where("tenant_id = ? AND location_id IS NULL AND location = ?", chair.TenantID, chair.Location).
+			Updates(map[string]interface{}{"location_id": location.ID, "location": location.Name}).Error; err != nil {
+			return fmt.Errorf("falha ao vincular cadeiras à localidade %s: %w", chair.Location, err)
+		}
+	}
+
+	if len(pending) > 0 {
+		log.Printf("%d localidade(s) vinculada(s) às cadeiras existentes", len(pending))
+	}
+	return nil
+}
+
+// linkClosureLocations vincula à localidade cadastrada os fechamentos gravados só com
+// o nome dela, de antes de location_id. Nomes ainda sem cadastro viram localidades,
+// como em linkChairLocations, para que o fechamento não passe a valer para todas
+func (d *Database) linkClosureLocations() error {
+	var pending []struct {
+		TenantID uint
+		Location string
+	}
+	if err := d.DB.Model(&entities.Closure{}).Where("location_id IS NULL AND location <> ''").
+		Distinct("tenant_id", "location").Scan(&pending).Error; err != nil {
+		return fmt.Errorf("falha ao buscar localidades dos fechamentos: %w", err)
+	}
+
+	for _, closure := range pending {
+		location := entities.Location{TenantID: closure.TenantID, Name: closure.Location, Site: closure.Location, Timezone: entities.DefaultLocationTimezone, IsActive: true}
+		if err := d.DB.Where("tenant_id = ? AND LOWER(name) = LOWER(?)", closure.TenantID, closure.Location).FirstOrCreate(&location).Error; err != nil {
+			return fmt.Errorf("falha ao cadastrar localidade %s: %w", closure.Location, err)
+		}
+		if err := d.DB.Model(&entities.Closure{}).Where("tenant_id = ? AND location_id IS NULL AND location = ?", closure.TenantID, closure.Location).
+			Updates(map[string]interface{}{"location_id": location.ID, "location": location.Name}).Error; err != nil {
+			return fmt.Errorf("falha ao vincular fechamentos à localidade %s: %w", closure.Location, err)
+		}
+	}
+
+	if len(pending) > 0 {
+		log.Printf("%d localidade(s) vinculada(s) aos fechamentos existentes", len(pending))
+	}
+	return nil
+}
+
+// hashWaitlistOfferTokens troca os tokens de oferta da lista de espera, antes gravados
+// como enviados no email, pelo hash em offer_token_hash e remove a coluna antiga. As
+// reservas dessas ofertas também passam a usar o hash, para que o aceite as encontre
+func (d *Database) hashWaitlistOfferTokens() error {
+	migrator := d.DB.Migrator()
+	if !migrator.HasColumn(&entities.WaitlistEntry{}, "offer_token") {
+		return nil
+	}
+
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`UPDATE slot_holds SET token = encode(sha256(convert_to(token, 'UTF8')), 'hex')
+			WHERE token IN (SELECT offer_token FROM waitlist_entries WHERE offer_token IS NOT NULL)`).Error; err != nil {
+			return fmt.Errorf("falha ao converter reservas das ofertas da lista de espera: %w", err)
+		}
+		if err := tx.Exec(`UPDATE waitlist_entries SET offer_token_hash = encode(sha256(convert_to(offer_token, 'UTF8')), 'hex')
+			WHERE offer_token IS NOT NULL`).Error; err != nil {
+			return fmt.Errorf("falha ao converter tokens das ofertas da lista de espera: %w", err)
+		}
+		if err := tx.Migrator().DropColumn(&entities.WaitlistEntry{}, "offer_token"); err != nil {
+			return fmt.Errorf("falha ao remover coluna offer_token da lista de espera: %w", err)
+		}
+
+		log.Println("Tokens das ofertas da lista de espera convertidos para hash")
+		return nil
+	})
+}
+
+// markExistingEmailsVerified considera confirmados os emails dos usuários cadastrados
+// antes da confirmação de email existir: eles nunca receberam o link e, sem isso,
+// nenhum cadastro pendente da época poderia ser aprovado. Roda só na migração que
+// cria a coluna
+func (d *Database) markExistingEmailsVerified() error {
+	result := d.DB.Model(&entities.User{}).Unscoped().Where("email_verified_at IS NULL").
+		UpdateColumn("email_verified_at", gorm.Expr("created_at"))
+	if result.Error != nil {
+		return fmt.Errorf("falha ao marcar emails dos usuários existentes como confirmados: %w", result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		log.Printf("%d usuário(s) existente(s) com email marcado como confirmado", result.RowsAffected)
+	}
+	return nil
+}
+
+// EnsureDefaultTenant garante o tenant que atende as requisições sem subdomínio nem
+// token. Na primeira execução com tenants, os dados existentes (todos com tenant_id
+// zero) passam a pertencer a ele, de modo que uma instalação de um cliente só
+// continua funcionando sem nenhuma configuração
+func (d *Database) EnsureDefaultTenant(slug string) (*entities.Tenant, error) {
+	var tenant entities.Tenant
+	err := d.DB.Where("slug = ?", slug).First(&tenant).Error
+	if err == nil {
+		return &tenant, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("falha ao buscar tenant padrão: %w", err)
+	}
+
+	tenant = entities.Tenant{Name: "Tenant padrão", Slug: slug, IsActive: true}
+	if err := tenant.Validate(); err != nil {
+		return nil, fmt.Errorf("tenant padrão %q: %w", slug, err)
+	}
+
+	var tenantCount int64
+	if err := d.DB.Model(&entities.Tenant{}).Unscoped().Count(&tenantCount).Error; err != nil {
+		return nil, fmt.Errorf("falha ao contar tenants: %w", err)
+	}
+
+	err = d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&tenant).Error; err != nil {
+			return err
+		}
+		if tenantCount > 0 {
+			return nil
+		}
+		for _, model := range tenantModels {
+			if err := tx.Model(model).Unscoped().Where("tenant_id = ?", entities.PlatformTenantID).
+				UpdateColumn("tenant_id", tenant.ID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao criar tenant padrão: %w", err)
+	}
+
+	log.Printf("Tenant padrão %s criado", slug)
+	return &tenant, nil
+}
+
+// ForTenant devolve o banco restrito ao tenant, usado para inserir os dados iniciais
+func (d *Database) ForTenant(tenantID uint) *Database {
+	return &Database{DB: tenancy.Scope(d.DB, tenantID)}
+}
+
+// ensureExclusionConstraint executa o ALTER TABLE informado quando a restrição ainda não existe
+func (d *Database) ensureExclusionConstraint(name, statement string) (bool, error) {
+	var exists bool
+	if err := d.DB.Raw("SELECT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = ?)", name).
+		Scan(&exists).Error; err != nil {
+		return false, fmt.Errorf("falha ao verificar restrição %s: %w", name, err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	if err := d.DB.Exec("CREATE EXTENSION IF NOT EXISTS btree_gist").Error; err != nil {
+		return false, fmt.Errorf("falha ao habilitar extensão btree_gist: %w", err)
+	}
+
+	if err := d.DB.Exec(statement).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // SeedData insere dados iniciais no banco
 func (d *Database) SeedData(userUseCase *usecases.UserUseCase) error {
 	// Verificar se já existem dados
@@ -174,24 +467,40 @@ func (d *Database) SeedData(userUseCase *usecases.UserUseCase) error {
 		}
 	}
 
+	// Criar localidades padrão da sede
+	locations := []entities.Location{
+		{Name: "Térreo - Sala 1", Site: "Sede", Floor: "Térreo", Room: "Sala 1", Timezone: entities.DefaultLocationTimezone, IsActive: true},
+		{Name: "Térreo - Sala 2", Site: "Sede", Floor: "Térreo", Room: "Sala 2", Timezone: entities.DefaultLocationTimezone, IsActive: true},
+		{Name: "1º Andar - Sala 1", Site: "Sede", Floor: "1º Andar", Room: "Sala 1", Timezone: entities.DefaultLocationTimezone, IsActive: true},
+	}
+
+	for i := range locations {
+		if err := d.DB.Create(&locations[i]).Error; err != nil {
+			return fmt.Errorf("erro ao criar localidade %s: %w", locations[i].Name, err)
+		}
+	}
+
 	// Criar cadeiras padrão
 	chairs := []entities.Chair{
 		{
 			Name:        "Cadeira 01",
 			Description: "Cadeira de massagem localizada no térreo",
 			Location:    "Térreo - Sala 1",
+			LocationID:  &locations[0].ID,
 			Status:      "ativa",
 		},
 		{
 			Name:        "Cadeira 02",
 			Description: "Cadeira de massagem localizada no térreo",
 			Location:    "Térreo - Sala 2",
+			LocationID:  &locations[1].ID,
 			Status:      "ativa",
 		},
 		{
 			Name:        "Cadeira 03",
 			Description: "Cadeira de massagem localizada no primeiro andar",
 			Location:    "1º Andar - Sala 1",
+			LocationID:  &locations[2].ID,
 			Status:      "ativa",
 		},
 	}
@@ -205,12 +514,74 @@ func (d *Database) SeedData(userUseCase *usecases.UserUseCase) error {
 		createdChairs = append(createdChairs, chair)
 	}
 
+	// Criar catálogo de serviços padrão, oferecido por todas as cadeiras
+	services := []entities.Service{
+		{Name: "Sessão rápida", Description: "Massagem expressa", DurationMinutes: 15, IsActive: true},
+		{Name: "Sessão padrão", Description: "Massagem tradicional", DurationMinutes: 30, IsActive: true},
+		{Name: "Sessão profunda", Description: "Massagem completa", DurationMinutes: 60, IsActive: true},
+	}
+
+	for i := range services {
+		if err := d.DB.Create(&services[i]).Error; err != nil {
+			return fmt.Errorf("erro ao criar serviço %s: %w", services[i].Name, err)
+		}
+	}
+
+	for i := range createdChairs {
+		if err := d.DB.Model(&createdChairs[i]).Association("Services").Replace(services); err != nil {
+			return fmt.Errorf("erro ao associar serviços à cadeira %s: %w", createdChairs[i].Name, err)
+		}
+	}
+
+	// Criar política global com as regras padrão (editável pelos admins)
+	maxActiveBookings := 1
+	cancellationCutoff := int(entities.DefaultCancellationCutoff.Minutes())
+	defaultPolicy := entities.BookingPolicy{
+		Name:                      "Política padrão",
+		Description:               "Regras aplicadas a todos os usuários quando não há política mais específica",
+		MaxActiveBookings:         &maxActiveBookings,
+		CancellationCutoffMinutes: &cancellationCutoff,
+		IsActive:                  true,
+	}
+	if err := d.DB.Create(&defaultPolicy).Error; err != nil {
+		return fmt.Errorf("erro ao criar política padrão: %w", err)
+	}
 
 
 	log.Println("Dados iniciais inseridos com sucesso")
 	return nil
 }
 
+// SeedSuperAdmin cria o super admin inicial quando a plataforma ainda não tem
+// usuários. Recebe o banco e o caso de uso de usuários do escopo da plataforma
+func (d *Database) SeedSuperAdmin(userUseCase *usecases.UserUseCase) error {
+	var userCount int64
+	if err := d.DB.Model(&entities.User{}).Count(&userCount).Error; err != nil {
+		return fmt.Errorf("erro ao contar usuários da plataforma: %w", err)
+	}
+	if userCount > 0 {
+		return nil
+	}
+
+	superAdmin := entities.User{
+		Name:     "Super Administrador",
+		CPF:      "52998224725",
+		Email:    "superadmin@sistema.com",
+		Phone:    "(11) 99999-3333",
+		Password: "123456",
+		Role:     "super_admin",
+		Status:   "aprovado",
+		Gender:   "outro",
+	}
+	superAdmin.SetDefaultValues()
+	if err := userUseCase.CreateUser(&superAdmin, nil); err != nil {
+		return fmt.Errorf("erro ao criar super admin: %w", err)
+	}
+
+	log.Println("Super admin inicial criado")
+	return nil
+}
+
 // getEnv obtém variável de ambiente com valor padrão
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config contém as configurações do serviço de email
@@ -14,6 +15,7 @@ type Config struct {
 	SMTPPassword string
 	FromEmail    string
 	FromName     string
+	AppURL       string
 }
 
 // NewConfig cria uma nova configuração de email a partir das variáveis de ambiente
@@ -30,6 +32,7 @@ func NewConfig() (*Config, error) {
 		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
 		FromEmail:    getEnvOrDefault("FROM_EMAIL", os.Getenv("SMTP_USERNAME")),
 		FromName:     getEnvOrDefault("FROM_NAME", "Sistema de Agendamento"),
+		AppURL:       strings.TrimRight(getEnvOrDefault("APP_URL", "http://localhost:8080"), "/"),
 	}
 
 	if config.SMTPUsername == "" {
@@ -1,12 +1,20 @@
 package email
 
 import (
+	"agendamento-backend/internal/application/mappers"
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/domain/repositories"
+	"agendamento-backend/pkg/ical"
+	"bytes"
 	"crypto/tls"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"strings"
+	"time"
 )
 
 // EmailService implementa o repositório de email
@@ -31,7 +39,7 @@ func (s *EmailService) SendBookingConfirmation(user *entities.User, booking *ent
 		return fmt.Errorf("erro ao renderizar template: %v", err)
 	}
 
-	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+	return s.sendEmailWithAttachments(user.Email, subject, htmlBody, textBody, s.calendarInvite(user, booking, ical.MethodRequest))
 }
 
 // SendBookingCancellation envia email de cancelamento de agendamento
@@ -44,13 +52,140 @@ func (s *EmailService) SendBookingCancellation(user *entities.User, booking *ent
 		return fmt.Errorf("erro ao renderizar template: %v", err)
 	}
 
+	return s.sendEmailWithAttachments(user.Email, subject, htmlBody, textBody, s.calendarInvite(user, booking, ical.MethodCancel))
+}
+
+// SendBookingRescheduled envia o novo horário do agendamento. O convite reutiliza
+// o UID do evento original para que a agenda do usuário seja atualizada
+func (s *EmailService) SendBookingRescheduled(user *entities.User, booking *entities.Booking) error {
+	template := GetBookingRescheduledTemplate()
+	data := PrepareTemplateData(user, booking, &booking.Chair, "")
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmailWithAttachments(user.Email, subject, htmlBody, textBody, s.calendarInvite(user, booking, ical.MethodRequest))
+}
+
+// SendBookingRelocated envia a nova cadeira do agendamento e o motivo da troca. O
+// convite mantém o UID do evento, como no reagendamento
+func (s *EmailService) SendBookingRelocated(user *entities.User, booking *entities.Booking, reason string) error {
+	template := GetBookingRelocatedTemplate()
+	data := PrepareTemplateData(user, booking, &booking.Chair, reason)
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmailWithAttachments(user.Email, subject, htmlBody, textBody, s.calendarInvite(user, booking, ical.MethodRequest))
+}
+
+// SendChairOutageReport envia o resumo da indisponibilidade da cadeira para um membro da equipe
+func (s *EmailService) SendChairOutageReport(user *entities.User, chair *entities.Chair, reason string, impacts []*entities.OutageImpact) error {
+	template := GetChairOutageReportTemplate()
+	data := PrepareTemplateData(user, nil, chair, reason)
+	data.Impacts = impacts
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
 	return s.sendEmail(user.Email, subject, htmlBody, textBody)
 }
 
-// SendBookingReminder envia lembrete de agendamento
-func (s *EmailService) SendBookingReminder(user *entities.User, booking *entities.Booking) error {
+// calendarInvite gera o anexo .ics do agendamento com o método informado
+func (s *EmailService) calendarInvite(user *entities.User, booking *entities.Booking, method string) Attachment {
+	calendar := mappers.ToCalendarInvite(booking, method, s.config.FromEmail, user.Email)
+
+	return Attachment{
+		Filename:    "convite.ics",
+		ContentType: fmt.Sprintf("text/calendar; charset=UTF-8; method=%s", method),
+		Data:        []byte(calendar.String()),
+	}
+}
+
+// SendBookingReminder envia lembrete de agendamento. Os links abrem a página de
+// confirmação da API, que confirma ou cancela quando o usuário envia o formulário
+func (s *EmailService) SendBookingReminder(user *entities.User, booking *entities.Booking, confirmationToken string) error {
 	template := GetBookingReminderTemplate()
 	data := PrepareTemplateData(user, booking, &booking.Chair, "")
+	if confirmationToken != "" {
+		data.Link = fmt.Sprintf("%s/api/bookings/presence/%s?action=confirm", s.config.AppURL, confirmationToken)
+		data.CancelLink = fmt.Sprintf("%s/api/bookings/presence/%s?action=cancel", s.config.AppURL, confirmationToken)
+	}
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// SendFeedbackRequest envia o convite de avaliação com um link por nota e o prazo
+// para avaliar. Cada link abre a página de avaliação da API com a nota já marcada,
+// e a avaliação só é gravada quando o usuário envia o formulário
+func (s *EmailService) SendFeedbackRequest(user *entities.User, booking *entities.Booking, feedbackToken string, deadline time.Time) error {
+	template := GetFeedbackRequestTemplate()
+	data := PrepareTemplateData(user, booking, &booking.Chair, "")
+	data.Link = fmt.Sprintf("%s/api/bookings/feedback/%s", s.config.AppURL, feedbackToken)
+	for rating := entities.FeedbackMinRating; rating <= entities.FeedbackMaxRating; rating++ {
+		data.Ratings = append(data.Ratings, rating)
+	}
+	data.Deadline = deadline.Format("02/01/2006")
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// SendPasswordReset envia o link de redefinição de senha. O link abre a página da API
+// com o formulário que envia a nova senha para POST /api/auth/reset-password
+func (s *EmailService) SendPasswordReset(user *entities.User, resetToken string, expiresAt time.Time) error {
+	template := GetPasswordResetTemplate()
+	data := PrepareTemplateData(user, nil, nil, "")
+	data.Link = fmt.Sprintf("%s/api/auth/reset-password?token=%s", s.config.AppURL, resetToken)
+	data.Deadline = expiresAt.Format("02/01/2006 15:04")
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// SendAccountLocked avisa do bloqueio do login. O link abre a página da API que pede
+// a redefinição de senha, o que também desfaz o bloqueio
+func (s *EmailService) SendAccountLocked(user *entities.User, lockedUntil time.Time, ipAddress string) error {
+	template := GetAccountLockedTemplate()
+	data := PrepareTemplateData(user, nil, nil, "")
+	data.Link = fmt.Sprintf("%s/api/auth/forgot-password", s.config.AppURL)
+	data.IPAddress = ipAddress
+	data.Deadline = lockedUntil.Format("02/01/2006 15:04")
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// SendEmailVerification envia o link de confirmação do email. O link abre a página
+// de confirmação da API, e o endereço só é confirmado quando o usuário envia o formulário
+func (s *EmailService) SendEmailVerification(user *entities.User, verificationToken string, expiresAt time.Time) error {
+	template := GetEmailVerificationTemplate()
+	data := PrepareTemplateData(user, nil, nil, "")
+	data.Link = fmt.Sprintf("%s/api/auth/verify-email/%s", s.config.AppURL, verificationToken)
+	data.Deadline = expiresAt.Format("02/01/2006 15:04")
 
 	subject, htmlBody, textBody, err := RenderTemplate(template, data)
 	if err != nil {
@@ -99,49 +234,190 @@ func (s *EmailService) SendRoleChangeNotification(user *entities.User, newRole s
 	return s.sendEmail(user.Email, subject, htmlBody, textBody)
 }
 
-// sendEmail envia um email usando SMTP
-func (s *EmailService) sendEmail(to, subject, htmlBody, textBody string) error {
-	// Configurar autenticação SMTP
-	auth := smtp.PlainAuth("", s.config.SMTPUsername, s.config.SMTPPassword, s.config.SMTPHost)
+// SendWaitlistOffer envia a oferta de vaga da lista de espera com o link de aceite.
+// O link abre a página da oferta na API, que aceita ou recusa a vaga pelo formulário.
+// A entrada guarda só o hash, por isso o token do link vem à parte
+func (s *EmailService) SendWaitlistOffer(user *entities.User, entry *entities.WaitlistEntry, chair *entities.Chair, offerToken string) error {
+	if offerToken == "" || entry.OfferedStart == nil {
+		return fmt.Errorf("entrada da lista de espera sem oferta ativa")
+	}
 
-	// Preparar cabeçalhos do email
-	headers := make(map[string]string)
-	headers["From"] = fmt.Sprintf("%s <%s>", s.config.FromName, s.config.FromEmail)
-	headers["To"] = to
-	headers["Subject"] = subject
-	headers["MIME-Version"] = "1.0"
-	headers["Content-Type"] = "multipart/alternative; boundary=\"boundary123\""
+	template := GetWaitlistOfferTemplate()
+	data := PrepareTemplateData(user, nil, chair, "")
+	data.Date = entry.OfferedStart.Format("02/01/2006")
+	data.Time = entry.OfferedStart.Format("15:04")
+	data.Link = fmt.Sprintf("%s/api/bookings/waitlist/offers/%s", s.config.AppURL, offerToken)
+	if entry.OfferExpiresAt != nil {
+		data.Deadline = entry.OfferExpiresAt.Format("02/01/2006 15:04")
+	}
 
-	// Construir mensagem
-	message := ""
-	for k, v := range headers {
-		message += fmt.Sprintf("%s: %s\r\n", k, v)
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
 	}
-	message += "\r\n"
 
-	// Adicionar corpo multipart (texto e HTML)
-	message += "--boundary123\r\n"
-	message += "Content-Type: text/plain; charset=UTF-8\r\n"
-	message += "\r\n"
-	message += textBody + "\r\n"
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// SendBookingSuspension envia o aviso de suspensão do direito de agendar
+func (s *EmailService) SendBookingSuspension(user *entities.User, suspension *entities.BookingSuspension) error {
+	template := GetBookingSuspensionTemplate()
+	data := PrepareTemplateData(user, nil, nil, suspension.Reason)
+	data.Deadline = suspension.EndsAt.Format("02/01/2006 15:04")
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// SendSuspensionLifted envia o aviso de encerramento antecipado da suspensão
+func (s *EmailService) SendSuspensionLifted(user *entities.User, suspension *entities.BookingSuspension) error {
+	template := GetSuspensionLiftedTemplate()
+	data := PrepareTemplateData(user, nil, nil, suspension.LiftReason)
+
+	subject, htmlBody, textBody, err := RenderTemplate(template, data)
+	if err != nil {
+		return fmt.Errorf("erro ao renderizar template: %v", err)
+	}
+
+	return s.sendEmail(user.Email, subject, htmlBody, textBody)
+}
+
+// Attachment representa um arquivo anexado ao email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// sendEmail envia um email usando SMTP
+func (s *EmailService) sendEmail(to, subject, htmlBody, textBody string) error {
+	return s.sendEmailWithAttachments(to, subject, htmlBody, textBody)
+}
 
-	message += "--boundary123\r\n"
-	message += "Content-Type: text/html; charset=UTF-8\r\n"
-	message += "\r\n"
-	message += htmlBody + "\r\n"
+// sendEmailWithAttachments envia um email com anexos. Sem anexos a mensagem é
+// multipart/alternative (texto e HTML); com anexos, essa parte é aninhada em um
+// multipart/mixed junto com os arquivos
+func (s *EmailService) sendEmailWithAttachments(to, subject, htmlBody, textBody string, attachments ...Attachment) error {
+	// Configurar autenticação SMTP
+	auth := smtp.PlainAuth("", s.config.SMTPUsername, s.config.SMTPPassword, s.config.SMTPHost)
 
-	message += "--boundary123--\r\n"
+	message, err := buildMessage(
+		fmt.Sprintf("%s <%s>", s.config.FromName, s.config.FromEmail),
+		to, subject, htmlBody, textBody, attachments,
+	)
+	if err != nil {
+		return fmt.Errorf("erro ao montar mensagem: %v", err)
+	}
 
 	// Enviar email
 	addr := fmt.Sprintf("%s:%d", s.config.SMTPHost, s.config.SMTPPort)
 
 	// Para Gmail e outros provedores que requerem TLS
 	if s.config.SMTPPort == 587 {
-		return s.sendEmailWithTLS(addr, auth, s.config.FromEmail, []string{to}, []byte(message))
+		return s.sendEmailWithTLS(addr, auth, s.config.FromEmail, []string{to}, message)
 	}
 
 	// Para outros provedores
-	return smtp.SendMail(addr, auth, s.config.FromEmail, []string{to}, []byte(message))
+	return smtp.SendMail(addr, auth, s.config.FromEmail, []string{to}, message)
+}
+
+// buildMessage monta a mensagem MIME completa com cabeçalhos e corpo
+func buildMessage(from, to, subject, htmlBody, textBody string, attachments []Attachment) ([]byte, error) {
+	var body bytes.Buffer
+	alternative := multipart.NewWriter(&body)
+	contentType := fmt.Sprintf("multipart/alternative; boundary=%q", alternative.Boundary())
+
+	if len(attachments) > 0 {
+		mixed := multipart.NewWriter(&body)
+		contentType = fmt.Sprintf("multipart/mixed; boundary=%q", mixed.Boundary())
+
+		var inner bytes.Buffer
+		alternative = multipart.NewWriter(&inner)
+		if err := writeAlternative(alternative, htmlBody, textBody); err != nil {
+			return nil, err
+		}
+
+		part, err := mixed.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {fmt.Sprintf("multipart/alternative; boundary=%q", alternative.Boundary())},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(inner.Bytes()); err != nil {
+			return nil, err
+		}
+
+		for _, attachment := range attachments {
+			if err := writeAttachment(mixed, attachment); err != nil {
+				return nil, err
+			}
+		}
+		if err := mixed.Close(); err != nil {
+			return nil, err
+		}
+	} else if err := writeAlternative(alternative, htmlBody, textBody); err != nil {
+		return nil, err
+	}
+
+	var message bytes.Buffer
+	message.WriteString(fmt.Sprintf("From: %s\r\n", from))
+	message.WriteString(fmt.Sprintf("To: %s\r\n", to))
+	message.WriteString(fmt.Sprintf("Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", subject)))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString(fmt.Sprintf("Content-Type: %s\r\n", contentType))
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+// writeAlternative escreve as versões texto e HTML do corpo
+func writeAlternative(w *multipart.Writer, htmlBody, textBody string) error {
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", textBody},
+		{"text/html; charset=UTF-8", htmlBody},
+	}
+
+	for _, p := range parts {
+		part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {p.contentType}})
+		if err != nil {
+			return err
+		}
+		if _, err := part.Write([]byte(p.content + "\r\n")); err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
+
+// writeAttachment escreve o anexo codificado em base64 com linhas de 76 caracteres
+func writeAttachment(w *multipart.Writer, attachment Attachment) error {
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {attachment.ContentType},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {fmt.Sprintf("attachment; filename=%q", attachment.Filename)},
+	})
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = part.Write([]byte(encoded + "\r\n"))
+	return err
 }
 
 // sendEmailWithTLS envia email usando TLS (necessário para Gmail)
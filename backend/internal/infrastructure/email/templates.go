@@ -16,13 +16,19 @@ type EmailTemplate struct {
 
 // TemplateData contém os dados para renderização dos templates
 type TemplateData struct {
-	User     *entities.User
-	Booking  *entities.Booking
-	Chair    *entities.Chair
-	Reason   string
-	DateTime string
-	Date     string
-	Time     string
+	User       *entities.User
+	Booking    *entities.Booking
+	Chair      *entities.Chair
+	Reason     string
+	DateTime   string
+	Date       string
+	Time       string
+	Link       string
+	CancelLink string
+	Deadline   string
+	Impacts    []*entities.OutageImpact
+	Ratings    []int
+	IPAddress  string
 }
 
 // GetBookingConfirmationTemplate retorna o template de confirmação de agendamento
@@ -50,6 +56,7 @@ func GetBookingConfirmationTemplate() *EmailTemplate {
             <p><strong>Horário:</strong> {{.Time}}</p>
             <p><strong>Cadeira:</strong> {{.Chair.Name}}</p>
             <p><strong>Local:</strong> {{.Chair.Location}}</p>
+            {{if .Booking.Therapist}}<p><strong>Massoterapeuta:</strong> {{.Booking.Therapist.Name}}</p>{{end}}
         </div>
         
         <p><strong>Importante:</strong> Chegue com 5 minutos de antecedência.</p>
@@ -70,6 +77,8 @@ Detalhes do Agendamento:
 - Horário: {{.Time}}
 - Cadeira: {{.Chair.Name}}
 - Local: {{.Chair.Location}}
+{{- if .Booking.Therapist}}
+- Massoterapeuta: {{.Booking.Therapist.Name}}{{end}}
 
 Importante: Chegue com 5 minutos de antecedência.
 
@@ -162,8 +171,16 @@ func GetBookingReminderTemplate() *EmailTemplate {
             <p><strong>Horário:</strong> {{.Time}}</p>
             <p><strong>Cadeira:</strong> {{.Chair.Name}}</p>
             <p><strong>Local:</strong> {{.Chair.Location}}</p>
+            {{if .Booking.Therapist}}<p><strong>Massoterapeuta:</strong> {{.Booking.Therapist.Name}}</p>{{end}}
         </div>
+        {{if .Link}}
+        <p>Confirme sua presença pelo botão abaixo. Sem confirmação, o horário será liberado para outras pessoas.</p>
         
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="{{.Link}}" style="background-color: #28a745; color: #fff; padding: 12px 24px; border-radius: 5px; text-decoration: none;">Confirmar presença</a>
+            <a href="{{.CancelLink}}" style="background-color: #dc3545; color: #fff; padding: 12px 24px; border-radius: 5px; text-decoration: none; margin-left: 10px;">Cancelar agendamento</a>
+        </p>
+        {{end}}
         <p><strong>Lembre-se:</strong> Chegue com 5 minutos de antecedência.</p>
         
         <p>Caso precise cancelar, faça-o com pelo menos 2 horas de antecedência.</p>
@@ -182,7 +199,14 @@ Detalhes do Agendamento:
 - Horário: {{.Time}}
 - Cadeira: {{.Chair.Name}}
 - Local: {{.Chair.Location}}
-
+{{- if .Booking.Therapist}}
+- Massoterapeuta: {{.Booking.Therapist.Name}}{{end}}
+{{if .Link}}
+Confirme sua presença: {{.Link}}
+Cancelar agendamento: {{.CancelLink}}
+
+Sem confirmação, o horário será liberado para outras pessoas.
+{{end}}
 Lembre-se: Chegue com 5 minutos de antecedência.
 
 Caso precise cancelar, faça-o com pelo menos 2 horas de antecedência.
@@ -403,3 +427,525 @@ Equipe de agendamento
 `,
 	}
 }
+
+// GetWaitlistOfferTemplate retorna o template de oferta de vaga da lista de espera
+func GetWaitlistOfferTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Vaga disponível na lista de espera - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Vaga Disponível</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #28a745;">Abriu uma vaga para você!</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Um horário da sua lista de espera ficou disponível.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0; color: #28a745;">Detalhes da Vaga:</h3>
+            <p><strong>Data:</strong> {{.Date}}</p>
+            <p><strong>Horário:</strong> {{.Time}}</p>
+            <p><strong>Cadeira:</strong> {{.Chair.Name}}</p>
+            <p><strong>Local:</strong> {{.Chair.Location}}</p>
+        </div>
+        
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="{{.Link}}" style="background-color: #28a745; color: #fff; padding: 12px 24px; border-radius: 5px; text-decoration: none;">Aceitar vaga</a>
+        </p>
+        
+        <p><strong>Importante:</strong> a oferta vale até {{.Deadline}}. Depois disso a vaga é oferecida ao próximo da fila.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Um horário da sua lista de espera ficou disponível.
+
+Detalhes da Vaga:
+- Data: {{.Date}}
+- Horário: {{.Time}}
+- Cadeira: {{.Chair.Name}}
+- Local: {{.Chair.Location}}
+
+Para aceitar a vaga, acesse: {{.Link}}
+
+Importante: a oferta vale até {{.Deadline}}. Depois disso a vaga é oferecida ao próximo da fila.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetBookingSuspensionTemplate retorna o template de suspensão do direito de agendar
+func GetBookingSuspensionTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Agendamentos suspensos temporariamente - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Agendamentos Suspensos</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #dc3545;">Agendamentos Suspensos</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Seu direito de fazer novos agendamentos foi suspenso temporariamente.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0; color: #dc3545;">Detalhes da Suspensão:</h3>
+            <p><strong>Motivo:</strong> {{.Reason}}</p>
+            <p><strong>Válida até:</strong> {{.Deadline}}</p>
+        </div>
+        
+        <p>Faltas e cancelamentos em cima da hora impedem que outros colegas usem o horário. Após o prazo, os agendamentos são liberados automaticamente.</p>
+        
+        <p>Se acredita que houve um engano, entre em contato com a administração.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Seu direito de fazer novos agendamentos foi suspenso temporariamente.
+
+Detalhes da Suspensão:
+- Motivo: {{.Reason}}
+- Válida até: {{.Deadline}}
+
+Faltas e cancelamentos em cima da hora impedem que outros colegas usem o horário. Após o prazo, os agendamentos são liberados automaticamente.
+
+Se acredita que houve um engano, entre em contato com a administração.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetSuspensionLiftedTemplate retorna o template de encerramento da suspensão
+func GetSuspensionLiftedTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Agendamentos liberados - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Agendamentos Liberados</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #28a745;">Agendamentos Liberados</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>A suspensão dos seus agendamentos foi encerrada pela administração. Você já pode agendar novamente.</p>
+        
+        {{if .Reason}}
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <p><strong>Observação:</strong> {{.Reason}}</p>
+        </div>
+        {{end}}
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+A suspensão dos seus agendamentos foi encerrada pela administração. Você já pode agendar novamente.
+{{if .Reason}}
+Observação: {{.Reason}}
+{{end}}
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetBookingRescheduledTemplate retorna o template de reagendamento
+func GetBookingRescheduledTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Agendamento Reagendado - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Agendamento Reagendado</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #2c5aa0;">Agendamento Reagendado</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Seu agendamento foi alterado para um novo horário.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0; color: #2c5aa0;">Novo Horário:</h3>
+            <p><strong>Data:</strong> {{.Date}}</p>
+            <p><strong>Horário:</strong> {{.Time}}</p>
+            <p><strong>Cadeira:</strong> {{.Chair.Name}}</p>
+            <p><strong>Local:</strong> {{.Chair.Location}}</p>
+            {{if .Booking.Therapist}}<p><strong>Massoterapeuta:</strong> {{.Booking.Therapist.Name}}</p>{{end}}
+        </div>
+        
+        <p>O convite anexo atualiza o evento na sua agenda.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Seu agendamento foi alterado para um novo horário.
+
+Novo Horário:
+- Data: {{.Date}}
+- Horário: {{.Time}}
+- Cadeira: {{.Chair.Name}}
+- Local: {{.Chair.Location}}
+{{- if .Booking.Therapist}}
+- Massoterapeuta: {{.Booking.Therapist.Name}}{{end}}
+
+O convite anexo atualiza o evento na sua agenda.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetBookingRelocatedTemplate retorna o template de transferência de cadeira
+func GetBookingRelocatedTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Agendamento Transferido de Cadeira - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Agendamento Transferido</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #2c5aa0;">Agendamento Transferido</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>A cadeira do seu agendamento ficará indisponível, então transferimos sua sessão para outra cadeira no mesmo horário.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0; color: #2c5aa0;">Sua Sessão:</h3>
+            <p><strong>Data:</strong> {{.Date}}</p>
+            <p><strong>Horário:</strong> {{.Time}}</p>
+            <p><strong>Nova cadeira:</strong> {{.Chair.Name}}</p>
+            <p><strong>Local:</strong> {{.Chair.Location}}</p>
+            {{if .Booking.Therapist}}<p><strong>Massoterapeuta:</strong> {{.Booking.Therapist.Name}}</p>{{end}}
+            {{if .Reason}}<p><strong>Motivo:</strong> {{.Reason}}</p>{{end}}
+        </div>
+        
+        <p>O convite anexo atualiza o evento na sua agenda.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+A cadeira do seu agendamento ficará indisponível, então transferimos sua sessão para outra cadeira no mesmo horário.
+
+Sua Sessão:
+- Data: {{.Date}}
+- Horário: {{.Time}}
+- Nova cadeira: {{.Chair.Name}}
+- Local: {{.Chair.Location}}
+{{- if .Booking.Therapist}}
+- Massoterapeuta: {{.Booking.Therapist.Name}}{{end}}
+{{- if .Reason}}
+- Motivo: {{.Reason}}{{end}}
+
+O convite anexo atualiza o evento na sua agenda.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetChairOutageReportTemplate retorna o template do resumo de indisponibilidade da cadeira
+func GetChairOutageReportTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Cadeira {{.Chair.Name}} indisponível - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Cadeira Indisponível</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #dc3545;">Cadeira Indisponível</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>A cadeira <strong>{{.Chair.Name}}</strong> ({{.Chair.Location}}) ficará fora de serviço. Motivo: {{.Reason}}</p>
+        
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0; color: #dc3545;">Agendamentos Atingidos:</h3>
+            <ul>
+            {{range .Impacts}}
+                <li>{{.Booking.StartTime.Format "02/01/2006 15:04"}} - {{.Booking.User.Name}}: {{if .TargetChair}}transferido para {{.TargetChair.Name}}{{else}}cancelado{{end}}</li>
+            {{end}}
+            </ul>
+        </div>
+        
+        <p>Os usuários já foram avisados por email.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+A cadeira {{.Chair.Name}} ({{.Chair.Location}}) ficará fora de serviço. Motivo: {{.Reason}}
+
+Agendamentos Atingidos:
+{{- range .Impacts}}
+- {{.Booking.StartTime.Format "02/01/2006 15:04"}} - {{.Booking.User.Name}}: {{if .TargetChair}}transferido para {{.TargetChair.Name}}{{else}}cancelado{{end}}
+{{- end}}
+
+Os usuários já foram avisados por email.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetFeedbackRequestTemplate retorna o template do convite para avaliar a sessão
+func GetFeedbackRequestTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Como foi sua sessão? - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Avalie sua Sessão</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #2c5aa0;">Como foi sua sessão?</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Obrigado por usar o espaço de massagem. Sua opinião nos ajuda a manter e melhorar o serviço.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0; color: #2c5aa0;">Sua Sessão:</h3>
+            <p><strong>Data:</strong> {{.Date}}</p>
+            <p><strong>Horário:</strong> {{.Time}}</p>
+            <p><strong>Cadeira:</strong> {{.Chair.Name}}</p>
+            {{if .Booking.Therapist}}<p><strong>Massoterapeuta:</strong> {{.Booking.Therapist.Name}}</p>{{end}}
+        </div>
+        
+        <p>De 0 a 10, quanto você recomendaria as sessões de massagem a um colega?</p>
+        
+        <p style="text-align: center; margin: 30px 0;">
+            {{range .Ratings}}<a href="{{$.Link}}?rating={{.}}" style="display: inline-block; background-color: #2c5aa0; color: #fff; padding: 8px 12px; border-radius: 5px; text-decoration: none; margin: 2px;">{{.}}</a>{{end}}
+        </p>
+        
+        <p>Se algo não correu bem com a cadeira ou com o atendimento, você pode contar em detalhes pelo sistema até {{.Deadline}}.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Obrigado por usar o espaço de massagem. Sua opinião nos ajuda a manter e melhorar o serviço.
+
+Sua Sessão:
+- Data: {{.Date}}
+- Horário: {{.Time}}
+- Cadeira: {{.Chair.Name}}
+{{- if .Booking.Therapist}}
+- Massoterapeuta: {{.Booking.Therapist.Name}}{{end}}
+
+De 0 a 10, quanto você recomendaria as sessões de massagem a um colega? Abra o link da sua nota:
+{{- range .Ratings}}
+- {{.}}: {{$.Link}}?rating={{.}}
+{{- end}}
+
+Se algo não correu bem com a cadeira ou com o atendimento, você pode contar em detalhes pelo sistema até {{.Deadline}}.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetPasswordResetTemplate retorna o template do link de redefinição de senha
+func GetPasswordResetTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Redefinição de senha - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Redefinição de Senha</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #2c5aa0;">Redefinição de senha</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Recebemos um pedido para redefinir a senha da sua conta. Para criar uma nova senha, use o botão abaixo.</p>
+        
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="{{.Link}}" style="background-color: #2c5aa0; color: #fff; padding: 12px 24px; border-radius: 5px; text-decoration: none;">Criar nova senha</a>
+        </p>
+        
+        <p><strong>Importante:</strong> o link vale até {{.Deadline}} e pode ser usado uma única vez. Ao redefinir a senha, você será desconectado de todos os dispositivos.</p>
+        
+        <p>Se você não pediu a redefinição, ignore este email: sua senha continua a mesma.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Recebemos um pedido para redefinir a senha da sua conta. Para criar uma nova senha, acesse:
+{{.Link}}
+
+Importante: o link vale até {{.Deadline}} e pode ser usado uma única vez. Ao redefinir a senha, você será desconectado de todos os dispositivos.
+
+Se você não pediu a redefinição, ignore este email: sua senha continua a mesma.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetEmailVerificationTemplate retorna o template de confirmação do email do cadastro
+func GetEmailVerificationTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Confirme seu email - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Confirmação de Email</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #2c5aa0;">Confirme seu email</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Recebemos seu cadastro no sistema de agendamento. Para que ele possa ser aprovado, confirme que este endereço é seu pelo botão abaixo.</p>
+        
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="{{.Link}}" style="background-color: #2c5aa0; color: #fff; padding: 12px 24px; border-radius: 5px; text-decoration: none;">Confirmar email</a>
+        </p>
+        
+        <p><strong>Importante:</strong> o link vale até {{.Deadline}}. Depois disso, peça um novo link na tela de login.</p>
+        
+        <p>Se você não se cadastrou, ignore este email.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Recebemos seu cadastro no sistema de agendamento. Para que ele possa ser aprovado, confirme que este endereço é seu acessando:
+{{.Link}}
+
+Importante: o link vale até {{.Deadline}}. Depois disso, peça um novo link na tela de login.
+
+Se você não se cadastrou, ignore este email.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
+
+// GetAccountLockedTemplate retorna o template do aviso de login bloqueado
+func GetAccountLockedTemplate() *EmailTemplate {
+	return &EmailTemplate{
+		Subject: "Acesso bloqueado temporariamente - Sistema de agendamento de cadeiras de massagem",
+		HTML: `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Acesso Bloqueado</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #d32f2f;">Acesso bloqueado temporariamente</h2>
+        
+        <p>Olá <strong>{{.User.Name}}</strong>,</p>
+        
+        <p>Houve várias tentativas de login na sua conta com a senha errada{{if .IPAddress}}, a última vinda do endereço {{.IPAddress}}{{end}}. Por segurança, o acesso ficará bloqueado até {{.Deadline}}.</p>
+        
+        <p>Se foi você, aguarde o fim do bloqueio ou peça uma nova senha pelo link abaixo: a nova senha libera o acesso na hora.</p>
+        
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="{{.Link}}" style="background-color: #2c5aa0; color: #fff; padding: 12px 24px; border-radius: 5px; text-decoration: none;">Redefinir senha</a>
+        </p>
+        
+        <p><strong>Não foi você?</strong> Alguém pode estar tentando adivinhar sua senha. Recomendamos redefini-la e avisar o administrador do sistema.</p>
+        
+        <p>Atenciosamente,<br>Equipe de agendamento</p>
+    </div>
+</body>
+</html>`,
+		Text: `
+Olá {{.User.Name}},
+
+Houve várias tentativas de login na sua conta com a senha errada{{if .IPAddress}}, a última vinda do endereço {{.IPAddress}}{{end}}. Por segurança, o acesso ficará bloqueado até {{.Deadline}}.
+
+Se foi você, aguarde o fim do bloqueio ou peça uma nova senha pelo link abaixo: a nova senha libera o acesso na hora.
+{{.Link}}
+
+Não foi você? Alguém pode estar tentando adivinhar sua senha. Recomendamos redefini-la e avisar o administrador do sistema.
+
+Atenciosamente,
+Equipe de agendamento
+`,
+	}
+}
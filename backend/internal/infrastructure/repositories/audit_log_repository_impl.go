@@ -5,6 +5,7 @@ import (
 
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/domain/repositories"
+	"agendamento-backend/internal/infrastructure/tenancy"
 	"gorm.io/gorm"
 )
 
@@ -202,13 +203,15 @@ func (r *auditLogRepositoryImpl) GetMostActiveUsers(limit int, startDate, endDat
 			COUNT(al.id) as activity_count
 		FROM users u
 		INNER JOIN audit_logs al ON u.id = al.user_id
-		WHERE al.created_at >= ? AND al.created_at <= ?
+		WHERE al.tenant_id = ? AND al.created_at >= ? AND al.created_at <= ?
 		GROUP BY u.id, u.name, u.email
 		ORDER BY activity_count DESC
 		LIMIT ?
 	`
 
-	err := r.db.Raw(query, startDate, endDate, limit).Scan(&results).Error
+	// SQL escrito à mão não passa pelo escopo automático do tenant
+	tenantID, _ := tenancy.TenantID(r.db)
+	err := r.db.Raw(query, tenantID, startDate, endDate, limit).Scan(&results).Error
 	return results, err
 }
 
@@ -222,13 +225,14 @@ func (r *auditLogRepositoryImpl) GetMostCommonActions(limit int, startDate, endD
 			resource,
 			COUNT(*) as count
 		FROM audit_logs
-		WHERE created_at >= ? AND created_at <= ?
+		WHERE tenant_id = ? AND created_at >= ? AND created_at <= ?
 		GROUP BY action, resource
 		ORDER BY count DESC
 		LIMIT ?
 	`
 
-	err := r.db.Raw(query, startDate, endDate, limit).Scan(&results).Error
+	tenantID, _ := tenancy.TenantID(r.db)
+	err := r.db.Raw(query, tenantID, startDate, endDate, limit).Scan(&results).Error
 	return results, err
 }
 
@@ -19,6 +19,22 @@ func NewAvailabilityRepository(db *gorm.DB) repositories.AvailabilityRepository
 	}
 }
 
+// openOnDate descarta as janelas de cadeiras cuja localidade tem feriado ou
+// fechamento no dia (fechamentos sem localidade valem para todas as cadeiras do tenant)
+func openOnDate(query *gorm.DB, date time.Time) *gorm.DB {
+	return openOnDateFor(query, "availabilities.chair_id", date)
+}
+
+// openOnDateFor aplica o filtro de fechamentos usando a coluna de cadeira informada
+func openOnDateFor(query *gorm.DB, chairColumn string, date time.Time) *gorm.DB {
+	day := date.Format("2006-01-02")
+	return query.Where(`NOT EXISTS (
+		SELECT 1 FROM closures JOIN chairs ON chairs.id = `+chairColumn+`
+		WHERE closures.deleted_at IS NULL AND closures.tenant_id = chairs.tenant_id
+		AND closures.start_date <= ? AND closures.end_date >= ?
+		AND (closures.location_id IS NULL OR closures.location_id = chairs.location_id))`, day, day)
+}
+
 // Create cria uma nova disponibilidade
 func (r *availabilityRepositoryImpl) Create(availability *entities.Availability) error {
 	return r.db.Create(availability).Error
@@ -117,12 +133,14 @@ func (r *availabilityRepositoryImpl) GetValidForDate(date time.Time) ([]*entitie
 
 	// Filtrar por período de validade
 	query = query.Where("(valid_from IS NULL OR valid_from <= ?) AND (valid_to IS NULL OR valid_to >= ?)", date, date)
+	query = openOnDate(query, date)
 
 	err := query.Order("chair_id ASC, start_time ASC").Find(&availabilities).Error
 	return availabilities, err
 }
 
-// GetChairAvailabilityForDate busca disponibilidade de uma cadeira para uma data
+// GetChairAvailabilityForDate busca as janelas efetivas de uma cadeira em uma data:
+// as semanais, ajustadas pelas exceções cadastradas para o dia
 func (r *availabilityRepositoryImpl) GetChairAvailabilityForDate(chairID uint, date time.Time) ([]*entities.Availability, error) {
 	var availabilities []*entities.Availability
 	dayOfWeek := int(date.Weekday())
@@ -131,9 +149,32 @@ func (r *availabilityRepositoryImpl) GetChairAvailabilityForDate(chairID uint, d
 
 	// Filtrar por período de validade
 	query = query.Where("(valid_from IS NULL OR valid_from <= ?) AND (valid_to IS NULL OR valid_to >= ?)", date, date)
+	query = openOnDate(query, date)
 
-	err := query.Order("start_time ASC").Find(&availabilities).Error
-	return availabilities, err
+	if err := query.Order("start_time ASC").Find(&availabilities).Error; err != nil {
+		return nil, err
+	}
+
+	exceptions, err := r.getExceptionsOnDate(chairID, date)
+	if err != nil {
+		return nil, err
+	}
+	if len(exceptions) == 0 {
+		return availabilities, nil
+	}
+
+	return entities.ApplyAvailabilityExceptions(availabilities, exceptions, date), nil
+}
+
+// getExceptionsOnDate busca as exceções da cadeira no dia. Em feriados e fechamentos
+// da localidade nenhuma exceção é retornada: o fechamento prevalece
+func (r *availabilityRepositoryImpl) getExceptionsOnDate(chairID uint, date time.Time) ([]*entities.AvailabilityException, error) {
+	var exceptions []*entities.AvailabilityException
+	query := r.db.Where("chair_id = ? AND date = ?", chairID, date.Format("2006-01-02"))
+	query = openOnDateFor(query, "availability_exceptions.chair_id", date)
+
+	err := query.Order("start_time ASC").Find(&exceptions).Error
+	return exceptions, err
 }
 
 // HasConflict verifica se há conflito de horário
@@ -153,26 +194,120 @@ func (r *availabilityRepositoryImpl) HasConflict(chairID uint, dayOfWeek int, st
 
 // IsChairAvailableAtTime verifica se uma cadeira está disponível em um horário
 func (r *availabilityRepositoryImpl) IsChairAvailableAtTime(chairID uint, dateTime time.Time) (bool, error) {
-	// Converter UTC para horário local (GMT-3)
-	// Como estamos no Brasil (GMT-3), subtraímos 3 horas do UTC
-	localDateTime := dateTime.Add(-3 * time.Hour)
+	// Converter para o horário local da localidade da cadeira
+	zone, err := chairTimeZone(r.db, chairID)
+	if err != nil {
+		return false, err
+	}
+	localDateTime := dateTime.In(zone)
 
 	dayOfWeek := int(localDateTime.Weekday())
 	timeStr := localDateTime.Format("15:04")
 
+	// Com exceções no dia, a verificação usa as janelas efetivas
+	date := time.Date(localDateTime.Year(), localDateTime.Month(), localDateTime.Day(), 0, 0, 0, 0, time.UTC)
+	if windows, ok, err := r.effectiveWindows(chairID, date); err != nil || ok {
+		return windowsContain(windows, timeStr), err
+	}
+
 	var count int64
 	query := r.db.Model(&entities.Availability{}).
 		Where("chair_id = ? AND day_of_week = ? AND is_active = ? AND start_time <= ? AND end_time > ?",
 			chairID, dayOfWeek, true, timeStr, timeStr)
 
 	// Filtrar por período de validade
-	date := time.Date(localDateTime.Year(), localDateTime.Month(), localDateTime.Day(), 0, 0, 0, 0, time.UTC)
 	query = query.Where("(valid_from IS NULL OR valid_from <= ?) AND (valid_to IS NULL OR valid_to >= ?)", date, date)
+	query = openOnDate(query, date)
 
-	err := query.Count(&count).Error
+	err = query.Count(&count).Error
+	return count > 0, err
+}
+
+// IsChairAvailableForPeriod verifica se uma janela de disponibilidade cobre a sessão inteira
+func (r *availabilityRepositoryImpl) IsChairAvailableForPeriod(chairID uint, startTime, endTime time.Time) (bool, error) {
+	// Mesmo ajuste de fuso de IsChairAvailableAtTime
+	zone, err := chairTimeZone(r.db, chairID)
+	if err != nil {
+		return false, err
+	}
+	localStart := startTime.In(zone)
+	localEnd := endTime.In(zone)
+
+	dayOfWeek := int(localStart.Weekday())
+	startStr := localStart.Format("15:04")
+	endStr := localEnd.Format("15:04")
+	crossesMidnight := localEnd.Day() != localStart.Day()
+
+	// Com exceções no dia, a verificação usa as janelas efetivas
+	date := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, time.UTC)
+	if windows, ok, err := r.effectiveWindows(chairID, date); err != nil || ok {
+		return windowsCover(windows, startStr, endStr, crossesMidnight), err
+	}
+
+	query := r.db.Model(&entities.Availability{}).
+		Where("chair_id = ? AND day_of_week = ? AND is_active = ? AND start_time <= ?",
+			chairID, dayOfWeek, true, startStr)
+
+	// Sessões que terminam à meia-noite só cabem em janelas que vão até o fim do dia
+	if crossesMidnight {
+		if endStr != "00:00" {
+			return false, nil
+		}
+		query = query.Where("end_time = ?", "00:00")
+	} else {
+		query = query.Where("(end_time >= ? OR end_time = ?)", endStr, "00:00")
+	}
+
+	// Filtrar por período de validade
+	query = query.Where("(valid_from IS NULL OR valid_from <= ?) AND (valid_to IS NULL OR valid_to >= ?)", date, date)
+	query = openOnDate(query, date)
+
+	var count int64
+	err = query.Count(&count).Error
 	return count > 0, err
 }
 
+// effectiveWindows retorna as janelas efetivas da data quando a cadeira tem exceções
+// no dia. ok falso indica que vale apenas a disponibilidade semanal
+func (r *availabilityRepositoryImpl) effectiveWindows(chairID uint, date time.Time) ([]*entities.Availability, bool, error) {
+	var count int64
+	if err := r.db.Model(&entities.AvailabilityException{}).
+		Where("chair_id = ? AND date = ?", chairID, date.Format("2006-01-02")).
+		Count(&count).Error; err != nil || count == 0 {
+		return nil, false, err
+	}
+
+	windows, err := r.GetChairAvailabilityForDate(chairID, date)
+	return windows, true, err
+}
+
+// windowsContain verifica se algum horário de janela contém o instante HH:MM
+func windowsContain(windows []*entities.Availability, clock string) bool {
+	for _, window := range windows {
+		if window.StartTime <= clock && (clock < window.EndTime || window.EndTime == "00:00") {
+			return true
+		}
+	}
+	return false
+}
+
+// windowsCover verifica se alguma janela cobre a sessão inteira. Sessões que terminam
+// à meia-noite só cabem em janelas que vão até o fim do dia
+func windowsCover(windows []*entities.Availability, start, end string, crossesMidnight bool) bool {
+	if crossesMidnight && end != "00:00" {
+		return false
+	}
+	for _, window := range windows {
+		if window.StartTime > start {
+			continue
+		}
+		if window.EndTime == "00:00" || (!crossesMidnight && window.EndTime >= end) {
+			return true
+		}
+	}
+	return false
+}
+
 // Activate ativa uma disponibilidade
 func (r *availabilityRepositoryImpl) Activate(id uint) error {
 	return r.db.Model(&entities.Availability{}).Where("id = ?", id).Update("is_active", true).Error
@@ -211,3 +346,60 @@ func (r *availabilityRepositoryImpl) CountTotal() (int64, error) {
 	err := r.db.Model(&entities.Availability{}).Count(&count).Error
 	return count, err
 }
+
+// CreateException cria uma exceção de disponibilidade
+func (r *availabilityRepositoryImpl) CreateException(exception *entities.AvailabilityException) error {
+	return r.db.Create(exception).Error
+}
+
+// GetExceptionByID busca exceção por ID
+func (r *availabilityRepositoryImpl) GetExceptionByID(id uint) (*entities.AvailabilityException, error) {
+	var exception entities.AvailabilityException
+	err := r.db.First(&exception, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &exception, nil
+}
+
+// UpdateException atualiza uma exceção de disponibilidade
+func (r *availabilityRepositoryImpl) UpdateException(exception *entities.AvailabilityException) error {
+	return r.db.Save(exception).Error
+}
+
+// DeleteException exclui uma exceção (soft delete)
+func (r *availabilityRepositoryImpl) DeleteException(id uint) error {
+	return r.db.Delete(&entities.AvailabilityException{}, id).Error
+}
+
+// GetExceptionsByChair lista as exceções de uma cadeira no período
+func (r *availabilityRepositoryImpl) GetExceptionsByChair(chairID uint, from, to *time.Time) ([]*entities.AvailabilityException, error) {
+	var exceptions []*entities.AvailabilityException
+	query := r.db.Where("chair_id = ?", chairID)
+
+	if from != nil {
+		query = query.Where("date >= ?", from.Format("2006-01-02"))
+	}
+	if to != nil {
+		query = query.Where("date <= ?", to.Format("2006-01-02"))
+	}
+
+	err := query.Order("date ASC, start_time ASC").Find(&exceptions).Error
+	return exceptions, err
+}
+
+// GetActiveByChairs busca as janelas semanais ativas de um conjunto de cadeiras
+func (r *availabilityRepositoryImpl) GetActiveByChairs(chairIDs []uint) ([]*entities.Availability, error) {
+	var availabilities []*entities.Availability
+	err := r.db.Where("chair_id IN ? AND is_active = ?", chairIDs, true).
+		Order("chair_id ASC, start_time ASC").Find(&availabilities).Error
+	return availabilities, err
+}
+
+// GetExceptionsByChairs busca as exceções de um conjunto de cadeiras entre as datas (inclusive)
+func (r *availabilityRepositoryImpl) GetExceptionsByChairs(chairIDs []uint, from, to time.Time) ([]*entities.AvailabilityException, error) {
+	var exceptions []*entities.AvailabilityException
+	err := r.db.Where("chair_id IN ? AND date >= ? AND date <= ?", chairIDs, from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Order("chair_id ASC, date ASC, start_time ASC").Find(&exceptions).Error
+	return exceptions, err
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"fmt"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type bookingPolicyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBookingPolicyRepository(db *gorm.DB) repositories.BookingPolicyRepository {
+	return &bookingPolicyRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria uma nova política de agendamento
+func (r *bookingPolicyRepositoryImpl) Create(policy *entities.BookingPolicy) error {
+	return r.db.Create(policy).Error
+}
+
+// GetByID busca política por ID
+func (r *bookingPolicyRepositoryImpl) GetByID(id uint) (*entities.BookingPolicy, error) {
+	var policy entities.BookingPolicy
+	err := r.db.Preload("Chair").First(&policy, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Update atualiza uma política de agendamento
+func (r *bookingPolicyRepositoryImpl) Update(policy *entities.BookingPolicy) error {
+	return r.db.Omit("Chair").Save(policy).Error
+}
+
+// Delete exclui uma política (soft delete)
+func (r *bookingPolicyRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.BookingPolicy{}, id).Error
+}
+
+// List lista políticas com paginação e filtros
+func (r *bookingPolicyRepositoryImpl) List(limit, offset int, filters map[string]interface{}) ([]*entities.BookingPolicy, int64, error) {
+	var policies []*entities.BookingPolicy
+	var total int64
+
+	query := r.db.Model(&entities.BookingPolicy{})
+
+	// Aplicar filtros
+	for key, value := range filters {
+		switch key {
+		case "name":
+			query = query.Where("name ILIKE ?", fmt.Sprintf("%%%s%%", value))
+		case "role":
+			query = query.Where("role = ?", value)
+		case "sector":
+			query = query.Where("sector ILIKE ?", value)
+		case "chair_id":
+			query = query.Where("chair_id = ?", value)
+		case "is_active":
+			query = query.Where("is_active = ?", value)
+		}
+	}
+
+	// Contar total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Buscar com paginação
+	err := query.Preload("Chair").Limit(limit).Offset(offset).Order("id ASC").Find(&policies).Error
+	return policies, total, err
+}
+
+// GetActive busca todas as políticas ativas
+func (r *bookingPolicyRepositoryImpl) GetActive() ([]*entities.BookingPolicy, error) {
+	var policies []*entities.BookingPolicy
+	err := r.db.Where("is_active = ?", true).Order("id ASC").Find(&policies).Error
+	return policies, err
+}
@@ -1,12 +1,15 @@
 package repositories
 
 import (
+	"errors"
 	"time"
 
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/domain/repositories"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type bookingRepositoryImpl struct {
@@ -19,24 +22,114 @@ func NewBookingRepository(db *gorm.DB) repositories.BookingRepository {
 	}
 }
 
+// pgExclusionViolation é o código do Postgres para violação de restrição de exclusão
+const pgExclusionViolation = "23P01"
+
+// translateBookingError converte a violação da restrição de sobreposição de horários
+// em entities.ErrBookingConflict
+func translateBookingError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgExclusionViolation {
+		return entities.ErrBookingConflict
+	}
+	return err
+}
+
 // Create cria um novo agendamento
+// O massoterapeuta carregado no agendamento serve só para exibição e não é gravado
 func (r *bookingRepositoryImpl) Create(booking *entities.Booking) error {
-	return r.db.Create(booking).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := reserveChair(tx, booking); err != nil {
+			return err
+		}
+		return tx.Omit("Therapist").Create(booking).Error
+	})
+	return translateBookingError(err)
+}
+
+// reserveChair bloqueia a cadeira do agendamento até o fim da transação e confirma
+// que o período continua livre. A restrição bookings_no_overlap cobre só o intervalo
+// da sessão; a folga de higienização, as pausas e as manutenções dependem desta
+// verificação, que assim fica serializada por cadeira
+func reserveChair(tx *gorm.DB, booking *entities.Booking) error {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").First(&entities.Chair{}, booking.ChairID).Error; err != nil {
+		return err
+	}
+
+	var excludeBookingID *uint
+	if booking.ID != 0 {
+		excludeBookingID = &booking.ID
+	}
+	conflict, err := hasConflict(tx, booking.ChairID, booking.StartTime, booking.EndTime, excludeBookingID)
+	if err != nil {
+		return err
+	}
+	if conflict {
+		return entities.ErrBookingConflict
+	}
+	return nil
+}
+
+// CreateWithHold cria o agendamento e consome a reserva temporária na mesma transação.
+// Se a reserva não estiver mais ativa em now, nada é gravado e retorna
+// entities.ErrSlotHoldInactive
+func (r *bookingRepositoryImpl) CreateWithHold(booking *entities.Booking, hold *entities.SlotHold, now time.Time) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := reserveChair(tx, booking); err != nil {
+			return err
+		}
+		if err := tx.Omit("Therapist").Create(booking).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&entities.SlotHold{}).
+			Where("id = ? AND status = ? AND expires_at > ?", hold.ID, entities.SlotHoldStatusActive, now).
+			Updates(map[string]interface{}{"status": entities.SlotHoldStatusConsumed, "booking_id": booking.ID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrSlotHoldInactive
+		}
+		return nil
+	})
+	if err != nil {
+		return translateBookingError(err)
+	}
+
+	hold.Consume(booking.ID)
+	return nil
 }
 
 // GetByID busca agendamento por ID
 func (r *bookingRepositoryImpl) GetByID(id uint) (*entities.Booking, error) {
 	var booking entities.Booking
-	err := r.db.Preload("User").Preload("Chair").First(&booking, id).Error
+	err := r.db.Preload("User").Preload("Chair").Preload("Service").Preload("Therapist").First(&booking, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &booking, nil
 }
 
-// Update atualiza um agendamento
+// Update atualiza um agendamento. Quando um agendamento ativo muda de cadeira ou de
+// horário, a nova cadeira é bloqueada e o período verificado na mesma transação
 func (r *bookingRepositoryImpl) Update(booking *entities.Booking) error {
-	return r.db.Save(booking).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var current entities.Booking
+		if err := tx.Select("id", "chair_id", "start_time", "end_time").First(&current, booking.ID).Error; err != nil {
+			return err
+		}
+
+		moved := current.ChairID != booking.ChairID || !current.StartTime.Equal(booking.StartTime) || !current.EndTime.Equal(booking.EndTime)
+		if moved && booking.IsActive() {
+			if err := reserveChair(tx, booking); err != nil {
+				return err
+			}
+		}
+		return tx.Omit("Therapist").Save(booking).Error
+	})
+	return translateBookingError(err)
 }
 
 // Delete exclui um agendamento (soft delete)
@@ -49,7 +142,7 @@ func (r *bookingRepositoryImpl) List(limit, offset int, filters map[string]inter
 	var bookings []*entities.Booking
 	var total int64
 
-	query := r.db.Model(&entities.Booking{}).Preload("User").Preload("Chair")
+	query := r.db.Model(&entities.Booking{}).Preload("User").Preload("Chair").Preload("Therapist")
 
 	// Aplicar filtros
 	for key, value := range filters {
@@ -58,6 +151,10 @@ func (r *bookingRepositoryImpl) List(limit, offset int, filters map[string]inter
 			query = query.Where("user_id = ?", value)
 		case "chair_id":
 			query = query.Where("chair_id = ?", value)
+		case "location_id":
+			query = query.Where("chair_id IN (?)", r.db.Model(&entities.Chair{}).Select("id").Where("location_id = ?", value))
+		case "therapist_id":
+			query = query.Where("therapist_id = ?", value)
 		case "status":
 			query = query.Where("status = ?", value)
 		case "date":
@@ -117,7 +214,7 @@ func (r *bookingRepositoryImpl) GetByUser(userID uint, limit, offset int) ([]*en
 	}
 
 	// Buscar agendamentos do usuário com preload das relações (incluindo passados)
-	if err := r.db.Preload("User").Preload("Chair").Where("user_id = ? AND deleted_at IS NULL", userID).Order("created_at DESC").Limit(limit).Offset(offset).Find(&bookings).Error; err != nil {
+	if err := r.db.Preload("User").Preload("Chair").Preload("Therapist").Where("user_id = ? AND deleted_at IS NULL", userID).Order("created_at DESC").Limit(limit).Offset(offset).Find(&bookings).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -212,6 +309,26 @@ func (r *bookingRepositoryImpl) GetByChairAndDate(chairID uint, date time.Time)
 	return bookings, err
 }
 
+// GetOccupyingByChairsInRange busca os agendamentos que ocupam horário (todos menos
+// cancelados e faltas) de um conjunto de cadeiras, iniciados no período
+func (r *bookingRepositoryImpl) GetOccupyingByChairsInRange(chairIDs []uint, startTime, endTime time.Time) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	err := r.db.Where("chair_id IN ? AND status NOT IN (?, ?) AND start_time >= ? AND start_time < ?",
+		chairIDs, "cancelado", "falta", startTime, endTime).
+		Order("chair_id ASC, start_time ASC").Find(&bookings).Error
+	return bookings, err
+}
+
+// GetOccupyingByTherapistInRange busca os agendamentos que ocupam horário do
+// massoterapeuta, iniciados no período
+func (r *bookingRepositoryImpl) GetOccupyingByTherapistInRange(therapistID uint, startTime, endTime time.Time) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	err := r.db.Where("therapist_id = ? AND status NOT IN (?, ?) AND start_time >= ? AND start_time < ?",
+		therapistID, "cancelado", "falta", startTime, endTime).
+		Order("start_time ASC").Find(&bookings).Error
+	return bookings, err
+}
+
 // GetByChairAndDateIncludingPast busca agendamentos de uma cadeira em uma data incluindo passados
 func (r *bookingRepositoryImpl) GetByChairAndDateIncludingPast(chairID uint, date time.Time) ([]*entities.Booking, error) {
 	var bookings []*entities.Booking
@@ -248,58 +365,130 @@ func (r *bookingRepositoryImpl) GetTodayBookings() ([]*entities.Booking, error)
 	return r.GetByDate(now)
 }
 
-// HasConflict verifica se há conflito de horário
+// GetScheduledEndedBefore busca agendamentos ainda em 'agendado' cuja sessão terminou antes do instante informado
+func (r *bookingRepositoryImpl) GetScheduledEndedBefore(before time.Time) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	err := r.db.Where("status = ? AND end_time < ?", entities.BookingStatusScheduled, before).
+		Order("end_time ASC").Find(&bookings).Error
+	return bookings, err
+}
+
+// GetActiveInPeriodByLocation busca agendamentos ativos que começam no período, nas
+// cadeiras da localidade cadastrada. Sem localidade, busca em todas as cadeiras
+func (r *bookingRepositoryImpl) GetActiveInPeriodByLocation(startTime, endTime time.Time, locationID *uint) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	query := r.db.Preload("Chair").Joins("JOIN chairs ON chairs.id = bookings.chair_id").
+		Where("bookings.status IN (?, ?) AND bookings.start_time >= ? AND bookings.start_time < ?",
+			entities.BookingStatusScheduled, entities.BookingStatusPresenceConfirmed, startTime, endTime)
+
+	if locationID != nil {
+		query = query.Where("chairs.location_id = ?", *locationID)
+	}
+
+	err := query.Order("bookings.start_time ASC").Find(&bookings).Error
+	return bookings, err
+}
+
+// FlagForClosure marca os agendamentos como atingidos pelo fechamento
+func (r *bookingRepositoryImpl) FlagForClosure(bookingIDs []uint, closureID uint) error {
+	if len(bookingIDs) == 0 {
+		return nil
+	}
+	return r.db.Model(&entities.Booking{}).Where("id IN ?", bookingIDs).Update("closure_id", closureID).Error
+}
+
+// ClearClosureFlag remove a marcação dos agendamentos de um fechamento excluído
+func (r *bookingRepositoryImpl) ClearClosureFlag(closureID uint) error {
+	return r.db.Model(&entities.Booking{}).Where("closure_id = ?", closureID).Update("closure_id", nil).Error
+}
+
+// GetByClosure busca os agendamentos marcados por um fechamento
+func (r *bookingRepositoryImpl) GetByClosure(closureID uint) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	err := r.db.Preload("User").Preload("Chair").Where("closure_id = ?", closureID).
+		Order("start_time ASC").Find(&bookings).Error
+	return bookings, err
+}
+
+// GetActiveByChairInPeriod busca os agendamentos ativos da cadeira que ocupam parte
+// do período. Sem fim, considera todos a partir do início
+func (r *bookingRepositoryImpl) GetActiveByChairInPeriod(chairID uint, startTime time.Time, endTime *time.Time) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	query := r.db.Preload("User").Preload("Chair").
+		Where("chair_id = ? AND status IN (?, ?) AND end_time > ?",
+			chairID, entities.BookingStatusScheduled, entities.BookingStatusPresenceConfirmed, startTime)
+
+	if endTime != nil {
+		query = query.Where("start_time < ?", *endTime)
+	}
+
+	err := query.Order("start_time ASC").Find(&bookings).Error
+	return bookings, err
+}
+
+// HasConflict verifica se há conflito de horário. A folga de higienização da cadeira
+// é exigida antes e depois da sessão, e pausas e manutenções cadastradas contam como ocupação
 func (r *bookingRepositoryImpl) HasConflict(chairID uint, startTime, endTime time.Time, excludeBookingID *uint) (bool, error) {
-	query := r.db.Model(&entities.Booking{}).
+	return hasConflict(r.db, chairID, startTime, endTime, excludeBookingID)
+}
+
+// hasConflict é a verificação de HasConflict sobre db, que pode ser uma transação
+func hasConflict(db *gorm.DB, chairID uint, startTime, endTime time.Time, excludeBookingID *uint) (bool, error) {
+	var chair entities.Chair
+	if err := db.Select("id", "buffer_minutes").Limit(1).Find(&chair, chairID).Error; err != nil {
+		return false, err
+	}
+	buffer := chair.BufferDuration()
+	guardedStart := startTime.Add(-buffer)
+	guardedEnd := endTime.Add(buffer)
+
+	query := db.Model(&entities.Booking{}).
 		Where("chair_id = ? AND status IN (?, ?) AND ((start_time < ? AND end_time > ?) OR (start_time < ? AND end_time > ?))",
-			chairID, "agendado", "presenca_confirmada", endTime, startTime, startTime, endTime)
+			chairID, "agendado", "presenca_confirmada", guardedEnd, guardedStart, guardedStart, guardedEnd)
 
 	if excludeBookingID != nil {
 		query = query.Where("id != ?", *excludeBookingID)
 	}
 
 	var count int64
-	err := query.Count(&count).Error
-	return count > 0, err
-}
-
-// CanBook verifica se é possível fazer um agendamento
-func (r *bookingRepositoryImpl) CanBook(userID, chairID uint, startTime time.Time) (bool, string, error) {
-	endTime := startTime.Add(30 * time.Minute)
-
-	// Verificar se usuário tem sessão ativa no momento
-	hasActive, err := r.HasActiveBooking(userID)
-	if err != nil {
-		return false, "Erro ao verificar sessão ativa", err
+	if err := query.Count(&count).Error; err != nil || count > 0 {
+		return count > 0, err
 	}
-	if hasActive {
-		return false, "Usuário já possui uma sessão ativa. Apenas uma sessão por vez é permitida", nil
+
+	if inBreak, err := overlapsBreak(db, chairID, startTime, endTime); err != nil || inBreak {
+		return inBreak, err
 	}
 
-	// Verificar conflito de horário para a cadeira
-	hasConflict, err := r.HasConflict(chairID, startTime, endTime, nil)
-	if err != nil {
-		return false, "Erro ao verificar conflitos", err
+	var maintenances int64
+	err := db.Model(&entities.ChairMaintenance{}).
+		Where("chair_id = ? AND start_time < ? AND end_time > ?", chairID, endTime, startTime).
+		Count(&maintenances).Error
+	return maintenances > 0, err
+}
+
+// overlapsBreak verifica se o período cai em uma pausa da cadeira. Os horários das
+// pausas são lidos no fuso da localidade da cadeira, como a disponibilidade
+func overlapsBreak(db *gorm.DB, chairID uint, startTime, endTime time.Time) (bool, error) {
+	var breaks []*entities.ChairBreak
+	if err := db.Where("chair_id = ?", chairID).Find(&breaks).Error; err != nil {
+		return false, err
 	}
-	if hasConflict {
-		return false, "Já existe um agendamento para este horário nesta cadeira", nil
+	if len(breaks) == 0 {
+		return false, nil
 	}
 
-	// Verificar se usuário já tem outro agendamento futuro (excluindo cancelados)
-	now := time.Now()
-	var futureCount int64
-	err = r.db.Model(&entities.Booking{}).
-		Where("user_id = ? AND status IN (?, ?) AND start_time > ?",
-			userID, "agendado", "presenca_confirmada", now).
-		Count(&futureCount).Error
+	zone, err := chairTimeZone(db, chairID)
 	if err != nil {
-		return false, "Erro ao verificar agendamentos futuros", err
+		return false, err
 	}
-
-	if futureCount > 0 {
-		return false, "Usuário já possui um agendamento futuro. Apenas um agendamento por vez é permitido", nil
+	localStart := startTime.In(zone)
+	localEnd := endTime.In(zone)
+	for _, chairBreak := range breaks {
+		if chairBreak.OverlapsPeriod(localStart, localEnd) {
+			return true, nil
+		}
 	}
-	return true, "", nil
+	return false, nil
 }
 
 // HasActiveBooking verifica se o usuário tem algum agendamento ativo
@@ -313,27 +502,92 @@ func (r *bookingRepositoryImpl) HasActiveBooking(userID uint) (bool, error) {
 	return count > 0, err
 }
 
+// CountActiveByUser conta os agendamentos do usuário que ainda não terminaram
+// (futuros ou em andamento)
+func (r *bookingRepositoryImpl) CountActiveByUser(userID uint, excludeBookingID *uint) (int64, error) {
+	var count int64
+	query := r.db.Model(&entities.Booking{}).
+		Where("user_id = ? AND status IN (?, ?) AND end_time > ?",
+			userID, "agendado", "presenca_confirmada", time.Now())
+
+	if excludeBookingID != nil {
+		query = query.Where("id != ?", *excludeBookingID)
+	}
+
+	err := query.Count(&count).Error
+	return count, err
+}
+
+// CountUserBookingsInRange conta os agendamentos não cancelados do usuário que
+// iniciam no intervalo [startTime, endTime)
+func (r *bookingRepositoryImpl) CountUserBookingsInRange(userID uint, startTime, endTime time.Time, excludeBookingID *uint) (int64, error) {
+	var count int64
+	query := r.db.Model(&entities.Booking{}).
+		Where("user_id = ? AND status != ? AND start_time >= ? AND start_time < ?",
+			userID, "cancelado", startTime, endTime)
+
+	if excludeBookingID != nil {
+		query = query.Where("id != ?", *excludeBookingID)
+	}
+
+	err := query.Count(&count).Error
+	return count, err
+}
+
 // Cancel cancela um agendamento
-func (r *bookingRepositoryImpl) Cancel(id uint, cancelledBy uint, reason string) error {
-	return r.db.Model(&entities.Booking{}).Where("id = ?", id).Updates(map[string]interface{}{
-		"status": "cancelado",
-		"notes":  reason,
-	}).Error
+func (r *bookingRepositoryImpl) Cancel(id uint, actor entities.BookingActor, reason string) error {
+	return r.transitionStatus(id, entities.BookingStatusCancelled, actor, reason)
 }
 
 // Complete marca um agendamento como realizado
-func (r *bookingRepositoryImpl) Complete(id uint, completedBy uint) error {
-	return r.db.Model(&entities.Booking{}).Where("id = ?", id).Update("status", "realizado").Error
+func (r *bookingRepositoryImpl) Complete(id uint, actor entities.BookingActor) error {
+	return r.transitionStatus(id, entities.BookingStatusCompleted, actor, "")
 }
 
 // ConfirmPresence marca a presença como confirmada
-func (r *bookingRepositoryImpl) ConfirmPresence(id uint, confirmedBy uint) error {
-	return r.db.Model(&entities.Booking{}).Where("id = ?", id).Update("status", "presenca_confirmada").Error
+func (r *bookingRepositoryImpl) ConfirmPresence(id uint, actor entities.BookingActor) error {
+	return r.transitionStatus(id, entities.BookingStatusPresenceConfirmed, actor, "")
 }
 
 // MarkAsNoShow marca um agendamento como falta
-func (r *bookingRepositoryImpl) MarkAsNoShow(id uint, markedBy uint) error {
-	return r.db.Model(&entities.Booking{}).Where("id = ?", id).Update("status", "falta").Error
+func (r *bookingRepositoryImpl) MarkAsNoShow(id uint, actor entities.BookingActor, reason string) error {
+	return r.transitionStatus(id, entities.BookingStatusNoShow, actor, reason)
+}
+
+// transitionStatus altera o status e grava o histórico na mesma transação. A linha é
+// bloqueada para que duas mudanças simultâneas não partam do mesmo status
+func (r *bookingRepositoryImpl) transitionStatus(id uint, to string, actor entities.BookingActor, reason string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var booking entities.Booking
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").First(&booking, id).Error; err != nil {
+			return err
+		}
+
+		if err := entities.ValidateBookingTransition(booking.Status, to); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entities.Booking{}).Where("id = ?", id).Update("status", to).Error; err != nil {
+			return err
+		}
+
+		return tx.Omit("Actor").Create(entities.NewBookingStatusHistory(id, booking.Status, to, actor, reason)).Error
+	})
+}
+
+// CreateStatusHistory grava um registro avulso no histórico de status
+func (r *bookingRepositoryImpl) CreateStatusHistory(entry *entities.BookingStatusHistory) error {
+	return r.db.Omit("Actor").Create(entry).Error
+}
+
+// GetStatusHistory busca o histórico de status de um agendamento em ordem cronológica
+func (r *bookingRepositoryImpl) GetStatusHistory(bookingID uint) ([]*entities.BookingStatusHistory, error) {
+	var history []*entities.BookingStatusHistory
+	err := r.db.Preload("Actor").
+		Where("booking_id = ?", bookingID).
+		Order("created_at ASC, id ASC").Find(&history).Error
+	return history, err
 }
 
 // CountByStatus conta agendamentos por status
@@ -366,6 +620,33 @@ func (r *bookingRepositoryImpl) CountByDate(date time.Time) (int64, error) {
 	return count, err
 }
 
+// CountActiveByChairsInRange conta, por cadeira, os agendamentos ativos que começam no período
+func (r *bookingRepositoryImpl) CountActiveByChairsInRange(chairIDs []uint, startTime, endTime time.Time) (map[uint]int64, error) {
+	loads := make(map[uint]int64, len(chairIDs))
+	if len(chairIDs) == 0 {
+		return loads, nil
+	}
+
+	var rows []struct {
+		ChairID uint
+		Total   int64
+	}
+	err := r.db.Model(&entities.Booking{}).
+		Select("chair_id, COUNT(*) AS total").
+		Where("chair_id IN ? AND status IN (?, ?) AND start_time >= ? AND start_time < ?",
+			chairIDs, "agendado", "presenca_confirmada", startTime, endTime).
+		Group("chair_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		loads[row.ChairID] = row.Total
+	}
+	return loads, nil
+}
+
 // CountTotal conta total de agendamentos
 func (r *bookingRepositoryImpl) CountTotal() (int64, error) {
 	var count int64
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 	"agendamento-backend/internal/domain/entities"
@@ -67,6 +68,8 @@ func (r *chairRepositoryImpl) List(limit, offset int, filters map[string]interfa
 			query = query.Where("name ILIKE ?", fmt.Sprintf("%%%s%%", value))
 		case "location":
 			query = query.Where("location ILIKE ?", fmt.Sprintf("%%%s%%", value))
+		case "location_id":
+			query = query.Where("location_id = ?", value)
 		case "status":
 			query = query.Where("status = ?", value)
 		}
@@ -152,11 +155,11 @@ func (r *chairRepositoryImpl) ExistsByName(name string) (bool, error) {
 	return count > 0, err
 }
 
-// ExistsByLocation verifica se localização já existe
-func (r *chairRepositoryImpl) ExistsByLocation(location string) (bool, error) {
+// CountByLocationID conta as cadeiras vinculadas à localidade
+func (r *chairRepositoryImpl) CountByLocationID(locationID uint) (int64, error) {
 	var count int64
-	err := r.db.Model(&entities.Chair{}).Where("location = ?", location).Count(&count).Error
-	return count > 0, err
+	err := r.db.Model(&entities.Chair{}).Where("location_id = ?", locationID).Count(&count).Error
+	return count, err
 }
 
 // CountByStatus conta cadeiras por status
@@ -179,3 +182,98 @@ func (r *chairRepositoryImpl) CountActive() (int64, error) {
 	err := r.db.Model(&entities.Chair{}).Where("status = ?", "ativa").Count(&count).Error
 	return count, err
 }
+
+// CreateBreak cria uma pausa da cadeira
+func (r *chairRepositoryImpl) CreateBreak(chairBreak *entities.ChairBreak) error {
+	return r.db.Create(chairBreak).Error
+}
+
+// GetBreakByID busca pausa por ID
+func (r *chairRepositoryImpl) GetBreakByID(id uint) (*entities.ChairBreak, error) {
+	var chairBreak entities.ChairBreak
+	err := r.db.First(&chairBreak, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &chairBreak, nil
+}
+
+// UpdateBreak atualiza uma pausa da cadeira
+func (r *chairRepositoryImpl) UpdateBreak(chairBreak *entities.ChairBreak) error {
+	return r.db.Save(chairBreak).Error
+}
+
+// DeleteBreak exclui uma pausa (soft delete)
+func (r *chairRepositoryImpl) DeleteBreak(id uint) error {
+	return r.db.Delete(&entities.ChairBreak{}, id).Error
+}
+
+// GetBreaksByChair lista as pausas da cadeira ordenadas por horário
+func (r *chairRepositoryImpl) GetBreaksByChair(chairID uint) ([]*entities.ChairBreak, error) {
+	var breaks []*entities.ChairBreak
+	err := r.db.Where("chair_id = ?", chairID).
+		Order("start_time ASC").
+		Find(&breaks).Error
+	return breaks, err
+}
+
+// GetBreaksByChairs lista as pausas de um conjunto de cadeiras
+func (r *chairRepositoryImpl) GetBreaksByChairs(chairIDs []uint) ([]*entities.ChairBreak, error) {
+	var breaks []*entities.ChairBreak
+	err := r.db.Where("chair_id IN ?", chairIDs).
+		Order("chair_id ASC, start_time ASC").
+		Find(&breaks).Error
+	return breaks, err
+}
+
+// CreateMaintenance cria uma manutenção programada da cadeira
+func (r *chairRepositoryImpl) CreateMaintenance(maintenance *entities.ChairMaintenance) error {
+	return r.db.Create(maintenance).Error
+}
+
+// GetMaintenanceByID busca manutenção por ID
+func (r *chairRepositoryImpl) GetMaintenanceByID(id uint) (*entities.ChairMaintenance, error) {
+	var maintenance entities.ChairMaintenance
+	err := r.db.First(&maintenance, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &maintenance, nil
+}
+
+// UpdateMaintenance atualiza uma manutenção programada
+func (r *chairRepositoryImpl) UpdateMaintenance(maintenance *entities.ChairMaintenance) error {
+	return r.db.Save(maintenance).Error
+}
+
+// DeleteMaintenance exclui uma manutenção (soft delete)
+func (r *chairRepositoryImpl) DeleteMaintenance(id uint) error {
+	return r.db.Delete(&entities.ChairMaintenance{}, id).Error
+}
+
+// GetMaintenancesByChair lista as manutenções da cadeira, das mais recentes para as mais antigas
+func (r *chairRepositoryImpl) GetMaintenancesByChair(chairID uint) ([]*entities.ChairMaintenance, error) {
+	var maintenances []*entities.ChairMaintenance
+	err := r.db.Where("chair_id = ?", chairID).
+		Order("start_time DESC").
+		Find(&maintenances).Error
+	return maintenances, err
+}
+
+// GetMaintenancesByChairsInRange lista as manutenções das cadeiras que ocupam parte do período
+func (r *chairRepositoryImpl) GetMaintenancesByChairsInRange(chairIDs []uint, startTime, endTime time.Time) ([]*entities.ChairMaintenance, error) {
+	var maintenances []*entities.ChairMaintenance
+	err := r.db.Where("chair_id IN ? AND start_time < ? AND end_time > ?", chairIDs, endTime, startTime).
+		Order("chair_id ASC, start_time ASC").
+		Find(&maintenances).Error
+	return maintenances, err
+}
+
+// HasMaintenanceInPeriod verifica se a cadeira tem manutenção em algum instante do período
+func (r *chairRepositoryImpl) HasMaintenanceInPeriod(chairID uint, startTime, endTime time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.ChairMaintenance{}).
+		Where("chair_id = ? AND start_time < ? AND end_time > ?", chairID, endTime, startTime).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type closureRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewClosureRepository(db *gorm.DB) repositories.ClosureRepository {
+	return &closureRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria um novo fechamento
+func (r *closureRepositoryImpl) Create(closure *entities.Closure) error {
+	return r.db.Create(closure).Error
+}
+
+// GetByID busca fechamento por ID
+func (r *closureRepositoryImpl) GetByID(id uint) (*entities.Closure, error) {
+	var closure entities.Closure
+	err := r.db.First(&closure, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &closure, nil
+}
+
+// Delete exclui um fechamento (soft delete)
+func (r *closureRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.Closure{}, id).Error
+}
+
+// List lista fechamentos que tocam o período, opcionalmente filtrando pela localidade.
+// Fechamentos globais aparecem em qualquer localidade
+func (r *closureRepositoryImpl) List(from, to *time.Time, locationID *uint) ([]*entities.Closure, error) {
+	var closures []*entities.Closure
+	query := r.db.Model(&entities.Closure{})
+
+	if from != nil {
+		query = query.Where("end_date >= ?", from.Format("2006-01-02"))
+	}
+	if to != nil {
+		query = query.Where("start_date <= ?", to.Format("2006-01-02"))
+	}
+	if locationID != nil {
+		query = query.Where("(location_id IS NULL OR location_id = ?)", *locationID)
+	}
+
+	err := query.Order("start_date ASC").Find(&closures).Error
+	return closures, err
+}
+
+// GetByExternalUID busca o fechamento importado de um evento .ics para a localidade,
+// ou entre os globais quando locationID é nulo
+func (r *closureRepositoryImpl) GetByExternalUID(externalUID string, locationID *uint) (*entities.Closure, error) {
+	var closure entities.Closure
+	query := r.db.Where("external_uid = ?", externalUID)
+	if locationID != nil {
+		query = query.Where("location_id = ?", *locationID)
+	} else {
+		query = query.Where("location_id IS NULL")
+	}
+	err := query.First(&closure).Error
+	if err != nil {
+		return nil, err
+	}
+	return &closure, nil
+}
@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// pgUniqueViolation é o código do Postgres para violação de índice único
+const pgUniqueViolation = "23505"
+
+type feedbackRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFeedbackRepository(db *gorm.DB) repositories.FeedbackRepository {
+	return &feedbackRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create registra a avaliação. O índice único em booking_id barra uma segunda
+// avaliação do mesmo agendamento, retornando entities.ErrFeedbackAlreadySubmitted
+func (r *feedbackRepositoryImpl) Create(feedback *entities.BookingFeedback) error {
+	err := r.db.Omit("Booking", "User", "Chair", "Location", "Therapist").Create(feedback).Error
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return entities.ErrFeedbackAlreadySubmitted
+	}
+	return err
+}
+
+// GetByBooking busca a avaliação de um agendamento
+func (r *feedbackRepositoryImpl) GetByBooking(bookingID uint) (*entities.BookingFeedback, error) {
+	var feedback entities.BookingFeedback
+	err := r.db.Preload("Chair").Preload("Therapist").
+		Where("booking_id = ?", bookingID).First(&feedback).Error
+	if err != nil {
+		return nil, err
+	}
+	return &feedback, nil
+}
+
+// ExistsForBooking verifica se o agendamento já foi avaliado
+func (r *feedbackRepositoryImpl) ExistsForBooking(bookingID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.BookingFeedback{}).Where("booking_id = ?", bookingID).Count(&count).Error
+	return count > 0, err
+}
+
+// List lista avaliações com paginação e filtros, das mais recentes para as mais antigas
+func (r *feedbackRepositoryImpl) List(limit, offset int, filters map[string]interface{}) ([]*entities.BookingFeedback, int64, error) {
+	var feedbacks []*entities.BookingFeedback
+	var total int64
+
+	query := applyFeedbackFilters(r.db.Model(&entities.BookingFeedback{}), filters)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("User").Preload("Chair").Preload("Location").Preload("Therapist").
+		Order("booking_feedbacks.created_at DESC").
+		Limit(limit).Offset(offset).
+		Find(&feedbacks).Error
+	return feedbacks, total, err
+}
+
+// GetBookingsAwaitingPrompt busca sessões realizadas que terminaram no período, ainda
+// sem convite de avaliação e sem avaliação enviada pelo sistema
+func (r *feedbackRepositoryImpl) GetBookingsAwaitingPrompt(endedAfter, endedBefore time.Time, limit int) ([]*entities.Booking, error) {
+	var bookings []*entities.Booking
+	err := r.db.Preload("User").Preload("Chair").Preload("Therapist").
+		Where("status = ? AND feedback_requested_at IS NULL AND end_time > ? AND end_time <= ?",
+			entities.BookingStatusCompleted, endedAfter, endedBefore).
+		Where("id NOT IN (?)", r.db.Model(&entities.BookingFeedback{}).Select("booking_id")).
+		Order("end_time ASC").
+		Limit(limit).
+		Find(&bookings).Error
+	return bookings, err
+}
+
+// MarkPromptSent registra o envio do convite. A condição feedback_requested_at IS NULL
+// faz com que apenas uma execução concorrente envie o convite do mesmo agendamento
+func (r *feedbackRepositoryImpl) MarkPromptSent(bookingID uint, sentAt time.Time) (bool, error) {
+	res := r.db.Model(&entities.Booking{}).
+		Where("id = ? AND feedback_requested_at IS NULL", bookingID).
+		UpdateColumn("feedback_requested_at", sentAt)
+	return res.RowsAffected == 1, res.Error
+}
+
+// ClearPromptSent devolve o agendamento à fila de convites quando o envio falha
+func (r *feedbackRepositoryImpl) ClearPromptSent(bookingID uint) error {
+	return r.db.Model(&entities.Booking{}).
+		Where("id = ?", bookingID).
+		UpdateColumn("feedback_requested_at", nil).Error
+}
+
+// GetSatisfactionStats agrega as avaliações por cadeira, massoterapeuta ou localidade.
+// Avaliações sem massoterapeuta ou sem localidade ficam de fora desses agrupamentos
+func (r *feedbackRepositoryImpl) GetSatisfactionStats(groupBy string, filters map[string]interface{}) ([]*entities.SatisfactionStats, error) {
+	query := applyFeedbackFilters(r.db.Model(&entities.BookingFeedback{}), filters)
+
+	counters := fmt.Sprintf(`COUNT(*) AS responses,
+		COALESCE(SUM(booking_feedbacks.rating), 0) AS rating_sum,
+		COUNT(*) FILTER (WHERE booking_feedbacks.rating >= %d) AS promoters,
+		COUNT(*) FILTER (WHERE booking_feedbacks.rating <= %d) AS detractors,
+		COUNT(*) FILTER (WHERE booking_feedbacks.chair_issue) AS chair_issues,
+		COUNT(*) FILTER (WHERE booking_feedbacks.therapist_issue) AS therapist_issues`,
+		entities.FeedbackPromoterRating, entities.FeedbackDetractorRating)
+
+	switch groupBy {
+	case "":
+		query = query.Select(counters)
+	case entities.SatisfactionByChair:
+		query = query.Select("booking_feedbacks.chair_id AS group_id, chairs.name AS group_name, " + counters).
+			Joins("LEFT JOIN chairs ON chairs.id = booking_feedbacks.chair_id").
+			Group("booking_feedbacks.chair_id, chairs.name").
+			Order("chairs.name")
+	case entities.SatisfactionByTherapist:
+		query = query.Select("booking_feedbacks.therapist_id AS group_id, therapists.name AS group_name, " + counters).
+			Joins("LEFT JOIN therapists ON therapists.id = booking_feedbacks.therapist_id").
+			Where("booking_feedbacks.therapist_id IS NOT NULL").
+			Group("booking_feedbacks.therapist_id, therapists.name").
+			Order("therapists.name")
+	case entities.SatisfactionByLocation:
+		query = query.Select("booking_feedbacks.location_id AS group_id, locations.name AS group_name, " + counters).
+			Joins("LEFT JOIN locations ON locations.id = booking_feedbacks.location_id").
+			Where("booking_feedbacks.location_id IS NOT NULL").
+			Group("booking_feedbacks.location_id, locations.name").
+			Order("locations.name")
+	default:
+		return nil, fmt.Errorf("agrupamento inválido: %s", groupBy)
+	}
+
+	var stats []*entities.SatisfactionStats
+	err := query.Scan(&stats).Error
+	return stats, err
+}
+
+// applyFeedbackFilters aplica os filtros de listagem e de métricas das avaliações
+func applyFeedbackFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
+	for key, value := range filters {
+		switch key {
+		case "chair_id":
+			query = query.Where("booking_feedbacks.chair_id = ?", value)
+		case "therapist_id":
+			query = query.Where("booking_feedbacks.therapist_id = ?", value)
+		case "location_id":
+			query = query.Where("booking_feedbacks.location_id = ?", value)
+		case "max_rating":
+			query = query.Where("booking_feedbacks.rating <= ?", value)
+		case "with_issue":
+			if withIssue, ok := value.(bool); ok && withIssue {
+				query = query.Where("booking_feedbacks.chair_issue OR booking_feedbacks.therapist_issue")
+			}
+		case "start_date":
+			if date, ok := value.(time.Time); ok {
+				query = query.Where("booking_feedbacks.created_at >= ?", date)
+			}
+		case "end_date":
+			if date, ok := value.(time.Time); ok {
+				query = query.Where("booking_feedbacks.created_at < ?", date)
+			}
+		}
+	}
+	return query
+}
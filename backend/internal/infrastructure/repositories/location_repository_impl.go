@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type locationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLocationRepository(db *gorm.DB) repositories.LocationRepository {
+	return &locationRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria uma nova localidade
+func (r *locationRepositoryImpl) Create(location *entities.Location) error {
+	return r.db.Create(location).Error
+}
+
+// GetByID busca localidade por ID
+func (r *locationRepositoryImpl) GetByID(id uint) (*entities.Location, error) {
+	var location entities.Location
+	err := r.db.First(&location, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// GetByName busca localidade pelo nome, sem diferenciar maiúsculas
+func (r *locationRepositoryImpl) GetByName(name string) (*entities.Location, error) {
+	var location entities.Location
+	err := r.db.Where("LOWER(name) = LOWER(?)", name).First(&location).Error
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// Update atualiza uma localidade. O novo nome é copiado para as cadeiras e os
+// fechamentos dela, na mesma transação
+func (r *locationRepositoryImpl) Update(location *entities.Location) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var current entities.Location
+		if err := tx.First(&current, location.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Save(location).Error; err != nil {
+			return err
+		}
+
+		if current.Name == location.Name {
+			return nil
+		}
+		if err := tx.Model(&entities.Chair{}).Where("location_id = ?", location.ID).
+			Update("location", location.Name).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.Closure{}).Where("location_id = ?", location.ID).
+			Update("location", location.Name).Error
+	})
+}
+
+// Delete exclui uma localidade (soft delete)
+func (r *locationRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.Location{}, id).Error
+}
+
+// List lista as localidades, opcionalmente de um escritório
+func (r *locationRepositoryImpl) List(site string, includeInactive bool) ([]*entities.Location, error) {
+	var locations []*entities.Location
+	query := r.db.Model(&entities.Location{})
+
+	if site != "" {
+		query = query.Where("LOWER(site) = LOWER(?)", site)
+	}
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	err := query.Order("site ASC, name ASC").Find(&locations).Error
+	return locations, err
+}
+
+// GetByIDs busca as localidades informadas
+func (r *locationRepositoryImpl) GetByIDs(ids []uint) ([]*entities.Location, error) {
+	var locations []*entities.Location
+	if len(ids) == 0 {
+		return locations, nil
+	}
+	err := r.db.Where("id IN ?", ids).Find(&locations).Error
+	return locations, err
+}
+
+// GetByChair busca a localidade da cadeira. Retorna nil quando a cadeira não tem
+// localidade cadastrada
+func (r *locationRepositoryImpl) GetByChair(chairID uint) (*entities.Location, error) {
+	return locationByChair(r.db, chairID)
+}
+
+// ExistsByName verifica se já existe localidade com o nome
+func (r *locationRepositoryImpl) ExistsByName(name string) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.Location{}).Where("LOWER(name) = LOWER(?)", name).Count(&count).Error
+	return count > 0, err
+}
+
+// locationByChair busca a localidade da cadeira, ou nil quando ela não tem uma
+func locationByChair(db *gorm.DB, chairID uint) (*entities.Location, error) {
+	var locations []*entities.Location
+	err := db.Joins("JOIN chairs ON chairs.location_id = locations.id").
+		Where("chairs.id = ?", chairID).
+		Limit(1).Find(&locations).Error
+	if err != nil || len(locations) == 0 {
+		return nil, err
+	}
+	return locations[0], nil
+}
+
+// chairTimeZone retorna o fuso da localidade da cadeira, em que são lidos os
+// horários das janelas e pausas. Cadeiras sem localidade usam o fuso padrão
+func chairTimeZone(db *gorm.DB, chairID uint) (*time.Location, error) {
+	location, err := locationByChair(db, chairID)
+	if err != nil {
+		return nil, err
+	}
+	return location.TimeZone(), nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type loginAttemptRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoginAttemptRepository(db *gorm.DB) repositories.LoginAttemptRepository {
+	return &loginAttemptRepositoryImpl{
+		db: db,
+	}
+}
+
+// CreateFailure registra uma tentativa de login que falhou
+func (r *loginAttemptRepositoryImpl) CreateFailure(failure *entities.LoginFailure) error {
+	return r.db.Create(failure).Error
+}
+
+// ListPendingFailures lista as falhas do CPF ainda não zeradas desde a data
+// informada, da mais recente para a mais antiga
+func (r *loginAttemptRepositoryImpl) ListPendingFailures(cpf string, since time.Time) ([]*entities.LoginFailure, error) {
+	var failures []*entities.LoginFailure
+	err := r.db.Where("cpf = ? AND cleared_at IS NULL AND created_at >= ?", cpf, since).
+		Order("created_at DESC").Find(&failures).Error
+	return failures, err
+}
+
+// CountFailuresByIP conta as falhas vindas do IP desde a data informada, em
+// qualquer CPF e inclusive as já zeradas
+func (r *loginAttemptRepositoryImpl) CountFailuresByIP(ipAddress string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.LoginFailure{}).
+		Where("ip_address = ? AND created_at >= ?", ipAddress, since).
+		Count(&count).Error
+	return count, err
+}
+
+// ClearFailures zera as falhas pendentes do CPF
+func (r *loginAttemptRepositoryImpl) ClearFailures(cpf string, at time.Time) error {
+	return r.db.Model(&entities.LoginFailure{}).
+		Where("cpf = ? AND cleared_at IS NULL", cpf).
+		Update("cleared_at", at).Error
+}
+
+// CreateLockout registra o bloqueio do login de um CPF
+func (r *loginAttemptRepositoryImpl) CreateLockout(lockout *entities.LoginLockout) error {
+	return r.db.Create(lockout).Error
+}
+
+// GetActiveLockout busca o bloqueio em vigor do CPF. Retorna nil quando não há
+func (r *loginAttemptRepositoryImpl) GetActiveLockout(cpf string, now time.Time) (*entities.LoginLockout, error) {
+	var lockout entities.LoginLockout
+	err := r.db.Where("cpf = ? AND unlocked_at IS NULL AND locked_until > ?", cpf, now).
+		Order("locked_until DESC").First(&lockout).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// Unlock desfaz os bloqueios em vigor do CPF e retorna quantos foram desfeitos
+func (r *loginAttemptRepositoryImpl) Unlock(cpf string, unlockedBy *uint, reason string, at time.Time) (int64, error) {
+	result := r.db.Model(&entities.LoginLockout{}).
+		Where("cpf = ? AND unlocked_at IS NULL AND locked_until > ?", cpf, at).
+		Updates(map[string]interface{}{
+			"unlocked_at":   at,
+			"unlocked_by":   unlockedBy,
+			"unlock_reason": reason,
+		})
+	return result.RowsAffected, result.Error
+}
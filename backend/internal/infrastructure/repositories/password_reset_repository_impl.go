@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type passwordResetRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetRepository(db *gorm.DB) repositories.PasswordResetRepository {
+	return &passwordResetRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create registra um pedido de redefinição de senha
+func (r *passwordResetRepositoryImpl) Create(token *entities.PasswordResetToken) error {
+	return r.db.Create(token).Error
+}
+
+// GetByHash busca o pedido pelo hash do token
+func (r *passwordResetRepositoryImpl) GetByHash(tokenHash string) (*entities.PasswordResetToken, error) {
+	var token entities.PasswordResetToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CountRequestedSince conta os pedidos do usuário feitos a partir da data informada
+func (r *passwordResetRepositoryImpl) CountRequestedSince(userID uint, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.PasswordResetToken{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkUsed marca o token como usado. Retorna false se ele já tinha sido usado
+func (r *passwordResetRepositoryImpl) MarkUsed(id uint, usedAt time.Time) (bool, error) {
+	result := r.db.Model(&entities.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		UpdateColumn("used_at", usedAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+// InvalidateByUser marca como usados os tokens ainda pendentes do usuário
+func (r *passwordResetRepositoryImpl) InvalidateByUser(userID uint, usedAt time.Time) error {
+	return r.db.Model(&entities.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		UpdateColumn("used_at", usedAt).Error
+}
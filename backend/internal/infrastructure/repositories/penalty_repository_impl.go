@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type penaltyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPenaltyRepository(db *gorm.DB) repositories.PenaltyRepository {
+	return &penaltyRepositoryImpl{
+		db: db,
+	}
+}
+
+// CreatePenalty registra uma penalidade
+func (r *penaltyRepositoryImpl) CreatePenalty(penalty *entities.BookingPenalty) error {
+	return r.db.Omit("Booking").Create(penalty).Error
+}
+
+// GetPenaltiesByUserSince busca as penalidades de um usuário a partir de uma data, mais recentes primeiro
+func (r *penaltyRepositoryImpl) GetPenaltiesByUserSince(userID uint, since time.Time) ([]*entities.BookingPenalty, error) {
+	var penalties []*entities.BookingPenalty
+	err := r.db.Preload("Booking").Preload("Booking.Chair").
+		Where("user_id = ? AND occurred_at >= ?", userID, since).
+		Order("occurred_at DESC").Find(&penalties).Error
+	return penalties, err
+}
+
+// CountPenaltiesSince conta as penalidades não perdoadas de um tipo a partir de uma data
+func (r *penaltyRepositoryImpl) CountPenaltiesSince(userID uint, penaltyType string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.BookingPenalty{}).
+		Where("user_id = ? AND type = ? AND occurred_at >= ? AND waived_at IS NULL", userID, penaltyType, since).
+		Count(&count).Error
+	return count, err
+}
+
+// WaivePenaltiesSince perdoa as penalidades de um usuário a partir de uma data
+func (r *penaltyRepositoryImpl) WaivePenaltiesSince(userID uint, since time.Time, waivedBy uint) error {
+	return r.db.Model(&entities.BookingPenalty{}).
+		Where("user_id = ? AND occurred_at >= ? AND waived_at IS NULL", userID, since).
+		Updates(map[string]interface{}{
+			"waived_at": time.Now(),
+			"waived_by": waivedBy,
+		}).Error
+}
+
+// CreateSuspension registra uma suspensão
+func (r *penaltyRepositoryImpl) CreateSuspension(suspension *entities.BookingSuspension) error {
+	return r.db.Omit("User").Create(suspension).Error
+}
+
+// UpdateSuspension atualiza uma suspensão
+func (r *penaltyRepositoryImpl) UpdateSuspension(suspension *entities.BookingSuspension) error {
+	return r.db.Omit("User").Save(suspension).Error
+}
+
+// GetActiveSuspension busca a suspensão em vigor do usuário. Retorna nil quando não há
+func (r *penaltyRepositoryImpl) GetActiveSuspension(userID uint, now time.Time) (*entities.BookingSuspension, error) {
+	var suspension entities.BookingSuspension
+	err := r.db.Where("user_id = ? AND lifted_at IS NULL AND starts_at <= ? AND ends_at > ?", userID, now, now).
+		Order("ends_at DESC").First(&suspension).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &suspension, nil
+}
+
+// GetSuspensionsByUser busca o histórico de suspensões de um usuário
+func (r *penaltyRepositoryImpl) GetSuspensionsByUser(userID uint) ([]*entities.BookingSuspension, error) {
+	var suspensions []*entities.BookingSuspension
+	err := r.db.Where("user_id = ?", userID).
+		Order("starts_at DESC").Find(&suspensions).Error
+	return suspensions, err
+}
+
+// ListActiveSuspensions lista as suspensões em vigor com paginação
+func (r *penaltyRepositoryImpl) ListActiveSuspensions(now time.Time, limit, offset int) ([]*entities.BookingSuspension, int64, error) {
+	var suspensions []*entities.BookingSuspension
+	var total int64
+
+	query := r.db.Model(&entities.BookingSuspension{}).
+		Where("lifted_at IS NULL AND starts_at <= ? AND ends_at > ?", now, now)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("User").Order("ends_at ASC").
+		Limit(limit).Offset(offset).Find(&suspensions).Error
+	return suspensions, total, err
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type presenceConfirmationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPresenceConfirmationRepository(db *gorm.DB) repositories.PresenceConfirmationRepository {
+	return &presenceConfirmationRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create registra um novo link de confirmação
+func (r *presenceConfirmationRepositoryImpl) Create(confirmation *entities.PresenceConfirmation) error {
+	return r.db.Create(confirmation).Error
+}
+
+// GetByNonce busca o link pelo nonce contido no token
+func (r *presenceConfirmationRepositoryImpl) GetByNonce(nonce string) (*entities.PresenceConfirmation, error) {
+	var confirmation entities.PresenceConfirmation
+	err := r.db.Where("nonce = ?", nonce).First(&confirmation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &confirmation, nil
+}
+
+// MarkUsed marca o link como utilizado. A condição used_at IS NULL faz com que apenas
+// uma requisição concorrente consiga usar o mesmo link
+func (r *presenceConfirmationRepositoryImpl) MarkUsed(id uint, result string, usedAt time.Time) (bool, error) {
+	res := r.db.Model(&entities.PresenceConfirmation{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Updates(map[string]interface{}{"used_at": usedAt, "result": result})
+	return res.RowsAffected == 1, res.Error
+}
+
+// ClearUsed devolve o link ao estado pendente quando a ação não pôde ser concluída
+func (r *presenceConfirmationRepositoryImpl) ClearUsed(id uint) error {
+	return r.db.Model(&entities.PresenceConfirmation{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"used_at": nil, "result": ""}).Error
+}
+
+// GetPendingExpired busca links não utilizados cujo prazo de resposta terminou
+func (r *presenceConfirmationRepositoryImpl) GetPendingExpired(now time.Time) ([]*entities.PresenceConfirmation, error) {
+	var confirmations []*entities.PresenceConfirmation
+	err := r.db.Where("used_at IS NULL AND expires_at <= ?", now).
+		Order("expires_at ASC").Find(&confirmations).Error
+	return confirmations, err
+}
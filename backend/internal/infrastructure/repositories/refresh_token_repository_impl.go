@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type refreshTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) repositories.RefreshTokenRepository {
+	return &refreshTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create registra um token emitido
+func (r *refreshTokenRepositoryImpl) Create(token *entities.RefreshToken) error {
+	return r.db.Create(token).Error
+}
+
+// GetByHash busca o token pelo hash do identificador
+func (r *refreshTokenRepositoryImpl) GetByHash(tokenHash string) (*entities.RefreshToken, error) {
+	var token entities.RefreshToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkRotated marca o token como trocado. Retorna false se outro uso já o trocou
+// ou se a sessão foi revogada nesse meio tempo
+func (r *refreshTokenRepositoryImpl) MarkRotated(id uint, rotatedAt time.Time) (bool, error) {
+	result := r.db.Model(&entities.RefreshToken{}).
+		Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", id).
+		UpdateColumn("rotated_at", rotatedAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+// RevokeFamily revoga os tokens da família que ainda não foram revogados
+func (r *refreshTokenRepositoryImpl) RevokeFamily(familyID, reason string, revokedAt time.Time) error {
+	return r.db.Model(&entities.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		UpdateColumns(map[string]interface{}{
+			"revoked_at":     revokedAt,
+			"revoked_reason": reason,
+		}).Error
+}
+
+// RevokeByUser revoga os tokens ainda não revogados do usuário, exceto os da família
+// informada
+func (r *refreshTokenRepositoryImpl) RevokeByUser(userID uint, exceptFamilyID, reason string, revokedAt time.Time) error {
+	query := r.db.Model(&entities.RefreshToken{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+	if exceptFamilyID != "" {
+		query = query.Where("family_id <> ?", exceptFamilyID)
+	}
+	return query.UpdateColumns(map[string]interface{}{
+		"revoked_at":     revokedAt,
+		"revoked_reason": reason,
+	}).Error
+}
+
+// DeleteExpiredByUser apaga os tokens do usuário vencidos antes da data informada
+func (r *refreshTokenRepositoryImpl) DeleteExpiredByUser(userID uint, before time.Time) error {
+	return r.db.Where("user_id = ? AND expires_at < ?", userID, before).
+		Delete(&entities.RefreshToken{}).Error
+}
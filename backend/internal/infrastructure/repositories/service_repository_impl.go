@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"fmt"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type serviceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewServiceRepository(db *gorm.DB) repositories.ServiceRepository {
+	return &serviceRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria um novo serviço
+func (r *serviceRepositoryImpl) Create(service *entities.Service) error {
+	return r.db.Create(service).Error
+}
+
+// GetByID busca serviço por ID
+func (r *serviceRepositoryImpl) GetByID(id uint) (*entities.Service, error) {
+	var service entities.Service
+	err := r.db.First(&service, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
+
+// Update atualiza um serviço
+func (r *serviceRepositoryImpl) Update(service *entities.Service) error {
+	return r.db.Omit("Chairs").Save(service).Error
+}
+
+// Delete exclui um serviço (soft delete)
+func (r *serviceRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.Service{}, id).Error
+}
+
+// List lista serviços com paginação e filtros
+func (r *serviceRepositoryImpl) List(limit, offset int, filters map[string]interface{}) ([]*entities.Service, int64, error) {
+	var services []*entities.Service
+	var total int64
+
+	query := r.db.Model(&entities.Service{})
+
+	// Aplicar filtros
+	for key, value := range filters {
+		switch key {
+		case "name":
+			query = query.Where("name ILIKE ?", fmt.Sprintf("%%%s%%", value))
+		case "is_active":
+			query = query.Where("is_active = ?", value)
+		}
+	}
+
+	// Contar total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Buscar com paginação
+	err := query.Limit(limit).Offset(offset).Order("duration_minutes ASC, name ASC").Find(&services).Error
+	return services, total, err
+}
+
+// GetActive busca serviços ativos
+func (r *serviceRepositoryImpl) GetActive() ([]*entities.Service, error) {
+	var services []*entities.Service
+	err := r.db.Where("is_active = ?", true).Order("duration_minutes ASC, name ASC").Find(&services).Error
+	return services, err
+}
+
+// GetByChair busca os serviços oferecidos por uma cadeira
+func (r *serviceRepositoryImpl) GetByChair(chairID uint) ([]*entities.Service, error) {
+	var services []*entities.Service
+	err := r.db.Joins("JOIN chair_services ON chair_services.service_id = services.id").
+		Where("chair_services.chair_id = ?", chairID).
+		Order("services.duration_minutes ASC, services.name ASC").
+		Find(&services).Error
+	return services, err
+}
+
+// SetChairServices substitui os serviços oferecidos por uma cadeira
+func (r *serviceRepositoryImpl) SetChairServices(chairID uint, serviceIDs []uint) error {
+	chair := entities.Chair{ID: chairID}
+
+	var services []entities.Service
+	if len(serviceIDs) > 0 {
+		if err := r.db.Where("id IN ?", serviceIDs).Find(&services).Error; err != nil {
+			return err
+		}
+	}
+
+	return r.db.Model(&chair).Association("Services").Replace(services)
+}
+
+// ChairSupportsService verifica se a cadeira oferece o serviço
+func (r *serviceRepositoryImpl) ChairSupportsService(chairID, serviceID uint) (bool, error) {
+	var count int64
+	err := r.db.Table("chair_services").
+		Where("chair_id = ? AND service_id = ?", chairID, serviceID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetChairIDsByService lista as cadeiras que oferecem o serviço
+func (r *serviceRepositoryImpl) GetChairIDsByService(serviceID uint) ([]uint, error) {
+	var chairIDs []uint
+	err := r.db.Table("chair_services").
+		Where("service_id = ?", serviceID).
+		Pluck("chair_id", &chairIDs).Error
+	return chairIDs, err
+}
+
+// ExistsByName verifica se nome já existe
+func (r *serviceRepositoryImpl) ExistsByName(name string) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.Service{}).Where("name = ?", name).Count(&count).Error
+	return count > 0, err
+}
+
+// CountTotal conta total de serviços
+func (r *serviceRepositoryImpl) CountTotal() (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.Service{}).Count(&count).Error
+	return count, err
+}
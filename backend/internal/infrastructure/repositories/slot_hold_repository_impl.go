@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type slotHoldRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSlotHoldRepository(db *gorm.DB) repositories.SlotHoldRepository {
+	return &slotHoldRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria uma nova reserva. A restrição de exclusão de slot_holds barra duas
+// reservas ativas sobrepostas na mesma cadeira, retornando entities.ErrSlotHeld
+func (r *slotHoldRepositoryImpl) Create(hold *entities.SlotHold) error {
+	err := r.db.Create(hold).Error
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgExclusionViolation {
+		return entities.ErrSlotHeld
+	}
+	return err
+}
+
+// GetByToken busca a reserva pelo token entregue ao usuário
+func (r *slotHoldRepositoryImpl) GetByToken(token string) (*entities.SlotHold, error) {
+	var hold entities.SlotHold
+	err := r.db.Preload("Chair").Where("token = ?", token).First(&hold).Error
+	if err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+// Update atualiza uma reserva
+func (r *slotHoldRepositoryImpl) Update(hold *entities.SlotHold) error {
+	return r.db.Omit("Chair").Save(hold).Error
+}
+
+// GetActiveByChairAndDate busca as reservas em vigor de uma cadeira em uma data
+func (r *slotHoldRepositoryImpl) GetActiveByChairAndDate(chairID uint, date time.Time, now time.Time) ([]*entities.SlotHold, error) {
+	var holds []*entities.SlotHold
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.Add(24 * time.Hour)
+
+	err := r.db.Where("chair_id = ? AND status = ? AND expires_at > ? AND start_time >= ? AND start_time < ?",
+		chairID, entities.SlotHoldStatusActive, now, startOfDay, endOfDay).
+		Order("start_time ASC").Find(&holds).Error
+	return holds, err
+}
+
+// GetActiveByChairsInRange busca as reservas em vigor de um conjunto de cadeiras iniciadas no período
+func (r *slotHoldRepositoryImpl) GetActiveByChairsInRange(chairIDs []uint, startTime, endTime time.Time, now time.Time) ([]*entities.SlotHold, error) {
+	var holds []*entities.SlotHold
+	err := r.db.Where("chair_id IN ? AND status = ? AND expires_at > ? AND start_time >= ? AND start_time < ?",
+		chairIDs, entities.SlotHoldStatusActive, now, startTime, endTime).
+		Order("chair_id ASC, start_time ASC").Find(&holds).Error
+	return holds, err
+}
+
+// HasActiveHold verifica se alguma reserva em vigor sobrepõe o horário, ignorando a do token informado
+func (r *slotHoldRepositoryImpl) HasActiveHold(chairID uint, startTime, endTime time.Time, now time.Time, excludeToken string) (bool, error) {
+	query := r.db.Model(&entities.SlotHold{}).
+		Where("chair_id = ? AND status = ? AND expires_at > ? AND start_time < ? AND end_time > ?",
+			chairID, entities.SlotHoldStatusActive, now, endTime, startTime)
+
+	if excludeToken != "" {
+		query = query.Where("token != ?", excludeToken)
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
+// ReleaseActiveByUser libera as reservas ativas do usuário. Vagas ofertadas pela
+// lista de espera continuam reservadas até o aceite ou o fim da oferta
+func (r *slotHoldRepositoryImpl) ReleaseActiveByUser(userID uint) error {
+	return r.db.Model(&entities.SlotHold{}).
+		Where("user_id = ? AND status = ? AND waitlist_entry_id IS NULL", userID, entities.SlotHoldStatusActive).
+		Update("status", entities.SlotHoldStatusReleased).Error
+}
+
+// ReleaseActiveByWaitlistEntry libera a reserva ativa da oferta da entrada da lista de espera
+func (r *slotHoldRepositoryImpl) ReleaseActiveByWaitlistEntry(entryID uint) error {
+	return r.db.Model(&entities.SlotHold{}).
+		Where("waitlist_entry_id = ? AND status = ?", entryID, entities.SlotHoldStatusActive).
+		Update("status", entities.SlotHoldStatusReleased).Error
+}
+
+// ExpireStale marca como expiradas as reservas ativas cujo prazo já passou
+func (r *slotHoldRepositoryImpl) ExpireStale(now time.Time) (int64, error) {
+	result := r.db.Model(&entities.SlotHold{}).
+		Where("status = ? AND expires_at <= ?", entities.SlotHoldStatusActive, now).
+		Update("status", entities.SlotHoldStatusExpired)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type tenantRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTenantRepository cria o repositório de tenants. Recebe a conexão sem escopo de
+// tenant: o cadastro de clientes pertence à plataforma
+func NewTenantRepository(db *gorm.DB) repositories.TenantRepository {
+	return &tenantRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria um novo tenant
+func (r *tenantRepositoryImpl) Create(tenant *entities.Tenant) error {
+	return r.db.Create(tenant).Error
+}
+
+// GetByID busca tenant por ID
+func (r *tenantRepositoryImpl) GetByID(id uint) (*entities.Tenant, error) {
+	var tenant entities.Tenant
+	err := r.db.First(&tenant, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tenant, nil
+}
+
+// GetBySlug busca tenant pelo subdomínio
+func (r *tenantRepositoryImpl) GetBySlug(slug string) (*entities.Tenant, error) {
+	var tenant entities.Tenant
+	err := r.db.Where("slug = ?", slug).First(&tenant).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tenant, nil
+}
+
+// Update atualiza um tenant
+func (r *tenantRepositoryImpl) Update(tenant *entities.Tenant) error {
+	return r.db.Save(tenant).Error
+}
+
+// List lista os tenants por nome
+func (r *tenantRepositoryImpl) List(includeInactive bool) ([]*entities.Tenant, error) {
+	var tenants []*entities.Tenant
+	query := r.db.Model(&entities.Tenant{})
+
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	err := query.Order("name ASC").Find(&tenants).Error
+	return tenants, err
+}
+
+// ExistsBySlug verifica se o subdomínio já está em uso
+func (r *tenantRepositoryImpl) ExistsBySlug(slug string) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.Tenant{}).Unscoped().Where("slug = ?", slug).Count(&count).Error
+	return count > 0, err
+}
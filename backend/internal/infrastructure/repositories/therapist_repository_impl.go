@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type therapistRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTherapistRepository(db *gorm.DB) repositories.TherapistRepository {
+	return &therapistRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria um novo massoterapeuta
+func (r *therapistRepositoryImpl) Create(therapist *entities.Therapist) error {
+	return r.db.Create(therapist).Error
+}
+
+// GetByID busca massoterapeuta por ID, com os turnos
+func (r *therapistRepositoryImpl) GetByID(id uint) (*entities.Therapist, error) {
+	var therapist entities.Therapist
+	err := r.db.Preload("Shifts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("day_of_week ASC, start_time ASC")
+	}).First(&therapist, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &therapist, nil
+}
+
+// Update atualiza os dados do massoterapeuta (os turnos têm operações próprias)
+func (r *therapistRepositoryImpl) Update(therapist *entities.Therapist) error {
+	return r.db.Omit("Shifts").Save(therapist).Error
+}
+
+// Delete exclui um massoterapeuta (soft delete)
+func (r *therapistRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.Therapist{}, id).Error
+}
+
+// List lista os massoterapeutas em ordem alfabética
+func (r *therapistRepositoryImpl) List(includeInactive bool) ([]*entities.Therapist, error) {
+	var therapists []*entities.Therapist
+	query := r.db.Model(&entities.Therapist{})
+
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	err := query.Order("name ASC").Find(&therapists).Error
+	return therapists, err
+}
+
+// CreateShift cria um turno
+func (r *therapistRepositoryImpl) CreateShift(shift *entities.TherapistShift) error {
+	return r.db.Omit("Therapist", "Chair").Create(shift).Error
+}
+
+// GetShiftByID busca turno por ID
+func (r *therapistRepositoryImpl) GetShiftByID(id uint) (*entities.TherapistShift, error) {
+	var shift entities.TherapistShift
+	err := r.db.Preload("Therapist").First(&shift, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+// UpdateShift atualiza um turno
+func (r *therapistRepositoryImpl) UpdateShift(shift *entities.TherapistShift) error {
+	return r.db.Omit("Therapist", "Chair").Save(shift).Error
+}
+
+// DeleteShift exclui um turno (soft delete)
+func (r *therapistRepositoryImpl) DeleteShift(id uint) error {
+	return r.db.Delete(&entities.TherapistShift{}, id).Error
+}
+
+// GetShiftsByTherapist busca os turnos do massoterapeuta, com as cadeiras
+func (r *therapistRepositoryImpl) GetShiftsByTherapist(therapistID uint) ([]*entities.TherapistShift, error) {
+	var shifts []*entities.TherapistShift
+	err := r.db.Preload("Chair").Where("therapist_id = ?", therapistID).
+		Order("day_of_week ASC, start_time ASC").
+		Find(&shifts).Error
+	return shifts, err
+}
+
+// GetShiftsByChairs busca os turnos de um conjunto de cadeiras, com os massoterapeutas
+func (r *therapistRepositoryImpl) GetShiftsByChairs(chairIDs []uint) ([]*entities.TherapistShift, error) {
+	var shifts []*entities.TherapistShift
+	if len(chairIDs) == 0 {
+		return shifts, nil
+	}
+	err := r.db.Preload("Therapist").Where("chair_id IN ?", chairIDs).
+		Order("chair_id ASC, day_of_week ASC, start_time ASC").
+		Find(&shifts).Error
+	return shifts, err
+}
+
+// CreateAbsence cria uma ausência
+func (r *therapistRepositoryImpl) CreateAbsence(absence *entities.TherapistAbsence) error {
+	return r.db.Create(absence).Error
+}
+
+// GetAbsenceByID busca ausência por ID
+func (r *therapistRepositoryImpl) GetAbsenceByID(id uint) (*entities.TherapistAbsence, error) {
+	var absence entities.TherapistAbsence
+	err := r.db.First(&absence, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &absence, nil
+}
+
+// DeleteAbsence exclui uma ausência (soft delete)
+func (r *therapistRepositoryImpl) DeleteAbsence(id uint) error {
+	return r.db.Delete(&entities.TherapistAbsence{}, id).Error
+}
+
+// GetAbsencesByTherapist busca as ausências do massoterapeuta, das mais recentes às mais antigas
+func (r *therapistRepositoryImpl) GetAbsencesByTherapist(therapistID uint) ([]*entities.TherapistAbsence, error) {
+	var absences []*entities.TherapistAbsence
+	err := r.db.Where("therapist_id = ?", therapistID).
+		Order("start_date DESC").
+		Find(&absences).Error
+	return absences, err
+}
+
+// GetAbsencesInRange busca as ausências dos massoterapeutas que tocam o período (datas inclusivas)
+func (r *therapistRepositoryImpl) GetAbsencesInRange(therapistIDs []uint, startDate, endDate time.Time) ([]*entities.TherapistAbsence, error) {
+	var absences []*entities.TherapistAbsence
+	if len(therapistIDs) == 0 {
+		return absences, nil
+	}
+	err := r.db.Where("therapist_id IN ? AND end_date >= ? AND start_date <= ?",
+		therapistIDs, startDate.Format("2006-01-02"), endDate.Format("2006-01-02")).
+		Order("start_date ASC").
+		Find(&absences).Error
+	return absences, err
+}
@@ -85,6 +85,12 @@ func (r *userRepositoryImpl) List(limit, offset int, filters map[string]interfac
 			query = query.Where("status = ?", value)
 		case "sector":
 			query = query.Where("sector ILIKE ?", fmt.Sprintf("%%%s%%", value))
+		case "email_verified":
+			if verified, ok := value.(bool); ok && verified {
+				query = query.Where("email_verified_at IS NOT NULL")
+			} else if ok {
+				query = query.Where("email_verified_at IS NULL")
+			}
 		}
 	}
 
@@ -150,7 +156,7 @@ func (r *userRepositoryImpl) GetByStatus(status string, limit, offset int) ([]*e
 }
 
 // Approve aprova um usuário
-func (r *userRepositoryImpl) Approve(id uint, approvedBy uint) error {
+func (r *userRepositoryImpl) Approve(id uint, approvedBy *uint) error {
 	// Primeiro, buscar o usuário para obter o requested_role
 	var user entities.User
 	if err := r.db.Where("id = ?", id).First(&user).Error; err != nil {
@@ -185,6 +191,31 @@ func (r *userRepositoryImpl) UpdateLastLogin(id uint) error {
 	return r.db.Model(&entities.User{}).Where("id = ?", id).Update("last_login", &now).Error
 }
 
+// UpdateCalendarToken grava o segredo da URL do feed iCal do usuário
+func (r *userRepositoryImpl) UpdateCalendarToken(id uint, token string) error {
+	return r.db.Model(&entities.User{}).Where("id = ?", id).Update("calendar_token", token).Error
+}
+
+// MarkEmailVerified registra a confirmação do email do usuário
+func (r *userRepositoryImpl) MarkEmailVerified(id uint, verifiedAt time.Time) error {
+	return r.db.Model(&entities.User{}).Where("id = ?", id).Update("email_verified_at", verifiedAt).Error
+}
+
+// UpdateEmailVerificationSentAt registra o envio do link de confirmação de email
+func (r *userRepositoryImpl) UpdateEmailVerificationSentAt(id uint, sentAt time.Time) error {
+	return r.db.Model(&entities.User{}).Where("id = ?", id).Update("email_verification_sent_at", sentAt).Error
+}
+
+// GetByCalendarToken busca o usuário dono do feed iCal
+func (r *userRepositoryImpl) GetByCalendarToken(token string) (*entities.User, error) {
+	var user entities.User
+	err := r.db.Where("calendar_token = ?", token).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
 // ExistsByEmail verifica se email já existe
 func (r *userRepositoryImpl) ExistsByEmail(email string) (bool, error) {
 	var count int64
@@ -228,3 +259,15 @@ func (r *userRepositoryImpl) CountTotal() (int64, error) {
 	err := r.db.Model(&entities.User{}).Count(&count).Error
 	return count, err
 }
+
+// UpdateLocation define a localidade atendida pelo usuário (nulo = todas)
+func (r *userRepositoryImpl) UpdateLocation(id uint, locationID *uint) error {
+	return r.db.Model(&entities.User{}).Where("id = ?", id).Update("location_id", locationID).Error
+}
+
+// GetByLocation busca os usuários vinculados à localidade
+func (r *userRepositoryImpl) GetByLocation(locationID uint) ([]*entities.User, error) {
+	var users []*entities.User
+	err := r.db.Where("location_id = ?", locationID).Order("name ASC").Find(&users).Error
+	return users, err
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type userSessionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUserSessionRepository(db *gorm.DB) repositories.UserSessionRepository {
+	return &userSessionRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create registra a sessão de um login
+func (r *userSessionRepositoryImpl) Create(session *entities.UserSession) error {
+	return r.db.Omit("User").Create(session).Error
+}
+
+// GetByID busca uma sessão pelo ID
+func (r *userSessionRepositoryImpl) GetByID(id uint) (*entities.UserSession, error) {
+	var session entities.UserSession
+	err := r.db.First(&session, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// GetByFamily busca a sessão da família de refresh tokens
+func (r *userSessionRepositoryImpl) GetByFamily(familyID string) (*entities.UserSession, error) {
+	var session entities.UserSession
+	err := r.db.Where("family_id = ?", familyID).First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListActiveByUser lista as sessões ativas do usuário, da usada mais recentemente
+// para a mais antiga
+func (r *userSessionRepositoryImpl) ListActiveByUser(userID uint, now time.Time) ([]*entities.UserSession, error) {
+	var sessions []*entities.UserSession
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// UpdateActivity grava os dados do último acesso sem tocar nos demais campos
+func (r *userSessionRepositoryImpl) UpdateActivity(session *entities.UserSession) error {
+	return r.db.Model(&entities.UserSession{}).
+		Where("id = ?", session.ID).
+		UpdateColumns(map[string]interface{}{
+			"user_agent":   session.UserAgent,
+			"ip_address":   session.IPAddress,
+			"last_seen_at": session.LastSeenAt,
+			"expires_at":   session.ExpiresAt,
+		}).Error
+}
+
+// Revoke encerra a sessão, se ainda estiver aberta
+func (r *userSessionRepositoryImpl) Revoke(id uint, reason string, revokedAt time.Time) (bool, error) {
+	result := r.db.Model(&entities.UserSession{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"revoked_at":     revokedAt,
+			"revoked_reason": reason,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// RevokeByUser encerra as sessões abertas do usuário, menos a informada
+func (r *userSessionRepositoryImpl) RevokeByUser(userID, exceptID uint, reason string, revokedAt time.Time) (int64, error) {
+	query := r.db.Model(&entities.UserSession{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+	if exceptID != 0 {
+		query = query.Where("id <> ?", exceptID)
+	}
+	result := query.UpdateColumns(map[string]interface{}{
+		"revoked_at":     revokedAt,
+		"revoked_reason": reason,
+	})
+	return result.RowsAffected, result.Error
+}
+
+// DeleteExpiredByUser apaga as sessões do usuário vencidas antes da data informada
+func (r *userSessionRepositoryImpl) DeleteExpiredByUser(userID uint, before time.Time) error {
+	return r.db.Where("user_id = ? AND expires_at < ?", userID, before).
+		Delete(&entities.UserSession{}).Error
+}
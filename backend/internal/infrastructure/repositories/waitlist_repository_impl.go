@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type waitlistRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWaitlistRepository(db *gorm.DB) repositories.WaitlistRepository {
+	return &waitlistRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create cria uma nova entrada na lista de espera
+func (r *waitlistRepositoryImpl) Create(entry *entities.WaitlistEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// GetByID busca entrada por ID
+func (r *waitlistRepositoryImpl) GetByID(id uint) (*entities.WaitlistEntry, error) {
+	var entry entities.WaitlistEntry
+	err := r.db.Preload("Chair").First(&entry, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Update atualiza uma entrada da lista de espera
+func (r *waitlistRepositoryImpl) Update(entry *entities.WaitlistEntry) error {
+	return r.db.Omit("User", "Chair").Save(entry).Error
+}
+
+// GetByUser busca as entradas de um usuário, mais recentes primeiro
+func (r *waitlistRepositoryImpl) GetByUser(userID uint) ([]*entities.WaitlistEntry, error) {
+	var entries []*entities.WaitlistEntry
+	err := r.db.Preload("Chair").
+		Where("user_id = ?", userID).
+		Order("date DESC, created_at DESC").Find(&entries).Error
+	return entries, err
+}
+
+// GetByChairAndDate busca a fila completa de uma cadeira em uma data
+func (r *waitlistRepositoryImpl) GetByChairAndDate(chairID uint, date time.Time) ([]*entities.WaitlistEntry, error) {
+	var entries []*entities.WaitlistEntry
+	err := r.db.Preload("User").
+		Where("chair_id = ? AND date = ?", chairID, date.Format("2006-01-02")).
+		Order("created_at ASC").Find(&entries).Error
+	return entries, err
+}
+
+// GetWaitingForChairAndDate busca as entradas aguardando vaga, em ordem de chegada
+func (r *waitlistRepositoryImpl) GetWaitingForChairAndDate(chairID uint, date time.Time) ([]*entities.WaitlistEntry, error) {
+	var entries []*entities.WaitlistEntry
+	err := r.db.Where("chair_id = ? AND date = ? AND status = ?", chairID, date.Format("2006-01-02"), entities.WaitlistStatusWaiting).
+		Order("created_at ASC").Find(&entries).Error
+	return entries, err
+}
+
+// GetByOfferTokenHash busca a entrada com oferta pendente pelo hash do token do link
+func (r *waitlistRepositoryImpl) GetByOfferTokenHash(tokenHash string) (*entities.WaitlistEntry, error) {
+	var entry entities.WaitlistEntry
+	err := r.db.Preload("Chair").Where("offer_token_hash = ?", tokenHash).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// ClaimOffer consome o token da oferta pendente. Retorna false se outro envio do
+// link já o consumiu ou se a oferta foi encerrada nesse meio tempo
+func (r *waitlistRepositoryImpl) ClaimOffer(id uint, tokenHash string) (bool, error) {
+	result := r.db.Model(&entities.WaitlistEntry{}).
+		Where("id = ? AND status = ? AND offer_token_hash = ?", id, entities.WaitlistStatusOffered, tokenHash).
+		UpdateColumn("offer_token_hash", nil)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetExpiredOffers busca ofertas cujo prazo de aceite já passou
+func (r *waitlistRepositoryImpl) GetExpiredOffers(now time.Time) ([]*entities.WaitlistEntry, error) {
+	var entries []*entities.WaitlistEntry
+	err := r.db.Where("status = ? AND offer_expires_at <= ?", entities.WaitlistStatusOffered, now).
+		Find(&entries).Error
+	return entries, err
+}
+
+// HasOpenEntry verifica se o usuário já está na fila da cadeira para a data
+func (r *waitlistRepositoryImpl) HasOpenEntry(userID, chairID uint, date time.Time) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.WaitlistEntry{}).
+		Where("user_id = ? AND chair_id = ? AND date = ? AND status IN (?, ?)",
+			userID, chairID, date.Format("2006-01-02"), entities.WaitlistStatusWaiting, entities.WaitlistStatusOffered).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -11,14 +11,24 @@ import (
 type Scheduler struct {
 	notificationUC *usecases.NotificationUseCase
 	bookingUC      *usecases.BookingUseCase
+	waitlistUC     *usecases.WaitlistUseCase
+	slotHoldUC     *usecases.SlotHoldUseCase
+	presenceUC     *usecases.PresenceConfirmationUseCase
+	feedbackUC     *usecases.FeedbackUseCase
+	noShowGrace    time.Duration
 	stopChan       chan bool
 }
 
 // NewScheduler cria uma nova instância do scheduler
-func NewScheduler(notificationUC *usecases.NotificationUseCase, bookingUC *usecases.BookingUseCase) *Scheduler {
+func NewScheduler(notificationUC *usecases.NotificationUseCase, bookingUC *usecases.BookingUseCase, waitlistUC *usecases.WaitlistUseCase, slotHoldUC *usecases.SlotHoldUseCase, presenceUC *usecases.PresenceConfirmationUseCase, feedbackUC *usecases.FeedbackUseCase, noShowGrace time.Duration) *Scheduler {
 	return &Scheduler{
 		notificationUC: notificationUC,
 		bookingUC:      bookingUC,
+		waitlistUC:     waitlistUC,
+		slotHoldUC:     slotHoldUC,
+		presenceUC:     presenceUC,
+		feedbackUC:     feedbackUC,
+		noShowGrace:    noShowGrace,
 		stopChan:       make(chan bool),
 	}
 }
@@ -27,12 +37,17 @@ func NewScheduler(notificationUC *usecases.NotificationUseCase, bookingUC *useca
 func (s *Scheduler) Start() {
 	go s.runDailyReminders()
 	go s.runMarkCompletedSessions()
+	go s.runMarkNoShows()
+	go s.runExpireWaitlistOffers()
+	go s.runExpireSlotHolds()
+	go s.runReleaseUnconfirmedBookings()
+	go s.runSendFeedbackPrompts()
 	fmt.Println("Scheduler iniciado - lembretes diários e marcação automática de sessões ativados")
 }
 
-// Stop para o scheduler
+// Stop para o scheduler. Fechar o canal encerra todas as rotinas de uma vez
 func (s *Scheduler) Stop() {
-	s.stopChan <- true
+	close(s.stopChan)
 	fmt.Println("Scheduler parado")
 }
 
@@ -82,6 +97,91 @@ func (s *Scheduler) runMarkCompletedSessions() {
 	}
 }
 
+// runMarkNoShows marca como falta os agendamentos não confirmados após a tolerância
+func (s *Scheduler) runMarkNoShows() {
+	ticker := time.NewTicker(30 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.bookingUC.MarkStaleNoShows(s.noShowGrace); err != nil {
+				fmt.Printf("Erro ao marcar faltas automaticamente: %v\n", err)
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// runExpireWaitlistOffers encerra ofertas da lista de espera vencidas e repassa as vagas
+func (s *Scheduler) runExpireWaitlistOffers() {
+	ticker := time.NewTicker(1 * time.Minute) // Ofertas têm prazo curto
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.waitlistUC.ExpireOffers(); err != nil {
+				fmt.Printf("Erro ao expirar ofertas da lista de espera: %v\n", err)
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// runExpireSlotHolds encerra as reservas temporárias de horário vencidas
+func (s *Scheduler) runExpireSlotHolds() {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.slotHoldUC.ExpireHolds(); err != nil {
+				fmt.Printf("Erro ao expirar reservas de horário: %v\n", err)
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// runReleaseUnconfirmedBookings libera os horários sem confirmação de presença até o prazo
+func (s *Scheduler) runReleaseUnconfirmedBookings() {
+	ticker := time.NewTicker(5 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.presenceUC.ReleaseUnconfirmed(); err != nil {
+				fmt.Printf("Erro ao liberar agendamentos sem confirmação: %v\n", err)
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
+// runSendFeedbackPrompts convida a avaliar as sessões marcadas como realizadas
+func (s *Scheduler) runSendFeedbackPrompts() {
+	ticker := time.NewTicker(30 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.feedbackUC.SendFeedbackPrompts(); err != nil {
+				fmt.Printf("Erro ao enviar convites de avaliação: %v\n", err)
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
+
 // SendImmediateReminders envia lembretes imediatamente (para testes)
 func (s *Scheduler) SendImmediateReminders() error {
 	return s.notificationUC.SendDailyReminders()
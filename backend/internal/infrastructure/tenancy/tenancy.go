@@ -0,0 +1,139 @@
+// Package tenancy isola os dados de cada cliente (tenant) no banco compartilhado.
+//
+// Cada tenant recebe uma conexão criada por Scope. Os callbacks registrados pelo
+// Plugin leem o tenant do contexto dessa conexão e, em toda tabela com a coluna
+// tenant_id, filtram consultas, alterações e exclusões e preenchem as inserções.
+// Assim os repositórios continuam escrevendo consultas comuns e nenhuma delas
+// enxerga dados de outro cliente. SQL escrito à mão (Raw/Exec) não passa pelos
+// callbacks e precisa filtrar o tenant explicitamente com TenantID.
+package tenancy
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	// Column é a coluna que identifica o tenant dono da linha
+	Column = "tenant_id"
+
+	fieldName = "TenantID"
+)
+
+type contextKey struct{}
+
+// WithTenant devolve um contexto associado ao tenant
+func WithTenant(ctx context.Context, tenantID uint) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantID)
+}
+
+// FromContext devolve o tenant do contexto, se houver
+func FromContext(ctx context.Context) (uint, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	tenantID, ok := ctx.Value(contextKey{}).(uint)
+	return tenantID, ok
+}
+
+// Scope devolve uma conexão restrita ao tenant. Transações abertas a partir dela
+// herdam o escopo
+func Scope(db *gorm.DB, tenantID uint) *gorm.DB {
+	return db.WithContext(WithTenant(context.Background(), tenantID))
+}
+
+// TenantID devolve o tenant da conexão, para filtrar SQL escrito à mão
+func TenantID(db *gorm.DB) (uint, bool) {
+	return FromContext(db.Statement.Context)
+}
+
+// Plugin registra os callbacks de isolamento por tenant
+type Plugin struct{}
+
+// Name identifica o plugin no GORM
+func (Plugin) Name() string {
+	return "tenancy"
+}
+
+// Initialize registra os callbacks antes das operações do GORM
+func (Plugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if err := callbacks.Create().Before("gorm:create").Register("tenancy:create", assignTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Query().Before("gorm:query").Register("tenancy:query", scopeStatement); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("tenancy:row", scopeStatement); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("tenancy:update", scopeUpdate); err != nil {
+		return err
+	}
+	return callbacks.Delete().Before("gorm:delete").Register("tenancy:delete", scopeStatement)
+}
+
+// tenantField devolve o tenant da operação e o campo TenantID do modelo, quando a
+// conexão tem escopo e a tabela pertence aos tenants
+func tenantField(db *gorm.DB) (uint, *schema.Field, bool) {
+	tenantID, ok := FromContext(db.Statement.Context)
+	if !ok || db.Statement.Schema == nil {
+		return 0, nil, false
+	}
+	field := db.Statement.Schema.LookUpField(fieldName)
+	if field == nil {
+		return 0, nil, false
+	}
+	return tenantID, field, true
+}
+
+// assignTenant grava o tenant da conexão em cada registro inserido, ignorando o
+// valor que o registro trouxer
+func assignTenant(db *gorm.DB) {
+	tenantID, field, ok := tenantField(db)
+	if !ok {
+		return
+	}
+	setTenant(db.Statement.Context, db.Statement.ReflectValue, field, tenantID)
+}
+
+// scopeUpdate impede que um Save mova a linha para outro tenant e restringe a
+// alteração às linhas do tenant
+func scopeUpdate(db *gorm.DB) {
+	assignTenant(db)
+	scopeStatement(db)
+}
+
+// scopeStatement acrescenta tenant_id = ? ao WHERE. As condições existentes são
+// agrupadas antes, para que um OR da consulta não escape do filtro
+func scopeStatement(db *gorm.DB) {
+	tenantID, _, ok := tenantField(db)
+	if !ok || db.Statement.SQL.Len() > 0 {
+		return
+	}
+
+	condition := clause.Eq{Column: clause.Column{Table: db.Statement.Table, Name: Column}, Value: tenantID}
+	exprs := []clause.Expression{condition}
+	if current, ok := db.Statement.Clauses["WHERE"]; ok {
+		if where, ok := current.Expression.(clause.Where); ok && len(where.Exprs) > 0 {
+			exprs = []clause.Expression{clause.And(where.Exprs...), condition}
+		}
+	}
+	db.Statement.Clauses["WHERE"] = clause.Clause{Name: "WHERE", Expression: clause.Where{Exprs: exprs}}
+}
+
+// setTenant preenche o campo em um registro ou em cada item de um lote
+func setTenant(ctx context.Context, value reflect.Value, field *schema.Field, tenantID uint) {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			setTenant(ctx, reflect.Indirect(value.Index(i)), field, tenantID)
+		}
+	case reflect.Struct:
+		_ = field.Set(ctx, value, tenantID)
+	}
+}
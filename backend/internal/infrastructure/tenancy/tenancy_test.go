@@ -0,0 +1,133 @@
+package tenancy
+
+import (
+	"testing"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB monta uma conexão que só gera o SQL, sem banco de verdade
+func dryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(Plugin{}))
+	return db
+}
+
+func TestScope_Statements(t *testing.T) {
+	db := dryRunDB(t)
+	scoped := Scope(db, 7)
+
+	tests := []struct {
+		name     string
+		run      func() *gorm.DB
+		contains string
+		absent   string
+	}{
+		{
+			name: "Consulta recebe o filtro do tenant",
+			run: func() *gorm.DB {
+				return scoped.Where("status = ?", "ativa").Find(&[]entities.Chair{})
+			},
+			contains: `WHERE status = $1 AND "chairs"."tenant_id" = $2`,
+		},
+		{
+			name: "OR da consulta fica agrupado antes do filtro",
+			run: func() *gorm.DB {
+				return scoped.Where("cpf = ?", "1").Or("email = ?", "a@b.com").Find(&[]entities.User{})
+			},
+			contains: `WHERE (cpf = $1 OR email = $2) AND "users"."tenant_id" = $3`,
+		},
+		{
+			name: "Consulta com join filtra a tabela principal",
+			run: func() *gorm.DB {
+				return scoped.Joins("JOIN chairs ON chairs.id = bookings.chair_id").Find(&[]entities.Booking{})
+			},
+			contains: `"bookings"."tenant_id" = $1`,
+		},
+		{
+			name: "Contagem recebe o filtro",
+			run: func() *gorm.DB {
+				var count int64
+				return scoped.Model(&entities.AuditLog{}).Where("action = ?", "LOGIN").Count(&count)
+			},
+			contains: `"audit_logs"."tenant_id" = $2`,
+		},
+		{
+			name: "Alteração recebe o filtro",
+			run: func() *gorm.DB {
+				return scoped.Model(&entities.Availability{}).Where("chair_id = ?", 1).Update("is_active", false)
+			},
+			contains: `WHERE chair_id = $3 AND "availabilities"."tenant_id" = $4`,
+		},
+		{
+			name: "Exclusão recebe o filtro",
+			run: func() *gorm.DB {
+				return scoped.Delete(&entities.Chair{}, 3)
+			},
+			contains: `"chairs"."tenant_id" = $3`,
+		},
+		{
+			name: "Tabela de tenants não é filtrada",
+			run: func() *gorm.DB {
+				return scoped.Find(&[]entities.Tenant{})
+			},
+			absent: "tenant_id",
+		},
+		{
+			name: "Conexão sem escopo não é filtrada",
+			run: func() *gorm.DB {
+				return db.Find(&[]entities.Chair{})
+			},
+			absent: `"chairs"."tenant_id"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.run()
+			require.NoError(t, result.Error)
+			sql := result.Statement.SQL.String()
+			if tt.contains != "" {
+				assert.Contains(t, sql, tt.contains)
+			}
+			if tt.absent != "" {
+				assert.NotContains(t, sql, tt.absent)
+			}
+		})
+	}
+}
+
+func TestScope_CreateAssignsTenant(t *testing.T) {
+	scoped := Scope(dryRunDB(t), 7)
+
+	chair := entities.Chair{Name: "Cadeira 01", Location: "Térreo", TenantID: 99}
+	require.NoError(t, scoped.Create(&chair).Error)
+	assert.Equal(t, uint(7), chair.TenantID)
+
+	services := []entities.Service{{Name: "Rápida"}, {Name: "Padrão"}}
+	require.NoError(t, scoped.Create(&services).Error)
+	for _, service := range services {
+		assert.Equal(t, uint(7), service.TenantID)
+	}
+}
+
+func TestScope_SaveKeepsTenant(t *testing.T) {
+	scoped := Scope(dryRunDB(t), 7)
+
+	user := entities.User{ID: 5, Name: "Ana"}
+	result := scoped.Save(&user)
+	require.NoError(t, result.Error)
+
+	assert.Equal(t, uint(7), user.TenantID)
+	assert.Contains(t, result.Statement.SQL.String(), `"users"."tenant_id" = `)
+}
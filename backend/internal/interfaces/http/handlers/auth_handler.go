@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"agendamento-backend/internal/application/mappers"
 	"agendamento-backend/internal/application/usecases"
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/domain/ports"
@@ -14,20 +18,29 @@ import (
 )
 
 type AuthHandler struct {
-	userUseCase     *usecases.UserUseCase
-	auditLogUseCase *usecases.AuditLogUseCase
-	passwordHasher  ports.PasswordHasher
+	userUseCase              *usecases.UserUseCase
+	auditLogUseCase          *usecases.AuditLogUseCase
+	refreshTokenUseCase      *usecases.RefreshTokenUseCase
+	loginProtectionUseCase   *usecases.LoginProtectionUseCase
+	emailVerificationUseCase *usecases.EmailVerificationUseCase // nulo na plataforma, que não tem cadastro público
+	passwordHasher           ports.PasswordHasher
 }
 
 func NewAuthHandler(
 	userUseCase *usecases.UserUseCase,
 	auditLogUseCase *usecases.AuditLogUseCase,
+	refreshTokenUseCase *usecases.RefreshTokenUseCase,
+	loginProtectionUseCase *usecases.LoginProtectionUseCase,
+	emailVerificationUseCase *usecases.EmailVerificationUseCase,
 	passwordHasher ports.PasswordHasher,
 ) *AuthHandler {
 	return &AuthHandler{
-		userUseCase:     userUseCase,
-		auditLogUseCase: auditLogUseCase,
-		passwordHasher:  passwordHasher,
+		userUseCase:              userUseCase,
+		auditLogUseCase:          auditLogUseCase,
+		refreshTokenUseCase:      refreshTokenUseCase,
+		loginProtectionUseCase:   loginProtectionUseCase,
+		emailVerificationUseCase: emailVerificationUseCase,
+		passwordHasher:           passwordHasher,
 	}
 }
 
@@ -43,6 +56,10 @@ type LoginRequest struct {
 	// required: true
 	// example: "minhasenha123"
 	Password string `json:"password" binding:"required" validate:"required,min=6"`
+
+	// Nome do dispositivo, exibido na lista de sessões (opcional)
+	// example: "iPhone de João"
+	DeviceName string `json:"device_name" validate:"max=100"`
 }
 
 // LoginResponse representa a resposta do login
@@ -52,7 +69,7 @@ type LoginResponse struct {
 	// example: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
 	Token string `json:"token"`
 
-	// Refresh token para renovar o token principal (válido por 7 dias)
+	// Refresh token para renovar o token principal (válido por 7 dias, de uso único)
 	// example: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
 	RefreshToken string `json:"refresh_token"`
 
@@ -64,10 +81,10 @@ type LoginResponse struct {
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
-// RefreshRequest representa a requisição de refresh
+// RefreshRequest representa a requisição de refresh e de logout
 // swagger:model RefreshRequest
 type RefreshRequest struct {
-	// Refresh token obtido no login
+	// Refresh token obtido no login ou na última renovação
 	// required: true
 	// example: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
 	RefreshToken string `json:"refresh_token" binding:"required"`
@@ -80,7 +97,7 @@ type RefreshResponse struct {
 	// example: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
 	Token string `json:"token"`
 
-	// Novo refresh token
+	// Novo refresh token. O token enviado deixa de valer
 	// example: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
 	RefreshToken string `json:"refresh_token"`
 
@@ -185,7 +202,7 @@ type RegisterResponse struct {
 
 // Login autentica um usuário usando CPF e senha
 // @Summary Autenticar usuário
-// @Description Autentica um usuário usando CPF e senha e retorna um token JWT
+// @Description Autentica um usuário usando CPF e senha e retorna um token JWT. Senhas erradas seguidas atrasam as próximas tentativas e, no limite, bloqueiam o login do CPF por um tempo; o bloqueio termina antes se a senha for redefinida ou um admin desbloquear
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -193,13 +210,11 @@ type RegisterResponse struct {
 // @Success 200 {object} LoginResponse "Login realizado com sucesso"
 // @Failure 400 {object} map[string]string "Dados inválidos"
 // @Failure 401 {object} map[string]string "CPF ou senha inválidos ou usuário não aprovado"
+// @Failure 429 {object} map[string]interface{} "Tentativas demais ou login bloqueado; o header Retry-After traz a espera em segundos"
 // @Failure 500 {object} map[string]string "Erro interno do servidor"
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
-	var loginRequest struct {
-		CPF      string `json:"cpf" binding:"required"`
-		Password string `json:"password" binding:"required"`
-	}
+	var loginRequest LoginRequest
 
 	if err := c.ShouldBindJSON(&loginRequest); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + err.Error()})
@@ -209,23 +224,30 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	// Normalizar CPF (remover pontos e hífen)
 	cpf := normalizeCPF(loginRequest.CPF)
 
-	// Buscar usuário por CPF
+	// Atraso progressivo, bloqueio do CPF e limite por IP valem antes de olhar a senha
+	if err := h.loginProtectionUseCase.CheckAllowed(cpf, c.ClientIP()); err != nil {
+		h.loginRefused(c, err)
+		return
+	}
+
+	// Buscar usuário por CPF. CPF desconhecido conta como falha, como senha errada
 	user, err := h.userUseCase.GetUserByCPF(cpf)
 	if err != nil {
-		// Log de tentativa de login com CPF inválido
-		h.auditLogUseCase.LogSystemAction(entities.ActionLogin, entities.ResourceAuth, 0, "Tentativa de login com CPF inválido: "+cpf)
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "CPF ou senha inválidos"})
+		h.loginFailed(c, cpf, nil)
 		return
 	}
 
 	// Verificar senha
 	if err := h.passwordHasher.Compare(user.Password, loginRequest.Password); err != nil {
-		// Log de tentativa de login com senha inválida
-		h.auditLogUseCase.LogUserAction(user.ID, entities.ActionLogin, entities.ResourceAuth, user.ID, "Tentativa de login com senha inválida", c.ClientIP(), c.GetHeader("User-Agent"))
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "CPF ou senha inválidos"})
+		h.loginFailed(c, cpf, user)
 		return
 	}
 
+	// Senha certa zera as falhas do CPF
+	if err := h.loginProtectionUseCase.RecordSuccess(cpf); err != nil {
+		h.auditLogUseCase.LogSystemAction(entities.ActionUpdate, entities.ResourceAuth, user.ID, "Erro ao zerar tentativas de login: "+err.Error())
+	}
+
 	// Verificar se o usuário está aprovado
 	if user.Status != "aprovado" {
 		// Log de tentativa de login com usuário não aprovado
@@ -236,6 +258,9 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		switch user.Status {
 		case "pendente":
 			message = "Seu cadastro ainda não foi aprovado. Por favor, aguarde a aprovação de um administrador ou atendente."
+			if !user.IsEmailVerified() {
+				message = "Confirme seu email pelo link que enviamos no cadastro. Depois da confirmação, seu cadastro poderá ser aprovado."
+			}
 		case "rejeitado":
 			message = "Seu cadastro foi rejeitado. Entre em contato com o administrador para mais informações."
 		case "suspenso":
@@ -257,14 +282,20 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	// Log de login bem-sucedido
 	h.auditLogUseCase.LogUserAction(user.ID, entities.ActionLogin, entities.ResourceAuth, user.ID, "Login realizado com sucesso", c.ClientIP(), c.GetHeader("User-Agent"))
 
-	// Gerar tokens
-	token, expiresAt, err := middleware.GenerateToken(user)
+	// Abrir a sessão e gerar os tokens
+	issued, err := h.refreshTokenUseCase.StartSession(user.ID, deviceInfo(c, loginRequest.DeviceName))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao gerar refresh token"})
+		return
+	}
+
+	token, expiresAt, err := middleware.GenerateToken(user, issued.Session.ID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao gerar token"})
 		return
 	}
 
-	refreshToken, _, err := middleware.GenerateRefreshToken(user)
+	refreshToken, err := middleware.GenerateRefreshToken(user, issued.TokenID, issued.Token.FamilyID, issued.Token.ExpiresAt)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao gerar refresh token"})
 		return
@@ -302,7 +333,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 // Register registra um novo usuário
 // @Summary Registrar novo usuário
-// @Description Registra um novo usuário no sistema. O usuário será criado com status "pendente" e aguardará aprovação.
+// @Description Registra um novo usuário no sistema. O usuário será criado com status "pendente" e recebe por email um link de confirmação; o cadastro só pode ser aprovado depois que o email for confirmado.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -364,6 +395,9 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	// Log de auditoria
 	h.auditLogUseCase.LogSystemAction(entities.ActionCreate, entities.ResourceUser, user.ID, "Novo usuário registrado via endpoint público")
 
+	// O cadastro só pode ser aprovado depois que o email for confirmado
+	h.emailVerificationUseCase.SendVerification(user)
+
 	// Preparar resposta
 	response := RegisterResponse{
 		ID:      user.ID,
@@ -371,7 +405,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		Email:   user.Email,
 		Status:  user.Status,
 		Role:    user.Role,
-		Message: "Usuário registrado com sucesso. Aguarde aprovação de um administrador ou atendente.",
+		Message: "Usuário registrado com sucesso. Confirme seu email pelo link que enviamos; depois disso, aguarde a aprovação de um administrador ou atendente.",
 	}
 
 	c.JSON(http.StatusCreated, response)
@@ -379,7 +413,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 // Refresh renova o token JWT usando o refresh token
 // @Summary Renovar token
-// @Description Renova o token JWT usando o refresh token válido
+// @Description Troca o refresh token por um novo token JWT e um novo refresh token. Cada refresh token vale uma única vez: reapresentar um token já trocado encerra a sessão inteira
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -403,27 +437,30 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
-	// Buscar usuário
-	user, err := h.userUseCase.GetUserByID(claims.UserID)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não encontrado"})
+	// Refresh token de outro tenant apontaria para outro usuário com o mesmo ID
+	if tenantID, _ := middleware.GetTenantIDFromContext(c); claims.TenantID != tenantID {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token inválido ou expirado"})
 		return
 	}
 
-	// Verificar se o usuário está aprovado
-	if user.Status != "aprovado" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não está aprovado"})
+	// Trocar o refresh token por um novo da mesma família
+	user, issued, err := h.refreshTokenUseCase.Rotate(claims.UserID, claims.ID, claims.FamilyID, deviceInfo(c, ""))
+	if err != nil {
+		if errors.Is(err, entities.ErrRefreshTokenReused) {
+			h.auditLogUseCase.LogUserAction(claims.UserID, entities.ActionLogout, entities.ResourceAuth, claims.UserID, "Sessão revogada: refresh token reutilizado", c.ClientIP(), c.GetHeader("User-Agent"))
+		}
+		c.JSON(refreshErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	// Gerar novos tokens
-	token, expiresAt, err := middleware.GenerateToken(user)
+	token, expiresAt, err := middleware.GenerateToken(user, issued.Session.ID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao gerar token"})
 		return
 	}
 
-	newRefreshToken, _, err := middleware.GenerateRefreshToken(user)
+	newRefreshToken, err := middleware.GenerateRefreshToken(user, issued.TokenID, issued.Token.FamilyID, issued.Token.ExpiresAt)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao gerar refresh token"})
 		return
@@ -443,29 +480,343 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 
 // Logout faz logout do usuário
 // @Summary Fazer logout
-// @Description Invalida o token atual e registra o logout no sistema
+// @Description Encerra a sessão do refresh token informado: ele e os demais tokens da mesma sessão deixam de valer
 // @Tags auth
 // @Accept json
 // @Produce json
-// @Security Bearer
+// @Param logout body RefreshRequest true "Refresh token da sessão"
 // @Success 200 {object} map[string]string "Logout realizado com sucesso"
-// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 400 {object} map[string]string "Refresh token é obrigatório"
+// @Failure 401 {object} map[string]string "Refresh token inválido"
 // @Failure 500 {object} map[string]string "Erro interno do servidor"
 // @Router /auth/logout [post]
 func (h *AuthHandler) Logout(c *gin.Context) {
-	// Obter ID do usuário do contexto
-	userID, exists := middleware.GetUserIDFromContext(c)
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Token inválido"})
+	var req RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Refresh token é obrigatório"})
+		return
+	}
+
+	// O refresh token vencido não serve para logout, mas a sessão dele já não vale
+	claims, err := middleware.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token inválido ou expirado"})
+		return
+	}
+
+	if tenantID, _ := middleware.GetTenantIDFromContext(c); claims.TenantID != tenantID {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token inválido ou expirado"})
+		return
+	}
+
+	if err := h.refreshTokenUseCase.Revoke(claims.UserID, claims.ID, claims.FamilyID); err != nil {
+		c.JSON(refreshErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	// Log do logout
-	h.auditLogUseCase.LogUserAction(userID, entities.ActionLogout, entities.ResourceAuth, userID, "Logout realizado com sucesso", c.ClientIP(), c.GetHeader("User-Agent"))
+	h.auditLogUseCase.LogUserAction(claims.UserID, entities.ActionLogout, entities.ResourceAuth, claims.UserID, "Logout realizado com sucesso", c.ClientIP(), c.GetHeader("User-Agent"))
 
 	c.JSON(http.StatusOK, gin.H{"message": "Logout realizado com sucesso"})
 }
 
+// ListSessions lista as sessões abertas do usuário autenticado
+// @Summary Listar minhas sessões
+// @Description Retorna as sessões de login abertas, com dispositivo, IP, navegador e último acesso. A sessão da requisição atual vem marcada em current
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dtos.SessionResponse "Sessões abertas"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 500 {object} map[string]string "Erro interno do servidor"
+// @Router /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	sessions, err := h.refreshTokenUseCase.ListSessions(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	currentSessionID, _ := middleware.GetSessionIDFromContext(c)
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToSessionResponseList(sessions, currentSessionID)})
+}
+
+// RevokeSession encerra uma sessão do usuário autenticado
+// @Summary Encerrar sessão
+// @Description Encerra uma das próprias sessões, por exemplo a de um celular perdido. Os tokens dela deixam de valer na hora
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da sessão"
+// @Success 200 {object} map[string]string "Sessão encerrada"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Sessão não encontrada ou já encerrada"
+// @Router /auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	sessionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.refreshTokenUseCase.RevokeSession(userID, uint(sessionID)); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.auditLogUseCase.LogUserAction(userID, entities.ActionLogout, entities.ResourceAuth, userID, fmt.Sprintf("Sessão %d encerrada pelo usuário", sessionID), c.ClientIP(), c.GetHeader("User-Agent"))
+
+	c.JSON(http.StatusOK, gin.H{"message": "Sessão encerrada com sucesso"})
+}
+
+// RevokeAllSessions faz logout do usuário em todos os dispositivos
+// @Summary Sair de todos os dispositivos
+// @Description Encerra todas as sessões do usuário autenticado. Com keep_current=true, mantém a sessão da requisição atual
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param keep_current query bool false "Manter a sessão atual"
+// @Success 200 {object} map[string]interface{} "Sessões encerradas"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 500 {object} map[string]string "Erro interno do servidor"
+// @Router /auth/sessions [delete]
+func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	var keepSessionID uint
+	if c.Query("keep_current") == "true" {
+		keepSessionID, _ = middleware.GetSessionIDFromContext(c)
+	}
+
+	count, err := h.refreshTokenUseCase.RevokeAllSessions(userID, keepSessionID, entities.SessionRevokedAll)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.auditLogUseCase.LogUserAction(userID, entities.ActionLogout, entities.ResourceAuth, userID, fmt.Sprintf("Logout em todos os dispositivos: %d sessões encerradas", count), c.ClientIP(), c.GetHeader("User-Agent"))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Sessões encerradas com sucesso",
+		"data":    gin.H{"revoked": count},
+	})
+}
+
+// ListUserSessions lista as sessões abertas de um usuário
+// @Summary Listar sessões de um usuário
+// @Description Retorna as sessões de login abertas de um usuário (apenas admins)
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do usuário"
+// @Success 200 {array} dtos.SessionResponse "Sessões abertas"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Usuário não encontrado"
+// @Router /users/{id}/sessions [get]
+func (h *AuthHandler) ListUserSessions(c *gin.Context) {
+	user, ok := h.targetUser(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.refreshTokenUseCase.ListSessions(user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToSessionResponseList(sessions, 0)})
+}
+
+// ForceLogoutUser encerra todas as sessões de um usuário
+// @Summary Forçar logout de um usuário
+// @Description Encerra todas as sessões de um usuário, por exemplo no desligamento ou na perda de um celular. Os tokens dele deixam de valer na hora (apenas admins)
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do usuário"
+// @Success 200 {object} map[string]interface{} "Sessões encerradas"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Usuário não encontrado"
+// @Router /users/{id}/sessions [delete]
+func (h *AuthHandler) ForceLogoutUser(c *gin.Context) {
+	user, ok := h.targetUser(c)
+	if !ok {
+		return
+	}
+
+	adminID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	count, err := h.refreshTokenUseCase.RevokeAllSessions(user.ID, 0, entities.SessionRevokedByAdmin)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.auditLogUseCase.LogUserAction(adminID, entities.ActionLogout, entities.ResourceUser, user.ID, fmt.Sprintf("Logout forçado pelo administrador: %d sessões encerradas", count), c.ClientIP(), c.GetHeader("User-Agent"))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Sessões do usuário encerradas com sucesso",
+		"data":    gin.H{"revoked": count},
+	})
+}
+
+// GetUserLockout consulta o bloqueio de login de um usuário
+// @Summary Consultar bloqueio de login
+// @Description Retorna o bloqueio em vigor do login do usuário por excesso de senhas erradas, ou null se não houver (apenas admins)
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do usuário"
+// @Success 200 {object} map[string]interface{} "Bloqueio em vigor ou null"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Usuário não encontrado"
+// @Router /users/{id}/lockout [get]
+func (h *AuthHandler) GetUserLockout(c *gin.Context) {
+	user, ok := h.targetUser(c)
+	if !ok {
+		return
+	}
+
+	lockout, err := h.loginProtectionUseCase.GetActiveLockout(user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": lockout})
+}
+
+// UnlockUser desbloqueia o login de um usuário
+// @Summary Desbloquear login
+// @Description Desfaz o bloqueio do login do usuário por excesso de senhas erradas e zera as tentativas pendentes (apenas admins)
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do usuário"
+// @Success 200 {object} map[string]interface{} "Login desbloqueado"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Usuário não encontrado ou sem bloqueio em vigor"
+// @Router /users/{id}/lockout [delete]
+func (h *AuthHandler) UnlockUser(c *gin.Context) {
+	user, ok := h.targetUser(c)
+	if !ok {
+		return
+	}
+
+	adminID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	unlocked, err := h.loginProtectionUseCase.Unlock(user, &adminID, entities.LoginUnlockByAdmin)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !unlocked {
+		c.JSON(http.StatusNotFound, gin.H{"error": "O login do usuário não está bloqueado"})
+		return
+	}
+
+	h.auditLogUseCase.LogUserAction(adminID, entities.ActionUpdate, entities.ResourceUser, user.ID, "Login desbloqueado pelo administrador", c.ClientIP(), c.GetHeader("User-Agent"))
+
+	c.JSON(http.StatusOK, gin.H{"message": "Login do usuário desbloqueado com sucesso"})
+}
+
+// loginFailed registra a falha de login e responde. A falha que atinge o limite já
+// responde com o bloqueio
+func (h *AuthHandler) loginFailed(c *gin.Context, cpf string, user *entities.User) {
+	if err := h.loginProtectionUseCase.RecordFailure(cpf, user, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		h.loginRefused(c, err)
+		return
+	}
+	c.JSON(http.StatusUnauthorized, gin.H{"error": "CPF ou senha inválidos"})
+}
+
+// loginRefused responde à tentativa recusada pela proteção contra senhas em série
+func (h *AuthHandler) loginRefused(c *gin.Context, err error) {
+	var blocked *entities.LoginBlockedError
+	if !errors.As(err, &blocked) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao verificar tentativas de login"})
+		return
+	}
+
+	retryAfter := blocked.RetryAfter(time.Now())
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error":       blocked.Error(),
+		"locked":      blocked.Locked,
+		"retry_after": retryAfter,
+	})
+}
+
+// targetUser busca o usuário do parâmetro id das rotas administrativas de sessões
+func (h *AuthHandler) targetUser(c *gin.Context) (*entities.User, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return nil, false
+	}
+
+	user, err := h.userUseCase.GetUserByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
+		return nil, false
+	}
+	return user, true
+}
+
+// deviceInfo reúne os dados do dispositivo que fez a requisição
+func deviceInfo(c *gin.Context, deviceName string) entities.DeviceInfo {
+	return entities.DeviceInfo{
+		Name:      strings.TrimSpace(deviceName),
+		UserAgent: c.GetHeader("User-Agent"),
+		IPAddress: c.ClientIP(),
+	}
+}
+
+// refreshErrorStatus separa as falhas de autenticação do refresh token dos erros internos
+func refreshErrorStatus(err error) int {
+	if errors.Is(err, entities.ErrRefreshTokenInvalid) ||
+		errors.Is(err, entities.ErrRefreshTokenReused) ||
+		errors.Is(err, usecases.ErrSessionUserNotApproved) {
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
+
 // normalizeCPF remove pontos, hífen e espaços em branco do CPF
 func normalizeCPF(cpf string) string {
 	cpf = strings.ReplaceAll(cpf, ".", "")
@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListExceptions lista as exceções de disponibilidade de uma cadeira
+// @Summary Listar exceções de disponibilidade
+// @Description Lista as exceções por data da cadeira (fechamentos, horários especiais e janelas adicionais)
+// @Tags availabilities
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param from query string false "Data inicial (YYYY-MM-DD)"
+// @Param to query string false "Data final (YYYY-MM-DD)"
+// @Success 200 {array} dtos.AvailabilityExceptionResponse "Exceções"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /availabilities/chair/{chair_id}/exceptions [get]
+func (h *AvailabilityHandler) ListExceptions(c *gin.Context) {
+	chairID, ok := parseChairIDParam(c)
+	if !ok {
+		return
+	}
+
+	from, err := parseOptionalDate(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inicial inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	to, err := parseOptionalDate(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data final inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	exceptions, err := h.availabilityUseCase.GetChairExceptions(chairID, from, to)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Exceções de disponibilidade encontradas",
+		"data":    mappers.ToAvailabilityExceptionResponseList(exceptions),
+	})
+}
+
+// GetException busca uma exceção de disponibilidade
+// @Summary Buscar exceção de disponibilidade
+// @Description Retorna uma exceção de disponibilidade da cadeira
+// @Tags availabilities
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param exception_id path int true "ID da exceção"
+// @Success 200 {object} dtos.AvailabilityExceptionResponse "Exceção"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Exceção não encontrada"
+// @Router /availabilities/chair/{chair_id}/exceptions/{exception_id} [get]
+func (h *AvailabilityHandler) GetException(c *gin.Context) {
+	chairID, exceptionID, ok := parseExceptionParams(c)
+	if !ok {
+		return
+	}
+
+	exception, err := h.availabilityUseCase.GetException(chairID, exceptionID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToAvailabilityExceptionResponse(exception)})
+}
+
+// CreateException cadastra uma exceção de disponibilidade
+// @Summary Criar exceção de disponibilidade
+// @Description Sobrepõe a disponibilidade semanal da cadeira em uma data: fechado (dia inteiro), substituir (horário especial) ou adicional (janela extra) (apenas admins)
+// @Tags availabilities
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param request body dtos.AvailabilityExceptionRequest true "Dados da exceção"
+// @Success 201 {object} dtos.AvailabilityExceptionResponse "Exceção criada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /availabilities/chair/{chair_id}/exceptions [post]
+func (h *AvailabilityHandler) CreateException(c *gin.Context) {
+	chairID, ok := parseChairIDParam(c)
+	if !ok {
+		return
+	}
+
+	exception, ok := bindException(c, chairID)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.availabilityUseCase.CreateException(exception, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Exceção de disponibilidade criada com sucesso",
+		"data":    mappers.ToAvailabilityExceptionResponse(exception),
+	})
+}
+
+// UpdateException atualiza uma exceção de disponibilidade
+// @Summary Atualizar exceção de disponibilidade
+// @Description Atualiza data, tipo ou horário de uma exceção de disponibilidade (apenas admins)
+// @Tags availabilities
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param exception_id path int true "ID da exceção"
+// @Param request body dtos.AvailabilityExceptionRequest true "Dados da exceção"
+// @Success 200 {object} dtos.AvailabilityExceptionResponse "Exceção atualizada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /availabilities/chair/{chair_id}/exceptions/{exception_id} [put]
+func (h *AvailabilityHandler) UpdateException(c *gin.Context) {
+	chairID, exceptionID, ok := parseExceptionParams(c)
+	if !ok {
+		return
+	}
+
+	exception, ok := bindException(c, chairID)
+	if !ok {
+		return
+	}
+	exception.ID = exceptionID
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.availabilityUseCase.UpdateException(exception, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Exceção de disponibilidade atualizada com sucesso",
+		"data":    mappers.ToAvailabilityExceptionResponse(exception),
+	})
+}
+
+// DeleteException exclui uma exceção de disponibilidade
+// @Summary Excluir exceção de disponibilidade
+// @Description Remove a exceção; a data volta a seguir a disponibilidade semanal (apenas admins)
+// @Tags availabilities
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param exception_id path int true "ID da exceção"
+// @Success 200 {object} map[string]string "Exceção excluída"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Exceção não encontrada"
+// @Router /availabilities/chair/{chair_id}/exceptions/{exception_id} [delete]
+func (h *AvailabilityHandler) DeleteException(c *gin.Context) {
+	chairID, exceptionID, ok := parseExceptionParams(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.availabilityUseCase.DeleteException(chairID, exceptionID, userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Exceção de disponibilidade excluída com sucesso"})
+}
+
+// bindException lê o corpo da requisição como exceção da cadeira, respondendo 400 se inválido
+func bindException(c *gin.Context, chairID uint) (*entities.AvailabilityException, bool) {
+	var req dtos.AvailabilityExceptionRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return nil, false
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inválido (YYYY-MM-DD)"})
+		return nil, false
+	}
+
+	return &entities.AvailabilityException{
+		ChairID:   chairID,
+		Date:      date,
+		Type:      req.Type,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Reason:    req.Reason,
+	}, true
+}
+
+// parseChairIDParam lê o ID da cadeira da rota, respondendo 400 se inválido
+func parseChairIDParam(c *gin.Context) (uint, bool) {
+	chairID, err := strconv.ParseUint(c.Param("chair_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da cadeira inválido"})
+		return 0, false
+	}
+	return uint(chairID), true
+}
+
+// parseExceptionParams lê os IDs da cadeira e da exceção da rota
+func parseExceptionParams(c *gin.Context) (uint, uint, bool) {
+	chairID, ok := parseChairIDParam(c)
+	if !ok {
+		return 0, 0, false
+	}
+
+	exceptionID, err := strconv.ParseUint(c.Param("exception_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da exceção inválido"})
+		return 0, 0, false
+	}
+
+	return chairID, uint(exceptionID), true
+}
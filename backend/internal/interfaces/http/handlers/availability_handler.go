@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
 	"agendamento-backend/internal/application/usecases"
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/interfaces/http/middleware"
@@ -375,6 +376,7 @@ func (h *AvailabilityHandler) GetChairAvailabilities(c *gin.Context) {
 // @Security Bearer
 // @Param chair_id path int true "ID da cadeira"
 // @Param date query string true "Data para buscar horários (formato: YYYY-MM-DD)"
+// @Param service_id query int false "Serviço desejado (define a duração da sessão)"
 // @Success 200 {object} map[string]interface{} "Horários disponíveis"
 // @Failure 400 {object} map[string]string "Parâmetros inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -399,7 +401,13 @@ func (h *AvailabilityHandler) GetAvailableTimeSlots(c *gin.Context) {
 		return
 	}
 
-	timeSlots, err := h.availabilityUseCase.GetAvailableTimeSlots(uint(chairID), date)
+	serviceID, err := parseOptionalServiceID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do serviço inválido"})
+		return
+	}
+
+	timeSlots, err := h.availabilityUseCase.GetAvailableTimeSlots(uint(chairID), date, serviceID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -415,19 +423,82 @@ func (h *AvailabilityHandler) GetAvailableTimeSlots(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GetNext15DaysAvailableSlots busca horários disponíveis para os próximos 15 dias
-// @Summary Buscar horários disponíveis para próximos 15 dias
-// @Description Retorna os horários disponíveis para agendamento em uma cadeira específica para os próximos 15 dias
+// SearchAvailableSlots busca horários livres em todas as cadeiras
+// @Summary Buscar horários em qualquer cadeira
+// @Description Agrega os horários livres de todas as cadeiras ativas no período (máximo de 15 dias por padrão), com filtro opcional de localidade e serviço
+// @Tags availabilities
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param start_date query string true "Data inicial (YYYY-MM-DD)"
+// @Param end_date query string false "Data final (YYYY-MM-DD); padrão é a data inicial"
+// @Param location_id query int false "Localidade cadastrada das cadeiras"
+// @Param location query string false "Nome da localidade das cadeiras"
+// @Param service_id query int false "Serviço desejado (define a duração da sessão)"
+// @Success 200 {array} dtos.AvailabilitySearchDay "Horários livres por data"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /availabilities/search [get]
+func (h *AvailabilityHandler) SearchAvailableSlots(c *gin.Context) {
+	startDate, err := time.Parse("2006-01-02", c.Query("start_date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Data inicial é obrigatória (YYYY-MM-DD)"})
+		return
+	}
+
+	endDate := startDate
+	if endParam := c.Query("end_date"); endParam != "" {
+		endDate, err = time.Parse("2006-01-02", endParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data final inválido (YYYY-MM-DD)"})
+			return
+		}
+	}
+
+	serviceID, err := parseOptionalServiceID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do serviço inválido"})
+		return
+	}
+
+	location := entities.LocationFilter{Name: c.Query("location")}
+	if locationIDParam := c.Query("location_id"); locationIDParam != "" {
+		locationID, parseErr := strconv.ParseUint(locationIDParam, 10, 32)
+		if parseErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID da localidade inválido"})
+			return
+		}
+		location.ID = uint(locationID)
+	}
+
+	results, err := h.availabilityUseCase.SearchAvailableSlots(startDate, endDate, location, serviceID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Horários disponíveis encontrados",
+		"data":    mappers.ToAvailabilitySearchResponse(results),
+	})
+}
+
+// GetUpcomingAvailableSlots busca horários disponíveis para os próximos dias
+// @Summary Buscar horários disponíveis para os próximos dias
+// @Description Retorna os horários disponíveis de uma cadeira a partir de hoje. Sem o parâmetro days vale o horizonte configurado (15 dias por padrão), que também é o limite
 // @Tags availabilities
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param chair_id path int true "ID da cadeira"
+// @Param service_id query int false "Serviço desejado (define a duração da sessão)"
+// @Param days query int false "Quantidade de dias a partir de hoje"
 // @Success 200 {object} map[string][]string "Horários disponíveis por data"
-// @Failure 400 {object} map[string]string "ID da cadeira inválido"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
+// @Router /availabilities/chair/{chair_id}/upcoming [get]
 // @Router /availabilities/chair/{chair_id}/next-15-days [get]
-func (h *AvailabilityHandler) GetNext15DaysAvailableSlots(c *gin.Context) {
+func (h *AvailabilityHandler) GetUpcomingAvailableSlots(c *gin.Context) {
 	chairIDParam := c.Param("chair_id")
 	chairID, err := strconv.ParseUint(chairIDParam, 10, 32)
 	if err != nil {
@@ -435,7 +506,22 @@ func (h *AvailabilityHandler) GetNext15DaysAvailableSlots(c *gin.Context) {
 		return
 	}
 
-	slotsByDate, err := h.availabilityUseCase.GetNext15DaysAvailableSlots(uint(chairID))
+	serviceID, err := parseOptionalServiceID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do serviço inválido"})
+		return
+	}
+
+	days := 0
+	if daysParam := c.Query("days"); daysParam != "" {
+		days, err = strconv.Atoi(daysParam)
+		if err != nil || days < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Quantidade de dias inválida"})
+			return
+		}
+	}
+
+	slotsByDate, err := h.availabilityUseCase.GetUpcomingAvailableSlots(uint(chairID), serviceID, days)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -444,6 +530,20 @@ func (h *AvailabilityHandler) GetNext15DaysAvailableSlots(c *gin.Context) {
 	c.JSON(http.StatusOK, slotsByDate)
 }
 
+// parseOptionalServiceID lê o parâmetro service_id da query (zero quando ausente)
+func parseOptionalServiceID(c *gin.Context) (uint, error) {
+	serviceIDParam := c.Query("service_id")
+	if serviceIDParam == "" {
+		return 0, nil
+	}
+
+	serviceID, err := strconv.ParseUint(serviceIDParam, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint(serviceID), nil
+}
+
 // ActivateAvailability ativa uma disponibilidade
 func (h *AvailabilityHandler) ActivateAvailability(c *gin.Context) {
 	idParam := c.Param("id")
@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
 	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
 	"agendamento-backend/internal/application/usecases"
 	"agendamento-backend/internal/domain/entities"
 	"agendamento-backend/internal/interfaces/http/middleware"
@@ -34,6 +36,7 @@ func NewBookingHandler(bookingUseCase *usecases.BookingUseCase) *BookingHandler
 // @Success 201 {object} dtos.CreateBookingResponse "Agendamento criado com sucesso"
 // @Failure 400 {object} map[string]string "Dados inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 409 {object} map[string]string "Horário já ocupado"
 // @Router /bookings [post]
 func (h *BookingHandler) CreateBooking(c *gin.Context) {
 	var req dtos.CreateBookingRequest
@@ -53,29 +56,69 @@ func (h *BookingHandler) CreateBooking(c *gin.Context) {
 	booking := &entities.Booking{
 		UserID:    userID,
 		ChairID:   req.ChairID,
+		ServiceID: req.ServiceID,
 		StartTime: req.StartTime,
 		Notes:     req.Notes,
 		Status:    "agendado", // Status padrão
 	}
 
-	err := h.bookingUseCase.CreateBooking(booking, userID)
+	err := h.bookingUseCase.CreateBookingWithHold(booking, userID, req.HoldToken)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(bookingErrorStatus(err), policyErrorResponse(err))
 		return
 	}
 
 	// Converter para DTO de resposta
-	response := dtos.CreateBookingResponse{
-		ID:        booking.ID,
-		UserID:    booking.UserID,
-		ChairID:   booking.ChairID,
-		StartTime: booking.StartTime,
-		EndTime:   booking.EndTime,
-		Status:    booking.Status,
-		Notes:     booking.Notes,
-		CreatedAt: booking.CreatedAt,
+	response := mappers.ToCreateBookingResponse(booking)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Agendamento criado com sucesso",
+		"data":    response,
+	})
+}
+
+// CreateAnyChairBooking agenda sem escolher a cadeira
+// @Summary Agendar em qualquer cadeira
+// @Description Cria o agendamento no horário pedido em uma cadeira livre escolhida pelo sistema, dando preferência à cadeira menos ocupada no dia
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param booking body dtos.CreateAnyChairBookingRequest true "Horário, serviço e localidade desejados"
+// @Success 201 {object} dtos.CreateBookingResponse "Agendamento criado com a cadeira escolhida"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 409 {object} map[string]string "Nenhuma cadeira disponível no horário"
+// @Router /bookings/any-chair [post]
+func (h *BookingHandler) CreateAnyChairBooking(c *gin.Context) {
+	var req dtos.CreateAnyChairBookingRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
 	}
 
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	booking := &entities.Booking{
+		UserID:    userID,
+		ServiceID: req.ServiceID,
+		StartTime: req.StartTime,
+		Notes:     req.Notes,
+		Status:    "agendado",
+	}
+
+	if err := h.bookingUseCase.CreateBookingAnyChair(booking, entities.LocationFilter{ID: req.LocationID, Name: req.Location}, userID); err != nil {
+		c.JSON(bookingErrorStatus(err), policyErrorResponse(err))
+		return
+	}
+
+	response := mappers.ToCreateBookingResponse(booking)
+
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "Agendamento criado com sucesso",
 		"data":    response,
@@ -112,6 +155,57 @@ func (h *BookingHandler) GetBooking(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"data": booking})
 }
 
+// GetBookingHistory retorna o histórico de status de um agendamento
+// @Summary Histórico de status do agendamento
+// @Description Lista cada mudança de status com status anterior, novo status, autor, origem e motivo. Usuários comuns só veem os próprios agendamentos
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do agendamento"
+// @Success 200 {array} dtos.BookingStatusHistoryResponse "Histórico de status"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Agendamento não encontrado"
+// @Router /bookings/{id}/history [get]
+func (h *BookingHandler) GetBookingHistory(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	booking, err := h.bookingUseCase.GetBookingByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Agendamento não encontrado"})
+		return
+	}
+
+	// Usuários comuns só consultam o histórico dos próprios agendamentos
+	role, _ := middleware.GetUserRoleFromContext(c)
+	if role != "admin" && role != "atendente" && booking.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Sem permissão para consultar o histórico deste agendamento"})
+		return
+	}
+
+	history, err := h.bookingUseCase.GetBookingHistory(uint(id))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToBookingStatusHistoryResponseList(history)})
+}
+
 // UpdateBooking atualiza um agendamento
 // @Summary Atualizar agendamento
 // @Description Atualiza os dados de um agendamento existente
@@ -125,6 +219,7 @@ func (h *BookingHandler) GetBooking(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Dados inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
 // @Failure 404 {object} map[string]string "Agendamento não encontrado"
+// @Failure 409 {object} map[string]string "Horário já ocupado"
 // @Router /bookings/{id} [put]
 func (h *BookingHandler) UpdateBooking(c *gin.Context) {
 	idParam := c.Param("id")
@@ -151,7 +246,7 @@ func (h *BookingHandler) UpdateBooking(c *gin.Context) {
 
 	err = h.bookingUseCase.UpdateBooking(&booking, userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(bookingErrorStatus(err), policyErrorResponse(err))
 		return
 	}
 
@@ -190,6 +285,10 @@ func (h *BookingHandler) CancelBooking(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(id)) {
+		return
+	}
+
 	// Obter userID do contexto de autenticação
 	userID, exists := middleware.GetUserIDFromContext(c)
 	if !exists {
@@ -208,7 +307,7 @@ func (h *BookingHandler) CancelBooking(c *gin.Context) {
 
 	err = h.bookingUseCase.CancelBooking(uint(id), userID, request.Reason)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, policyErrorResponse(err))
 		return
 	}
 
@@ -224,6 +323,10 @@ func (h *BookingHandler) CompleteBooking(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(id)) {
+		return
+	}
+
 	// Obter userID do contexto de autenticação
 	userID, exists := middleware.GetUserIDFromContext(c)
 	if !exists {
@@ -249,6 +352,10 @@ func (h *BookingHandler) MarkAsNoShow(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(id)) {
+		return
+	}
+
 	// Obter userID do contexto de autenticação
 	userID, exists := middleware.GetUserIDFromContext(c)
 	if !exists {
@@ -276,12 +383,15 @@ func (h *BookingHandler) MarkAsNoShow(c *gin.Context) {
 // @Param offset query int false "Offset para paginação" default(0)
 // @Param user_id query int false "Filtrar por ID do usuário"
 // @Param chair_id query int false "Filtrar por ID da cadeira"
+// @Param location_id query int false "Filtrar pela localidade das cadeiras (atendentes vinculados ficam restritos à sua)"
+// @Param therapist_id query int false "Filtrar pelo massoterapeuta da sessão"
 // @Param status query string false "Filtrar por status"
 // @Param start_date query string false "Data de início (YYYY-MM-DD)"
 // @Param end_date query string false "Data de fim (YYYY-MM-DD)"
 // @Success 200 {object} map[string]interface{} "Lista de agendamentos"
 // @Failure 400 {object} map[string]string "Parâmetros inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Localidade de outro atendente"
 // @Router /bookings [get]
 func (h *BookingHandler) ListBookings(c *gin.Context) {
 	// Parâmetros de paginação
@@ -310,9 +420,21 @@ func (h *BookingHandler) ListBookings(c *gin.Context) {
 			filters["chair_id"] = uint(chairID)
 		}
 	}
+	if therapistIDParam := c.Query("therapist_id"); therapistIDParam != "" {
+		if therapistID, parseErr := strconv.ParseUint(therapistIDParam, 10, 32); parseErr == nil {
+			filters["therapist_id"] = uint(therapistID)
+		}
+	}
 	if status := c.Query("status"); status != "" {
 		filters["status"] = status
 	}
+	locationID, ok := locationScope(c)
+	if !ok {
+		return
+	}
+	if locationID != 0 {
+		filters["location_id"] = locationID
+	}
 	if startDate := c.Query("start_date"); startDate != "" {
 		if date, parseErr := time.Parse("2006-01-02", startDate); parseErr == nil {
 			filters["start_date"] = date
@@ -463,17 +585,23 @@ func (h *BookingHandler) GetUserBookings(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Security Bearer
+// @Param location_id query int false "Filtrar pela localidade das cadeiras (atendentes vinculados ficam restritos à sua)"
 // @Success 200 {object} map[string]interface{} "Lista de agendamentos de hoje"
 // @Failure 500 {object} map[string]string "Erro interno do servidor"
 // @Router /bookings/today [get]
 func (h *BookingHandler) GetTodayBookings(c *gin.Context) {
+	locationID, ok := locationScope(c)
+	if !ok {
+		return
+	}
+
 	bookings, err := h.bookingUseCase.GetTodayBookings()
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": bookings})
+	c.JSON(http.StatusOK, gin.H{"data": bookingsAtLocation(bookings, locationID)})
 }
 
 // GetUpcomingBookings busca próximos agendamentos
@@ -541,6 +669,10 @@ func (h *BookingHandler) GetChairBookings(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsChair(c, uint(chairID)) {
+		return
+	}
+
 	// Parâmetros de paginação
 	limitParam := c.DefaultQuery("limit", "10")
 	offsetParam := c.DefaultQuery("offset", "0")
@@ -593,6 +725,7 @@ func (h *BookingHandler) GetChairBookings(c *gin.Context) {
 // @Produce json
 // @Security Bearer
 // @Param date path string true "Data para buscar agendamentos (formato: YYYY-MM-DD)"
+// @Param location_id query int false "Filtrar pela localidade das cadeiras (atendentes vinculados ficam restritos à sua)"
 // @Success 200 {object} map[string]interface{} "Lista de agendamentos da data"
 // @Failure 400 {object} map[string]string "Formato de data inválido"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -606,13 +739,18 @@ func (h *BookingHandler) GetBookingsByDate(c *gin.Context) {
 		return
 	}
 
+	locationID, ok := locationScope(c)
+	if !ok {
+		return
+	}
+
 	bookings, err := h.bookingUseCase.GetBookingsByDate(date)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": bookings})
+	c.JSON(http.StatusOK, gin.H{"data": bookingsAtLocation(bookings, locationID)})
 }
 
 // GetBookingsByDateIncludingPast busca agendamentos de uma data específica incluindo passados
@@ -681,6 +819,10 @@ func (h *BookingHandler) GetChairBookingsByDate(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsChair(c, uint(chairID)) {
+		return
+	}
+
 	bookings, err := h.bookingUseCase.GetChairBookingsByDate(uint(chairID), date)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -704,6 +846,7 @@ func (h *BookingHandler) GetChairBookingsByDate(c *gin.Context) {
 // @Failure 401 {object} map[string]string "Token inválido"
 // @Failure 403 {object} map[string]string "Sem permissão"
 // @Failure 404 {object} map[string]string "Agendamento não encontrado"
+// @Failure 409 {object} map[string]string "Horário já ocupado"
 // @Router /bookings/{id}/reschedule [put]
 func (h *BookingHandler) RescheduleBooking(c *gin.Context) {
 	idParam := c.Param("id")
@@ -713,6 +856,10 @@ func (h *BookingHandler) RescheduleBooking(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(id)) {
+		return
+	}
+
 	var req struct {
 		StartTime time.Time `json:"start_time" binding:"required"`
 		ChairID   uint      `json:"chair_id"`
@@ -726,7 +873,7 @@ func (h *BookingHandler) RescheduleBooking(c *gin.Context) {
 
 	err = h.bookingUseCase.RescheduleBooking(uint(id), req.StartTime, req.ChairID, req.UpdatedBy)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(bookingErrorStatus(err), policyErrorResponse(err))
 		return
 	}
 
@@ -756,9 +903,12 @@ func (h *BookingHandler) MarkAttendance(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(id)) {
+		return
+	}
+
 	var req struct {
-		Attended bool `json:"attended" binding:"required"`
-		MarkedBy uint `json:"marked_by" binding:"required"`
+		Attended bool `json:"attended"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -766,11 +916,18 @@ func (h *BookingHandler) MarkAttendance(c *gin.Context) {
 		return
 	}
 
+	// O autor da marcação é sempre o usuário autenticado, para que o histórico seja confiável
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
 	var err2 error
 	if req.Attended {
-		err2 = h.bookingUseCase.ConfirmPresence(uint(id), req.MarkedBy)
+		err2 = h.bookingUseCase.ConfirmPresence(uint(id), userID)
 	} else {
-		err2 = h.bookingUseCase.MarkAsNoShow(uint(id), req.MarkedBy)
+		err2 = h.bookingUseCase.MarkAsNoShow(uint(id), userID)
 	}
 
 	if err2 != nil {
@@ -914,6 +1071,10 @@ func (h *BookingHandler) GetRescheduleOptions(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(bookingID)) {
+		return
+	}
+
 	dateParam := c.Query("date")
 	if dateParam == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Data é obrigatória"})
@@ -948,6 +1109,7 @@ func (h *BookingHandler) GetRescheduleOptions(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Dados inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
 // @Failure 404 {object} map[string]string "Agendamento não encontrado"
+// @Failure 409 {object} map[string]string "Horário já ocupado"
 // @Router /bookings/reschedule-datetime/{booking_id} [put]
 func (h *BookingHandler) RescheduleBookingDateTime(c *gin.Context) {
 	bookingIDParam := c.Param("booking_id")
@@ -957,6 +1119,10 @@ func (h *BookingHandler) RescheduleBookingDateTime(c *gin.Context) {
 		return
 	}
 
+	if !h.attendsBooking(c, uint(bookingID)) {
+		return
+	}
+
 	var req dtos.RescheduleBookingRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + err.Error()})
@@ -972,7 +1138,7 @@ func (h *BookingHandler) RescheduleBookingDateTime(c *gin.Context) {
 
 	err = h.bookingUseCase.RescheduleBookingDateTime(uint(bookingID), req.StartTime, userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(bookingErrorStatus(err), policyErrorResponse(err))
 		return
 	}
 
@@ -1000,3 +1166,71 @@ func (h *BookingHandler) RescheduleBookingDateTime(c *gin.Context) {
 		"data":    response,
 	})
 }
+
+// attendsBooking verifica se o usuário autenticado pode agir no agendamento.
+// Atendentes vinculados a uma localidade só agem nos agendamentos das cadeiras
+// dela; fora disso responde 403 e retorna false
+func (h *BookingHandler) attendsBooking(c *gin.Context, bookingID uint) bool {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists || !user.IsAttendant() || user.LocationID == nil {
+		return true
+	}
+
+	booking, err := h.bookingUseCase.GetBookingByID(bookingID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Agendamento não encontrado"})
+		return false
+	}
+	if booking.Chair.LocationID == nil || !user.AttendsLocation(*booking.Chair.LocationID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Atendente vinculado a outra localidade"})
+		return false
+	}
+	return true
+}
+
+// attendsChair verifica se o usuário autenticado pode consultar os agendamentos da
+// cadeira. Atendentes vinculados a uma localidade só veem as cadeiras dela; fora
+// disso responde 403 e retorna false
+func (h *BookingHandler) attendsChair(c *gin.Context, chairID uint) bool {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists || !user.IsAttendant() || user.LocationID == nil {
+		return true
+	}
+
+	chair, err := h.bookingUseCase.GetChairByID(chairID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Cadeira não encontrada"})
+		return false
+	}
+	if chair.LocationID == nil || !user.AttendsLocation(*chair.LocationID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Atendente vinculado a outra localidade"})
+		return false
+	}
+	return true
+}
+
+// bookingsAtLocation mantém só os agendamentos das cadeiras da localidade. Zero
+// mantém todos
+func bookingsAtLocation(bookings []*entities.Booking, locationID uint) []*entities.Booking {
+	if locationID == 0 {
+		return bookings
+	}
+	filtered := make([]*entities.Booking, 0, len(bookings))
+	for _, booking := range bookings {
+		if booking.Chair.LocationID != nil && *booking.Chair.LocationID == locationID {
+			filtered = append(filtered, booking)
+		}
+	}
+	return filtered
+}
+
+// bookingErrorStatus escolhe o status HTTP do erro de gravação de agendamento:
+// 409 quando o horário já foi ocupado, está reservado ou não tem massoterapeuta de
+// plantão, 400 nos demais casos
+func bookingErrorStatus(err error) int {
+	if errors.Is(err, entities.ErrBookingConflict) || errors.Is(err, entities.ErrSlotHeld) ||
+		errors.Is(err, entities.ErrNoChairAvailable) || errors.Is(err, entities.ErrNoTherapistAvailable) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingHandler_ChairBookings_AttendantLocation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	headquarters, branch := uint(1), uint(2)
+	chairs := map[uint]*entities.Chair{
+		1: {ID: 1, Name: "Cadeira da sede", Status: "ativa", LocationID: &headquarters},
+		2: {ID: 2, Name: "Cadeira da filial", Status: "ativa", LocationID: &branch},
+	}
+	startTime := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	bookingRepo := fakes.NewBookingRepository(nil,
+		&entities.Booking{ID: 10, UserID: 5, ChairID: 1, StartTime: startTime, EndTime: startTime.Add(30 * time.Minute), User: entities.User{Name: "Ana"}},
+		&entities.Booking{ID: 20, UserID: 6, ChairID: 2, StartTime: startTime, EndTime: startTime.Add(30 * time.Minute), User: entities.User{Name: "Bruno"}},
+	)
+	bookingUseCase := usecases.NewBookingUseCase(bookingRepo, &fakes.ChairRepository{Chairs: chairs},
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	handler := NewBookingHandler(bookingUseCase)
+
+	newRouter := func(user *entities.User) *gin.Engine {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set("user", user)
+			c.Set("user_id", user.ID)
+		})
+		router.GET("/bookings/chair/:chair_id", handler.GetChairBookings)
+		router.GET("/bookings/chair/:chair_id/date/:date", handler.GetChairBookingsByDate)
+		return router
+	}
+	get := func(router *gin.Engine, path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+	date := startTime.Format("2006-01-02")
+
+	t.Run("Atendente de outra localidade não vê os agendamentos da cadeira", func(t *testing.T) {
+		router := newRouter(&entities.User{ID: 2, Role: "atendente", Status: "aprovado", LocationID: &headquarters})
+
+		recorder := get(router, "/bookings/chair/2")
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), "Bruno")
+
+		recorder = get(router, "/bookings/chair/2/date/"+date)
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), "Bruno")
+	})
+
+	t.Run("Atendente da localidade vê os agendamentos da cadeira", func(t *testing.T) {
+		router := newRouter(&entities.User{ID: 2, Role: "atendente", Status: "aprovado", LocationID: &headquarters})
+
+		recorder := get(router, "/bookings/chair/1")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Ana")
+
+		recorder = get(router, "/bookings/chair/1/date/"+date)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Ana")
+	})
+
+	t.Run("Admin vê os agendamentos de qualquer localidade", func(t *testing.T) {
+		router := newRouter(&entities.User{ID: 1, Role: "admin", Status: "aprovado"})
+
+		recorder := get(router, "/bookings/chair/2")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Bruno")
+	})
+}
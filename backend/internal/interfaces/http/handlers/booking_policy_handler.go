@@ -0,0 +1,311 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookingPolicyHandler struct {
+	policyUseCase *usecases.BookingPolicyUseCase
+}
+
+func NewBookingPolicyHandler(policyUseCase *usecases.BookingPolicyUseCase) *BookingPolicyHandler {
+	return &BookingPolicyHandler{
+		policyUseCase: policyUseCase,
+	}
+}
+
+// CreatePolicy cria uma nova política de agendamento
+// @Summary Criar política de agendamento
+// @Description Cria uma política com escopo por role, setor e/ou cadeira (apenas admins)
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param policy body dtos.BookingPolicyRequest true "Dados da política"
+// @Success 201 {object} dtos.BookingPolicyResponse "Política criada com sucesso"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /policies [post]
+func (h *BookingPolicyHandler) CreatePolicy(c *gin.Context) {
+	var req dtos.BookingPolicyRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	policy := mappers.ToBookingPolicyEntity(0, &req)
+
+	if err := h.policyUseCase.CreatePolicy(policy, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Política criada com sucesso",
+		"data":    mappers.ToBookingPolicyResponse(policy),
+	})
+}
+
+// GetPolicy busca política por ID
+// @Summary Buscar política por ID
+// @Description Retorna uma política de agendamento (apenas admins)
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da política"
+// @Success 200 {object} dtos.BookingPolicyResponse "Política encontrada"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 404 {object} map[string]string "Política não encontrada"
+// @Router /policies/{id} [get]
+func (h *BookingPolicyHandler) GetPolicy(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	policy, err := h.policyUseCase.GetPolicyByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Política não encontrada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToBookingPolicyResponse(policy)})
+}
+
+// UpdatePolicy atualiza uma política de agendamento
+// @Summary Atualizar política de agendamento
+// @Description Atualiza escopo e regras de uma política (apenas admins)
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da política"
+// @Param policy body dtos.BookingPolicyRequest true "Dados da política"
+// @Success 200 {object} dtos.BookingPolicyResponse "Política atualizada com sucesso"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /policies/{id} [put]
+func (h *BookingPolicyHandler) UpdatePolicy(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dtos.BookingPolicyRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	policy := mappers.ToBookingPolicyEntity(uint(id), &req)
+
+	if err := h.policyUseCase.UpdatePolicy(policy, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Política atualizada com sucesso",
+		"data":    mappers.ToBookingPolicyResponse(policy),
+	})
+}
+
+// DeletePolicy exclui uma política de agendamento
+// @Summary Excluir política de agendamento
+// @Description Exclui uma política; as regras voltam a ser herdadas das demais (apenas admins)
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da política"
+// @Success 200 {object} map[string]string "Política excluída com sucesso"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /policies/{id} [delete]
+func (h *BookingPolicyHandler) DeletePolicy(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.policyUseCase.DeletePolicy(uint(id), userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Política excluída com sucesso"})
+}
+
+// ListPolicies lista políticas de agendamento
+// @Summary Listar políticas de agendamento
+// @Description Retorna uma lista paginada de políticas (apenas admins)
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param limit query int false "Limite de resultados por página" default(10)
+// @Param offset query int false "Número de registros a pular" default(0)
+// @Param role query string false "Filtrar por role"
+// @Param sector query string false "Filtrar por setor"
+// @Param chair_id query int false "Filtrar por cadeira"
+// @Param is_active query bool false "Filtrar por status ativo"
+// @Success 200 {object} dtos.ListBookingPoliciesResponse "Lista de políticas"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /policies [get]
+func (h *BookingPolicyHandler) ListPolicies(c *gin.Context) {
+	// Parâmetros de paginação
+	limitParam := c.DefaultQuery("limit", "10")
+	offsetParam := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(offsetParam)
+	if err != nil {
+		offset = 0
+	}
+
+	// Filtros
+	filters := make(map[string]interface{})
+	if role := c.Query("role"); role != "" {
+		filters["role"] = role
+	}
+	if sector := c.Query("sector"); sector != "" {
+		filters["sector"] = sector
+	}
+	if chairIDParam := c.Query("chair_id"); chairIDParam != "" {
+		if chairID, parseErr := strconv.ParseUint(chairIDParam, 10, 32); parseErr == nil {
+			filters["chair_id"] = uint(chairID)
+		}
+	}
+	if isActiveParam := c.Query("is_active"); isActiveParam != "" {
+		if isActive, parseErr := strconv.ParseBool(isActiveParam); parseErr == nil {
+			filters["is_active"] = isActive
+		}
+	}
+
+	policies, total, err := h.policyUseCase.ListPolicies(limit, offset, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": mappers.ToListBookingPoliciesResponse(policies, total, limit, offset),
+	})
+}
+
+// GetEffectivePolicy retorna as regras que valem para o usuário em uma cadeira
+// @Summary Regras efetivas de agendamento
+// @Description Combina as políticas aplicáveis ao usuário autenticado (ou ao user_id informado, para admins e atendentes) na cadeira
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id query int true "ID da cadeira"
+// @Param user_id query int false "ID do usuário (apenas admins e atendentes)"
+// @Success 200 {object} dtos.EffectivePolicyResponse "Regras efetivas"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /policies/effective [get]
+func (h *BookingPolicyHandler) GetEffectivePolicy(c *gin.Context) {
+	chairID, err := strconv.ParseUint(c.Query("chair_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da cadeira inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	// Admins e atendentes podem consultar as regras de outro usuário
+	if userIDParam := c.Query("user_id"); userIDParam != "" {
+		role, _ := middleware.GetUserRoleFromContext(c)
+		if role != "admin" && role != "atendente" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Sem permissão para consultar regras de outro usuário"})
+			return
+		}
+
+		targetID, parseErr := strconv.ParseUint(userIDParam, 10, 32)
+		if parseErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do usuário inválido"})
+			return
+		}
+		userID = uint(targetID)
+	}
+
+	rules, err := h.policyUseCase.ResolveRules(userID, uint(chairID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToEffectivePolicyResponse(userID, uint(chairID), rules)})
+}
+
+// policyErrorResponse monta o corpo de erro, incluindo o código quando a causa é
+// uma violação de política de agendamento ou um conflito de horário
+func policyErrorResponse(err error) gin.H {
+	var violation *entities.PolicyViolation
+	if errors.As(err, &violation) {
+		return gin.H{"error": violation.Message, "code": violation.Code}
+	}
+	if errors.Is(err, entities.ErrBookingConflict) {
+		return gin.H{"error": err.Error(), "code": "BOOKING_CONFLICT"}
+	}
+	if errors.Is(err, entities.ErrSlotHeld) {
+		return gin.H{"error": err.Error(), "code": "SLOT_HELD"}
+	}
+	if errors.Is(err, entities.ErrNoChairAvailable) {
+		return gin.H{"error": err.Error(), "code": "NO_CHAIR_AVAILABLE"}
+	}
+	return gin.H{"error": err.Error()}
+}
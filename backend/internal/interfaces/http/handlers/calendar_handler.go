@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CalendarHandler struct {
+	calendarUseCase *usecases.CalendarUseCase
+}
+
+func NewCalendarHandler(calendarUseCase *usecases.CalendarUseCase) *CalendarHandler {
+	return &CalendarHandler{
+		calendarUseCase: calendarUseCase,
+	}
+}
+
+// GetFeedURL retorna o endereço do feed de calendário do usuário autenticado
+// @Summary Endereço do feed de calendário
+// @Description Retorna a URL secreta para assinar os próximos agendamentos em aplicativos de calendário (Google, Outlook, Apple)
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} map[string]string "URL do feed"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /bookings/calendar-feed [get]
+func (h *CalendarHandler) GetFeedURL(c *gin.Context) {
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	url, err := h.calendarUseCase.GetFeedURL(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Endereço do feed de calendário",
+		"data":    gin.H{"url": url},
+	})
+}
+
+// RotateFeedURL gera um novo endereço para o feed de calendário
+// @Summary Gerar novo endereço do feed de calendário
+// @Description Substitui o token do feed. Assinaturas feitas com o endereço anterior deixam de funcionar
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} map[string]string "Nova URL do feed"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /bookings/calendar-feed/rotate [post]
+func (h *CalendarHandler) RotateFeedURL(c *gin.Context) {
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	url, err := h.calendarUseCase.RotateFeedToken(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Novo endereço do feed de calendário gerado",
+		"data":    gin.H{"url": url},
+	})
+}
+
+// GetFeed serve o feed iCalendar dos próximos agendamentos
+// @Summary Feed de calendário
+// @Description Feed iCalendar com os próximos agendamentos do dono do token. Não exige login: o token na URL identifica o usuário
+// @Tags bookings
+// @Produce text/calendar
+// @Param token path string true "Token do feed (aceita o sufixo .ics)"
+// @Success 200 {string} string "Calendário no formato iCalendar"
+// @Failure 404 {object} map[string]string "Feed não encontrado"
+// @Router /calendar/{token} [get]
+func (h *CalendarHandler) GetFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	bookings, err := h.calendarUseCase.GetFeedBookings(token)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=900")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(mappers.ToCalendarFeed(bookings).String()))
+}
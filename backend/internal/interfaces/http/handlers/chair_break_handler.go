@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListBreaks lista as pausas de uma cadeira
+// @Summary Listar pausas da cadeira
+// @Description Lista as pausas nomeadas (almoço, higienização geral) em que a cadeira não recebe sessões
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Success 200 {array} dtos.ChairBreakResponse "Pausas"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Cadeira não encontrada"
+// @Router /chairs/{id}/breaks [get]
+func (h *ChairHandler) ListBreaks(c *gin.Context) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	breaks, err := h.chairUseCase.GetChairBreaks(uint(chairID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Pausas encontradas",
+		"data":    mappers.ToChairBreakResponseList(breaks),
+	})
+}
+
+// CreateBreak cadastra uma pausa da cadeira
+// @Summary Criar pausa da cadeira
+// @Description Cadastra uma pausa nomeada; sem dia da semana ela vale para todos os dias (admins e atendentes)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Param request body dtos.ChairBreakRequest true "Dados da pausa"
+// @Success 201 {object} dtos.ChairBreakResponse "Pausa criada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /chairs/{id}/breaks [post]
+func (h *ChairHandler) CreateBreak(c *gin.Context) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	chairBreak, ok := bindChairBreak(c, uint(chairID))
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.chairUseCase.CreateBreak(chairBreak, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Pausa criada com sucesso",
+		"data":    mappers.ToChairBreakResponse(chairBreak),
+	})
+}
+
+// UpdateBreak atualiza uma pausa da cadeira
+// @Summary Atualizar pausa da cadeira
+// @Description Atualiza nome, dia da semana ou horário de uma pausa (admins e atendentes)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Param break_id path int true "ID da pausa"
+// @Param request body dtos.ChairBreakRequest true "Dados da pausa"
+// @Success 200 {object} dtos.ChairBreakResponse "Pausa atualizada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /chairs/{id}/breaks/{break_id} [put]
+func (h *ChairHandler) UpdateBreak(c *gin.Context) {
+	chairID, breakID, ok := parseBreakParams(c)
+	if !ok {
+		return
+	}
+
+	chairBreak, ok := bindChairBreak(c, chairID)
+	if !ok {
+		return
+	}
+	chairBreak.ID = breakID
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.chairUseCase.UpdateBreak(chairBreak, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Pausa atualizada com sucesso",
+		"data":    mappers.ToChairBreakResponse(chairBreak),
+	})
+}
+
+// DeleteBreak exclui uma pausa da cadeira
+// @Summary Excluir pausa da cadeira
+// @Description Remove a pausa; o horário volta a ser oferecido (admins e atendentes)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Param break_id path int true "ID da pausa"
+// @Success 200 {object} map[string]string "Pausa excluída"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Pausa não encontrada"
+// @Router /chairs/{id}/breaks/{break_id} [delete]
+func (h *ChairHandler) DeleteBreak(c *gin.Context) {
+	chairID, breakID, ok := parseBreakParams(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.chairUseCase.DeleteBreak(chairID, breakID, userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Pausa excluída com sucesso"})
+}
+
+// bindChairBreak lê o corpo da requisição como pausa da cadeira, respondendo 400 se inválido
+func bindChairBreak(c *gin.Context, chairID uint) (*entities.ChairBreak, bool) {
+	var req dtos.ChairBreakRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return nil, false
+	}
+	return mappers.ToChairBreakEntity(chairID, &req), true
+}
+
+// parseBreakParams lê os IDs da cadeira e da pausa da rota
+func parseBreakParams(c *gin.Context) (uint, uint, bool) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return 0, 0, false
+	}
+
+	breakID, err := strconv.ParseUint(c.Param("break_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da pausa inválido"})
+		return 0, 0, false
+	}
+
+	return uint(chairID), uint(breakID), true
+}
@@ -211,6 +211,7 @@ func (h *ChairHandler) DeleteChair(c *gin.Context) {
 // @Param limit query int false "Limite de resultados por página" default(10)
 // @Param offset query int false "Número de registros a pular" default(0)
 // @Param status query string false "Filtrar por status da cadeira"
+// @Param location_id query int false "Filtrar por localidade cadastrada"
 // @Success 200 {array} entities.Chair "Lista de cadeiras"
 // @Failure 400 {object} map[string]string "Parâmetros inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -238,6 +239,11 @@ func (h *ChairHandler) ListChairs(c *gin.Context) {
 	if location := c.Query("location"); location != "" {
 		filters["location"] = location
 	}
+	if locationIDParam := c.Query("location_id"); locationIDParam != "" {
+		if locationID, parseErr := strconv.ParseUint(locationIDParam, 10, 32); parseErr == nil {
+			filters["location_id"] = uint(locationID)
+		}
+	}
 	if status := c.Query("status"); status != "" {
 		filters["status"] = status
 	}
@@ -324,7 +330,7 @@ func (h *ChairHandler) GetAvailableChairs(c *gin.Context) {
 
 // ToggleChairStatus alterna o status de uma cadeira
 // @Summary Alternar status da cadeira
-// @Description Alterna o status de uma cadeira entre ativa e inativa (apenas admin)
+// @Description Alterna o status de uma cadeira entre ativa e inativa. Na desativação, os agendamentos futuros são transferidos para outra cadeira no mesmo horário ou cancelados, e todos são avisados (apenas admin)
 // @Tags chairs
 // @Accept json
 // @Produce json
@@ -366,7 +372,7 @@ func (h *ChairHandler) ToggleChairStatus(c *gin.Context) {
 		newStatus = "ativa"
 	}
 
-	err = h.chairUseCase.ChangeChairStatus(uint(id), newStatus, userID)
+	outage, err := h.chairUseCase.ChangeChairStatus(uint(id), newStatus, userID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -383,6 +389,7 @@ func (h *ChairHandler) ToggleChairStatus(c *gin.Context) {
 			"chair_id":        id,
 			"new_status":      newStatus,
 			"previous_status": chair.Status,
+			"bookings":        toChairOutageResponse(outage),
 		},
 	})
 }
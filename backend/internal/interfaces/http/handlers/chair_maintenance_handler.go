@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListMaintenances lista as manutenções de uma cadeira
+// @Summary Listar manutenções da cadeira
+// @Description Lista as manutenções programadas da cadeira, das mais recentes para as mais antigas
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Success 200 {array} dtos.ChairMaintenanceResponse "Manutenções"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Cadeira não encontrada"
+// @Router /chairs/{id}/maintenances [get]
+func (h *ChairHandler) ListMaintenances(c *gin.Context) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	maintenances, err := h.chairUseCase.GetChairMaintenances(uint(chairID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Manutenções encontradas",
+		"data":    mappers.ToChairMaintenanceResponseList(maintenances),
+	})
+}
+
+// CreateMaintenance programa uma manutenção da cadeira
+// @Summary Programar manutenção da cadeira
+// @Description Tira a cadeira de serviço na janela informada. Os agendamentos da janela são transferidos para outra cadeira no mesmo horário (preferindo a mesma localidade) ou cancelados; usuários e equipe recebem email (admins e atendentes)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Param request body dtos.ChairMaintenanceRequest true "Janela de manutenção"
+// @Success 201 {object} dtos.ChairOutageResponse "Manutenção programada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /chairs/{id}/maintenances [post]
+func (h *ChairHandler) CreateMaintenance(c *gin.Context) {
+	maintenance, ok := bindChairMaintenance(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	outage, err := h.chairUseCase.ScheduleMaintenance(maintenance, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := toChairOutageResponse(outage)
+	response.Maintenance = mappers.ToChairMaintenanceResponse(maintenance)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Manutenção programada com sucesso",
+		"data":    response,
+	})
+}
+
+// PreviewMaintenance simula uma manutenção da cadeira
+// @Summary Prévia de manutenção da cadeira
+// @Description Mostra quais agendamentos seriam transferidos (e para qual cadeira) ou cancelados se a manutenção fosse programada, sem gravar nada (admins e atendentes)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Param request body dtos.ChairMaintenanceRequest true "Janela de manutenção"
+// @Success 200 {object} dtos.ChairOutageResponse "Prévia"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /chairs/{id}/maintenances/preview [post]
+func (h *ChairHandler) PreviewMaintenance(c *gin.Context) {
+	maintenance, ok := bindChairMaintenance(c)
+	if !ok {
+		return
+	}
+
+	outage, err := h.chairUseCase.PreviewMaintenance(maintenance)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Prévia da manutenção",
+		"data":    toChairOutageResponse(outage),
+	})
+}
+
+// DeleteMaintenance exclui uma manutenção da cadeira
+// @Summary Excluir manutenção da cadeira
+// @Description Remove a manutenção e a cadeira volta a oferecer os horários. Agendamentos já transferidos ou cancelados não são desfeitos (admins e atendentes)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Param maintenance_id path int true "ID da manutenção"
+// @Success 200 {object} map[string]string "Manutenção excluída"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Manutenção não encontrada"
+// @Router /chairs/{id}/maintenances/{maintenance_id} [delete]
+func (h *ChairHandler) DeleteMaintenance(c *gin.Context) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	maintenanceID, err := strconv.ParseUint(c.Param("maintenance_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da manutenção inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.chairUseCase.DeleteMaintenance(uint(chairID), uint(maintenanceID), userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Manutenção excluída com sucesso"})
+}
+
+// PreviewDeactivation simula a desativação da cadeira
+// @Summary Prévia de desativação da cadeira
+// @Description Mostra quais agendamentos futuros seriam transferidos (e para qual cadeira) ou cancelados se a cadeira fosse desativada agora, sem gravar nada (apenas admin)
+// @Tags chairs
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da cadeira"
+// @Success 200 {object} dtos.ChairOutageResponse "Prévia"
+// @Failure 400 {object} map[string]string "Cadeira já inativa"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /chairs/{id}/deactivation-preview [get]
+func (h *ChairHandler) PreviewDeactivation(c *gin.Context) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	outage, err := h.chairUseCase.PreviewDeactivation(uint(chairID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Prévia da desativação",
+		"data":    toChairOutageResponse(outage),
+	})
+}
+
+// bindChairMaintenance lê a cadeira da rota e a janela do corpo, respondendo 400 se inválidos
+func bindChairMaintenance(c *gin.Context) (*entities.ChairMaintenance, bool) {
+	chairID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return nil, false
+	}
+
+	var req dtos.ChairMaintenanceRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return nil, false
+	}
+	return mappers.ToChairMaintenanceEntity(uint(chairID), &req), true
+}
+
+// toChairOutageResponse monta o resumo dos agendamentos atingidos
+func toChairOutageResponse(outage *usecases.ChairOutageResult) *dtos.ChairOutageResponse {
+	return &dtos.ChairOutageResponse{
+		DryRun:    outage.DryRun,
+		Relocated: outage.Relocated,
+		Cancelled: outage.Cancelled,
+		Bookings:  mappers.ToOutageImpactResponseList(outage.Impacts),
+	}
+}
@@ -0,0 +1,352 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxClosureImportSize limita o tamanho do arquivo .ics importado
+const maxClosureImportSize = 2 << 20
+
+type ClosureHandler struct {
+	closureUseCase *usecases.ClosureUseCase
+}
+
+func NewClosureHandler(closureUseCase *usecases.ClosureUseCase) *ClosureHandler {
+	return &ClosureHandler{
+		closureUseCase: closureUseCase,
+	}
+}
+
+// ListClosures lista feriados e fechamentos
+// @Summary Listar feriados e fechamentos
+// @Description Lista os fechamentos que tocam o período. Com location, inclui os fechamentos globais
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param from query string false "Data inicial (YYYY-MM-DD)"
+// @Param to query string false "Data final (YYYY-MM-DD)"
+// @Param location_id query int false "Localidade cadastrada das cadeiras"
+// @Param location query string false "Nome da localidade, quando sem location_id"
+// @Success 200 {array} dtos.ClosureResponse "Fechamentos"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /closures [get]
+func (h *ClosureHandler) ListClosures(c *gin.Context) {
+	from, err := parseOptionalDate(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inicial inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	to, err := parseOptionalDate(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data final inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	location, ok := parseClosureLocation(c, c.Query("location_id"), c.Query("location"))
+	if !ok {
+		return
+	}
+
+	closures, err := h.closureUseCase.ListClosures(from, to, location)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, entities.ErrClosureLocationNotFound) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Fechamentos encontrados",
+		"data":    mappers.ToClosureResponseList(closures),
+	})
+}
+
+// CreateClosure cadastra um feriado ou fechamento
+// @Summary Cadastrar feriado ou fechamento
+// @Description Bloqueia os horários das cadeiras no período (de todas as localidades ou de uma só) e marca os agendamentos existentes para cancelamento em lote (apenas admins)
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateClosureRequest true "Dados do fechamento"
+// @Success 201 {object} dtos.ClosureResponse "Fechamento cadastrado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /closures [post]
+func (h *ClosureHandler) CreateClosure(c *gin.Context) {
+	var req dtos.CreateClosureRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inicial inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	endDate := startDate
+	if req.EndDate != "" {
+		endDate, err = time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data final inválido (YYYY-MM-DD)"})
+			return
+		}
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	closure := &entities.Closure{
+		LocationID: req.LocationID,
+		Location:   req.Location,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Reason:     req.Reason,
+		Source:     entities.ClosureSourceManual,
+	}
+
+	flagged, err := h.closureUseCase.CreateClosure(closure, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := mappers.ToClosureResponse(closure)
+	response.FlaggedBookings = &flagged
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Fechamento cadastrado com sucesso",
+		"data":    response,
+	})
+}
+
+// ImportClosures importa feriados e fechamentos de um arquivo .ics
+// @Summary Importar feriados de arquivo .ics
+// @Description Cadastra cada evento do arquivo como fechamento. Eventos já importados para a mesma localidade são ignorados (apenas admins)
+// @Tags closures
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "Arquivo .ics"
+// @Param location_id formData int false "Localidade cadastrada (vazio para todas)"
+// @Param location formData string false "Nome da localidade, quando sem location_id"
+// @Success 201 {object} dtos.ImportClosuresResponse "Resultado da importação"
+// @Failure 400 {object} map[string]string "Arquivo inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /closures/import [post]
+func (h *ClosureHandler) ImportClosures(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo .ics é obrigatório"})
+		return
+	}
+	if fileHeader.Size > maxClosureImportSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo .ics muito grande (máximo de 2 MB)"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Não foi possível ler o arquivo"})
+		return
+	}
+	defer file.Close()
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	location, ok := parseClosureLocation(c, c.PostForm("location_id"), c.PostForm("location"))
+	if !ok {
+		return
+	}
+
+	result, err := h.closureUseCase.ImportICS(file, location, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Importação concluída",
+		"data": dtos.ImportClosuresResponse{
+			Imported:        mappers.ToClosureResponseList(result.Imported),
+			Skipped:         result.Skipped,
+			FlaggedBookings: result.FlaggedBookings,
+		},
+	})
+}
+
+// DeleteClosure exclui um fechamento
+// @Summary Excluir fechamento
+// @Description Reabre os horários do período e desmarca os agendamentos ainda não cancelados (apenas admins)
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do fechamento"
+// @Success 200 {object} map[string]string "Fechamento excluído"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Fechamento não encontrado"
+// @Router /closures/{id} [delete]
+func (h *ClosureHandler) DeleteClosure(c *gin.Context) {
+	closureID, ok := parseClosureID(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.closureUseCase.DeleteClosure(closureID, userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Fechamento excluído com sucesso"})
+}
+
+// GetAffectedBookings lista os agendamentos marcados pelo fechamento
+// @Summary Agendamentos atingidos pelo fechamento
+// @Description Lista os agendamentos ativos marcados para cancelamento pelo fechamento (admins e atendentes)
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do fechamento"
+// @Success 200 {array} dtos.BookingResponse "Agendamentos atingidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Fechamento não encontrado"
+// @Router /closures/{id}/bookings [get]
+func (h *ClosureHandler) GetAffectedBookings(c *gin.Context) {
+	closureID, ok := parseClosureID(c)
+	if !ok {
+		return
+	}
+
+	bookings, err := h.closureUseCase.GetAffectedBookings(closureID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Agendamentos atingidos pelo fechamento",
+		"data":    mappers.ToBookingResponseList(bookings),
+	})
+}
+
+// CancelAffectedBookings cancela em lote os agendamentos atingidos pelo fechamento
+// @Summary Cancelar agendamentos do fechamento
+// @Description Cancela os agendamentos marcados pelo fechamento, sem penalidade para os usuários, e envia o email de cancelamento a cada um (apenas admins)
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do fechamento"
+// @Param request body dtos.CancelClosureBookingsRequest false "Motivo informado aos usuários"
+// @Success 200 {object} map[string]int "Quantidade de agendamentos cancelados"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Fechamento não encontrado"
+// @Router /closures/{id}/cancel-bookings [post]
+func (h *ClosureHandler) CancelAffectedBookings(c *gin.Context) {
+	closureID, ok := parseClosureID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.CancelClosureBookingsRequest
+	if c.Request.ContentLength > 0 {
+		if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+			return
+		}
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	cancelled, err := h.closureUseCase.CancelAffectedBookings(closureID, userID, req.Reason)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Agendamentos cancelados com sucesso",
+		"data":    gin.H{"cancelled": cancelled},
+	})
+}
+
+// parseClosureID lê o ID do fechamento da rota, respondendo 400 se inválido
+func parseClosureID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do fechamento inválido"})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// parseClosureLocation monta o filtro de localidade do fechamento pelo ID ou pelo
+// nome, respondendo 400 se o ID for inválido
+func parseClosureLocation(c *gin.Context, locationIDParam, name string) (entities.LocationFilter, bool) {
+	location := entities.LocationFilter{Name: name}
+	if locationIDParam != "" {
+		locationID, err := strconv.ParseUint(locationIDParam, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID da localidade inválido"})
+			return location, false
+		}
+		location.ID = uint(locationID)
+	}
+	return location, true
+}
+
+// parseOptionalDate interpreta uma data YYYY-MM-DD opcional
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
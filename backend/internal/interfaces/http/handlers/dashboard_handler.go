@@ -16,20 +16,26 @@ type DashboardHandler struct {
 	bookingUseCase      *usecases.BookingUseCase
 	userUseCase         *usecases.UserUseCase
 	chairUseCase        *usecases.ChairUseCase
+	availabilityUseCase *usecases.AvailabilityUseCase
 	notificationUseCase *usecases.NotificationUseCase
+	feedbackUseCase     *usecases.FeedbackUseCase
 }
 
 func NewDashboardHandler(
 	bookingUseCase *usecases.BookingUseCase,
 	userUseCase *usecases.UserUseCase,
 	chairUseCase *usecases.ChairUseCase,
+	availabilityUseCase *usecases.AvailabilityUseCase,
 	notificationUseCase *usecases.NotificationUseCase,
+	feedbackUseCase *usecases.FeedbackUseCase,
 ) *DashboardHandler {
 	return &DashboardHandler{
 		bookingUseCase:      bookingUseCase,
 		userUseCase:         userUseCase,
 		chairUseCase:        chairUseCase,
+		availabilityUseCase: availabilityUseCase,
 		notificationUseCase: notificationUseCase,
+		feedbackUseCase:     feedbackUseCase,
 	}
 }
 
@@ -41,6 +47,7 @@ func NewDashboardHandler(
 // @Produce json
 // @Security Bearer
 // @Param date path string true "Data para buscar sessões (formato: YYYY-MM-DD)"
+// @Param location_id query int false "Localidade das cadeiras (atendentes vinculados ficam restritos à sua)"
 // @Success 200 {object} map[string]interface{} "Lista de agendamentos da data"
 // @Failure 400 {object} map[string]string "Formato de data inválido"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -54,11 +61,17 @@ func (h *DashboardHandler) GetSessionsByDate(c *gin.Context) {
 		return
 	}
 
+	locationID, ok := locationScope(c)
+	if !ok {
+		return
+	}
+
 	bookings, err := h.bookingUseCase.GetBookingsByDate(date)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar agendamentos"})
 		return
 	}
+	bookings = bookingsInLocation(bookings, locationID)
 
 	c.JSON(http.StatusOK, gin.H{"data": bookings})
 }
@@ -99,6 +112,7 @@ func (h *DashboardHandler) GetPendingApprovals(c *gin.Context) {
 // @Produce json
 // @Security Bearer
 // @Param date query string false "Data para calcular ocupação (formato: YYYY-MM-DD)" default(hoje)
+// @Param location_id query int false "Localidade das cadeiras (atendentes vinculados ficam restritos à sua)"
 // @Success 200 {object} map[string]interface{} "Dados de ocupação das cadeiras"
 // @Failure 400 {object} map[string]string "Formato de data inválido"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -112,12 +126,18 @@ func (h *DashboardHandler) GetChairOccupancy(c *gin.Context) {
 		return
 	}
 
+	locationID, ok := locationScope(c)
+	if !ok {
+		return
+	}
+
 	// Buscar agendamentos do dia
 	bookings, err := h.bookingUseCase.GetBookingsByDate(date)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar agendamentos"})
 		return
 	}
+	bookings = bookingsInLocation(bookings, locationID)
 
 	// Buscar cadeiras ativas
 	chairs, _, err := h.chairUseCase.GetActiveChairs(100, 0)
@@ -125,9 +145,17 @@ func (h *DashboardHandler) GetChairOccupancy(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar cadeiras"})
 		return
 	}
+	chairs = chairsInLocation(chairs, locationID)
+
+	// Capacidade do dia conforme as janelas e pausas de cada cadeira
+	capacity, err := h.availabilityUseCase.GetDailyCapacity(chairs, date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao calcular capacidade das cadeiras"})
+		return
+	}
 
 	// Calcular ocupação por cadeira
-	chairOccupancy := h.calculateChairOccupancy(bookings, chairs)
+	chairOccupancy := h.calculateChairOccupancy(bookings, chairs, capacity)
 
 	c.JSON(http.StatusOK, gin.H{"data": chairOccupancy})
 }
@@ -153,6 +181,38 @@ func (h *DashboardHandler) GetAttendanceStats(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"data": stats})
 }
 
+// GetSatisfactionStats busca as métricas de satisfação das sessões
+// @Summary Satisfação e NPS
+// @Description Retorna nota média, NPS (% de promotores, notas 9 e 10, menos % de detratores, notas 0 a 6) e problemas apontados, no total e por cadeira, massoterapeuta e localidade (apenas atendentes e admins)
+// @Tags dashboard
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param start_date query string false "Início do período (YYYY-MM-DD)" default(30 dias atrás)
+// @Param end_date query string false "Fim do período, inclusive (YYYY-MM-DD)"
+// @Param chair_id query int false "Cadeira"
+// @Param therapist_id query int false "Massoterapeuta"
+// @Param location_id query int false "Localidade (atendentes vinculados ficam restritos à sua)"
+// @Success 200 {object} dtos.SatisfactionReportResponse "Métricas de satisfação"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Acesso negado"
+// @Router /dashboard/satisfaction [get]
+func (h *DashboardHandler) GetSatisfactionStats(c *gin.Context) {
+	filters, ok := feedbackFilters(c, defaultSatisfactionDays)
+	if !ok {
+		return
+	}
+
+	report, err := h.feedbackUseCase.GetSatisfactionReport(filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao calcular métricas de satisfação"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": toSatisfactionReportResponse(report)})
+}
+
 // GetOperationalDashboard retorna dados do dashboard operacional unificado
 // @Summary Dashboard operacional unificado
 // @Description Retorna dados do dashboard operacional para administradores e atendentes
@@ -161,6 +221,7 @@ func (h *DashboardHandler) GetAttendanceStats(c *gin.Context) {
 // @Produce json
 // @Security Bearer
 // @Param date query string false "Data para filtrar agendamentos (formato: YYYY-MM-DD)" default(hoje)
+// @Param location_id query int false "Localidade das cadeiras (atendentes vinculados ficam restritos à sua)"
 // @Success 200 {object} map[string]interface{} "Dados do dashboard operacional"
 // @Failure 400 {object} map[string]string "Parâmetros inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -182,12 +243,18 @@ func (h *DashboardHandler) GetOperationalDashboard(c *gin.Context) {
 		return
 	}
 
+	locationID, ok := locationScope(c)
+	if !ok {
+		return
+	}
+
 	// Buscar agendamentos do dia
 	bookings, err := h.bookingUseCase.GetBookingsByDate(date)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar agendamentos"})
 		return
 	}
+	bookings = bookingsInLocation(bookings, locationID)
 
 	// Buscar usuários pendentes de aprovação baseado no role
 	var pendingUsers []*entities.User
@@ -213,9 +280,17 @@ func (h *DashboardHandler) GetOperationalDashboard(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar cadeiras"})
 		return
 	}
+	chairs = chairsInLocation(chairs, locationID)
+
+	// Capacidade do dia conforme as janelas e pausas de cada cadeira
+	capacity, err := h.availabilityUseCase.GetDailyCapacity(chairs, date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao calcular capacidade das cadeiras"})
+		return
+	}
 
 	// Calcular ocupação por cadeira
-	chairOccupancy := h.calculateChairOccupancy(bookings, chairs)
+	chairOccupancy := h.calculateChairOccupancy(bookings, chairs, capacity)
 
 	// Calcular indicadores do dia (usado para estatísticas)
 	_ = h.calculateDayIndicators(bookings)
@@ -345,7 +420,7 @@ func (h *DashboardHandler) GetOperationalDashboard(c *gin.Context) {
 }
 
 // calculateChairOccupancy calcula a ocupação de cada cadeira
-func (h *DashboardHandler) calculateChairOccupancy(bookings []*entities.Booking, chairs []*entities.Chair) []gin.H {
+func (h *DashboardHandler) calculateChairOccupancy(bookings []*entities.Booking, chairs []*entities.Chair, capacity map[uint]int) []gin.H {
 	chairBookings := make(map[uint][]string)
 
 	// Agrupar agendamentos por cadeira
@@ -363,9 +438,12 @@ func (h *DashboardHandler) calculateChairOccupancy(bookings []*entities.Booking,
 		chairID := chair.ID
 		bookingStatuses := chairBookings[chairID]
 
-		totalSlots := 8 // Assumindo 8 slots por dia
+		totalSlots := capacity[chairID]
 		occupiedSlots := len(bookingStatuses)
-		occupancyRate := float64(occupiedSlots) / float64(totalSlots) * 100
+		occupancyRate := 0.0
+		if totalSlots > 0 {
+			occupancyRate = float64(occupiedSlots) / float64(totalSlots) * 100
+		}
 
 		occupancy = append(occupancy, gin.H{
 			"chair_id":       chairID,
@@ -380,6 +458,36 @@ func (h *DashboardHandler) calculateChairOccupancy(bookings []*entities.Booking,
 	return occupancy
 }
 
+// bookingsInLocation mantém os agendamentos nas cadeiras da localidade (zero = todas)
+func bookingsInLocation(bookings []*entities.Booking, locationID uint) []*entities.Booking {
+	if locationID == 0 {
+		return bookings
+	}
+	filter := entities.LocationFilter{ID: locationID}
+	var filtered []*entities.Booking
+	for _, booking := range bookings {
+		if filter.Matches(&booking.Chair) {
+			filtered = append(filtered, booking)
+		}
+	}
+	return filtered
+}
+
+// chairsInLocation mantém as cadeiras da localidade (zero = todas)
+func chairsInLocation(chairs []*entities.Chair, locationID uint) []*entities.Chair {
+	if locationID == 0 {
+		return chairs
+	}
+	filter := entities.LocationFilter{ID: locationID}
+	var filtered []*entities.Chair
+	for _, chair := range chairs {
+		if filter.Matches(chair) {
+			filtered = append(filtered, chair)
+		}
+	}
+	return filtered
+}
+
 // calculateDayIndicators calcula indicadores do dia
 func (h *DashboardHandler) calculateDayIndicators(bookings []*entities.Booking) gin.H {
 	totalBookings := len(bookings)
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailVerificationHandler struct {
+	emailVerificationUseCase *usecases.EmailVerificationUseCase
+}
+
+func NewEmailVerificationHandler(emailVerificationUseCase *usecases.EmailVerificationUseCase) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		emailVerificationUseCase: emailVerificationUseCase,
+	}
+}
+
+// GetVerification mostra a conta do link de confirmação de email
+// @Summary Consultar link de confirmação de email
+// @Description Valida o token assinado do link e mostra o email a confirmar, sem confirmá-lo. A confirmação é feita por POST. No navegador, abre a página com o botão de confirmar
+// @Tags auth
+// @Produce json,html
+// @Param token path string true "Token do link de confirmação"
+// @Success 200 {object} map[string]interface{} "Email aguardando confirmação"
+// @Failure 400 {object} map[string]string "Link inválido ou expirado"
+// @Router /auth/verify-email/{token} [get]
+func (h *EmailVerificationHandler) GetVerification(c *gin.Context) {
+	user, err := h.emailVerificationUseCase.GetVerification(c.Param("token"))
+	if err != nil {
+		respondLink(c, emailVerificationErrorStatus(err), gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	page := &linkPage{
+		Title:   "Confirmação de email",
+		Message: "Confirme que este é o seu email para concluir o cadastro.",
+		Details: []linkPageDetail{{Label: "Email", Value: user.Email}},
+		Forms:   []linkPageForm{{Action: linkAction(c, ""), Submit: "Confirmar email"}},
+	}
+	if user.IsEmailVerified() {
+		page.Message = "Este email já foi confirmado."
+		page.Forms = nil
+	}
+
+	respondLink(c, http.StatusOK, gin.H{
+		"data": gin.H{
+			"email":          user.Email,
+			"email_verified": user.IsEmailVerified(),
+		},
+	}, page)
+}
+
+// VerifyEmail confirma o email do cadastro pelo link enviado
+// @Summary Confirmar email
+// @Description Confirma o email do cadastro usando o token assinado do link, sem login. Cadastros de usuário comum com email de um domínio corporativo liberado são aprovados na confirmação
+// @Tags auth
+// @Produce json,html
+// @Param token path string true "Token do link de confirmação"
+// @Success 200 {object} map[string]interface{} "Email confirmado"
+// @Failure 400 {object} map[string]string "Link inválido ou expirado"
+// @Failure 500 {object} map[string]string "Erro interno do servidor"
+// @Router /auth/verify-email/{token} [post]
+func (h *EmailVerificationHandler) VerifyEmail(c *gin.Context) {
+	user, err := h.emailVerificationUseCase.VerifyEmail(c.Param("token"), c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		respondLink(c, emailVerificationErrorStatus(err), gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	message := "Email confirmado com sucesso."
+	switch user.Status {
+	case "pendente":
+		message = "Email confirmado com sucesso. Seu cadastro aguarda a aprovação de um administrador ou atendente."
+	case "aprovado":
+		message = "Email confirmado com sucesso. Seu cadastro está aprovado e você já pode fazer login."
+	}
+
+	respondLink(c, http.StatusOK, gin.H{
+		"message": message,
+		"data": gin.H{
+			"email":             user.Email,
+			"email_verified_at": user.EmailVerifiedAt,
+			"status":            user.Status,
+		},
+	}, &linkPage{Title: "Email confirmado", Message: message, Details: []linkPageDetail{{Label: "Email", Value: user.Email}}})
+}
+
+// ResendVerification reenvia o link de confirmação de email
+// @Summary Reenviar confirmação de email
+// @Description Reenvia o link de confirmação ao email do cadastro. A resposta é sempre a mesma, exista ou não a conta e esteja ou não o email confirmado
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dtos.ResendVerificationRequest true "CPF ou email da conta"
+// @Success 202 {object} map[string]string "Pedido recebido"
+// @Failure 400 {object} map[string]string "CPF ou email não informado"
+// @Router /auth/resend-verification [post]
+func (h *EmailVerificationHandler) ResendVerification(c *gin.Context) {
+	var req dtos.ResendVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.Identifier) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Informe o CPF ou o email da conta"})
+		return
+	}
+
+	h.emailVerificationUseCase.ResendVerification(req.Identifier, c.ClientIP(), c.GetHeader("User-Agent"))
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Se houver um cadastro com esses dados aguardando confirmação, enviaremos um novo link ao email cadastrado",
+	})
+}
+
+// emailVerificationErrorStatus responde 400 para link inválido ou expirado e 500 nos
+// demais erros
+func emailVerificationErrorStatus(err error) int {
+	if errors.Is(err, entities.ErrEmailVerificationInvalid) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
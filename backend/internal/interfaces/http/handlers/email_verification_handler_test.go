@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationHandler_VerifyEmail(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setup := func(user *entities.User) (*gin.Engine, *fakes.UserRepository, *fakes.AuditLogRepository) {
+		userRepo := fakes.NewUserRepository(user)
+		auditRepo := &fakes.AuditLogRepository{}
+		uc := usecases.NewEmailVerificationUseCase(userRepo, auditRepo, nil, nil, fakes.TokenSigner{}, time.Hour, []string{"empresa.com.br"})
+		handler := NewEmailVerificationHandler(uc)
+
+		router := gin.New()
+		router.GET("/auth/verify-email/:token", handler.GetVerification)
+		router.POST("/auth/verify-email/:token", handler.VerifyEmail)
+		return router, userRepo, auditRepo
+	}
+	serve := func(router *gin.Engine, method, token string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(method, "/auth/verify-email/"+token, nil))
+		return recorder
+	}
+	token := func(user *entities.User, expiresAt time.Time) string {
+		return fakes.TokenSigner{}.Sign(entities.EmailVerificationTokenPayload(user.ID, user.Email, expiresAt))
+	}
+
+	t.Run("Abrir o link não confirma nem aprova o cadastro", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@empresa.com.br", Status: "pendente", RequestedRole: "usuario"}
+		router, userRepo, auditRepo := setup(user)
+
+		recorder := serve(router, http.MethodGet, token(user, time.Now().Add(time.Hour)))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "joao@empresa.com.br")
+		assert.Contains(t, recorder.Body.String(), `"email_verified":false`)
+
+		assert.Zero(t, userRepo.Verified)
+		assert.Zero(t, userRepo.Approvals)
+		assert.Empty(t, auditRepo.Logs)
+		assert.Nil(t, user.EmailVerifiedAt)
+		assert.Equal(t, "pendente", user.Status)
+	})
+
+	t.Run("No navegador, o formulário da página confirma o email", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@gmail.com", Status: "pendente", RequestedRole: "usuario"}
+		router, userRepo, _ := setup(user)
+
+		recorder := browse(router, http.MethodGet, "/auth/verify-email/"+token(user, time.Now().Add(time.Hour)))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+		assert.Zero(t, userRepo.Verified)
+
+		actions := formActions(recorder.Body.String())
+		require.Len(t, actions, 1)
+		recorder = browse(router, http.MethodPost, actions[0])
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Email confirmado")
+		assert.Equal(t, 1, userRepo.Verified)
+		assert.NotNil(t, user.EmailVerifiedAt)
+	})
+
+	t.Run("Link vencido é recusado na consulta", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@gmail.com", Status: "pendente", RequestedRole: "usuario"}
+		router, userRepo, _ := setup(user)
+
+		recorder := serve(router, http.MethodGet, token(user, time.Now().Add(-time.Minute)))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Zero(t, userRepo.Verified)
+	})
+
+	t.Run("POST confirma o email", func(t *testing.T) {
+		user := &entities.User{ID: 1, Email: "joao@gmail.com", Status: "pendente", RequestedRole: "usuario"}
+		router, userRepo, auditRepo := setup(user)
+
+		recorder := serve(router, http.MethodPost, token(user, time.Now().Add(time.Hour)))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, 1, userRepo.Verified)
+		assert.Len(t, auditRepo.Logs, 1)
+		assert.NotNil(t, user.EmailVerifiedAt)
+	})
+}
@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultSatisfactionDays é o período padrão das métricas de satisfação
+const defaultSatisfactionDays = 30
+
+type FeedbackHandler struct {
+	feedbackUseCase *usecases.FeedbackUseCase
+	bookingUseCase  *usecases.BookingUseCase
+}
+
+func NewFeedbackHandler(feedbackUseCase *usecases.FeedbackUseCase, bookingUseCase *usecases.BookingUseCase) *FeedbackHandler {
+	return &FeedbackHandler{
+		feedbackUseCase: feedbackUseCase,
+		bookingUseCase:  bookingUseCase,
+	}
+}
+
+// SubmitFeedback registra a avaliação da sessão pelo próprio usuário
+// @Summary Avaliar sessão
+// @Description Registra nota de 0 a 10, comentário e problemas com a cadeira ou com o atendimento. Uma avaliação por sessão realizada, dentro do prazo após o fim da sessão
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do agendamento"
+// @Param request body dtos.FeedbackRequest true "Avaliação"
+// @Success 201 {object} dtos.FeedbackResponse "Avaliação registrada"
+// @Failure 400 {object} map[string]string "Dados inválidos, sessão não realizada ou prazo encerrado"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 409 {object} map[string]string "Sessão já avaliada"
+// @Router /bookings/{id}/feedback [post]
+func (h *FeedbackHandler) SubmitFeedback(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	feedback, ok := bindFeedback(c)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.feedbackUseCase.SubmitFeedback(uint(id), userID, feedback); err != nil {
+		c.JSON(feedbackErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Avaliação registrada com sucesso",
+		"data":    mappers.ToFeedbackResponse(feedback),
+	})
+}
+
+// GetFeedbackInvite mostra a sessão do link do convite de avaliação
+// @Summary Consultar convite de avaliação pelo link do email
+// @Description Mostra a sessão do convite sem registrar a avaliação, para preencher o formulário. O parâmetro rating indica a nota clicada no email; a avaliação é enviada por POST. No navegador, abre a página com o formulário de avaliação
+// @Tags bookings
+// @Produce json,html
+// @Param token path string true "Token do convite"
+// @Param rating query int false "Nota clicada no email (0 a 10)"
+// @Success 200 {object} dtos.BookingResponse "Sessão aguardando avaliação"
+// @Failure 400 {object} map[string]string "Link inválido ou expirado, ou sessão fora do prazo"
+// @Failure 409 {object} map[string]string "Sessão já avaliada"
+// @Router /bookings/feedback/{token} [get]
+func (h *FeedbackHandler) GetFeedbackInvite(c *gin.Context) {
+	booking, err := h.feedbackUseCase.GetFeedbackInvite(c.Param("token"))
+	if err != nil {
+		respondLink(c, feedbackErrorStatus(err), gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	response := gin.H{"data": mappers.ToBookingResponse(booking)}
+	selected := ""
+	if rating, err := strconv.Atoi(c.Query("rating")); err == nil &&
+		rating >= entities.FeedbackMinRating && rating <= entities.FeedbackMaxRating {
+		response["rating"] = rating
+		selected = strconv.Itoa(rating)
+	}
+
+	// A nota clicada no email já vem marcada; o usuário confirma e pode comentar
+	options := []linkPageOption{{Value: "", Label: "Selecione"}}
+	for rating := entities.FeedbackMinRating; rating <= entities.FeedbackMaxRating; rating++ {
+		value := strconv.Itoa(rating)
+		options = append(options, linkPageOption{Value: value, Label: value, Selected: value == selected})
+	}
+
+	respondLink(c, http.StatusOK, response, &linkPage{
+		Title:   "Avalie sua sessão",
+		Message: "De 0 a 10, quanto você recomendaria as sessões de massagem a um colega?",
+		Details: bookingLinkDetails(booking),
+		Forms: []linkPageForm{{
+			Action: linkAction(c, ""),
+			Submit: "Enviar avaliação",
+			Fields: []linkPageField{
+				{Name: "rating", Label: "Nota", Type: "select", Options: options, Required: true},
+				{Name: "comments", Label: "Comentário (opcional)", Type: "textarea"},
+				{Name: "chair_issue", Label: "Tive problema com a cadeira", Type: "checkbox", Value: "true"},
+				{Name: "therapist_issue", Label: "Tive problema com o atendimento", Type: "checkbox", Value: "true"},
+			},
+		}},
+	})
+}
+
+// SubmitFeedbackByToken registra a avaliação pelo link do convite
+// @Summary Avaliar sessão pelo link do email
+// @Description Registra a avaliação usando o token assinado do convite, sem login: nota, comentário e problemas no corpo, em JSON ou pelo formulário da página do link
+// @Tags bookings
+// @Accept json,x-www-form-urlencoded
+// @Produce json,html
+// @Param token path string true "Token do convite"
+// @Param request body dtos.FeedbackRequest true "Avaliação"
+// @Success 201 {object} dtos.FeedbackResponse "Avaliação registrada"
+// @Failure 400 {object} map[string]string "Link inválido ou expirado, ou nota inválida"
+// @Failure 409 {object} map[string]string "Sessão já avaliada"
+// @Router /bookings/feedback/{token} [post]
+func (h *FeedbackHandler) SubmitFeedbackByToken(c *gin.Context) {
+	feedback, ok := bindFeedback(c)
+	if !ok {
+		return
+	}
+
+	if _, err := h.feedbackUseCase.SubmitFeedbackByToken(c.Param("token"), feedback); err != nil {
+		respondLink(c, feedbackErrorStatus(err), gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	message := "Obrigado pela avaliação!"
+	respondLink(c, http.StatusCreated, gin.H{
+		"message": message,
+		"data":    mappers.ToFeedbackResponse(feedback),
+	}, &linkPage{Title: message, Message: "Sua avaliação foi registrada e ajuda a melhorar as sessões."})
+}
+
+// GetBookingFeedback busca a avaliação de uma sessão
+// @Summary Avaliação da sessão
+// @Description Retorna a avaliação de um agendamento. Usuários comuns só veem a dos próprios agendamentos
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do agendamento"
+// @Success 200 {object} dtos.FeedbackResponse "Avaliação da sessão"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Sessão sem avaliação"
+// @Router /bookings/{id}/feedback [get]
+func (h *FeedbackHandler) GetBookingFeedback(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	booking, err := h.bookingUseCase.GetBookingByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Agendamento não encontrado"})
+		return
+	}
+
+	// Usuários comuns só consultam a avaliação dos próprios agendamentos
+	role, _ := middleware.GetUserRoleFromContext(c)
+	if role != "admin" && role != "atendente" && booking.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Sem permissão para consultar a avaliação deste agendamento"})
+		return
+	}
+
+	feedback, err := h.feedbackUseCase.GetBookingFeedback(booking.ID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sessão ainda não avaliada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToFeedbackResponse(feedback)})
+}
+
+// ListFeedbacks lista as avaliações com comentários
+// @Summary Listar avaliações
+// @Description Retorna as avaliações das sessões, das mais recentes para as mais antigas (atendentes e admins)
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param limit query int false "Limite de resultados por página" default(10)
+// @Param offset query int false "Número de registros a pular" default(0)
+// @Param start_date query string false "Início do período (YYYY-MM-DD)"
+// @Param end_date query string false "Fim do período, inclusive (YYYY-MM-DD)"
+// @Param chair_id query int false "Cadeira"
+// @Param therapist_id query int false "Massoterapeuta"
+// @Param location_id query int false "Localidade (atendentes vinculados ficam restritos à sua)"
+// @Param max_rating query int false "Apenas notas até este valor (ex.: 6 para detratores)"
+// @Param with_issue query bool false "Apenas avaliações que apontaram problema"
+// @Success 200 {object} dtos.ListFeedbacksResponse "Avaliações"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /bookings/feedback [get]
+func (h *FeedbackHandler) ListFeedbacks(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	filters, ok := feedbackFilters(c, 0)
+	if !ok {
+		return
+	}
+	if param := c.Query("max_rating"); param != "" {
+		maxRating, err := strconv.Atoi(param)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Nota máxima inválida"})
+			return
+		}
+		filters["max_rating"] = maxRating
+	}
+	if c.Query("with_issue") == "true" {
+		filters["with_issue"] = true
+	}
+
+	feedbacks, total, err := h.feedbackUseCase.ListFeedbacks(limit, offset, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": mappers.ToListFeedbacksResponse(feedbacks, total, limit, offset),
+	})
+}
+
+// bindFeedback lê a avaliação do corpo da requisição, em JSON ou do formulário da página do link
+func bindFeedback(c *gin.Context) (*entities.BookingFeedback, bool) {
+	var req dtos.FeedbackRequest
+	if err := c.ShouldBind(&req); err != nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + err.Error()}, nil)
+		return nil, false
+	}
+	// No formulário da página, a opção "Selecione" chega vazia, e o bind a leria como zero
+	if c.ContentType() == gin.MIMEPOSTForm && c.PostForm("rating") == "" {
+		req.Rating = nil
+	}
+	if req.Rating == nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": "Nota é obrigatória"}, nil)
+		return nil, false
+	}
+	return mappers.ToBookingFeedbackEntity(&req), true
+}
+
+// feedbackErrorStatus distingue a avaliação repetida dos demais erros de envio
+func feedbackErrorStatus(err error) int {
+	if errors.Is(err, entities.ErrFeedbackAlreadySubmitted) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
+
+// feedbackFilters lê o período e os filtros de cadeira, massoterapeuta e localidade das
+// avaliações. Sem start_date, o período começa defaultDays dias atrás (0 = sem limite)
+func feedbackFilters(c *gin.Context, defaultDays int) (map[string]interface{}, bool) {
+	filters := make(map[string]interface{})
+
+	if param := c.Query("start_date"); param != "" {
+		startDate, err := time.ParseInLocation("2006-01-02", param, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inválido. Use YYYY-MM-DD"})
+			return nil, false
+		}
+		filters["start_date"] = startDate
+	} else if defaultDays > 0 {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		filters["start_date"] = today.AddDate(0, 0, -defaultDays)
+	}
+
+	if param := c.Query("end_date"); param != "" {
+		endDate, err := time.ParseInLocation("2006-01-02", param, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inválido. Use YYYY-MM-DD"})
+			return nil, false
+		}
+		filters["end_date"] = endDate.AddDate(0, 0, 1)
+	}
+
+	for _, key := range []string{"chair_id", "therapist_id"} {
+		if param := c.Query(key); param != "" {
+			id, err := strconv.ParseUint(param, 10, 32)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Parâmetro " + key + " inválido"})
+				return nil, false
+			}
+			filters[key] = uint(id)
+		}
+	}
+
+	locationID, ok := locationScope(c)
+	if !ok {
+		return nil, false
+	}
+	if locationID != 0 {
+		filters["location_id"] = locationID
+	}
+
+	return filters, true
+}
+
+// toSatisfactionReportResponse converte o relatório de satisfação do caso de uso
+func toSatisfactionReportResponse(report *usecases.SatisfactionReport) *dtos.SatisfactionReportResponse {
+	return &dtos.SatisfactionReportResponse{
+		Overall:     *mappers.ToSatisfactionStatsResponse(report.Overall),
+		ByChair:     mappers.ToSatisfactionStatsResponseList(report.ByChair),
+		ByTherapist: mappers.ToSatisfactionStatsResponseList(report.ByTherapist),
+		ByLocation:  mappers.ToSatisfactionStatsResponseList(report.ByLocation),
+	}
+}
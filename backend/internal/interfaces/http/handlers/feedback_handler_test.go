@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/infrastructure/adapters"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedbackHandler_InviteLinkSubmitsFeedback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setup := func() (*gin.Engine, *fakes.FeedbackRepository, string) {
+		endTime := time.Now().Add(-time.Hour)
+		booking := &entities.Booking{ID: 10, UserID: 1, ChairID: 1, StartTime: endTime.Add(-entities.DefaultSessionDuration),
+			EndTime: endTime, Status: entities.BookingStatusCompleted, Chair: entities.Chair{ID: 1, Name: "Cadeira 1"}}
+		feedbackRepo := &fakes.FeedbackRepository{}
+		uc := usecases.NewFeedbackUseCase(feedbackRepo, fakes.NewBookingRepository(nil, booking), nil, fakes.TokenSigner{},
+			adapters.NewValidatorAdapter(), entities.DefaultFeedbackWindow)
+		handler := NewFeedbackHandler(uc, nil)
+
+		router := gin.New()
+		router.GET("/api/bookings/feedback/:token", handler.GetFeedbackInvite)
+		router.POST("/api/bookings/feedback/:token", handler.SubmitFeedbackByToken)
+
+		token := fakes.TokenSigner{}.Sign(entities.FeedbackTokenPayload(booking.ID, time.Now().Add(24*time.Hour)))
+		return router, feedbackRepo, token
+	}
+
+	t.Run("A nota clicada no email abre o formulário, que registra a avaliação", func(t *testing.T) {
+		router, feedbackRepo, token := setup()
+
+		recorder := browse(router, http.MethodGet, "/api/bookings/feedback/"+token+"?rating=9")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+		assert.Contains(t, recorder.Body.String(), `<option value="9" selected>`)
+		assert.Empty(t, feedbackRepo.Feedbacks)
+
+		actions := formActions(recorder.Body.String())
+		require.Equal(t, []string{"/api/bookings/feedback/" + token}, actions)
+		recorder = submitForm(router, http.MethodPost, actions[0], url.Values{
+			"rating":      {"9"},
+			"comments":    {"Cadeira um pouco baixa"},
+			"chair_issue": {"true"},
+		})
+		require.Equal(t, http.StatusCreated, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Obrigado pela avaliação!")
+
+		require.Len(t, feedbackRepo.Feedbacks, 1)
+		feedback := feedbackRepo.Feedbacks[0]
+		assert.Equal(t, 9, feedback.Rating)
+		assert.Equal(t, "Cadeira um pouco baixa", feedback.Comments)
+		assert.True(t, feedback.ChairIssue)
+		assert.False(t, feedback.TherapistIssue)
+		assert.Equal(t, entities.FeedbackSourceEmail, feedback.Source)
+
+		// Sessão já avaliada: o link mostra o aviso, sem formulário
+		recorder = browse(router, http.MethodGet, "/api/bookings/feedback/"+token)
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+		assert.Empty(t, formActions(recorder.Body.String()))
+	})
+
+	t.Run("Formulário sem nota não registra avaliação", func(t *testing.T) {
+		router, feedbackRepo, token := setup()
+
+		recorder := submitForm(router, http.MethodPost, "/api/bookings/feedback/"+token, url.Values{"rating": {""}, "comments": {"Sem nota"}})
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Empty(t, feedbackRepo.Feedbacks)
+	})
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// linkPage é a página aberta pelos links enviados por email. O GET do link mostra o
+// que ele faz e um formulário; a ação só acontece quando o usuário envia o formulário
+// (POST), para que leitores de link e pré-carregamentos do cliente de email não ajam
+// no lugar dele. Clientes da API continuam recebendo JSON
+type linkPage struct {
+	Title   string
+	Message string
+	Error   string
+	Details []linkPageDetail
+	Forms   []linkPageForm
+}
+
+// linkPageDetail é uma linha de detalhe da página (ex.: data e cadeira da sessão)
+type linkPageDetail struct {
+	Label string
+	Value string
+}
+
+// linkPageForm é um formulário enviado por POST ao endereço de Action
+type linkPageForm struct {
+	Action    string
+	Submit    string
+	Secondary bool
+	Fields    []linkPageField
+}
+
+// linkPageField é um campo do formulário. Type aceita hidden, password, textarea,
+// select e checkbox; os demais viram input do tipo informado
+type linkPageField struct {
+	Name      string
+	Label     string
+	Type      string
+	Value     string
+	Options   []linkPageOption
+	Required  bool
+	MinLength int
+}
+
+// linkPageOption é uma opção de um campo select
+type linkPageOption struct {
+	Value    string
+	Label    string
+	Selected bool
+}
+
+var linkPageTemplate = template.Must(template.New("link").Parse(`<!DOCTYPE html>
+<html lang="pt-BR">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <meta name="robots" content="noindex">
+    <title>{{.Title}}</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #2c5aa0;">{{.Title}}</h2>
+        {{if .Message}}<p>{{.Message}}</p>{{end}}
+        {{if .Error}}<p style="color: #b00020;"><strong>{{.Error}}</strong></p>{{end}}
+        {{if .Details}}
+        <div style="background-color: #f8f9fa; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            {{range .Details}}<p style="margin: 4px 0;"><strong>{{.Label}}:</strong> {{.Value}}</p>
+            {{end}}
+        </div>
+        {{end}}
+        {{range .Forms}}
+        <form method="post" action="{{.Action}}" style="margin: 20px 0;">
+            {{range .Fields}}
+            {{if eq .Type "hidden"}}<input type="hidden" name="{{.Name}}" value="{{.Value}}">
+            {{else if eq .Type "checkbox"}}<p><label><input type="checkbox" name="{{.Name}}" value="{{.Value}}"> {{.Label}}</label></p>
+            {{else}}<p>
+                <label for="{{.Name}}"><strong>{{.Label}}</strong></label><br>
+                {{if eq .Type "textarea"}}<textarea id="{{.Name}}" name="{{.Name}}" rows="4" style="width: 100%;"{{if .Required}} required{{end}}>{{.Value}}</textarea>
+                {{else if eq .Type "select"}}<select id="{{.Name}}" name="{{.Name}}"{{if .Required}} required{{end}}>
+                    {{range .Options}}<option value="{{.Value}}"{{if .Selected}} selected{{end}}>{{.Label}}</option>
+                    {{end}}
+                </select>
+                {{else}}<input id="{{.Name}}" type="{{.Type}}" name="{{.Name}}" value="{{.Value}}" style="width: 100%; padding: 8px;"{{if .Required}} required{{end}}{{if .MinLength}} minlength="{{.MinLength}}"{{end}}>
+                {{end}}
+            </p>
+            {{end}}
+            {{end}}
+            <button type="submit" style="padding: 10px 20px; border: 0; border-radius: 5px; cursor: pointer;{{if .Secondary}} background-color: #6c757d;{{else}} background-color: #2c5aa0;{{end}} color: #fff;">{{.Submit}}</button>
+        </form>
+        {{end}}
+    </div>
+</body>
+</html>`))
+
+// wantsHTML indica que a requisição veio do navegador (clique no link do email ou
+// envio do formulário da página), e não de um cliente da API
+func wantsHTML(c *gin.Context) bool {
+	return c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) == gin.MIMEHTML
+}
+
+// renderLinkPage responde com a página do link
+func renderLinkPage(c *gin.Context, status int, page linkPage) {
+	var buf bytes.Buffer
+	if err := linkPageTemplate.Execute(&buf, page); err != nil {
+		c.String(http.StatusInternalServerError, "Erro ao montar a página")
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Data(status, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// respondLink responde em JSON aos clientes da API e com a página ao navegador. Sem
+// página, o navegador vê a mensagem de erro do corpo
+func respondLink(c *gin.Context, status int, body gin.H, page *linkPage) {
+	if !wantsHTML(c) {
+		c.JSON(status, body)
+		return
+	}
+	if page == nil {
+		message, _ := body["error"].(string)
+		page = &linkPage{Title: "Link indisponível", Error: message}
+	}
+	renderLinkPage(c, status, *page)
+}
+
+// linkAction monta o endereço do formulário a partir do caminho do link aberto
+func linkAction(c *gin.Context, suffix string) string {
+	return c.Request.URL.Path + suffix
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// browserAccept é o cabeçalho Accept enviado pelo navegador ao abrir um link
+const browserAccept = "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"
+
+var formActionPattern = regexp.MustCompile(`<form method="post" action="([^"]+)"`)
+
+// formActions devolve os endereços dos formulários da página, na ordem em que aparecem
+func formActions(page string) []string {
+	var actions []string
+	for _, match := range formActionPattern.FindAllStringSubmatch(page, -1) {
+		actions = append(actions, html.UnescapeString(match[1]))
+	}
+	return actions
+}
+
+// browse faz a requisição como o navegador: GET do link ou envio do formulário sem campos
+func browse(router *gin.Engine, method, target string) *httptest.ResponseRecorder {
+	return submitForm(router, method, target, nil)
+}
+
+// submitForm envia o formulário da página com os campos informados, como o navegador
+func submitForm(router *gin.Engine, method, target string, fields url.Values) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, strings.NewReader(fields.Encode()))
+	request.Header.Set("Accept", browserAccept)
+	if method == http.MethodPost {
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
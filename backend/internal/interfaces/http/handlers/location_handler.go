@@ -0,0 +1,348 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LocationHandler struct {
+	locationUseCase *usecases.LocationUseCase
+}
+
+func NewLocationHandler(locationUseCase *usecases.LocationUseCase) *LocationHandler {
+	return &LocationHandler{
+		locationUseCase: locationUseCase,
+	}
+}
+
+// ListLocations lista as localidades
+// @Summary Listar localidades
+// @Description Lista as localidades ativas, opcionalmente de um escritório. Admins podem incluir as inativas
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param site query string false "Escritório ou unidade"
+// @Param include_inactive query bool false "Incluir localidades inativas (apenas admins)"
+// @Success 200 {array} dtos.LocationResponse "Localidades"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /locations [get]
+func (h *LocationHandler) ListLocations(c *gin.Context) {
+	role, _ := middleware.GetUserRoleFromContext(c)
+	includeInactive := role == "admin" && c.Query("include_inactive") == "true"
+
+	locations, err := h.locationUseCase.ListLocations(c.Query("site"), includeInactive)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar localidades"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Localidades encontradas",
+		"data":    mappers.ToLocationResponseList(locations),
+	})
+}
+
+// GetLocation busca localidade por ID
+// @Summary Buscar localidade
+// @Description Retorna os dados de uma localidade
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da localidade"
+// @Success 200 {object} dtos.LocationResponse "Localidade"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Localidade não encontrada"
+// @Router /locations/{id} [get]
+func (h *LocationHandler) GetLocation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	location, err := h.locationUseCase.GetLocation(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Localidade não encontrada"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToLocationResponse(location)})
+}
+
+// CreateLocation cadastra uma localidade
+// @Summary Criar localidade
+// @Description Cadastra escritório, prédio, andar e sala de uma localidade, com fuso e horário de funcionamento (apenas admins)
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.LocationRequest true "Dados da localidade"
+// @Success 201 {object} dtos.LocationResponse "Localidade criada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /locations [post]
+func (h *LocationHandler) CreateLocation(c *gin.Context) {
+	var req dtos.LocationRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	location := mappers.ToLocationEntity(&req)
+	if err := h.locationUseCase.CreateLocation(location, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Localidade criada com sucesso",
+		"data":    mappers.ToLocationResponse(location),
+	})
+}
+
+// UpdateLocation atualiza uma localidade
+// @Summary Atualizar localidade
+// @Description Atualiza a localidade; o novo nome é aplicado às cadeiras e fechamentos dela (apenas admins)
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da localidade"
+// @Param request body dtos.LocationRequest true "Dados da localidade"
+// @Success 200 {object} dtos.LocationResponse "Localidade atualizada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /locations/{id} [put]
+func (h *LocationHandler) UpdateLocation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dtos.LocationRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	location := mappers.ToLocationEntity(&req)
+	location.ID = uint(id)
+	if err := h.locationUseCase.UpdateLocation(location, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Localidade atualizada com sucesso",
+		"data":    mappers.ToLocationResponse(location),
+	})
+}
+
+// DeleteLocation exclui uma localidade
+// @Summary Excluir localidade
+// @Description Exclui uma localidade sem cadeiras vinculadas (apenas admins)
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da localidade"
+// @Success 200 {object} map[string]string "Localidade excluída"
+// @Failure 400 {object} map[string]string "Localidade com cadeiras"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /locations/{id} [delete]
+func (h *LocationHandler) DeleteLocation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.locationUseCase.DeleteLocation(uint(id), userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Localidade excluída com sucesso"})
+}
+
+// ListAttendants lista os atendentes vinculados à localidade
+// @Summary Atendentes da localidade
+// @Description Lista os atendentes restritos a esta localidade (apenas admins)
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da localidade"
+// @Success 200 {array} dtos.LocationAttendantResponse "Atendentes"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Failure 404 {object} map[string]string "Localidade não encontrada"
+// @Router /locations/{id}/attendants [get]
+func (h *LocationHandler) ListAttendants(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	attendants, err := h.locationUseCase.GetAttendants(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Atendentes encontrados",
+		"data":    mappers.ToLocationAttendantResponseList(attendants),
+	})
+}
+
+// AssignAttendant vincula um atendente à localidade
+// @Summary Vincular atendente à localidade
+// @Description Restringe o dashboard e a listagem de agendamentos do atendente às cadeiras da localidade (apenas admins)
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da localidade"
+// @Param user_id path int true "ID do atendente"
+// @Success 200 {object} map[string]string "Atendente vinculado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /locations/{id}/attendants/{user_id} [put]
+func (h *LocationHandler) AssignAttendant(c *gin.Context) {
+	locationID, attendantID, ok := parseAttendantParams(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.locationUseCase.AssignAttendant(locationID, attendantID, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Atendente vinculado à localidade"})
+}
+
+// RemoveAttendant desvincula um atendente da localidade
+// @Summary Desvincular atendente da localidade
+// @Description O atendente volta a atender todas as localidades (apenas admins)
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da localidade"
+// @Param user_id path int true "ID do atendente"
+// @Success 200 {object} map[string]string "Atendente desvinculado"
+// @Failure 400 {object} map[string]string "Atendente não vinculado"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /locations/{id}/attendants/{user_id} [delete]
+func (h *LocationHandler) RemoveAttendant(c *gin.Context) {
+	locationID, attendantID, ok := parseAttendantParams(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.locationUseCase.RemoveAttendant(locationID, attendantID, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Atendente desvinculado da localidade"})
+}
+
+// parseAttendantParams lê os IDs da localidade e do atendente da rota
+func parseAttendantParams(c *gin.Context) (uint, uint, bool) {
+	locationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return 0, 0, false
+	}
+
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do atendente inválido"})
+		return 0, 0, false
+	}
+
+	return uint(locationID), uint(userID), true
+}
+
+// locationScope lê o filtro location_id da query. Atendentes vinculados a uma
+// localidade ficam restritos a ela: sem filtro recebem a própria localidade e o
+// pedido de outra é recusado. Zero significa todas as localidades
+func locationScope(c *gin.Context) (uint, bool) {
+	var locationID uint
+	if param := c.Query("location_id"); param != "" {
+		id, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID da localidade inválido"})
+			return 0, false
+		}
+		locationID = uint(id)
+	}
+
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists || !user.IsAttendant() || user.LocationID == nil {
+		return locationID, true
+	}
+	if locationID == 0 {
+		return *user.LocationID, true
+	}
+	if !user.AttendsLocation(locationID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Atendente vinculado a outra localidade"})
+		return 0, false
+	}
+	return locationID, true
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordResetHandler struct {
+	passwordResetUseCase *usecases.PasswordResetUseCase
+}
+
+func NewPasswordResetHandler(passwordResetUseCase *usecases.PasswordResetUseCase) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		passwordResetUseCase: passwordResetUseCase,
+	}
+}
+
+// ShowForgotPassword mostra a página de pedido de redefinição de senha
+// @Summary Página de esqueci minha senha
+// @Description Página aberta pelo link do aviso de login bloqueado, com o formulário que envia o CPF ou email para POST /auth/forgot-password
+// @Tags auth
+// @Produce html
+// @Success 200 {string} string "Página com o formulário"
+// @Router /auth/forgot-password [get]
+func (h *PasswordResetHandler) ShowForgotPassword(c *gin.Context) {
+	renderLinkPage(c, http.StatusOK, forgotPasswordPage(c, ""))
+}
+
+// ForgotPassword pede o link de redefinição de senha
+// @Summary Esqueci minha senha
+// @Description Envia para o email da conta um link de redefinição de senha, válido uma única vez. A resposta é sempre a mesma, exista ou não a conta. Aceita JSON ou o formulário da página
+// @Tags auth
+// @Accept json,x-www-form-urlencoded
+// @Produce json,html
+// @Param request body dtos.ForgotPasswordRequest true "CPF ou email da conta"
+// @Success 202 {object} map[string]string "Pedido recebido"
+// @Failure 400 {object} map[string]string "CPF ou email não informado"
+// @Router /auth/forgot-password [post]
+func (h *PasswordResetHandler) ForgotPassword(c *gin.Context) {
+	var req dtos.ForgotPasswordRequest
+	if err := c.ShouldBind(&req); err != nil || strings.TrimSpace(req.Identifier) == "" {
+		message := "Informe o CPF ou o email da conta"
+		page := forgotPasswordPage(c, message)
+		respondLink(c, http.StatusBadRequest, gin.H{"error": message}, &page)
+		return
+	}
+
+	h.passwordResetUseCase.RequestReset(req.Identifier, c.ClientIP(), c.GetHeader("User-Agent"))
+
+	message := "Se houver uma conta com esses dados, enviaremos um link para redefinir a senha ao email cadastrado"
+	respondLink(c, http.StatusAccepted, gin.H{"message": message}, &linkPage{Title: "Pedido recebido", Message: message})
+}
+
+// ShowResetPassword mostra a página do link de redefinição de senha
+// @Summary Página de redefinição de senha
+// @Description Página aberta pelo link enviado por email, com o formulário da nova senha que envia para POST /auth/reset-password. Não gasta o link
+// @Tags auth
+// @Produce json,html
+// @Param token query string true "Token do link de redefinição"
+// @Success 200 {object} map[string]string "Link válido"
+// @Failure 400 {object} map[string]string "Link inválido ou expirado"
+// @Router /auth/reset-password [get]
+func (h *PasswordResetHandler) ShowResetPassword(c *gin.Context) {
+	token := c.Query("token")
+	if err := h.passwordResetUseCase.CheckToken(token); err != nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	page := resetPasswordPage(c, token, "")
+	respondLink(c, http.StatusOK, gin.H{"message": "Link válido. Envie a nova senha"}, &page)
+}
+
+// ResetPassword redefine a senha com o token do link
+// @Summary Redefinir senha
+// @Description Troca a senha usando o token do link enviado por email. O token vale uma única vez, e todas as sessões abertas da conta são encerradas. Aceita JSON ou o formulário da página do link
+// @Tags auth
+// @Accept json,x-www-form-urlencoded
+// @Produce json,html
+// @Param request body dtos.ResetPasswordRequest true "Token e nova senha"
+// @Success 200 {object} map[string]string "Senha redefinida"
+// @Failure 400 {object} map[string]string "Dados inválidos, link inválido ou expirado"
+// @Failure 500 {object} map[string]string "Erro interno do servidor"
+// @Router /auth/reset-password [post]
+func (h *PasswordResetHandler) ResetPassword(c *gin.Context) {
+	var req dtos.ResetPasswordRequest
+	if err := c.ShouldBind(&req); err != nil || req.Token == "" {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": "Token e nova senha são obrigatórios"}, nil)
+		return
+	}
+
+	if err := h.passwordResetUseCase.ResetPassword(req.Token, req.Password, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, entities.ErrPasswordResetInvalid) || errors.Is(err, entities.ErrPasswordTooShort) {
+			status = http.StatusBadRequest
+		}
+
+		// Senha curta não gasta o link: a página volta com o formulário
+		var page *linkPage
+		if errors.Is(err, entities.ErrPasswordTooShort) {
+			retry := resetPasswordPage(c, req.Token, err.Error())
+			page = &retry
+		}
+		respondLink(c, status, gin.H{"error": err.Error()}, page)
+		return
+	}
+
+	message := "Senha redefinida com sucesso. Faça login com a nova senha"
+	respondLink(c, http.StatusOK, gin.H{"message": message}, &linkPage{Title: "Senha redefinida", Message: message})
+}
+
+// forgotPasswordPage monta a página com o formulário do pedido de redefinição
+func forgotPasswordPage(c *gin.Context, errMessage string) linkPage {
+	return linkPage{
+		Title:   "Esqueci minha senha",
+		Message: "Informe o CPF ou o email da conta para receber o link de redefinição de senha.",
+		Error:   errMessage,
+		Forms: []linkPageForm{{
+			Action: linkAction(c, ""),
+			Submit: "Enviar link",
+			Fields: []linkPageField{{Name: "identifier", Label: "CPF ou email", Type: "text", Required: true}},
+		}},
+	}
+}
+
+// resetPasswordPage monta a página com o formulário da nova senha do link
+func resetPasswordPage(c *gin.Context, token, errMessage string) linkPage {
+	return linkPage{
+		Title:   "Redefinir senha",
+		Message: "Escolha a nova senha. Ao salvar, as sessões abertas da conta são encerradas.",
+		Error:   errMessage,
+		Forms: []linkPageForm{{
+			Action: linkAction(c, ""),
+			Submit: "Redefinir senha",
+			Fields: []linkPageField{
+				{Name: "token", Type: "hidden", Value: token},
+				{Name: "password", Label: "Nova senha", Type: "password", Required: true, MinLength: entities.MinPasswordLength},
+			},
+		}},
+	}
+}
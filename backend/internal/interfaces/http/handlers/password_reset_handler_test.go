@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetHandler_ResetPasswordPage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setup := func(expiresAt time.Time) (*gin.Engine, *fakes.PasswordResetRepository) {
+		resetRepo := &fakes.PasswordResetRepository{}
+		require.NoError(t, resetRepo.Create(entities.NewPasswordResetToken(1, "token", "", expiresAt)))
+		uc := usecases.NewPasswordResetUseCase(resetRepo, fakes.NewUserRepository(), &fakes.AuditLogRepository{}, nil, nil, nil, nil, time.Hour)
+		handler := NewPasswordResetHandler(uc)
+
+		router := gin.New()
+		router.GET("/api/auth/reset-password", handler.ShowResetPassword)
+		router.POST("/api/auth/reset-password", handler.ResetPassword)
+		return router, resetRepo
+	}
+
+	t.Run("O link abre o formulário que envia a nova senha para a API", func(t *testing.T) {
+		router, resetRepo := setup(time.Now().Add(time.Hour))
+
+		recorder := browse(router, http.MethodGet, "/api/auth/reset-password?token=token")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+		assert.Equal(t, []string{"/api/auth/reset-password"}, formActions(recorder.Body.String()))
+		assert.Contains(t, recorder.Body.String(), `name="token" value="token"`)
+		assert.Contains(t, recorder.Body.String(), `minlength="6"`)
+		assert.Nil(t, resetRepo.Tokens[0].UsedAt)
+	})
+
+	t.Run("Link vencido mostra o erro sem formulário", func(t *testing.T) {
+		router, _ := setup(time.Now().Add(-time.Minute))
+
+		recorder := browse(router, http.MethodGet, "/api/auth/reset-password?token=token")
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), entities.ErrPasswordResetInvalid.Error())
+		assert.Empty(t, formActions(recorder.Body.String()))
+	})
+
+	t.Run("Senha curta volta ao formulário sem gastar o link", func(t *testing.T) {
+		router, resetRepo := setup(time.Now().Add(time.Hour))
+
+		recorder := submitForm(router, http.MethodPost, "/api/auth/reset-password", url.Values{"token": {"token"}, "password": {"123"}})
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), entities.ErrPasswordTooShort.Error())
+		assert.Len(t, formActions(recorder.Body.String()), 1)
+		assert.Nil(t, resetRepo.Tokens[0].UsedAt)
+	})
+
+	t.Run("Clientes da API continuam enviando JSON", func(t *testing.T) {
+		router, _ := setup(time.Now().Add(time.Hour))
+
+		request := httptest.NewRequest(http.MethodPost, "/api/auth/reset-password", strings.NewReader(`{"token":"outro","password":"nova-senha"}`))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "application/json")
+		assert.Contains(t, recorder.Body.String(), entities.ErrPasswordResetInvalid.Error())
+	})
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PenaltyHandler struct {
+	penaltyUseCase *usecases.PenaltyUseCase
+}
+
+func NewPenaltyHandler(penaltyUseCase *usecases.PenaltyUseCase) *PenaltyHandler {
+	return &PenaltyHandler{
+		penaltyUseCase: penaltyUseCase,
+	}
+}
+
+// GetMyPenaltyStatus retorna as penalidades do usuário autenticado
+// @Summary Minhas penalidades
+// @Description Retorna faltas e cancelamentos tardios na janela atual e a suspensão em vigor, se houver
+// @Tags penalties
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dtos.PenaltyStatusResponse "Situação de penalidades"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /penalties/me [get]
+func (h *PenaltyHandler) GetMyPenaltyStatus(c *gin.Context) {
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	status, err := h.penaltyUseCase.GetUserStatus(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToPenaltyStatusResponse(status)})
+}
+
+// GetUserPenaltyStatus retorna as penalidades de um usuário
+// @Summary Penalidades do usuário
+// @Description Retorna faltas, cancelamentos tardios e suspensão em vigor de um usuário (atendentes e admins)
+// @Tags penalties
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param user_id path int true "ID do usuário"
+// @Success 200 {object} dtos.PenaltyStatusResponse "Situação de penalidades"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 404 {object} map[string]string "Usuário não encontrado"
+// @Router /penalties/users/{user_id} [get]
+func (h *PenaltyHandler) GetUserPenaltyStatus(c *gin.Context) {
+	userIDParam := c.Param("user_id")
+	userID, err := strconv.ParseUint(userIDParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do usuário inválido"})
+		return
+	}
+
+	status, err := h.penaltyUseCase.GetUserStatus(uint(userID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToPenaltyStatusResponse(status)})
+}
+
+// GetUserSuspensions retorna o histórico de suspensões de um usuário
+// @Summary Histórico de suspensões
+// @Description Lista todas as suspensões de um usuário, incluindo as encerradas (atendentes e admins)
+// @Tags penalties
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param user_id path int true "ID do usuário"
+// @Success 200 {array} dtos.SuspensionResponse "Suspensões do usuário"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Router /penalties/users/{user_id}/suspensions [get]
+func (h *PenaltyHandler) GetUserSuspensions(c *gin.Context) {
+	userIDParam := c.Param("user_id")
+	userID, err := strconv.ParseUint(userIDParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do usuário inválido"})
+		return
+	}
+
+	suspensions, err := h.penaltyUseCase.GetUserSuspensions(uint(userID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToSuspensionResponseList(suspensions)})
+}
+
+// ListActiveSuspensions lista as suspensões em vigor
+// @Summary Listar suspensões ativas
+// @Description Retorna uma lista paginada de usuários com agendamentos suspensos (atendentes e admins)
+// @Tags penalties
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param limit query int false "Limite de resultados por página" default(10)
+// @Param offset query int false "Número de registros a pular" default(0)
+// @Success 200 {object} dtos.ListSuspensionsResponse "Suspensões ativas"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /penalties/suspensions [get]
+func (h *PenaltyHandler) ListActiveSuspensions(c *gin.Context) {
+	// Parâmetros de paginação
+	limitParam := c.DefaultQuery("limit", "10")
+	offsetParam := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(offsetParam)
+	if err != nil {
+		offset = 0
+	}
+
+	suspensions, total, err := h.penaltyUseCase.ListActiveSuspensions(limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": mappers.ToListSuspensionsResponse(suspensions, total, limit, offset),
+	})
+}
+
+// LiftSuspension encerra a suspensão de um usuário
+// @Summary Encerrar suspensão
+// @Description Libera os agendamentos de um usuário suspenso antes do prazo, opcionalmente perdoando as penalidades da janela (apenas admins)
+// @Tags penalties
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param user_id path int true "ID do usuário"
+// @Param request body dtos.LiftSuspensionRequest true "Motivo da liberação"
+// @Success 200 {object} dtos.SuspensionResponse "Suspensão encerrada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /penalties/users/{user_id}/lift [post]
+func (h *PenaltyHandler) LiftSuspension(c *gin.Context) {
+	userIDParam := c.Param("user_id")
+	userID, err := strconv.ParseUint(userIDParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do usuário inválido"})
+		return
+	}
+
+	var req dtos.LiftSuspensionRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	adminID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	suspension, err := h.penaltyUseCase.LiftSuspension(uint(userID), adminID, req.Reason, req.ClearPenalties)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Suspensão encerrada com sucesso",
+		"data":    mappers.ToSuspensionResponse(suspension),
+	})
+}
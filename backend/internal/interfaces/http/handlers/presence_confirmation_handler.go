@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PresenceConfirmationHandler struct {
+	presenceUseCase *usecases.PresenceConfirmationUseCase
+}
+
+func NewPresenceConfirmationHandler(presenceUseCase *usecases.PresenceConfirmationUseCase) *PresenceConfirmationHandler {
+	return &PresenceConfirmationHandler{
+		presenceUseCase: presenceUseCase,
+	}
+}
+
+// GetBooking mostra o agendamento do link do lembrete
+// @Summary Consultar agendamento pelo link do email
+// @Description Mostra o agendamento do link do lembrete sem alterá-lo. A confirmação e o cancelamento são feitos por POST. O parâmetro action indica o botão clicado no email. No navegador, abre a página com os botões de confirmar e cancelar
+// @Tags bookings
+// @Produce json,html
+// @Param token path string true "Token de confirmação"
+// @Param action query string false "Ação escolhida no email (confirm ou cancel)"
+// @Success 200 {object} dtos.BookingResponse "Agendamento aguardando confirmação"
+// @Failure 400 {object} map[string]string "Link inválido, expirado ou já utilizado"
+// @Router /bookings/presence/{token} [get]
+func (h *PresenceConfirmationHandler) GetBooking(c *gin.Context) {
+	booking, err := h.presenceUseCase.GetBooking(c.Param("token"))
+	if err != nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	action := c.Query("action")
+	if action != "confirm" && action != "cancel" {
+		action = ""
+	}
+
+	confirm := linkPageForm{Action: linkAction(c, "/confirm"), Submit: "Confirmar presença"}
+	cancel := linkPageForm{Action: linkAction(c, "/cancel"), Submit: "Cancelar agendamento", Secondary: true}
+	page := &linkPage{
+		Title:   "Confirmação de presença",
+		Message: "Confirme sua presença ou cancele o agendamento abaixo. Sem confirmação até o prazo, o horário é liberado.",
+		Details: bookingLinkDetails(booking),
+		Forms:   []linkPageForm{confirm, cancel},
+	}
+	if action == "cancel" {
+		cancel.Secondary, confirm.Secondary = false, true
+		page.Forms = []linkPageForm{cancel, confirm}
+	}
+
+	respondLink(c, http.StatusOK, gin.H{
+		"data":   mappers.ToBookingResponse(booking),
+		"action": action,
+	}, page)
+}
+
+// ConfirmPresence confirma a presença pelo link do lembrete
+// @Summary Confirmar presença pelo link do email
+// @Description Confirma a presença no agendamento usando o token assinado do lembrete. Não exige login: o link é de uso único e vale até o prazo de confirmação
+// @Tags bookings
+// @Accept json
+// @Produce json,html
+// @Param token path string true "Token de confirmação"
+// @Success 200 {object} dtos.BookingResponse "Presença confirmada"
+// @Failure 400 {object} map[string]string "Link inválido, expirado ou já utilizado"
+// @Router /bookings/presence/{token}/confirm [post]
+func (h *PresenceConfirmationHandler) ConfirmPresence(c *gin.Context) {
+	booking, err := h.presenceUseCase.ConfirmPresence(c.Param("token"))
+	if err != nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	respondLink(c, http.StatusOK, gin.H{
+		"message": "Presença confirmada com sucesso",
+		"data":    mappers.ToBookingResponse(booking),
+	}, &linkPage{Title: "Presença confirmada", Message: "Obrigado! Esperamos você no horário.", Details: bookingLinkDetails(booking)})
+}
+
+// CancelBooking cancela o agendamento pelo link do lembrete
+// @Summary Cancelar agendamento pelo link do email
+// @Description Cancela o agendamento usando o token assinado do lembrete. Respeita o prazo de cancelamento da política e conta como cancelamento do próprio usuário
+// @Tags bookings
+// @Accept json
+// @Produce json,html
+// @Param token path string true "Token de confirmação"
+// @Success 200 {object} dtos.BookingResponse "Agendamento cancelado"
+// @Failure 400 {object} map[string]string "Link inválido, expirado ou já utilizado"
+// @Router /bookings/presence/{token}/cancel [post]
+func (h *PresenceConfirmationHandler) CancelBooking(c *gin.Context) {
+	booking, err := h.presenceUseCase.CancelBooking(c.Param("token"))
+	if err != nil {
+		respondLink(c, http.StatusBadRequest, policyErrorResponse(err), nil)
+		return
+	}
+
+	respondLink(c, http.StatusOK, gin.H{
+		"message": "Agendamento cancelado com sucesso",
+		"data":    mappers.ToBookingResponse(booking),
+	}, &linkPage{Title: "Agendamento cancelado", Message: "O horário foi liberado.", Details: bookingLinkDetails(booking)})
+}
+
+// bookingLinkDetails resume o agendamento nas páginas dos links enviados por email
+func bookingLinkDetails(booking *entities.Booking) []linkPageDetail {
+	details := []linkPageDetail{
+		{Label: "Data", Value: booking.StartTime.Format("02/01/2006")},
+		{Label: "Horário", Value: booking.StartTime.Format("15:04")},
+	}
+	if booking.Chair.Name != "" {
+		details = append(details, linkPageDetail{Label: "Cadeira", Value: booking.Chair.Name})
+	}
+	if booking.Chair.Location != "" {
+		details = append(details, linkPageDetail{Label: "Local", Value: booking.Chair.Location})
+	}
+	return details
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresenceConfirmationHandler_ReminderLinkKeepsBooking(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	user := &entities.User{ID: 1, Name: "Ana", Email: "ana@empresa.com.br", Role: "usuario", Status: "aprovado"}
+	chair := &entities.Chair{ID: 1, Name: "Cadeira 1", Status: "ativa"}
+	now := time.Now()
+	startTime := time.Date(now.Year(), now.Month(), now.Day()+1, 12, 0, 0, 0, time.Local)
+	confirmed := &entities.Booking{ID: 10, UserID: user.ID, ChairID: chair.ID, StartTime: startTime,
+		EndTime: startTime.Add(entities.DefaultSessionDuration), Status: entities.BookingStatusScheduled}
+	unanswered := &entities.Booking{ID: 11, UserID: user.ID, ChairID: chair.ID, StartTime: startTime.Add(time.Hour),
+		EndTime: startTime.Add(time.Hour + entities.DefaultSessionDuration), Status: entities.BookingStatusScheduled}
+
+	userRepo := fakes.NewUserRepository(user)
+	chairRepo := &fakes.ChairRepository{Chairs: map[uint]*entities.Chair{chair.ID: chair}}
+	bookingRepo := fakes.NewBookingRepository(&fakes.SlotHoldRepository{}, confirmed, unanswered)
+	confirmationRepo := &fakes.PresenceConfirmationRepository{}
+	emailRepo := fakes.NewEmailRepository()
+	auditRepo := &fakes.AuditLogRepository{}
+
+	penaltyUseCase := usecases.NewPenaltyUseCase(&fakes.PenaltyRepository{}, userRepo, auditRepo, emailRepo, entities.PenaltySettings{})
+	bookingUseCase := usecases.NewBookingUseCase(bookingRepo, chairRepo, userRepo, fakes.AvailabilityRepository{}, nil,
+		&fakes.WaitlistRepository{}, &fakes.SlotHoldRepository{}, fakes.TherapistRepository{}, &fakes.LocationRepository{},
+		nil, penaltyUseCase, auditRepo, emailRepo, nil)
+	presenceUseCase := usecases.NewPresenceConfirmationUseCase(confirmationRepo, bookingRepo, bookingUseCase, fakes.TokenSigner{}, 2*time.Hour)
+	notificationUseCase := usecases.NewNotificationUseCase(emailRepo, bookingRepo, userRepo, chairRepo, presenceUseCase)
+
+	handler := NewPresenceConfirmationHandler(presenceUseCase)
+	router := gin.New()
+	router.GET("/api/bookings/presence/:token", handler.GetBooking)
+	router.POST("/api/bookings/presence/:token/confirm", handler.ConfirmPresence)
+	router.POST("/api/bookings/presence/:token/cancel", handler.CancelBooking)
+
+	// Os dois agendamentos recebem o lembrete com o link de confirmação
+	require.NoError(t, notificationUseCase.SendBookingReminder(confirmed.ID))
+	token := fakes.Receive(t, emailRepo.Reminders)
+	require.NotEmpty(t, token)
+	require.NoError(t, notificationUseCase.SendBookingReminder(unanswered.ID))
+	fakes.Receive(t, emailRepo.Reminders)
+
+	// O botão "confirmar" do email abre a página, que não confirma sozinha
+	recorder := browse(router, http.MethodGet, "/api/bookings/presence/"+token+"?action=confirm")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+	stored, err := bookingRepo.GetByID(confirmed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.BookingStatusScheduled, stored.Status)
+
+	// O usuário envia o formulário de confirmação da página
+	actions := formActions(recorder.Body.String())
+	require.Len(t, actions, 2)
+	assert.True(t, strings.HasSuffix(actions[0], "/confirm"))
+	recorder = browse(router, http.MethodPost, actions[0])
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Presença confirmada")
+
+	// Passado o prazo, o job libera apenas o horário sem resposta
+	confirmationRepo.PassDeadline()
+	require.NoError(t, presenceUseCase.ReleaseUnconfirmed())
+
+	stored, err = bookingRepo.GetByID(confirmed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.BookingStatusPresenceConfirmed, stored.Status)
+
+	released, err := bookingRepo.GetByID(unanswered.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.BookingStatusCancelled, released.Status)
+}
+
+func TestPresenceConfirmationHandler_GetBooking_JSONForAPIClients(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewPresenceConfirmationHandler(usecases.NewPresenceConfirmationUseCase(
+		&fakes.PresenceConfirmationRepository{}, fakes.NewBookingRepository(nil), nil, fakes.TokenSigner{}, time.Hour))
+	router := gin.New()
+	router.GET("/api/bookings/presence/:token", handler.GetBooking)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/bookings/presence/invalido", nil)
+	request.Header.Set("Accept", "application/json, text/plain, */*")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "application/json")
+	assert.Contains(t, recorder.Body.String(), `"error"`)
+}
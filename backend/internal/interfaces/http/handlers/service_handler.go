@@ -0,0 +1,330 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ServiceHandler struct {
+	serviceUseCase *usecases.ServiceUseCase
+}
+
+func NewServiceHandler(serviceUseCase *usecases.ServiceUseCase) *ServiceHandler {
+	return &ServiceHandler{
+		serviceUseCase: serviceUseCase,
+	}
+}
+
+// CreateService cria um novo serviço
+// @Summary Criar serviço
+// @Description Cadastra um tipo de sessão com sua duração (apenas admins)
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param service body dtos.CreateServiceRequest true "Dados do serviço"
+// @Success 201 {object} dtos.ServiceResponse "Serviço criado com sucesso"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /services [post]
+func (h *ServiceHandler) CreateService(c *gin.Context) {
+	var req dtos.CreateServiceRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	service := mappers.ToServiceEntity(&req)
+
+	if err := h.serviceUseCase.CreateService(service, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Serviço criado com sucesso",
+		"data":    mappers.ToServiceResponse(service),
+	})
+}
+
+// GetService busca serviço por ID
+// @Summary Buscar serviço por ID
+// @Description Retorna os dados de um serviço específico pelo ID
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do serviço"
+// @Success 200 {object} dtos.ServiceResponse "Serviço encontrado"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Serviço não encontrado"
+// @Router /services/{id} [get]
+func (h *ServiceHandler) GetService(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	service, err := h.serviceUseCase.GetServiceByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Serviço não encontrado"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToServiceResponse(service)})
+}
+
+// UpdateService atualiza um serviço
+// @Summary Atualizar serviço
+// @Description Atualiza nome, descrição, duração ou status de um serviço (apenas admins)
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do serviço"
+// @Param service body dtos.UpdateServiceRequest true "Dados atualizados do serviço"
+// @Success 200 {object} dtos.ServiceResponse "Serviço atualizado com sucesso"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /services/{id} [put]
+func (h *ServiceHandler) UpdateService(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dtos.UpdateServiceRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	service := mappers.ToServiceUpdateEntity(uint(id), &req)
+
+	if err := h.serviceUseCase.UpdateService(service, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Serviço atualizado com sucesso",
+		"data":    mappers.ToServiceResponse(service),
+	})
+}
+
+// DeleteService exclui um serviço
+// @Summary Excluir serviço
+// @Description Exclui um serviço do catálogo (apenas admins)
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do serviço"
+// @Success 200 {object} map[string]string "Serviço excluído com sucesso"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /services/{id} [delete]
+func (h *ServiceHandler) DeleteService(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.serviceUseCase.DeleteService(uint(id), userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Serviço excluído com sucesso"})
+}
+
+// ListServices lista serviços com paginação
+// @Summary Listar serviços
+// @Description Retorna uma lista paginada do catálogo de serviços
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param limit query int false "Limite de resultados por página" default(10)
+// @Param offset query int false "Número de registros a pular" default(0)
+// @Param name query string false "Filtrar por nome"
+// @Param is_active query bool false "Filtrar por status ativo"
+// @Success 200 {object} dtos.ListServicesResponse "Lista de serviços"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /services [get]
+func (h *ServiceHandler) ListServices(c *gin.Context) {
+	// Parâmetros de paginação
+	limitParam := c.DefaultQuery("limit", "10")
+	offsetParam := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(offsetParam)
+	if err != nil {
+		offset = 0
+	}
+
+	// Filtros
+	filters := make(map[string]interface{})
+	if name := c.Query("name"); name != "" {
+		filters["name"] = name
+	}
+	if isActiveParam := c.Query("is_active"); isActiveParam != "" {
+		if isActive, parseErr := strconv.ParseBool(isActiveParam); parseErr == nil {
+			filters["is_active"] = isActive
+		}
+	}
+
+	services, total, err := h.serviceUseCase.ListServices(limit, offset, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": mappers.ToListServicesResponse(services, total, limit, offset),
+	})
+}
+
+// GetActiveServices busca serviços ativos
+// @Summary Buscar serviços ativos
+// @Description Lista os serviços disponíveis para agendamento
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dtos.ServiceResponse "Lista de serviços ativos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /services/active [get]
+func (h *ServiceHandler) GetActiveServices(c *gin.Context) {
+	services, err := h.serviceUseCase.GetActiveServices()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToServiceResponseList(services)})
+}
+
+// GetChairServices busca os serviços oferecidos por uma cadeira
+// @Summary Serviços da cadeira
+// @Description Lista os serviços que uma cadeira oferece
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Success 200 {array} dtos.ServiceResponse "Serviços da cadeira"
+// @Failure 400 {object} map[string]string "ID da cadeira inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Cadeira não encontrada"
+// @Router /services/chair/{chair_id} [get]
+func (h *ServiceHandler) GetChairServices(c *gin.Context) {
+	chairIDParam := c.Param("chair_id")
+	chairID, err := strconv.ParseUint(chairIDParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da cadeira inválido"})
+		return
+	}
+
+	services, err := h.serviceUseCase.GetChairServices(uint(chairID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToServiceResponseList(services)})
+}
+
+// SetChairServices define os serviços oferecidos por uma cadeira
+// @Summary Definir serviços da cadeira
+// @Description Substitui a lista de serviços que uma cadeira oferece (apenas admins)
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param request body dtos.SetChairServicesRequest true "IDs dos serviços"
+// @Success 200 {array} dtos.ServiceResponse "Serviços da cadeira atualizados"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /services/chair/{chair_id} [put]
+func (h *ServiceHandler) SetChairServices(c *gin.Context) {
+	chairIDParam := c.Param("chair_id")
+	chairID, err := strconv.ParseUint(chairIDParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da cadeira inválido"})
+		return
+	}
+
+	var req dtos.SetChairServicesRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.serviceUseCase.SetChairServices(uint(chairID), req.ServiceIDs, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	services, err := h.serviceUseCase.GetChairServices(uint(chairID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar serviços da cadeira"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Serviços da cadeira atualizados com sucesso",
+		"data":    mappers.ToServiceResponseList(services),
+	})
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SlotHoldHandler struct {
+	slotHoldUseCase *usecases.SlotHoldUseCase
+}
+
+func NewSlotHoldHandler(slotHoldUseCase *usecases.SlotHoldUseCase) *SlotHoldHandler {
+	return &SlotHoldHandler{
+		slotHoldUseCase: slotHoldUseCase,
+	}
+}
+
+// CreateHold reserva temporariamente um horário para o usuário autenticado
+// @Summary Reservar horário
+// @Description Segura o horário da cadeira por alguns minutos enquanto o usuário preenche o agendamento. O token retornado deve ser enviado em hold_token ao criar o agendamento
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateSlotHoldRequest true "Horário a reservar"
+// @Success 201 {object} dtos.SlotHoldResponse "Horário reservado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 409 {object} map[string]string "Horário ocupado ou reservado"
+// @Router /bookings/holds [post]
+func (h *SlotHoldHandler) CreateHold(c *gin.Context) {
+	var req dtos.CreateSlotHoldRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	if req.ChairID == 0 || req.StartTime.IsZero() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cadeira e horário são obrigatórios"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	hold, err := h.slotHoldUseCase.HoldSlot(userID, req.ChairID, req.ServiceID, req.StartTime)
+	if err != nil {
+		c.JSON(bookingErrorStatus(err), policyErrorResponse(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Horário reservado com sucesso",
+		"data":    mappers.ToSlotHoldResponse(hold),
+	})
+}
+
+// GetHold consulta uma reserva do usuário autenticado
+// @Summary Consultar reserva de horário
+// @Description Retorna a reserva pelo token, incluindo o prazo de expiração
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param token path string true "Token da reserva"
+// @Success 200 {object} dtos.SlotHoldResponse "Reserva"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Reserva não encontrada"
+// @Router /bookings/holds/{token} [get]
+func (h *SlotHoldHandler) GetHold(c *gin.Context) {
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	hold, err := h.slotHoldUseCase.GetHold(c.Param("token"), userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToSlotHoldResponse(hold)})
+}
+
+// ReleaseHold libera uma reserva antes do prazo
+// @Summary Liberar reserva de horário
+// @Description Devolve o horário reservado quando o usuário desiste do agendamento
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param token path string true "Token da reserva"
+// @Success 200 {object} map[string]string "Reserva liberada"
+// @Failure 400 {object} map[string]string "Reserva inválida"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /bookings/holds/{token} [delete]
+func (h *SlotHoldHandler) ReleaseHold(c *gin.Context) {
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.slotHoldUseCase.ReleaseHold(c.Param("token"), userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Reserva de horário liberada"})
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TenantHandler struct {
+	tenantUseCase *usecases.TenantUseCase
+}
+
+func NewTenantHandler(tenantUseCase *usecases.TenantUseCase) *TenantHandler {
+	return &TenantHandler{
+		tenantUseCase: tenantUseCase,
+	}
+}
+
+// ListTenants lista os tenants
+// @Summary Listar tenants
+// @Description Lista os clientes da plataforma, opcionalmente incluindo os desativados (apenas super admins)
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param include_inactive query bool false "Incluir tenants desativados"
+// @Success 200 {array} dtos.TenantResponse "Tenants"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /platform/tenants [get]
+func (h *TenantHandler) ListTenants(c *gin.Context) {
+	tenants, err := h.tenantUseCase.ListTenants(c.Query("include_inactive") == "true")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar tenants"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tenants encontrados",
+		"data":    mappers.ToTenantResponseList(tenants),
+	})
+}
+
+// GetTenant busca tenant por ID
+// @Summary Buscar tenant
+// @Description Retorna os dados de um cliente da plataforma (apenas super admins)
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do tenant"
+// @Success 200 {object} dtos.TenantResponse "Tenant"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Tenant não encontrado"
+// @Router /platform/tenants/{id} [get]
+func (h *TenantHandler) GetTenant(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	tenant, err := h.tenantUseCase.GetTenant(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tenant não encontrado"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToTenantResponse(tenant)})
+}
+
+// CreateTenant cadastra um tenant
+// @Summary Criar tenant
+// @Description Cadastra um cliente e o primeiro admin dele. O slug é o subdomínio de acesso do cliente (apenas super admins)
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.CreateTenantRequest true "Dados do tenant e do admin"
+// @Success 201 {object} dtos.TenantResponse "Tenant criado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /platform/tenants [post]
+func (h *TenantHandler) CreateTenant(c *gin.Context) {
+	var req dtos.CreateTenantRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	req.Admin.CPF = normalizeCPF(req.Admin.CPF)
+	tenant := mappers.ToTenantEntity(&req)
+	if err := h.tenantUseCase.CreateTenant(tenant, mappers.ToTenantAdminEntity(&req.Admin), userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Tenant criado com sucesso",
+		"data":    mappers.ToTenantResponse(tenant),
+	})
+}
+
+// UpdateTenant atualiza um tenant
+// @Summary Atualizar tenant
+// @Description Altera o nome ou desativa/reativa um cliente. Tenants desativados não atendem requisições (apenas super admins)
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do tenant"
+// @Param request body dtos.UpdateTenantRequest true "Dados do tenant"
+// @Success 200 {object} dtos.TenantResponse "Tenant atualizado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Tenant não encontrado"
+// @Router /platform/tenants/{id} [put]
+func (h *TenantHandler) UpdateTenant(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dtos.UpdateTenantRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	tenant, err := h.tenantUseCase.GetTenant(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tenant não encontrado"})
+		return
+	}
+
+	tenant.Name = req.Name
+	if req.IsActive != nil {
+		tenant.IsActive = *req.IsActive
+	}
+	if err := h.tenantUseCase.UpdateTenant(tenant, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tenant atualizado com sucesso",
+		"data":    mappers.ToTenantResponse(tenant),
+	})
+}
+
+// CreateTenantAdmin cadastra um admin no tenant
+// @Summary Cadastrar admin do tenant
+// @Description Cadastra um admin já aprovado dentro do cliente, por exemplo quando o único admin saiu (apenas super admins)
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do tenant"
+// @Param request body dtos.TenantAdminRequest true "Dados do admin"
+// @Success 201 {object} map[string]interface{} "Admin cadastrado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /platform/tenants/{id}/admins [post]
+func (h *TenantHandler) CreateTenantAdmin(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req dtos.TenantAdminRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	req.CPF = normalizeCPF(req.CPF)
+	admin := mappers.ToTenantAdminEntity(&req)
+	if err := h.tenantUseCase.CreateTenantAdmin(uint(id), admin, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Admin cadastrado com sucesso",
+		"data":    gin.H{"id": admin.ID, "name": admin.Name, "email": admin.Email},
+	})
+}
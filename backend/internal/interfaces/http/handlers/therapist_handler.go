@@ -0,0 +1,587 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TherapistHandler struct {
+	therapistUseCase *usecases.TherapistUseCase
+}
+
+func NewTherapistHandler(therapistUseCase *usecases.TherapistUseCase) *TherapistHandler {
+	return &TherapistHandler{
+		therapistUseCase: therapistUseCase,
+	}
+}
+
+// ListTherapists lista os massoterapeutas
+// @Summary Listar massoterapeutas
+// @Description Lista os massoterapeutas ativos; admins podem incluir os inativos (admins e atendentes)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param include_inactive query bool false "Incluir massoterapeutas inativos (apenas admins)"
+// @Success 200 {array} dtos.TherapistResponse "Massoterapeutas"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists [get]
+func (h *TherapistHandler) ListTherapists(c *gin.Context) {
+	role, _ := middleware.GetUserRoleFromContext(c)
+	includeInactive := role == "admin" && c.Query("include_inactive") == "true"
+
+	therapists, err := h.therapistUseCase.ListTherapists(includeInactive)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao buscar massoterapeutas"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Massoterapeutas encontrados",
+		"data":    mappers.ToTherapistResponseList(therapists),
+	})
+}
+
+// GetTherapist busca massoterapeuta por ID
+// @Summary Buscar massoterapeuta
+// @Description Retorna os dados do massoterapeuta com os turnos (admins e atendentes)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Success 200 {object} dtos.TherapistResponse "Massoterapeuta"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Massoterapeuta não encontrado"
+// @Router /therapists/{id} [get]
+func (h *TherapistHandler) GetTherapist(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	therapist, err := h.therapistUseCase.GetTherapist(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Massoterapeuta não encontrado"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToTherapistResponse(therapist)})
+}
+
+// CreateTherapist cadastra um massoterapeuta
+// @Summary Criar massoterapeuta
+// @Description Cadastra um massoterapeuta; os horários de trabalho são definidos pelos turnos (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.TherapistRequest true "Dados do massoterapeuta"
+// @Success 201 {object} dtos.TherapistResponse "Massoterapeuta criado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists [post]
+func (h *TherapistHandler) CreateTherapist(c *gin.Context) {
+	var req dtos.TherapistRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	therapist := mappers.ToTherapistEntity(&req)
+	if err := h.therapistUseCase.CreateTherapist(therapist, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Massoterapeuta criado com sucesso",
+		"data":    mappers.ToTherapistResponse(therapist),
+	})
+}
+
+// UpdateTherapist atualiza um massoterapeuta
+// @Summary Atualizar massoterapeuta
+// @Description Atualiza os dados do massoterapeuta; inativo, ele deixa de cobrir os turnos (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param request body dtos.TherapistRequest true "Dados do massoterapeuta"
+// @Success 200 {object} dtos.TherapistResponse "Massoterapeuta atualizado"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists/{id} [put]
+func (h *TherapistHandler) UpdateTherapist(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.TherapistRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	therapist := mappers.ToTherapistEntity(&req)
+	therapist.ID = id
+	if err := h.therapistUseCase.UpdateTherapist(therapist, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Massoterapeuta atualizado com sucesso",
+		"data":    mappers.ToTherapistResponse(therapist),
+	})
+}
+
+// DeleteTherapist exclui um massoterapeuta
+// @Summary Excluir massoterapeuta
+// @Description Exclui um massoterapeuta sem turnos cadastrados (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Success 200 {object} map[string]string "Massoterapeuta excluído"
+// @Failure 400 {object} map[string]string "Massoterapeuta com turnos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists/{id} [delete]
+func (h *TherapistHandler) DeleteTherapist(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.therapistUseCase.DeleteTherapist(id, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Massoterapeuta excluído com sucesso"})
+}
+
+// ListShifts lista os turnos do massoterapeuta
+// @Summary Listar turnos do massoterapeuta
+// @Description Lista os turnos semanais do massoterapeuta e as cadeiras em que atende (admins e atendentes)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Success 200 {array} dtos.TherapistShiftResponse "Turnos"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Massoterapeuta não encontrado"
+// @Router /therapists/{id}/shifts [get]
+func (h *TherapistHandler) ListShifts(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	shifts, err := h.therapistUseCase.GetShifts(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Turnos encontrados",
+		"data":    mappers.ToTherapistShiftResponseList(shifts),
+	})
+}
+
+// CreateShift aloca o massoterapeuta em uma cadeira
+// @Summary Criar turno do massoterapeuta
+// @Description Aloca o massoterapeuta em uma cadeira em um dia da semana e horário. Depois do primeiro turno, a cadeira só oferece horários cobertos por turnos (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param request body dtos.TherapistShiftRequest true "Dados do turno"
+// @Success 201 {object} dtos.TherapistShiftResponse "Turno criado"
+// @Failure 400 {object} map[string]string "Dados inválidos ou turno sobreposto"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists/{id}/shifts [post]
+func (h *TherapistHandler) CreateShift(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	shift, ok := bindTherapistShift(c, id)
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.therapistUseCase.CreateShift(shift, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Turno criado com sucesso",
+		"data":    mappers.ToTherapistShiftResponse(shift),
+	})
+}
+
+// UpdateShift atualiza um turno do massoterapeuta
+// @Summary Atualizar turno do massoterapeuta
+// @Description Atualiza cadeira, dia, horário ou validade do turno (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param shift_id path int true "ID do turno"
+// @Param request body dtos.TherapistShiftRequest true "Dados do turno"
+// @Success 200 {object} dtos.TherapistShiftResponse "Turno atualizado"
+// @Failure 400 {object} map[string]string "Dados inválidos ou turno sobreposto"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists/{id}/shifts/{shift_id} [put]
+func (h *TherapistHandler) UpdateShift(c *gin.Context) {
+	id, shiftID, ok := parseTherapistChildParams(c, "shift_id", "ID do turno inválido")
+	if !ok {
+		return
+	}
+
+	shift, ok := bindTherapistShift(c, id)
+	if !ok {
+		return
+	}
+	shift.ID = shiftID
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.therapistUseCase.UpdateShift(shift, userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Turno atualizado com sucesso",
+		"data":    mappers.ToTherapistShiftResponse(shift),
+	})
+}
+
+// DeleteShift remove um turno do massoterapeuta
+// @Summary Excluir turno do massoterapeuta
+// @Description Remove o turno; agendamentos já feitos no horário são mantidos (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param shift_id path int true "ID do turno"
+// @Success 200 {object} map[string]string "Turno excluído"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Turno não encontrado"
+// @Router /therapists/{id}/shifts/{shift_id} [delete]
+func (h *TherapistHandler) DeleteShift(c *gin.Context) {
+	id, shiftID, ok := parseTherapistChildParams(c, "shift_id", "ID do turno inválido")
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.therapistUseCase.DeleteShift(id, shiftID, userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Turno excluído com sucesso"})
+}
+
+// ListAbsences lista as ausências do massoterapeuta
+// @Summary Listar ausências do massoterapeuta
+// @Description Lista férias, folgas e demais ausências do massoterapeuta (admins e atendentes)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Success 200 {array} dtos.TherapistAbsenceResponse "Ausências"
+// @Failure 400 {object} map[string]string "ID inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Massoterapeuta não encontrado"
+// @Router /therapists/{id}/absences [get]
+func (h *TherapistHandler) ListAbsences(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	absences, err := h.therapistUseCase.GetAbsences(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Ausências encontradas",
+		"data":    mappers.ToTherapistAbsenceResponseList(absences),
+	})
+}
+
+// CreateAbsence registra uma ausência do massoterapeuta
+// @Summary Registrar ausência do massoterapeuta
+// @Description Retira os dias da escala do massoterapeuta e retorna os agendamentos dele no período, que precisam ser remanejados (admins e atendentes)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param request body dtos.TherapistAbsenceRequest true "Dados da ausência"
+// @Success 201 {object} dtos.TherapistAbsenceResponse "Ausência registrada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists/{id}/absences [post]
+func (h *TherapistHandler) CreateAbsence(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.TherapistAbsenceRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inicial inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	endDate := startDate
+	if req.EndDate != "" {
+		endDate, err = time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data final inválido (YYYY-MM-DD)"})
+			return
+		}
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	absence := &entities.TherapistAbsence{
+		TherapistID: id,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Reason:      req.Reason,
+	}
+
+	affected, err := h.therapistUseCase.CreateAbsence(absence, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := mappers.ToTherapistAbsenceResponse(absence)
+	for _, booking := range affected {
+		response.AffectedBookings = append(response.AffectedBookings, booking.ID)
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Ausência registrada com sucesso",
+		"data":    response,
+	})
+}
+
+// DeleteAbsence remove uma ausência do massoterapeuta
+// @Summary Excluir ausência do massoterapeuta
+// @Description Remove a ausência; os turnos do período voltam a ser oferecidos (admins e atendentes)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param absence_id path int true "ID da ausência"
+// @Success 200 {object} map[string]string "Ausência excluída"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 404 {object} map[string]string "Ausência não encontrada"
+// @Router /therapists/{id}/absences/{absence_id} [delete]
+func (h *TherapistHandler) DeleteAbsence(c *gin.Context) {
+	id, absenceID, ok := parseTherapistChildParams(c, "absence_id", "ID da ausência inválido")
+	if !ok {
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	if err := h.therapistUseCase.DeleteAbsence(id, absenceID, userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Ausência excluída com sucesso"})
+}
+
+// GetTherapistHours resume as horas do massoterapeuta no período
+// @Summary Horas do massoterapeuta
+// @Description Soma as horas de turno, de ausência e de sessões do massoterapeuta no período, para o planejamento das horas contratadas (apenas admins)
+// @Tags therapists
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID do massoterapeuta"
+// @Param start_date query string true "Data inicial (YYYY-MM-DD)"
+// @Param end_date query string true "Data final (YYYY-MM-DD)"
+// @Success 200 {object} dtos.TherapistHoursResponse "Horas do período"
+// @Failure 400 {object} map[string]string "Período inválido"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /therapists/{id}/hours [get]
+func (h *TherapistHandler) GetTherapistHours(c *gin.Context) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", c.Query("start_date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inicial inválido (YYYY-MM-DD)"})
+		return
+	}
+	endDate, err := time.Parse("2006-01-02", c.Query("end_date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data final inválido (YYYY-MM-DD)"})
+		return
+	}
+
+	hours, err := h.therapistUseCase.GetTherapistHours(id, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": hours})
+}
+
+// bindTherapistShift lê o corpo da requisição como turno do massoterapeuta, respondendo 400 se inválido
+func bindTherapistShift(c *gin.Context, therapistID uint) (*entities.TherapistShift, bool) {
+	var req dtos.TherapistShiftRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return nil, false
+	}
+
+	validFrom, err := parseOptionalDate(req.ValidFrom)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de valid_from inválido (YYYY-MM-DD)"})
+		return nil, false
+	}
+	validTo, err := parseOptionalDate(req.ValidTo)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de valid_to inválido (YYYY-MM-DD)"})
+		return nil, false
+	}
+
+	return &entities.TherapistShift{
+		TherapistID: therapistID,
+		ChairID:     req.ChairID,
+		DayOfWeek:   req.DayOfWeek,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		ValidFrom:   validFrom,
+		ValidTo:     validTo,
+	}, true
+}
+
+// parseTherapistID lê o ID do massoterapeuta da rota
+func parseTherapistID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// parseTherapistChildParams lê os IDs do massoterapeuta e do turno ou ausência da rota
+func parseTherapistChildParams(c *gin.Context, param, invalidMessage string) (uint, uint, bool) {
+	id, ok := parseTherapistID(c)
+	if !ok {
+		return 0, 0, false
+	}
+
+	childID, err := strconv.ParseUint(c.Param(param), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidMessage})
+		return 0, 0, false
+	}
+
+	return id, uint(childID), true
+}
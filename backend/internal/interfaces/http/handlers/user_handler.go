@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -227,6 +228,7 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 // @Failure 401 {object} map[string]string "Token inválido"
 // @Failure 403 {object} map[string]string "Sem permissão"
 // @Failure 404 {object} map[string]string "Usuário não encontrado"
+// @Failure 409 {object} map[string]string "Email do usuário não confirmado"
 // @Router /users/{id}/approve [post]
 func (h *UserHandler) ApproveUser(c *gin.Context) {
 	idParam := c.Param("id")
@@ -263,7 +265,11 @@ func (h *UserHandler) ApproveUser(c *gin.Context) {
 		return
 	}
 
-	err = h.userUseCase.ApproveUser(uint(id), currentUser.ID)
+	err = h.userUseCase.ApproveUser(uint(id), &currentUser.ID)
+	if errors.Is(err, entities.ErrEmailNotVerified) {
+		c.JSON(http.StatusConflict, gin.H{"error": "O usuário ainda não confirmou o email. Aguarde a confirmação antes de aprovar"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -459,13 +465,14 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 
 // GetPendingApprovals busca usuários pendentes de aprovação
 // @Summary Buscar usuários pendentes
-// @Description Lista usuários pendentes de aprovação (apenas atendentes e admins)
+// @Description Lista usuários pendentes de aprovação (apenas atendentes e admins). Cada usuário traz email_verified_at; só cadastros com email confirmado podem ser aprovados
 // @Tags users
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param limit query int false "Limite de registros por página" default(10)
 // @Param offset query int false "Offset para paginação" default(0)
+// @Param email_verified query bool false "Filtrar pelos cadastros com (true) ou sem (false) email confirmado"
 // @Success 200 {object} map[string]interface{} "Lista de usuários pendentes"
 // @Failure 400 {object} map[string]string "Parâmetros inválidos"
 // @Failure 401 {object} map[string]string "Token inválido"
@@ -486,7 +493,17 @@ func (h *UserHandler) GetPendingApprovals(c *gin.Context) {
 		offset = 0
 	}
 
-	users, total, err := h.userUseCase.GetPendingApprovals(limit, offset)
+	var emailVerified *bool
+	if param := c.Query("email_verified"); param != "" {
+		verified, err := strconv.ParseBool(param)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "email_verified deve ser true ou false"})
+			return
+		}
+		emailVerified = &verified
+	}
+
+	users, total, err := h.userUseCase.GetPendingApprovals(limit, offset, emailVerified)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
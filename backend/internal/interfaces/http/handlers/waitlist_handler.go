@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"agendamento-backend/internal/application/dtos"
+	"agendamento-backend/internal/application/mappers"
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WaitlistHandler struct {
+	waitlistUseCase *usecases.WaitlistUseCase
+}
+
+func NewWaitlistHandler(waitlistUseCase *usecases.WaitlistUseCase) *WaitlistHandler {
+	return &WaitlistHandler{
+		waitlistUseCase: waitlistUseCase,
+	}
+}
+
+// JoinWaitlist coloca o usuário autenticado na lista de espera
+// @Summary Entrar na lista de espera
+// @Description Entra na fila de uma cadeira para uma data e janela de horário. Quando um horário abrir, o usuário recebe uma oferta com prazo ou é agendado automaticamente, conforme a preferência
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dtos.JoinWaitlistRequest true "Dados da entrada"
+// @Success 201 {object} dtos.WaitlistEntryResponse "Entrada criada"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /bookings/waitlist [post]
+func (h *WaitlistHandler) JoinWaitlist(c *gin.Context) {
+	var req dtos.JoinWaitlistRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dados inválidos: " + bindErr.Error()})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inválido. Use YYYY-MM-DD"})
+		return
+	}
+
+	entry := mappers.ToWaitlistEntryEntity(&req, userID, date)
+
+	if err := h.waitlistUseCase.JoinWaitlist(entry); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Entrada na lista de espera realizada com sucesso",
+		"data":    mappers.ToWaitlistEntryResponse(entry),
+	})
+}
+
+// GetMyWaitlistEntries lista as entradas do usuário autenticado
+// @Summary Minhas entradas na lista de espera
+// @Description Lista as entradas de lista de espera do usuário autenticado
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dtos.WaitlistEntryResponse "Entradas do usuário"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /bookings/waitlist [get]
+func (h *WaitlistHandler) GetMyWaitlistEntries(c *gin.Context) {
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	entries, err := h.waitlistUseCase.GetUserEntries(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToWaitlistEntryResponseList(entries)})
+}
+
+// LeaveWaitlist remove uma entrada da lista de espera
+// @Summary Sair da lista de espera
+// @Description Remove uma entrada da fila. Usuários só removem as próprias entradas; atendentes e admins removem qualquer uma
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "ID da entrada"
+// @Success 200 {object} map[string]string "Entrada removida"
+// @Failure 400 {object} map[string]string "Dados inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Router /bookings/waitlist/{id} [delete]
+func (h *WaitlistHandler) LeaveWaitlist(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	// Obter userID do contexto de autenticação
+	userID, exists := middleware.GetUserIDFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+		return
+	}
+
+	role, _ := middleware.GetUserRoleFromContext(c)
+	canManage := role == "admin" || role == "atendente"
+
+	if err := h.waitlistUseCase.LeaveWaitlist(uint(id), userID, canManage); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Entrada removida da lista de espera"})
+}
+
+// GetChairQueue lista a fila de uma cadeira em uma data
+// @Summary Fila da cadeira
+// @Description Lista a lista de espera de uma cadeira para uma data (atendentes e admins)
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param chair_id path int true "ID da cadeira"
+// @Param date query string true "Data (YYYY-MM-DD)"
+// @Success 200 {array} dtos.WaitlistEntryResponse "Fila da cadeira"
+// @Failure 400 {object} map[string]string "Parâmetros inválidos"
+// @Failure 401 {object} map[string]string "Token inválido"
+// @Failure 403 {object} map[string]string "Sem permissão"
+// @Router /bookings/waitlist/chair/{chair_id} [get]
+func (h *WaitlistHandler) GetChairQueue(c *gin.Context) {
+	chairIDParam := c.Param("chair_id")
+	chairID, err := strconv.ParseUint(chairIDParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID da cadeira inválido"})
+		return
+	}
+
+	dateParam := c.Query("date")
+	if dateParam == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Data é obrigatória"})
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", dateParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de data inválido. Use YYYY-MM-DD"})
+		return
+	}
+
+	entries, err := h.waitlistUseCase.GetChairQueue(uint(chairID), date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": mappers.ToWaitlistEntryResponseList(entries)})
+}
+
+// GetOffer mostra a vaga ofertada pela lista de espera
+// @Summary Consultar vaga da lista de espera
+// @Description Mostra a vaga ofertada usando o token do link enviado por email, sem aceitá-la. O aceite e a recusa são feitos por POST. No navegador, abre a página com os botões de aceitar e recusar
+// @Tags waitlist
+// @Produce json,html
+// @Param token path string true "Token da oferta"
+// @Success 200 {object} dtos.WaitlistEntryResponse "Vaga ofertada"
+// @Failure 400 {object} map[string]string "Oferta inválida ou expirada"
+// @Router /bookings/waitlist/offers/{token} [get]
+func (h *WaitlistHandler) GetOffer(c *gin.Context) {
+	entry, err := h.waitlistUseCase.GetOffer(c.Param("token"))
+	if err != nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	details := []linkPageDetail{
+		{Label: "Data", Value: entry.OfferedStart.Format("02/01/2006")},
+		{Label: "Horário", Value: entry.OfferedStart.Format("15:04")},
+	}
+	if entry.Chair.Name != "" {
+		details = append(details, linkPageDetail{Label: "Cadeira", Value: entry.Chair.Name})
+	}
+	if entry.Chair.Location != "" {
+		details = append(details, linkPageDetail{Label: "Local", Value: entry.Chair.Location})
+	}
+	if entry.OfferExpiresAt != nil {
+		details = append(details, linkPageDetail{Label: "Aceite até", Value: entry.OfferExpiresAt.Format("02/01/2006 15:04")})
+	}
+
+	respondLink(c, http.StatusOK, gin.H{"data": mappers.ToWaitlistEntryResponse(entry)}, &linkPage{
+		Title:   "Vaga da lista de espera",
+		Message: "Abriu uma vaga no horário que você aguardava. Aceite para agendar ou recuse para liberá-la ao próximo da fila.",
+		Details: details,
+		Forms: []linkPageForm{
+			{Action: linkAction(c, "/accept"), Submit: "Aceitar vaga"},
+			{Action: linkAction(c, "/decline"), Submit: "Recusar vaga", Secondary: true},
+		},
+	})
+}
+
+// AcceptOffer aceita uma vaga ofertada pela lista de espera
+// @Summary Aceitar vaga da lista de espera
+// @Description Confirma a vaga ofertada usando o token do link enviado por email. Não exige login: o token é de uso único e expira
+// @Tags waitlist
+// @Accept json
+// @Produce json,html
+// @Param token path string true "Token da oferta"
+// @Success 200 {object} dtos.BookingResponse "Agendamento criado"
+// @Failure 400 {object} map[string]string "Oferta inválida ou expirada"
+// @Failure 409 {object} map[string]string "Horário já ocupado"
+// @Router /bookings/waitlist/offers/{token}/accept [post]
+func (h *WaitlistHandler) AcceptOffer(c *gin.Context) {
+	token := c.Param("token")
+
+	booking, err := h.waitlistUseCase.AcceptOffer(token)
+	if err != nil {
+		respondLink(c, bookingErrorStatus(err), policyErrorResponse(err), nil)
+		return
+	}
+
+	message := "Vaga confirmada com sucesso"
+	respondLink(c, http.StatusOK, gin.H{
+		"message": message,
+		"data":    mappers.ToBookingResponse(booking),
+	}, &linkPage{Title: "Vaga confirmada", Message: message + ". Você receberá o email de confirmação do agendamento.", Details: bookingLinkDetails(booking)})
+}
+
+// DeclineOffer recusa uma vaga ofertada pela lista de espera
+// @Summary Recusar vaga da lista de espera
+// @Description Recusa a vaga ofertada, saindo da fila e liberando a vaga para o próximo
+// @Tags waitlist
+// @Accept json
+// @Produce json,html
+// @Param token path string true "Token da oferta"
+// @Success 200 {object} map[string]string "Oferta recusada"
+// @Failure 400 {object} map[string]string "Oferta inválida"
+// @Router /bookings/waitlist/offers/{token}/decline [post]
+func (h *WaitlistHandler) DeclineOffer(c *gin.Context) {
+	token := c.Param("token")
+
+	if err := h.waitlistUseCase.DeclineOffer(token); err != nil {
+		respondLink(c, http.StatusBadRequest, gin.H{"error": err.Error()}, nil)
+		return
+	}
+
+	message := "Oferta recusada"
+	respondLink(c, http.StatusOK, gin.H{"message": message}, &linkPage{Title: message, Message: "Você saiu da lista de espera deste horário, e a vaga foi liberada ao próximo da fila."})
+}
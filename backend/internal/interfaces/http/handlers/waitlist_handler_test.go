@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/tests/fakes"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitlistHandler_OfferLinkAcceptsSlot(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	user := &entities.User{ID: 1, Name: "Ana", Email: "ana@empresa.com.br", Role: "usuario", Status: "aprovado"}
+	chair := &entities.Chair{ID: 1, Name: "Cadeira 1", Status: "ativa"}
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day()+3, 0, 0, 0, 0, time.Local)
+	startTime := day.Add(10 * time.Hour)
+
+	userRepo := fakes.NewUserRepository(user)
+	chairRepo := &fakes.ChairRepository{Chairs: map[uint]*entities.Chair{chair.ID: chair}}
+	holdRepo := &fakes.SlotHoldRepository{}
+	bookingRepo := fakes.NewBookingRepository(holdRepo)
+	waitlistRepo := &fakes.WaitlistRepository{}
+	waitlistRepo.Add(&entities.WaitlistEntry{ID: 1, UserID: user.ID, ChairID: chair.ID, Date: day, WindowStart: "08:00", WindowEnd: "18:00",
+		Preference: entities.WaitlistPreferenceOffer, Status: entities.WaitlistStatusWaiting})
+	emailRepo := fakes.NewEmailRepository()
+	auditRepo := &fakes.AuditLogRepository{}
+
+	locationRepo := &fakes.LocationRepository{}
+	policyUseCase := usecases.NewBookingPolicyUseCase(&fakes.BookingPolicyRepository{}, bookingRepo, userRepo, chairRepo, locationRepo, auditRepo, nil)
+	penaltyUseCase := usecases.NewPenaltyUseCase(&fakes.PenaltyRepository{}, userRepo, auditRepo, emailRepo, entities.PenaltySettings{})
+	bookingUseCase := usecases.NewBookingUseCase(bookingRepo, chairRepo, userRepo, fakes.AvailabilityRepository{}, nil,
+		waitlistRepo, holdRepo, fakes.TherapistRepository{}, locationRepo, policyUseCase, penaltyUseCase, auditRepo, emailRepo, nil)
+	handler := NewWaitlistHandler(usecases.NewWaitlistUseCase(waitlistRepo, chairRepo, auditRepo, bookingUseCase, nil))
+
+	router := gin.New()
+	router.GET("/api/bookings/waitlist/offers/:token", handler.GetOffer)
+	router.POST("/api/bookings/waitlist/offers/:token/accept", handler.AcceptOffer)
+	router.POST("/api/bookings/waitlist/offers/:token/decline", handler.DeclineOffer)
+
+	// A vaga liberada é ofertada por email
+	bookingUseCase.ReleaseSlotToWaitlist(chair.ID, startTime, startTime.Add(entities.DefaultSessionDuration))
+	offer := fakes.Receive(t, emailRepo.WaitlistOffers)
+
+	// O link do email abre a página da oferta, que não aceita sozinha
+	recorder := browse(router, http.MethodGet, "/api/bookings/waitlist/offers/"+offer.Token)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+	assert.Equal(t, entities.WaitlistStatusOffered, waitlistRepo.Get(1).Status)
+
+	// O usuário envia o formulário de aceite
+	actions := formActions(recorder.Body.String())
+	require.Len(t, actions, 2)
+	assert.True(t, strings.HasSuffix(actions[0], "/accept"))
+	assert.True(t, strings.HasSuffix(actions[1], "/decline"))
+	recorder = browse(router, http.MethodPost, actions[0])
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Vaga confirmada")
+
+	entry := waitlistRepo.Get(1)
+	assert.Equal(t, entities.WaitlistStatusFulfilled, entry.Status)
+	require.NotNil(t, entry.BookingID)
+	booking, err := bookingRepo.GetByID(*entry.BookingID)
+	require.NoError(t, err)
+	assert.True(t, booking.StartTime.Equal(startTime))
+
+	// O mesmo link não serve mais
+	recorder = browse(router, http.MethodGet, "/api/bookings/waitlist/offers/"+offer.Token)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Empty(t, formActions(recorder.Body.String()))
+}
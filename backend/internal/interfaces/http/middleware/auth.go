@@ -15,14 +15,26 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Tipos de token, gravados na claim typ. Um refresh token não vale como token de
+// acesso e vice-versa, embora os dois sejam assinados com o mesmo segredo
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 type Claims struct {
-	UserID uint   `json:"user_id"`
-	Role   string `json:"role"`
+	UserID    uint   `json:"user_id"`
+	Role      string `json:"role"`
+	TenantID  uint   `json:"tenant_id"` // tenant do usuário; zero para super admins
+	TokenType string `json:"typ"`
+	SessionID uint   `json:"sid,omitempty"` // sessão do token de acesso
+	FamilyID  string `json:"fid,omitempty"` // família do refresh token; o jti identifica o token
 	jwt.RegisteredClaims
 }
 
-// AuthMiddleware middleware de autenticação JWT
-func AuthMiddleware(userUseCase *usecases.UserUseCase) gin.HandlerFunc {
+// AuthMiddleware middleware de autenticação JWT. Além do token, confere se a sessão
+// dele continua aberta, para que logout e encerramento de sessões valham na hora
+func AuthMiddleware(userUseCase *usecases.UserUseCase, sessionUseCase *usecases.RefreshTokenUseCase) gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
 		token := extractToken(c)
 		if token == "" {
@@ -38,6 +50,23 @@ func AuthMiddleware(userUseCase *usecases.UserUseCase) gin.HandlerFunc {
 			return
 		}
 
+		// O ID do usuário só tem sentido dentro do tenant que emitiu o token
+		if tenantID, _ := GetTenantIDFromContext(c); claims.TenantID != tenantID {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token não pertence a este tenant"})
+			c.Abort()
+			return
+		}
+
+		session, err := sessionUseCase.ValidateSession(claims.SessionID, claims.UserID, entities.DeviceInfo{
+			UserAgent: c.GetHeader("User-Agent"),
+			IPAddress: c.ClientIP(),
+		})
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Sessão encerrada. Faça login novamente"})
+			c.Abort()
+			return
+		}
+
 		// Verificar se o usuário ainda existe e está ativo
 		user, err := userUseCase.GetUserByID(claims.UserID)
 		if err != nil {
@@ -56,6 +85,7 @@ func AuthMiddleware(userUseCase *usecases.UserUseCase) gin.HandlerFunc {
 		c.Set("user_id", claims.UserID)
 		c.Set("user_role", claims.Role)
 		c.Set("user", user)
+		c.Set("session_id", session.ID)
 
 		c.Next()
 	})
@@ -81,6 +111,26 @@ func AdminOnlyMiddleware() gin.HandlerFunc {
 	})
 }
 
+// SuperAdminOnlyMiddleware middleware que permite apenas super admins (cadastro de tenants)
+func SuperAdminOnlyMiddleware() gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		userRole, exists := c.Get("user_role")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Usuário não autenticado"})
+			c.Abort()
+			return
+		}
+
+		if userRole != "super_admin" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Acesso negado. Apenas super administradores podem realizar esta operação"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
+
 // AdminOrAttendantMiddleware middleware que permite admins e atendentes
 func AdminOrAttendantMiddleware() gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
@@ -122,13 +172,16 @@ func UserApprovalMiddleware() gin.HandlerFunc {
 	})
 }
 
-// GenerateToken gera um token JWT para o usuário
-func GenerateToken(user *entities.User) (string, time.Time, error) {
+// GenerateToken gera um token JWT de acesso para o usuário, vinculado à sessão
+func GenerateToken(user *entities.User, sessionID uint) (string, time.Time, error) {
 	expirationTime := time.Now().Add(24 * time.Hour) // 24 horas
 
 	claims := &Claims{
-		UserID: user.ID,
-		Role:   user.Role,
+		UserID:    user.ID,
+		Role:      user.Role,
+		TenantID:  user.TenantID,
+		TokenType: TokenTypeAccess,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -147,15 +200,18 @@ func GenerateToken(user *entities.User) (string, time.Time, error) {
 	return tokenString, expirationTime, nil
 }
 
-// GenerateRefreshToken gera um refresh token
-func GenerateRefreshToken(user *entities.User) (string, time.Time, error) {
-	expirationTime := time.Now().Add(7 * 24 * time.Hour) // 7 dias
-
+// GenerateRefreshToken gera o JWT de um refresh token registrado no banco. O jti
+// carrega o identificador secreto do token e a claim fid, a família da sessão
+func GenerateRefreshToken(user *entities.User, tokenID, familyID string, expiresAt time.Time) (string, error) {
 	claims := &Claims{
-		UserID: user.ID,
-		Role:   user.Role,
+		UserID:    user.ID,
+		Role:      user.Role,
+		TenantID:  user.TenantID,
+		TokenType: TokenTypeRefresh,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			Issuer:    "agendamento-backend",
@@ -164,27 +220,17 @@ func GenerateRefreshToken(user *entities.User) (string, time.Time, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(getJWTSecret()))
-	if err != nil {
-		return "", time.Time{}, err
-	}
-
-	return tokenString, expirationTime, nil
+	return token.SignedString([]byte(getJWTSecret()))
 }
 
-// ValidateRefreshToken valida um refresh token
+// ValidateRefreshToken valida um refresh token. Tokens de acesso são recusados
 func ValidateRefreshToken(tokenString string) (*Claims, error) {
-	claims := &Claims{}
-
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(getJWTSecret()), nil
-	})
-
+	claims, err := parseToken(tokenString)
 	if err != nil {
 		return nil, err
 	}
 
-	if !token.Valid {
+	if claims.TokenType != TokenTypeRefresh || claims.ID == "" || claims.FamilyID == "" {
 		return nil, errors.New("token inválido")
 	}
 
@@ -207,13 +253,28 @@ func extractToken(c *gin.Context) string {
 	return parts[1]
 }
 
-// validateToken valida e decodifica o token JWT
+// validateToken valida e decodifica o token de acesso. Refresh tokens e tokens sem
+// tipo ou sem sessão são recusados
 func validateToken(tokenString string) (*Claims, error) {
+	claims, err := parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != TokenTypeAccess || claims.SessionID == 0 {
+		return nil, errors.New("tipo de token inválido")
+	}
+
+	return claims, nil
+}
+
+// parseToken verifica a assinatura e a validade do JWT e decodifica as claims
+func parseToken(tokenString string) (*Claims, error) {
 	claims := &Claims{}
 
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(getJWTSecret()), nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 
 	if err != nil {
 		return nil, err
@@ -263,6 +324,17 @@ func GetUserIDFromContext(c *gin.Context) (uint, bool) {
 	return 0, false
 }
 
+// GetSessionIDFromContext obtém o ID da sessão do token de acesso
+func GetSessionIDFromContext(c *gin.Context) (uint, bool) {
+	sessionID, exists := c.Get("session_id")
+	if !exists {
+		return 0, false
+	}
+
+	id, ok := sessionID.(uint)
+	return id, ok
+}
+
 // GetUserRoleFromContext obtém o role do usuário do contexto
 func GetUserRoleFromContext(c *gin.Context) (string, bool) {
 	userRole, exists := c.Get("user_role")
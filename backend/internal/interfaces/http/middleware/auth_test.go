@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenTypes(t *testing.T) {
+	user := &entities.User{ID: 7, Role: "usuario", TenantID: 3}
+
+	accessToken, _, err := GenerateToken(user, 5)
+	require.NoError(t, err)
+
+	refreshToken, err := GenerateRefreshToken(user, "token-id", "familia", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	t.Run("Token de acesso vale como bearer", func(t *testing.T) {
+		claims, err := validateToken(accessToken)
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeAccess, claims.TokenType)
+		assert.Equal(t, uint(7), claims.UserID)
+		assert.Equal(t, uint(5), claims.SessionID)
+	})
+
+	t.Run("Token de acesso sem sessão é recusado", func(t *testing.T) {
+		withoutSession, _, err := GenerateToken(user, 0)
+		require.NoError(t, err)
+		_, err = validateToken(withoutSession)
+		assert.Error(t, err)
+	})
+
+	t.Run("Refresh token não vale como bearer", func(t *testing.T) {
+		_, err := validateToken(refreshToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("Refresh token carrega identificador e família", func(t *testing.T) {
+		claims, err := ValidateRefreshToken(refreshToken)
+		require.NoError(t, err)
+		assert.Equal(t, "token-id", claims.ID)
+		assert.Equal(t, "familia", claims.FamilyID)
+		assert.Equal(t, uint(3), claims.TenantID)
+	})
+
+	t.Run("Token de acesso não vale como refresh token", func(t *testing.T) {
+		_, err := ValidateRefreshToken(accessToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("Refresh token vencido é recusado", func(t *testing.T) {
+		expired, err := GenerateRefreshToken(user, "token-id", "familia", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		_, err = ValidateRefreshToken(expired)
+		assert.Error(t, err)
+	})
+}
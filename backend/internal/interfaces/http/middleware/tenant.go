@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"agendamento-backend/internal/application/usecases"
+	"agendamento-backend/internal/domain/entities"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TenantMiddleware identifica o tenant da requisição: primeiro pelo subdomínio do
+// domínio base, depois pelo tenant_id do token e, sem nenhum dos dois, pelo tenant
+// padrão. Tenant inexistente responde 404; desativado, 403
+func TenantMiddleware(tenantUseCase *usecases.TenantUseCase, baseDomain, defaultSlug string) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		tenant, err := resolveTenant(c, tenantUseCase, baseDomain, defaultSlug)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tenant não encontrado"})
+			c.Abort()
+			return
+		}
+
+		if !tenant.IsActive {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Tenant desativado. Entre em contato com o suporte"})
+			c.Abort()
+			return
+		}
+
+		c.Set("tenant", tenant)
+		c.Next()
+	})
+}
+
+// TenantContext fixa o tenant atendido por um router. Cada tenant tem o seu router,
+// e o AuthMiddleware recusa tokens emitidos para outro tenant
+func TenantContext(tenantID uint) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		c.Set("tenant_id", tenantID)
+		c.Next()
+	})
+}
+
+// TenantSlugFromHost extrai o subdomínio do host: "acme.agenda.com:8080" com domínio
+// base "agenda.com" resulta em "acme". O próprio domínio base, hosts fora dele e
+// subdomínios de mais de um nível não identificam tenant
+func TenantSlugFromHost(host, baseDomain string) string {
+	if baseDomain == "" {
+		return ""
+	}
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	slug, found := strings.CutSuffix(host, "."+strings.ToLower(baseDomain))
+	if !found || slug == "" || strings.Contains(slug, ".") {
+		return ""
+	}
+	return slug
+}
+
+// GetTenantFromContext obtém o tenant identificado pelo TenantMiddleware
+func GetTenantFromContext(c *gin.Context) (*entities.Tenant, bool) {
+	tenant, exists := c.Get("tenant")
+	if !exists {
+		return nil, false
+	}
+
+	tenantObj, ok := tenant.(*entities.Tenant)
+	return tenantObj, ok
+}
+
+// GetTenantIDFromContext obtém o tenant atendido pelo router
+func GetTenantIDFromContext(c *gin.Context) (uint, bool) {
+	tenantID, exists := c.Get("tenant_id")
+	if !exists {
+		return 0, false
+	}
+
+	id, ok := tenantID.(uint)
+	return id, ok
+}
+
+// resolveTenant busca o tenant pelo subdomínio, pelo token ou pelo slug padrão
+func resolveTenant(c *gin.Context, tenantUseCase *usecases.TenantUseCase, baseDomain, defaultSlug string) (*entities.Tenant, error) {
+	if slug := TenantSlugFromHost(c.Request.Host, baseDomain); slug != "" {
+		return tenantUseCase.GetTenantBySlug(slug)
+	}
+
+	if token := extractToken(c); token != "" {
+		if claims, err := validateToken(token); err == nil && claims.TenantID != entities.PlatformTenantID {
+			return tenantUseCase.GetTenant(claims.TenantID)
+		}
+	}
+
+	return tenantUseCase.GetTenantBySlug(defaultSlug)
+}
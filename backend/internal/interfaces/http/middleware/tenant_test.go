@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantSlugFromHost(t *testing.T) {
+	tests := []struct {
+		name       string
+		host       string
+		baseDomain string
+		expected   string
+	}{
+		{"Subdomínio do domínio base", "acme.agenda.com", "agenda.com", "acme"},
+		{"Subdomínio com porta", "acme.agenda.com:8080", "agenda.com", "acme"},
+		{"Maiúsculas no host", "ACME.Agenda.com", "agenda.com", "acme"},
+		{"Próprio domínio base", "agenda.com", "agenda.com", ""},
+		{"Host fora do domínio base", "acme.outro.com", "agenda.com", ""},
+		{"Subdomínio de dois níveis", "a.acme.agenda.com", "agenda.com", ""},
+		{"Sem domínio base configurado", "acme.agenda.com", "", ""},
+		{"Domínio que só termina igual", "acmeagenda.com", "agenda.com", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TenantSlugFromHost(tt.host, tt.baseDomain))
+		})
+	}
+}
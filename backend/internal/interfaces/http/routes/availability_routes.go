@@ -14,10 +14,14 @@ func SetupAvailabilityRoutes(router *gin.RouterGroup, availabilityHandler *handl
 		// Rotas de consulta (todos os usuários autenticados)
 		availabilities.GET("/:id", availabilityHandler.GetAvailability)
 		availabilities.GET("", availabilityHandler.ListAvailabilities)
+		availabilities.GET("/search", availabilityHandler.SearchAvailableSlots)
 		availabilities.GET("/chair/:chair_id", availabilityHandler.GetChairAvailabilities)
 		availabilities.GET("/chair/:chair_id/slots", availabilityHandler.GetAvailableTimeSlots)
 		availabilities.GET("/chair/:chair_id/available-slots", availabilityHandler.GetAvailableTimeSlots)
-		availabilities.GET("/chair/:chair_id/next-15-days", availabilityHandler.GetNext15DaysAvailableSlots)
+		availabilities.GET("/chair/:chair_id/upcoming", availabilityHandler.GetUpcomingAvailableSlots)
+		availabilities.GET("/chair/:chair_id/next-15-days", availabilityHandler.GetUpcomingAvailableSlots)
+		availabilities.GET("/chair/:chair_id/exceptions", availabilityHandler.ListExceptions)
+		availabilities.GET("/chair/:chair_id/exceptions/:exception_id", availabilityHandler.GetException)
 		availabilities.GET("/stats", availabilityHandler.GetAvailabilityStats)
 
 		// Rotas restritas a admin (gerenciamento de disponibilidade)
@@ -34,6 +38,11 @@ func SetupAvailabilityRoutes(router *gin.RouterGroup, availabilityHandler *handl
 			adminOnly.POST("/:id/activate", availabilityHandler.ActivateAvailability)
 			adminOnly.POST("/:id/deactivate", availabilityHandler.DeactivateAvailability)
 			adminOnly.PUT("/:id/validity", availabilityHandler.SetValidityPeriod)
+
+			// Exceções por data (apenas admin)
+			adminOnly.POST("/chair/:chair_id/exceptions", availabilityHandler.CreateException)
+			adminOnly.PUT("/chair/:chair_id/exceptions/:exception_id", availabilityHandler.UpdateException)
+			adminOnly.DELETE("/chair/:chair_id/exceptions/:exception_id", availabilityHandler.DeleteException)
 		}
 	}
 }
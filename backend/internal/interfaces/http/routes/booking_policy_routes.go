@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupBookingPolicyRoutes configura as rotas de políticas de agendamento
+func SetupBookingPolicyRoutes(router *gin.RouterGroup, policyHandler *handlers.BookingPolicyHandler) {
+	policies := router.Group("/policies")
+	{
+		// Regras efetivas (todos os usuários autenticados)
+		policies.GET("/effective", policyHandler.GetEffectivePolicy)
+
+		// Rotas restritas a admin
+		adminOnly := policies.Group("/")
+		adminOnly.Use(middleware.AdminOnlyMiddleware())
+		{
+			adminOnly.GET("", policyHandler.ListPolicies)
+			adminOnly.GET("/:id", policyHandler.GetPolicy)
+			adminOnly.POST("", policyHandler.CreatePolicy)
+			adminOnly.PUT("/:id", policyHandler.UpdatePolicy)
+			adminOnly.DELETE("/:id", policyHandler.DeletePolicy)
+		}
+	}
+}
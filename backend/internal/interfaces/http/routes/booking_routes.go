@@ -13,6 +13,7 @@ func SetupBookingRoutes(router *gin.RouterGroup, bookingHandler *handlers.Bookin
 	{
 		// Rotas para usuários (visualizar e agendar)
 		bookings.POST("", bookingHandler.CreateBooking)
+		bookings.POST("/any-chair", bookingHandler.CreateAnyChairBooking)
 		bookings.GET("", bookingHandler.ListBookings)
 		bookings.GET("/today", bookingHandler.GetTodayBookings)
 		bookings.GET("/upcoming", bookingHandler.GetUpcomingBookings)
@@ -35,6 +36,7 @@ func SetupBookingRoutes(router *gin.RouterGroup, bookingHandler *handlers.Bookin
 		// Rotas com parâmetros (depois das rotas específicas)
 		bookings.GET("/user/:user_id", bookingHandler.GetUserBookings) // Rota com parâmetro depois
 		bookings.GET("/:id", bookingHandler.GetBooking)
+		bookings.GET("/:id/history", bookingHandler.GetBookingHistory)
 		bookings.POST("/:id/cancel", bookingHandler.CancelBooking)
 		bookings.PATCH("/:id/cancel", bookingHandler.CancelBooking)
 
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupCalendarFeedRoutes configura o feed público, acessado pelos aplicativos de calendário
+func SetupCalendarFeedRoutes(router *gin.RouterGroup, calendarHandler *handlers.CalendarHandler) {
+	router.GET("/calendar/:token", calendarHandler.GetFeed)
+}
+
+// SetupCalendarRoutes configura as rotas autenticadas de gerenciamento do feed
+func SetupCalendarRoutes(router *gin.RouterGroup, calendarHandler *handlers.CalendarHandler) {
+	feed := router.Group("/bookings/calendar-feed")
+	{
+		feed.GET("", calendarHandler.GetFeedURL)
+		feed.POST("/rotate", calendarHandler.RotateFeedURL)
+	}
+}
@@ -17,6 +17,21 @@ func SetupChairRoutes(router *gin.RouterGroup, chairHandler *handlers.ChairHandl
 		chairs.GET("/active", chairHandler.GetActiveChairs)
 		chairs.GET("/available", chairHandler.GetAvailableChairs)
 		chairs.GET("/stats", chairHandler.GetChairStats)
+		chairs.GET("/:id/breaks", chairHandler.ListBreaks)
+		chairs.GET("/:id/maintenances", chairHandler.ListMaintenances)
+
+		// Pausas e manutenções podem ser mantidas por admins e atendentes
+		staff := chairs.Group("/")
+		staff.Use(middleware.AdminOrAttendantMiddleware())
+		{
+			staff.POST("/:id/breaks", chairHandler.CreateBreak)
+			staff.PUT("/:id/breaks/:break_id", chairHandler.UpdateBreak)
+			staff.DELETE("/:id/breaks/:break_id", chairHandler.DeleteBreak)
+
+			staff.POST("/:id/maintenances", chairHandler.CreateMaintenance)
+			staff.POST("/:id/maintenances/preview", chairHandler.PreviewMaintenance)
+			staff.DELETE("/:id/maintenances/:maintenance_id", chairHandler.DeleteMaintenance)
+		}
 
 		// Rotas restritas a admin
 		adminOnly := chairs.Group("/")
@@ -29,6 +44,7 @@ func SetupChairRoutes(router *gin.RouterGroup, chairHandler *handlers.ChairHandl
 
 			// Operação de status (apenas admin)
 			adminOnly.PATCH("/:id/toggle-status", chairHandler.ToggleChairStatus)
+			adminOnly.GET("/:id/deactivation-preview", chairHandler.PreviewDeactivation)
 		}
 	}
 }
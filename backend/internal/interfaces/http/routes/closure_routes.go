@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupClosureRoutes configura as rotas de feriados e fechamentos
+func SetupClosureRoutes(router *gin.RouterGroup, closureHandler *handlers.ClosureHandler) {
+	closures := router.Group("/closures")
+	{
+		// Consulta (todos os usuários autenticados)
+		closures.GET("", closureHandler.ListClosures)
+
+		// Agendamentos atingidos (atendentes e admins)
+		staff := closures.Group("/")
+		staff.Use(middleware.AdminOrAttendantMiddleware())
+		{
+			staff.GET("/:id/bookings", closureHandler.GetAffectedBookings)
+		}
+
+		// Gerenciamento restrito a admin
+		adminOnly := closures.Group("/")
+		adminOnly.Use(middleware.AdminOnlyMiddleware())
+		{
+			adminOnly.POST("", closureHandler.CreateClosure)
+			adminOnly.POST("/import", closureHandler.ImportClosures)
+			adminOnly.DELETE("/:id", closureHandler.DeleteClosure)
+			adminOnly.POST("/:id/cancel-bookings", closureHandler.CancelAffectedBookings)
+		}
+	}
+}
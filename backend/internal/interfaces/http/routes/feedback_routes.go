@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupFeedbackRoutes configura as rotas autenticadas de avaliação das sessões
+func SetupFeedbackRoutes(router *gin.RouterGroup, feedbackHandler *handlers.FeedbackHandler) {
+	bookings := router.Group("/bookings")
+	{
+		// Avaliação da sessão (o próprio usuário avalia; equipe consulta)
+		bookings.POST("/:id/feedback", feedbackHandler.SubmitFeedback)
+		bookings.GET("/:id/feedback", feedbackHandler.GetBookingFeedback)
+
+		// Avaliações com comentários (atendentes e admins)
+		bookings.GET("/feedback", middleware.AdminOrAttendantMiddleware(), feedbackHandler.ListFeedbacks)
+	}
+}
+
+// SetupFeedbackLinkRoutes configura as rotas públicas dos links do convite de avaliação
+func SetupFeedbackLinkRoutes(router *gin.RouterGroup, feedbackHandler *handlers.FeedbackHandler) {
+	feedback := router.Group("/bookings/feedback")
+	{
+		feedback.GET("/:token", feedbackHandler.GetFeedbackInvite)
+		feedback.POST("/:token", feedbackHandler.SubmitFeedbackByToken)
+	}
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupLocationRoutes configura as rotas de localidades
+func SetupLocationRoutes(router *gin.RouterGroup, locationHandler *handlers.LocationHandler) {
+	locations := router.Group("/locations")
+	{
+		// Consulta (todos os usuários autenticados)
+		locations.GET("", locationHandler.ListLocations)
+		locations.GET("/:id", locationHandler.GetLocation)
+
+		// Gerenciamento restrito a admin
+		adminOnly := locations.Group("/")
+		adminOnly.Use(middleware.AdminOnlyMiddleware())
+		{
+			adminOnly.POST("", locationHandler.CreateLocation)
+			adminOnly.PUT("/:id", locationHandler.UpdateLocation)
+			adminOnly.DELETE("/:id", locationHandler.DeleteLocation)
+
+			// Atendentes por localidade
+			adminOnly.GET("/:id/attendants", locationHandler.ListAttendants)
+			adminOnly.PUT("/:id/attendants/:user_id", locationHandler.AssignAttendant)
+			adminOnly.DELETE("/:id/attendants/:user_id", locationHandler.RemoveAttendant)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupPenaltyRoutes configura as rotas de penalidades e suspensões
+func SetupPenaltyRoutes(router *gin.RouterGroup, penaltyHandler *handlers.PenaltyHandler) {
+	penalties := router.Group("/penalties")
+	{
+		// Situação do próprio usuário (todos os usuários autenticados)
+		penalties.GET("/me", penaltyHandler.GetMyPenaltyStatus)
+
+		// Consulta para atendentes e admins
+		staff := penalties.Group("/")
+		staff.Use(middleware.AdminOrAttendantMiddleware())
+		{
+			staff.GET("/suspensions", penaltyHandler.ListActiveSuspensions)
+			staff.GET("/users/:user_id", penaltyHandler.GetUserPenaltyStatus)
+			staff.GET("/users/:user_id/suspensions", penaltyHandler.GetUserSuspensions)
+		}
+
+		// Liberação manual restrita a admin
+		adminOnly := penalties.Group("/")
+		adminOnly.Use(middleware.AdminOnlyMiddleware())
+		{
+			adminOnly.POST("/users/:user_id/lift", penaltyHandler.LiftSuspension)
+		}
+	}
+}
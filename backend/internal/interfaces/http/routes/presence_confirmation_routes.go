@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupPresenceConfirmationRoutes configura as rotas públicas dos links de confirmação enviados no lembrete
+func SetupPresenceConfirmationRoutes(router *gin.RouterGroup, presenceHandler *handlers.PresenceConfirmationHandler) {
+	presence := router.Group("/bookings/presence")
+	{
+		presence.GET("/:token", presenceHandler.GetBooking)
+		presence.POST("/:token/confirm", presenceHandler.ConfirmPresence)
+		presence.POST("/:token/cancel", presenceHandler.CancelBooking)
+	}
+}
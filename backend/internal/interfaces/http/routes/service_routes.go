@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupServiceRoutes configura as rotas do catálogo de serviços
+func SetupServiceRoutes(router *gin.RouterGroup, serviceHandler *handlers.ServiceHandler) {
+	services := router.Group("/services")
+	{
+		// Rotas de consulta (todos os usuários autenticados)
+		services.GET("", serviceHandler.ListServices)
+		services.GET("/active", serviceHandler.GetActiveServices)
+		services.GET("/chair/:chair_id", serviceHandler.GetChairServices)
+		services.GET("/:id", serviceHandler.GetService)
+
+		// Rotas restritas a admin
+		adminOnly := services.Group("/")
+		adminOnly.Use(middleware.AdminOnlyMiddleware())
+		{
+			adminOnly.POST("", serviceHandler.CreateService)
+			adminOnly.PUT("/:id", serviceHandler.UpdateService)
+			adminOnly.DELETE("/:id", serviceHandler.DeleteService)
+			adminOnly.PUT("/chair/:chair_id", serviceHandler.SetChairServices)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupSessionRoutes configura as rotas das sessões do próprio usuário
+func SetupSessionRoutes(router *gin.RouterGroup, authHandler *handlers.AuthHandler) {
+	sessions := router.Group("/auth/sessions")
+	{
+		sessions.GET("", authHandler.ListSessions)
+		sessions.DELETE("", authHandler.RevokeAllSessions) // Sair de todos os dispositivos
+		sessions.DELETE("/:id", authHandler.RevokeSession)
+	}
+}
+
+// SetupUserSessionRoutes configura as rotas de sessões de outros usuários (apenas admins)
+func SetupUserSessionRoutes(router *gin.RouterGroup, authHandler *handlers.AuthHandler) {
+	users := router.Group("/users")
+	users.Use(middleware.AdminOnlyMiddleware())
+	{
+		users.GET("/:id/sessions", authHandler.ListUserSessions)
+		users.DELETE("/:id/sessions", authHandler.ForceLogoutUser) // Logout forçado
+	}
+}
+
+// SetupUserLockoutRoutes configura as rotas do bloqueio de login por senhas erradas
+// (apenas admins)
+func SetupUserLockoutRoutes(router *gin.RouterGroup, authHandler *handlers.AuthHandler) {
+	users := router.Group("/users")
+	users.Use(middleware.AdminOnlyMiddleware())
+	{
+		users.GET("/:id/lockout", authHandler.GetUserLockout)
+		users.DELETE("/:id/lockout", authHandler.UnlockUser) // Desbloqueio antes do prazo
+	}
+}
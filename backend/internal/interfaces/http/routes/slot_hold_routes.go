@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupSlotHoldRoutes configura as rotas de reserva temporária de horários
+func SetupSlotHoldRoutes(router *gin.RouterGroup, slotHoldHandler *handlers.SlotHoldHandler) {
+	holds := router.Group("/bookings/holds")
+	{
+		holds.POST("", slotHoldHandler.CreateHold)
+		holds.GET("/:token", slotHoldHandler.GetHold)
+		holds.DELETE("/:token", slotHoldHandler.ReleaseHold)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupTenantRoutes configura as rotas de cadastro de tenants da plataforma
+func SetupTenantRoutes(router *gin.RouterGroup, tenantHandler *handlers.TenantHandler) {
+	tenants := router.Group("/tenants")
+	tenants.Use(middleware.SuperAdminOnlyMiddleware())
+	{
+		tenants.GET("", tenantHandler.ListTenants)
+		tenants.GET("/:id", tenantHandler.GetTenant)
+		tenants.POST("", tenantHandler.CreateTenant)
+		tenants.PUT("/:id", tenantHandler.UpdateTenant)
+		tenants.POST("/:id/admins", tenantHandler.CreateTenantAdmin)
+	}
+}
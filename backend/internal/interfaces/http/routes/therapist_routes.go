@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupTherapistRoutes configura as rotas de massoterapeutas, turnos e ausências
+func SetupTherapistRoutes(router *gin.RouterGroup, therapistHandler *handlers.TherapistHandler) {
+	therapists := router.Group("/therapists")
+	{
+		// Consulta e ausências (admins e atendentes)
+		staff := therapists.Group("/")
+		staff.Use(middleware.AdminOrAttendantMiddleware())
+		{
+			staff.GET("", therapistHandler.ListTherapists)
+			staff.GET("/:id", therapistHandler.GetTherapist)
+			staff.GET("/:id/shifts", therapistHandler.ListShifts)
+			staff.GET("/:id/absences", therapistHandler.ListAbsences)
+			staff.POST("/:id/absences", therapistHandler.CreateAbsence)
+			staff.DELETE("/:id/absences/:absence_id", therapistHandler.DeleteAbsence)
+		}
+
+		// Cadastro, escala e horas restritos a admin
+		adminOnly := therapists.Group("/")
+		adminOnly.Use(middleware.AdminOnlyMiddleware())
+		{
+			adminOnly.POST("", therapistHandler.CreateTherapist)
+			adminOnly.PUT("/:id", therapistHandler.UpdateTherapist)
+			adminOnly.DELETE("/:id", therapistHandler.DeleteTherapist)
+
+			adminOnly.POST("/:id/shifts", therapistHandler.CreateShift)
+			adminOnly.PUT("/:id/shifts/:shift_id", therapistHandler.UpdateShift)
+			adminOnly.DELETE("/:id/shifts/:shift_id", therapistHandler.DeleteShift)
+
+			adminOnly.GET("/:id/hours", therapistHandler.GetTherapistHours)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"agendamento-backend/internal/interfaces/http/handlers"
+	"agendamento-backend/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupWaitlistRoutes configura as rotas autenticadas da lista de espera
+func SetupWaitlistRoutes(router *gin.RouterGroup, waitlistHandler *handlers.WaitlistHandler) {
+	waitlist := router.Group("/bookings/waitlist")
+	{
+		// Rotas para usuários
+		waitlist.POST("", waitlistHandler.JoinWaitlist)
+		waitlist.GET("", waitlistHandler.GetMyWaitlistEntries)
+		waitlist.DELETE("/:id", waitlistHandler.LeaveWaitlist)
+
+		// Fila de uma cadeira (atendentes e admins)
+		waitlist.GET("/chair/:chair_id", middleware.AdminOrAttendantMiddleware(), waitlistHandler.GetChairQueue)
+	}
+}
+
+// SetupWaitlistOfferRoutes configura as rotas públicas dos links de oferta enviados por email
+func SetupWaitlistOfferRoutes(router *gin.RouterGroup, waitlistHandler *handlers.WaitlistHandler) {
+	offers := router.Group("/bookings/waitlist/offers")
+	{
+		offers.GET("/:token", waitlistHandler.GetOffer)
+		offers.POST("/:token/accept", waitlistHandler.AcceptOffer)
+		offers.POST("/:token/decline", waitlistHandler.DeclineOffer)
+	}
+}
@@ -9,9 +9,9 @@ import (
 )
 
 // SetupBookingRoutes configura as rotas de agendamentos
-func SetupBookingRoutes(router *gin.RouterGroup, bookingHandler *handlers.BookingHandler, userUseCase *usecases.UserUseCase) {
+func SetupBookingRoutes(router *gin.RouterGroup, bookingHandler *handlers.BookingHandler, userUseCase *usecases.UserUseCase, sessionUseCase *usecases.RefreshTokenUseCase) {
 	bookings := router.Group("/bookings")
-	bookings.Use(middleware.AuthMiddleware(userUseCase)) // Requer autenticação
+	bookings.Use(middleware.AuthMiddleware(userUseCase, sessionUseCase)) // Requer autenticação
 	{
 		// Criar agendamento
 		bookings.POST("", bookingHandler.CreateBooking)
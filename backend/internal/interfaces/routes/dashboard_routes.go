@@ -9,9 +9,9 @@ import (
 )
 
 // SetupDashboardRoutes configura as rotas do dashboard
-func SetupDashboardRoutes(router *gin.RouterGroup, dashboardHandler *handlers.DashboardHandler, userUseCase *usecases.UserUseCase) {
+func SetupDashboardRoutes(router *gin.RouterGroup, dashboardHandler *handlers.DashboardHandler, userUseCase *usecases.UserUseCase, sessionUseCase *usecases.RefreshTokenUseCase) {
 	dashboard := router.Group("/dashboard")
-	dashboard.Use(middleware.AuthMiddleware(userUseCase)) // Requer autenticação
+	dashboard.Use(middleware.AuthMiddleware(userUseCase, sessionUseCase)) // Requer autenticação
 	{
 		// Dashboard operacional unificado (para admin e atendente)
 		dashboard.GET("/operational", middleware.AdminOrAttendantMiddleware(), dashboardHandler.GetOperationalDashboard)
@@ -31,6 +31,9 @@ func SetupDashboardRoutes(router *gin.RouterGroup, dashboardHandler *handlers.Da
 		// Estatísticas de comparecimento e cancelamento (atendentes e admins)
 		dashboard.GET("/stats/attendance", middleware.AdminOrAttendantMiddleware(), dashboardHandler.GetAttendanceStats)
 
+		// Satisfação e NPS das sessões (atendentes e admins)
+		dashboard.GET("/satisfaction", middleware.AdminOrAttendantMiddleware(), dashboardHandler.GetSatisfactionStats)
+
 		// Aprovações pendentes (apenas admins)
 		dashboard.GET("/pending-approvals", middleware.AdminOnlyMiddleware(), dashboardHandler.GetPendingApprovals)
 
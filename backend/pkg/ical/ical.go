@@ -0,0 +1,130 @@
+// Package ical gera calendários no formato iCalendar (RFC 5545) para convites
+// enviados por email e feeds de assinatura
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Métodos de um calendário (RFC 5546)
+const (
+	MethodPublish = "PUBLISH" // feed de assinatura
+	MethodRequest = "REQUEST" // convite novo ou atualizado
+	MethodCancel  = "CANCEL"  // cancelamento de um convite enviado
+)
+
+// Status de um evento
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Event representa um VEVENT. UID e Sequence identificam o evento entre o convite
+// original e suas atualizações
+type Event struct {
+	UID         string
+	Sequence    int
+	Start       time.Time
+	End         time.Time
+	AllDay      bool // preenchido na leitura de eventos de dia inteiro
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+	Organizer   string // email do organizador
+	Attendee    string // email do participante
+	URL         string
+}
+
+// Calendar representa um VCALENDAR
+type Calendar struct {
+	ProductID string
+	Name      string
+	Method    string
+	Events    []Event
+}
+
+// String serializa o calendário com quebras CRLF e linhas dobradas em 75 octetos
+func (c *Calendar) String() string {
+	var b strings.Builder
+	stamp := time.Now().UTC()
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+c.ProductID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	if c.Method != "" {
+		writeLine(&b, "METHOD:"+c.Method)
+	}
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+event.UID)
+		writeLine(&b, fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+		writeLine(&b, "DTSTAMP:"+formatTime(stamp))
+		writeLine(&b, "DTSTART:"+formatTime(event.Start))
+		writeLine(&b, "DTEND:"+formatTime(event.End))
+		writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.Location != "" {
+			writeLine(&b, "LOCATION:"+escapeText(event.Location))
+		}
+		if event.Status != "" {
+			writeLine(&b, "STATUS:"+event.Status)
+		}
+		if event.Organizer != "" {
+			writeLine(&b, "ORGANIZER:mailto:"+event.Organizer)
+		}
+		if event.Attendee != "" {
+			writeLine(&b, "ATTENDEE;ROLE=REQ-PARTICIPANT;RSVP=FALSE:mailto:"+event.Attendee)
+		}
+		if event.URL != "" {
+			writeLine(&b, "URL:"+event.URL)
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// formatTime formata o horário em UTC no formato básico da RFC 5545
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapa os caracteres especiais de valores do tipo TEXT
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// writeLine escreve a linha dobrando-a a cada 75 octetos sem quebrar caracteres UTF-8
+func writeLine(b *strings.Builder, line string) {
+	const limit = 75
+
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+}
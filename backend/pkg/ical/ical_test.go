@@ -0,0 +1,167 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_String(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.FixedZone("BRT", -3*3600))
+	calendar := &Calendar{
+		ProductID: "-//Agendamento//PT",
+		Method:    MethodRequest,
+		Events: []Event{{
+			UID:       "booking-1@agendamento",
+			Sequence:  2,
+			Start:     start,
+			End:       start.Add(30 * time.Minute),
+			Summary:   "Massagem; cadeira 1, sala 2",
+			Location:  "Térreo",
+			Status:    StatusConfirmed,
+			Organizer: "noreply@agendamento.com",
+			Attendee:  "cliente@empresa.com",
+		}},
+	}
+
+	output := calendar.String()
+
+	assert.True(t, strings.HasPrefix(output, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(output, "END:VCALENDAR\r\n"))
+	assert.Contains(t, output, "METHOD:REQUEST\r\n")
+	assert.Contains(t, output, "UID:booking-1@agendamento\r\n")
+	assert.Contains(t, output, "SEQUENCE:2\r\n")
+	assert.Contains(t, output, "DTSTART:20250115T130000Z\r\n")
+	assert.Contains(t, output, "DTEND:20250115T133000Z\r\n")
+	assert.Contains(t, output, `SUMMARY:Massagem\; cadeira 1\, sala 2`)
+	assert.Contains(t, output, "ORGANIZER:mailto:noreply@agendamento.com\r\n")
+}
+
+func TestCalendar_FoldsLongLines(t *testing.T) {
+	calendar := &Calendar{
+		ProductID: "-//Agendamento//PT",
+		Events: []Event{{
+			UID:         "booking-1@agendamento",
+			Description: strings.Repeat("sessão ", 40),
+		}},
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(calendar.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"Texto simples", "Massagem", "Massagem"},
+		{"Vírgula e ponto e vírgula", "a,b;c", `a\,b\;c`},
+		{"Barra invertida", `a\b`, `a\\b`},
+		{"Quebra de linha", "linha 1\nlinha 2", `linha 1\nlinha 2`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, escapeText(tt.value))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	input := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:natal-2025@feriados\r\n" +
+		"DTSTART;VALUE=DATE:20251225\r\n" +
+		"DTEND;VALUE=DATE:20251226\r\n" +
+		"SUMMARY:Natal\\, feriado nacional\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:recesso@empresa\r\n" +
+		"DTSTART:20251229T030000Z\r\n" +
+		"DTEND:20251231T030000Z\r\n" +
+		"SUMMARY:Recesso de fim de ano com descrição muito longa que precisa ser dob\r\n" +
+		" rada\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:carnaval@feriados\r\n" +
+		"DTSTART;VALUE=DATE:20260216\r\n" +
+		"SUMMARY:Carnaval\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse(strings.NewReader(input))
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, "natal-2025@feriados", events[0].UID)
+	assert.Equal(t, "Natal, feriado nacional", events[0].Summary)
+	assert.True(t, events[0].AllDay)
+	assert.Equal(t, time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), events[0].End)
+
+	assert.False(t, events[1].AllDay)
+	assert.Equal(t, "Recesso de fim de ano com descrição muito longa que precisa ser dobrada", events[1].Summary)
+	assert.Equal(t, time.Date(2025, 12, 29, 3, 0, 0, 0, time.UTC), events[1].Start)
+
+	assert.Equal(t, time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC), events[2].End)
+}
+
+func TestParseInLocation(t *testing.T) {
+	local := time.FixedZone("GMT-3", -3*60*60)
+	input := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:flutuante@empresa\r\n" +
+		"DTSTART:20250101T000000\r\n" +
+		"DTEND:20250101T120000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:utc@empresa\r\n" +
+		"DTSTART:20250101T030000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:tzid@empresa\r\n" +
+		"DTSTART;TZID=America/Manaus:20250101T080000\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := ParseInLocation(strings.NewReader(input), local)
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	// Horário flutuante é horário local, não UTC
+	assert.True(t, events[0].Start.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, local)))
+	assert.True(t, events[0].End.Equal(time.Date(2025, 1, 1, 12, 0, 0, 0, local)))
+	assert.Equal(t, 1, events[0].Start.In(local).Day())
+
+	assert.True(t, events[1].Start.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, local)))
+
+	manaus, err := time.LoadLocation("America/Manaus")
+	if err == nil {
+		assert.True(t, events[2].Start.Equal(time.Date(2025, 1, 1, 8, 0, 0, 0, manaus)))
+	}
+}
+
+func TestParse_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"Arquivo sem calendário", "qualquer coisa\r\n"},
+		{"Data inválida", "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:2025-12-25\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Parse lê os eventos (VEVENT) de um arquivo iCalendar. Eventos de dia inteiro
+// (VALUE=DATE) são marcados com AllDay e, como na RFC 5545, têm DTEND exclusivo.
+// Horários flutuantes (sem Z e sem TZID) são interpretados em UTC; use
+// ParseInLocation para interpretá-los no horário local
+func Parse(r io.Reader) ([]Event, error) {
+	return ParseInLocation(r, time.UTC)
+}
+
+// ParseInLocation é como Parse, mas interpreta os horários flutuantes, que na RFC
+// 5545 são horário local, no fuso informado. TZID desconhecido também cai nele
+func ParseInLocation(r io.Reader, local *time.Location) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var current *Event
+	foundCalendar := false
+
+	for _, line := range lines {
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VCALENDAR":
+			foundCalendar = true
+		case name == "BEGIN" && value == "VEVENT":
+			current = &Event{}
+		case name == "END" && value == "VEVENT":
+			if current != nil {
+				if current.End.IsZero() {
+					current.End = current.Start
+					if current.AllDay {
+						current.End = current.Start.AddDate(0, 0, 1)
+					}
+				}
+				events = append(events, *current)
+			}
+			current = nil
+		case current == nil:
+			continue
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = unescapeText(value)
+		case name == "DESCRIPTION":
+			current.Description = unescapeText(value)
+		case name == "LOCATION":
+			current.Location = unescapeText(value)
+		case name == "STATUS":
+			current.Status = value
+		case name == "DTSTART":
+			start, allDay, err := parseTime(params, value, local)
+			if err != nil {
+				return nil, fmt.Errorf("DTSTART inválido: %w", err)
+			}
+			current.Start, current.AllDay = start, allDay
+		case name == "DTEND":
+			end, _, err := parseTime(params, value, local)
+			if err != nil {
+				return nil, fmt.Errorf("DTEND inválido: %w", err)
+			}
+			current.End = end
+		}
+	}
+
+	if !foundCalendar {
+		return nil, errors.New("arquivo não é um calendário iCalendar válido")
+	}
+
+	return events, nil
+}
+
+// unfold junta as linhas dobradas (iniciadas por espaço ou tab) à linha anterior
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+// splitProperty separa "NOME;PARAM=X:valor" em nome, parâmetros e valor
+func splitProperty(line string) (name string, params map[string]string, value string, ok bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	head := strings.Split(line[:colon], ";")
+	params = make(map[string]string)
+	for _, param := range head[1:] {
+		if key, val, found := strings.Cut(param, "="); found {
+			params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+	}
+
+	return strings.ToUpper(head[0]), params, line[colon+1:], true
+}
+
+// parseTime interpreta datas (VALUE=DATE), horários UTC, horários com TZID e
+// horários flutuantes, estes no fuso local
+func parseTime(params map[string]string, value string, local *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		date, err := time.Parse("20060102", value)
+		return date, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	location := local
+	if tzid := params["TZID"]; tzid != "" {
+		if loaded, err := time.LoadLocation(tzid); err == nil {
+			location = loaded
+		}
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", value, location)
+	return t, false, err
+}
+
+// unescapeText desfaz o escape de valores do tipo TEXT
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	)
+	return replacer.Replace(value)
+}
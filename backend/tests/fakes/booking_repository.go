@@ -0,0 +1,382 @@
+package fakes
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// BookingRepository guarda os agendamentos em memória. As reservas consumidas na
+// criação ficam no fake de reservas
+type BookingRepository struct {
+	repositories.BookingRepository
+	mu       sync.Mutex
+	bookings map[uint]*entities.Booking
+	nextID   uint
+	holds    *SlotHoldRepository
+}
+
+func NewBookingRepository(holds *SlotHoldRepository, bookings ...*entities.Booking) *BookingRepository {
+	r := &BookingRepository{bookings: make(map[uint]*entities.Booking), holds: holds}
+	for _, booking := range bookings {
+		r.bookings[booking.ID] = booking
+		if booking.ID > r.nextID {
+			r.nextID = booking.ID
+		}
+	}
+	return r
+}
+
+func (r *BookingRepository) Create(booking *entities.Booking) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	booking.ID = r.nextID
+	stored := *booking
+	r.bookings[booking.ID] = &stored
+	return nil
+}
+
+func (r *BookingRepository) CreateWithHold(booking *entities.Booking, hold *entities.SlotHold, now time.Time) error {
+	r.holds.mu.Lock()
+	defer r.holds.mu.Unlock()
+	for _, stored := range r.holds.holds {
+		if stored.ID != hold.ID {
+			continue
+		}
+		if !stored.IsActiveAt(now) {
+			return entities.ErrSlotHoldInactive
+		}
+		if err := r.Create(booking); err != nil {
+			return err
+		}
+		stored.Consume(booking.ID)
+		hold.Consume(booking.ID)
+		return nil
+	}
+	return entities.ErrSlotHoldInactive
+}
+
+func (r *BookingRepository) GetByID(id uint) (*entities.Booking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	booking, ok := r.bookings[id]
+	if !ok {
+		return nil, errNotFound
+	}
+	copied := *booking
+	return &copied, nil
+}
+
+func (r *BookingRepository) GetByChair(chairID uint, limit, offset int) ([]*entities.Booking, int64, error) {
+	bookings := r.filter(func(booking *entities.Booking) bool {
+		return booking.ChairID == chairID
+	})
+	return bookings, int64(len(bookings)), nil
+}
+
+func (r *BookingRepository) GetByChairAndDate(chairID uint, date time.Time) ([]*entities.Booking, error) {
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.Add(24 * time.Hour)
+	return r.filter(func(booking *entities.Booking) bool {
+		return booking.ChairID == chairID && !booking.StartTime.Before(startOfDay) && booking.StartTime.Before(endOfDay)
+	}), nil
+}
+
+func (r *BookingRepository) Update(booking *entities.Booking) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *booking
+	r.bookings[booking.ID] = &stored
+	return nil
+}
+
+func (r *BookingRepository) HasConflict(chairID uint, startTime, endTime time.Time, excludeBookingID *uint) (bool, error) {
+	conflicts := r.filter(func(booking *entities.Booking) bool {
+		if excludeBookingID != nil && booking.ID == *excludeBookingID {
+			return false
+		}
+		return booking.ChairID == chairID && booking.IsActive() && booking.StartTime.Before(endTime) && startTime.Before(booking.EndTime)
+	})
+	return len(conflicts) > 0, nil
+}
+
+func (r *BookingRepository) CountActiveByUser(userID uint, excludeBookingID *uint) (int64, error) {
+	return r.CountUserBookingsInRange(userID, time.Time{}, time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), excludeBookingID)
+}
+
+func (r *BookingRepository) CountUserBookingsInRange(userID uint, startTime, endTime time.Time, excludeBookingID *uint) (int64, error) {
+	bookings := r.filter(func(booking *entities.Booking) bool {
+		if excludeBookingID != nil && booking.ID == *excludeBookingID {
+			return false
+		}
+		return booking.UserID == userID && booking.IsActive() && !booking.StartTime.Before(startTime) && booking.StartTime.Before(endTime)
+	})
+	return int64(len(bookings)), nil
+}
+
+func (r *BookingRepository) GetScheduledEndedBefore(before time.Time) ([]*entities.Booking, error) {
+	return r.filter(func(booking *entities.Booking) bool {
+		return booking.Status == entities.BookingStatusScheduled && booking.EndTime.Before(before)
+	}), nil
+}
+
+func (r *BookingRepository) Cancel(id uint, actor entities.BookingActor, reason string) error {
+	return r.setStatus(id, entities.BookingStatusCancelled)
+}
+
+func (r *BookingRepository) ConfirmPresence(id uint, actor entities.BookingActor) error {
+	return r.setStatus(id, entities.BookingStatusPresenceConfirmed)
+}
+
+func (r *BookingRepository) MarkAsNoShow(id uint, actor entities.BookingActor, reason string) error {
+	return r.setStatus(id, entities.BookingStatusNoShow)
+}
+
+// setStatus grava o novo status do agendamento se a transição for permitida, como
+// o repositório real
+func (r *BookingRepository) setStatus(id uint, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	booking, ok := r.bookings[id]
+	if !ok {
+		return errNotFound
+	}
+	if err := entities.ValidateBookingTransition(booking.Status, status); err != nil {
+		return err
+	}
+	booking.Status = status
+	return nil
+}
+
+func (r *BookingRepository) CreateStatusHistory(entry *entities.BookingStatusHistory) error {
+	return nil
+}
+
+// filter devolve cópias dos agendamentos que atendem ao critério, em ordem de início
+func (r *BookingRepository) filter(match func(booking *entities.Booking) bool) []*entities.Booking {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var bookings []*entities.Booking
+	for _, booking := range r.bookings {
+		if match(booking) {
+			copied := *booking
+			bookings = append(bookings, &copied)
+		}
+	}
+	sort.Slice(bookings, func(i, j int) bool {
+		return bookings[i].StartTime.Before(bookings[j].StartTime)
+	})
+	return bookings
+}
+
+// SlotHoldRepository guarda as reservas temporárias em memória
+type SlotHoldRepository struct {
+	repositories.SlotHoldRepository
+	mu    sync.Mutex
+	holds []*entities.SlotHold
+}
+
+func (r *SlotHoldRepository) Create(hold *entities.SlotHold) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hold.ID = uint(len(r.holds) + 1)
+	stored := *hold
+	r.holds = append(r.holds, &stored)
+	return nil
+}
+
+func (r *SlotHoldRepository) GetByToken(token string) (*entities.SlotHold, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, hold := range r.holds {
+		if hold.Token == token {
+			copied := *hold
+			return &copied, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *SlotHoldRepository) Update(hold *entities.SlotHold) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, stored := range r.holds {
+		if stored.ID == hold.ID {
+			updated := *hold
+			r.holds[i] = &updated
+			return nil
+		}
+	}
+	return errNotFound
+}
+
+func (r *SlotHoldRepository) HasActiveHold(chairID uint, startTime, endTime time.Time, now time.Time, excludeToken string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, hold := range r.holds {
+		if hold.ChairID == chairID && hold.Token != excludeToken && hold.IsActiveAt(now) && hold.Overlaps(startTime, endTime) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *SlotHoldRepository) ExpireStale(now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var expired int64
+	for _, hold := range r.holds {
+		if hold.Status == entities.SlotHoldStatusActive && !now.Before(hold.ExpiresAt) {
+			hold.Status = entities.SlotHoldStatusExpired
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+func (r *SlotHoldRepository) ReleaseActiveByWaitlistEntry(entryID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, hold := range r.holds {
+		if hold.WaitlistEntryID != nil && *hold.WaitlistEntryID == entryID && hold.Status == entities.SlotHoldStatusActive {
+			hold.Release()
+		}
+	}
+	return nil
+}
+
+// ActiveOfferHold devolve a reserva ativa da oferta da entrada da lista de espera
+func (r *SlotHoldRepository) ActiveOfferHold(entryID uint) *entities.SlotHold {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, hold := range r.holds {
+		if hold.WaitlistEntryID != nil && *hold.WaitlistEntryID == entryID && hold.Status == entities.SlotHoldStatusActive {
+			copied := *hold
+			return &copied
+		}
+	}
+	return nil
+}
+
+// WaitlistRepository guarda as entradas da lista de espera em memória
+type WaitlistRepository struct {
+	repositories.WaitlistRepository
+	mu      sync.Mutex
+	entries []*entities.WaitlistEntry
+}
+
+// Add cadastra as entradas informadas
+func (r *WaitlistRepository) Add(entries ...*entities.WaitlistEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entries...)
+}
+
+// Get devolve uma cópia da entrada (nil se não existir)
+func (r *WaitlistRepository) Get(id uint) *entities.WaitlistEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.entries {
+		if entry.ID == id {
+			copied := *entry
+			return &copied
+		}
+	}
+	return nil
+}
+
+func (r *WaitlistRepository) Update(entry *entities.WaitlistEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, stored := range r.entries {
+		if stored.ID == entry.ID {
+			updated := *entry
+			r.entries[i] = &updated
+			return nil
+		}
+	}
+	return errNotFound
+}
+
+func (r *WaitlistRepository) GetWaitingForChairAndDate(chairID uint, date time.Time) ([]*entities.WaitlistEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var waiting []*entities.WaitlistEntry
+	for _, entry := range r.entries {
+		if entry.ChairID == chairID && entry.Status == entities.WaitlistStatusWaiting {
+			copied := *entry
+			waiting = append(waiting, &copied)
+		}
+	}
+	return waiting, nil
+}
+
+func (r *WaitlistRepository) GetByOfferTokenHash(tokenHash string) (*entities.WaitlistEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.entries {
+		if entry.OfferTokenHash != nil && *entry.OfferTokenHash == tokenHash {
+			copied := *entry
+			return &copied, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *WaitlistRepository) ClaimOffer(id uint, tokenHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.entries {
+		if entry.ID == id && entry.Status == entities.WaitlistStatusOffered &&
+			entry.OfferTokenHash != nil && *entry.OfferTokenHash == tokenHash {
+			entry.OfferTokenHash = nil
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// BookingPolicyRepository devolve as políticas cadastradas no teste
+type BookingPolicyRepository struct {
+	repositories.BookingPolicyRepository
+	Policies []*entities.BookingPolicy
+}
+
+func (r *BookingPolicyRepository) GetActive() ([]*entities.BookingPolicy, error) {
+	return r.Policies, nil
+}
+
+// PenaltyRepository guarda as penalidades registradas em memória, sem suspensões
+type PenaltyRepository struct {
+	repositories.PenaltyRepository
+	mu        sync.Mutex
+	Penalties []*entities.BookingPenalty
+}
+
+func (r *PenaltyRepository) CreatePenalty(penalty *entities.BookingPenalty) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	penalty.ID = uint(len(r.Penalties) + 1)
+	r.Penalties = append(r.Penalties, penalty)
+	return nil
+}
+
+func (r *PenaltyRepository) CountPenaltiesSince(userID uint, penaltyType string, since time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var count int64
+	for _, penalty := range r.Penalties {
+		if penalty.UserID == userID && penalty.Type == penaltyType && !penalty.OccurredAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *PenaltyRepository) GetActiveSuspension(userID uint, now time.Time) (*entities.BookingSuspension, error) {
+	return nil, nil
+}
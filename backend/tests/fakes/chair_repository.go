@@ -0,0 +1,54 @@
+package fakes
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// ChairRepository devolve as cadeiras cadastradas no teste, sem manutenções
+type ChairRepository struct {
+	repositories.ChairRepository
+	Chairs map[uint]*entities.Chair
+}
+
+func (r *ChairRepository) GetByID(id uint) (*entities.Chair, error) {
+	chair, ok := r.Chairs[id]
+	if !ok {
+		return nil, errNotFound
+	}
+	return chair, nil
+}
+
+func (r *ChairRepository) HasMaintenanceInPeriod(chairID uint, startTime, endTime time.Time) (bool, error) {
+	return false, nil
+}
+
+// AvailabilityRepository considera as cadeiras sempre disponíveis
+type AvailabilityRepository struct {
+	repositories.AvailabilityRepository
+}
+
+func (AvailabilityRepository) IsChairAvailableForPeriod(chairID uint, startTime, endTime time.Time) (bool, error) {
+	return true, nil
+}
+
+// TherapistRepository não tem turnos cadastrados
+type TherapistRepository struct {
+	repositories.TherapistRepository
+}
+
+func (TherapistRepository) GetShiftsByChairs(chairIDs []uint) ([]*entities.TherapistShift, error) {
+	return nil, nil
+}
+
+// LocationRepository devolve a localidade de cada cadeira (nil = sem localidade)
+type LocationRepository struct {
+	repositories.LocationRepository
+	ByChair map[uint]*entities.Location
+}
+
+func (r *LocationRepository) GetByChair(chairID uint) (*entities.Location, error) {
+	return r.ByChair[chairID], nil
+}
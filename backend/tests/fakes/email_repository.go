@@ -0,0 +1,54 @@
+package fakes
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// EmailRepository entrega em canais os agendamentos dos emails enviados em segundo plano
+type EmailRepository struct {
+	repositories.EmailRepository
+	Rescheduled    chan entities.Booking
+	Cancelled      chan entities.Booking
+	WaitlistOffers chan WaitlistOffer
+	Reminders      chan string // token de confirmação de cada lembrete
+}
+
+func NewEmailRepository() *EmailRepository {
+	return &EmailRepository{
+		Rescheduled:    make(chan entities.Booking, 10),
+		Cancelled:      make(chan entities.Booking, 10),
+		WaitlistOffers: make(chan WaitlistOffer, 10),
+		Reminders:      make(chan string, 10),
+	}
+}
+
+func (r *EmailRepository) SendBookingConfirmation(user *entities.User, booking *entities.Booking) error {
+	return nil
+}
+
+func (r *EmailRepository) SendBookingRescheduled(user *entities.User, booking *entities.Booking) error {
+	r.Rescheduled <- *booking
+	return nil
+}
+
+func (r *EmailRepository) SendBookingCancellation(user *entities.User, booking *entities.Booking, reason string) error {
+	r.Cancelled <- *booking
+	return nil
+}
+
+// WaitlistOffer é a oferta da lista de espera enviada por email, com o token do link
+type WaitlistOffer struct {
+	Entry entities.WaitlistEntry
+	Token string
+}
+
+func (r *EmailRepository) SendWaitlistOffer(user *entities.User, entry *entities.WaitlistEntry, chair *entities.Chair, offerToken string) error {
+	r.WaitlistOffers <- WaitlistOffer{Entry: *entry, Token: offerToken}
+	return nil
+}
+
+func (r *EmailRepository) SendBookingReminder(user *entities.User, booking *entities.Booking, confirmationToken string) error {
+	r.Reminders <- confirmationToken
+	return nil
+}
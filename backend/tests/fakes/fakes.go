@@ -0,0 +1,43 @@
+// Package fakes reúne os repositórios em memória usados pelos testes de casos de uso
+// e de handlers. Cada fake implementa só o que os fluxos testados usam; os demais
+// métodos das interfaces ficam sem implementação e falham se chamados
+package fakes
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// errNotFound imita o registro não encontrado dos repositórios
+var errNotFound = errors.New("not found")
+
+// TokenSigner "assina" prefixando o conteúdo; basta para os casos de uso, que só
+// dependem de Verify devolver o que Sign recebeu
+type TokenSigner struct{}
+
+func (TokenSigner) Sign(payload string) string {
+	return "assinado." + payload
+}
+
+func (TokenSigner) Verify(token string) (string, error) {
+	payload, ok := strings.CutPrefix(token, "assinado.")
+	if !ok {
+		return "", errors.New("assinatura inválida")
+	}
+	return payload, nil
+}
+
+// Receive aguarda o email enviado em segundo plano
+func Receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case value := <-ch:
+		return value
+	case <-time.After(2 * time.Second):
+		t.Fatal("email não enviado")
+		var zero T
+		return zero
+	}
+}
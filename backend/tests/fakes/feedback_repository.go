@@ -0,0 +1,27 @@
+package fakes
+
+import (
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// FeedbackRepository guarda as avaliações registradas em memória
+type FeedbackRepository struct {
+	repositories.FeedbackRepository
+	Feedbacks []*entities.BookingFeedback
+}
+
+func (r *FeedbackRepository) Create(feedback *entities.BookingFeedback) error {
+	feedback.ID = uint(len(r.Feedbacks) + 1)
+	r.Feedbacks = append(r.Feedbacks, feedback)
+	return nil
+}
+
+func (r *FeedbackRepository) ExistsForBooking(bookingID uint) (bool, error) {
+	for _, feedback := range r.Feedbacks {
+		if feedback.BookingID == bookingID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,58 @@
+package fakes
+
+import (
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+)
+
+// PasswordResetRepository guarda os pedidos de redefinição de senha em memória
+type PasswordResetRepository struct {
+	Tokens []*entities.PasswordResetToken
+}
+
+func (r *PasswordResetRepository) Create(token *entities.PasswordResetToken) error {
+	token.ID = uint(len(r.Tokens) + 1)
+	token.CreatedAt = time.Now()
+	r.Tokens = append(r.Tokens, token)
+	return nil
+}
+
+func (r *PasswordResetRepository) GetByHash(tokenHash string) (*entities.PasswordResetToken, error) {
+	for _, token := range r.Tokens {
+		if token.TokenHash == tokenHash {
+			copied := *token
+			return &copied, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *PasswordResetRepository) CountRequestedSince(userID uint, since time.Time) (int64, error) {
+	var count int64
+	for _, token := range r.Tokens {
+		if token.UserID == userID && !token.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *PasswordResetRepository) MarkUsed(id uint, usedAt time.Time) (bool, error) {
+	for _, token := range r.Tokens {
+		if token.ID == id && token.UsedAt == nil {
+			token.UsedAt = &usedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *PasswordResetRepository) InvalidateByUser(userID uint, usedAt time.Time) error {
+	for _, token := range r.Tokens {
+		if token.UserID == userID && token.UsedAt == nil {
+			token.UsedAt = &usedAt
+		}
+	}
+	return nil
+}
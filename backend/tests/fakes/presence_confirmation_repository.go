@@ -0,0 +1,88 @@
+package fakes
+
+import (
+	"sync"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// PresenceConfirmationRepository guarda os links de confirmação de presença em memória
+type PresenceConfirmationRepository struct {
+	repositories.PresenceConfirmationRepository
+	mu            sync.Mutex
+	confirmations []*entities.PresenceConfirmation
+}
+
+func (r *PresenceConfirmationRepository) Create(confirmation *entities.PresenceConfirmation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	confirmation.ID = uint(len(r.confirmations) + 1)
+	stored := *confirmation
+	r.confirmations = append(r.confirmations, &stored)
+	return nil
+}
+
+func (r *PresenceConfirmationRepository) GetByNonce(nonce string) (*entities.PresenceConfirmation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, confirmation := range r.confirmations {
+		if confirmation.Nonce == nonce {
+			copied := *confirmation
+			return &copied, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *PresenceConfirmationRepository) MarkUsed(id uint, result string, usedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, confirmation := range r.confirmations {
+		if confirmation.ID == id {
+			if confirmation.IsUsed() {
+				return false, nil
+			}
+			confirmation.UsedAt = &usedAt
+			confirmation.Result = result
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *PresenceConfirmationRepository) ClearUsed(id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, confirmation := range r.confirmations {
+		if confirmation.ID == id {
+			confirmation.UsedAt = nil
+			confirmation.Result = ""
+		}
+	}
+	return nil
+}
+
+func (r *PresenceConfirmationRepository) GetPendingExpired(now time.Time) ([]*entities.PresenceConfirmation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pending []*entities.PresenceConfirmation
+	for _, confirmation := range r.confirmations {
+		if !confirmation.IsUsed() && confirmation.IsExpiredAt(now) {
+			copied := *confirmation
+			pending = append(pending, &copied)
+		}
+	}
+	return pending, nil
+}
+
+// PassDeadline encerra o prazo de resposta de todos os links, como se o horário
+// de corte já tivesse passado
+func (r *PresenceConfirmationRepository) PassDeadline() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, confirmation := range r.confirmations {
+		confirmation.ExpiresAt = time.Now().Add(-time.Minute)
+	}
+}
@@ -0,0 +1,76 @@
+package fakes
+
+import (
+	"sync"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	"agendamento-backend/internal/domain/repositories"
+)
+
+// UserRepository guarda os usuários em memória e conta as escritas feitas nas contas
+type UserRepository struct {
+	repositories.UserRepository
+	mu        sync.Mutex
+	users     map[uint]*entities.User
+	Verified  int
+	Approvals int
+}
+
+func NewUserRepository(users ...*entities.User) *UserRepository {
+	r := &UserRepository{users: make(map[uint]*entities.User)}
+	for _, user := range users {
+		r.users[user.ID] = user
+	}
+	return r
+}
+
+func (r *UserRepository) GetByID(id uint) (*entities.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *UserRepository) MarkEmailVerified(id uint, verifiedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return errNotFound
+	}
+	r.Verified++
+	user.EmailVerifiedAt = &verifiedAt
+	return nil
+}
+
+func (r *UserRepository) Approve(id uint, approvedBy *uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return errNotFound
+	}
+	r.Approvals++
+	user.Status = "aprovado"
+	user.Role = user.RequestedRole
+	return nil
+}
+
+// AuditLogRepository guarda os registros de auditoria
+type AuditLogRepository struct {
+	repositories.AuditLogRepository
+	mu   sync.Mutex
+	Logs []*entities.AuditLog
+}
+
+func (r *AuditLogRepository) Create(auditLog *entities.AuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Logs = append(r.Logs, auditLog)
+	return nil
+}
@@ -0,0 +1,194 @@
+package integration
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"agendamento-backend/internal/domain/entities"
+	domainrepositories "agendamento-backend/internal/domain/repositories"
+	"agendamento-backend/internal/infrastructure/database"
+	"agendamento-backend/internal/infrastructure/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupTestDatabase conecta ao Postgres de testes indicado em TEST_DATABASE_DSN.
+// Sem a variável o teste é ignorado, pois a garantia depende do banco real
+func setupTestDatabase(t *testing.T) *gorm.DB {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN não definido; teste de concorrência requer Postgres")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, (&database.Database{DB: db}).AutoMigrate())
+	return db
+}
+
+// TestBookingCreate_ConcurrentSameSlot dispara várias criações simultâneas para o mesmo
+// horário da mesma cadeira e verifica que apenas uma é gravada
+func TestBookingCreate_ConcurrentSameSlot(t *testing.T) {
+	db := setupTestDatabase(t)
+	bookingRepo := repositories.NewBookingRepository(db)
+
+	suffix := time.Now().UnixNano()
+	chair := &entities.Chair{Name: fmt.Sprintf("Cadeira concorrência %d", suffix), Location: "Teste", Status: "ativa"}
+	require.NoError(t, db.Create(chair).Error)
+
+	const attempts = 10
+	users := createTestUsers(t, db, suffix, attempts)
+	cleanupChair(t, db, chair, users)
+
+	startTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+	created, conflicts := createConcurrently(t, bookingRepo, users, func(int) *entities.Booking {
+		return &entities.Booking{
+			ChairID:   chair.ID,
+			StartTime: startTime,
+			EndTime:   startTime.Add(entities.DefaultSessionDuration),
+			Status:    "agendado",
+		}
+	})
+
+	assert.Equal(t, 1, created)
+	assert.Equal(t, attempts-1, conflicts)
+
+	// Um horário sobreposto parcialmente também é barrado
+	overlapping := &entities.Booking{
+		UserID:    users[0].ID,
+		ChairID:   chair.ID,
+		StartTime: startTime.Add(15 * time.Minute),
+		EndTime:   startTime.Add(45 * time.Minute),
+		Status:    "agendado",
+	}
+	assert.ErrorIs(t, bookingRepo.Create(overlapping), entities.ErrBookingConflict)
+
+	// Agendamentos cancelados liberam o horário
+	var booking entities.Booking
+	require.NoError(t, db.Where("chair_id = ? AND status = ?", chair.ID, "agendado").First(&booking).Error)
+	require.NoError(t, bookingRepo.Cancel(booking.ID, entities.SystemBookingActor(), "Teste de concorrência"))
+
+	rebooked := &entities.Booking{
+		UserID:    users[1].ID,
+		ChairID:   chair.ID,
+		StartTime: startTime,
+		EndTime:   startTime.Add(entities.DefaultSessionDuration),
+		Status:    "agendado",
+	}
+	assert.NoError(t, bookingRepo.Create(rebooked))
+}
+
+// TestBookingCreate_ConcurrentWithinBuffer dispara criações simultâneas em horários que
+// não se sobrepõem, mas ficam dentro da folga de higienização um do outro. A restrição
+// do banco não cobre a folga, então só o bloqueio da cadeira impede os dois de entrar
+func TestBookingCreate_ConcurrentWithinBuffer(t *testing.T) {
+	db := setupTestDatabase(t)
+	bookingRepo := repositories.NewBookingRepository(db)
+
+	suffix := time.Now().UnixNano()
+	chair := &entities.Chair{Name: fmt.Sprintf("Cadeira folga %d", suffix), Location: "Teste", Status: "ativa", BufferMinutes: 15}
+	require.NoError(t, db.Create(chair).Error)
+
+	const attempts = 10
+	users := createTestUsers(t, db, suffix, attempts)
+	cleanupChair(t, db, chair, users)
+
+	// O segundo horário começa 5 minutos depois do fim do primeiro
+	firstStart := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	secondStart := firstStart.Add(entities.DefaultSessionDuration + 5*time.Minute)
+
+	created, conflicts := createConcurrently(t, bookingRepo, users, func(i int) *entities.Booking {
+		startTime := firstStart
+		if i%2 == 1 {
+			startTime = secondStart
+		}
+		return &entities.Booking{
+			ChairID:   chair.ID,
+			StartTime: startTime,
+			EndTime:   startTime.Add(entities.DefaultSessionDuration),
+			Status:    "agendado",
+		}
+	})
+
+	assert.Equal(t, 1, created)
+	assert.Equal(t, attempts-1, conflicts)
+}
+
+// createTestUsers grava n usuários aprovados com dados únicos para o sufixo
+func createTestUsers(t *testing.T, db *gorm.DB, suffix int64, n int) []*entities.User {
+	users := make([]*entities.User, n)
+	for i := range users {
+		users[i] = &entities.User{
+			Name:          fmt.Sprintf("Usuário %d", i),
+			CPF:           fmt.Sprintf("%d%02d", suffix%1000000000, i),
+			Email:         fmt.Sprintf("concorrencia%d_%d@teste.com", suffix, i),
+			Phone:         fmt.Sprintf("119%08d", i),
+			Password:      "hash",
+			Role:          "usuario",
+			RequestedRole: "usuario",
+			Status:        "aprovado",
+			Gender:        "outro",
+		}
+		require.NoError(t, db.Create(users[i]).Error)
+	}
+	return users
+}
+
+// cleanupChair remove ao fim do teste a cadeira, seus agendamentos e os usuários
+func cleanupChair(t *testing.T, db *gorm.DB, chair *entities.Chair, users []*entities.User) {
+	t.Cleanup(func() {
+		db.Unscoped().Where("chair_id = ?", chair.ID).Delete(&entities.Booking{})
+		db.Unscoped().Delete(chair)
+		for _, user := range users {
+			db.Unscoped().Delete(user)
+		}
+	})
+}
+
+// createConcurrently cria ao mesmo tempo um agendamento por usuário, montado por
+// newBooking, e conta quantos foram gravados e quantos recusados por conflito
+func createConcurrently(t *testing.T, bookingRepo domainrepositories.BookingRepository, users []*entities.User, newBooking func(i int) *entities.Booking) (created, conflicts int) {
+	var wg sync.WaitGroup
+	results := make(chan error, len(users))
+	ready := make(chan struct{})
+
+	for i, user := range users {
+		booking := newBooking(i)
+		booking.UserID = user.ID
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ready
+			results <- bookingRepo.Create(booking)
+		}()
+	}
+
+	close(ready)
+	wg.Wait()
+	close(results)
+
+	for err := range results {
+		switch {
+		case err == nil:
+			created++
+		case errors.Is(err, entities.ErrBookingConflict):
+			conflicts++
+		default:
+			t.Errorf("erro inesperado: %v", err)
+		}
+	}
+	return created, conflicts
+}
@@ -107,19 +107,18 @@ export const userService = {
     throw new Error('Endpoint /users/change-password não implementado no backend')
   },
 
-  // Solicitar redefinição de senha
-  // TODO: Implementar endpoint /users/forgot-password no backend
-  async requestPasswordReset(_email: string): Promise<void> {
-    throw new Error('Endpoint /users/forgot-password não implementado no backend')
+  // Solicitar redefinição de senha (CPF ou email). O link enviado abre a página
+  // de redefinição servida pela API
+  async requestPasswordReset(identifier: string): Promise<void> {
+    await api.post('/api/auth/forgot-password', { identifier })
   },
 
   // Redefinir senha com token
-  // TODO: Implementar endpoint /users/reset-password no backend
-  async resetPassword(_data: {
+  async resetPassword(data: {
     token: string
     newPassword: string
   }): Promise<void> {
-    throw new Error('Endpoint /users/reset-password não implementado no backend')
+    await api.post('/api/auth/reset-password', { token: data.token, password: data.newPassword })
   },
 
   // Verificar se CPF já existe